@@ -25,11 +25,13 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/prometheus-operator/prometheus-operator/internal/goruntime"
 	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
 	"github.com/prometheus-operator/prometheus-operator/internal/metrics"
 	"github.com/prometheus-operator/prometheus-operator/pkg/admission"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/server"
 	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
 )
@@ -42,9 +44,18 @@ func main() {
 		flagset       = flag.CommandLine
 		logConfig     logging.Config
 		memlimitRatio float64
+		defaults      admission.MonitorDefaults
+		// Whether the webhook warns about missing references.
+		warnMissingReferences bool
+		kubeconfigPath        string
+		ruleCostLimits        admission.RuleCostLimits
 	)
 
 	server.RegisterFlags(flagset, &serverConfig)
+	admission.RegisterFlags(flagset, &defaults)
+	admission.RegisterRuleCostFlags(flagset, &ruleCostLimits)
+	flagset.BoolVar(&warnMissingReferences, "admission.warn-missing-references", false, "Check that the Secrets and ConfigMaps referenced by the ServiceMonitors, PodMonitors, Probes, ScrapeConfigs and AlertmanagerConfigs exist when validating them. The missing references are returned as admission warnings, the objects aren't rejected. It requires the permissions to get the Secrets and ConfigMaps. Default: false.")
+	flagset.StringVar(&kubeconfigPath, "kubeconfig", "", "Path to a kubeconfig file used when --admission.warn-missing-references is enabled. If empty, the in-cluster configuration is used.")
 	versionutil.RegisterFlags(flagset)
 	logging.RegisterFlags(flagset, &logConfig)
 
@@ -62,6 +73,16 @@ func main() {
 		stdlog.Fatal(err)
 	}
 
+	if err := defaults.Validate(); err != nil {
+		logger.Error("invalid defaults", "err", err)
+		os.Exit(1)
+	}
+
+	if err := ruleCostLimits.Validate(); err != nil {
+		logger.Error("invalid rule cost limits", "err", err)
+		os.Exit(1)
+	}
+
 	goruntime.SetMaxProcs(logger)
 	goruntime.SetMemLimit(logger, memlimitRatio)
 
@@ -69,12 +90,32 @@ func main() {
 	defer cancel()
 	wg, ctx := errgroup.WithContext(ctx)
 
+	r := metrics.NewRegistry("prometheus_operator_admission_webhook")
+
+	opts := []admission.Option{
+		admission.WithRuleCostLimits(ruleCostLimits),
+		admission.WithRegisterer(r),
+	}
+	if warnMissingReferences {
+		restConfig, err := k8sutil.NewClusterConfig(k8sutil.ClusterConfig{KubeconfigPath: kubeconfigPath})
+		if err != nil {
+			logger.Error("failed to create Kubernetes client configuration", "err", err)
+			os.Exit(1)
+		}
+
+		kclient, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			logger.Error("failed to create Kubernetes client", "err", err)
+			os.Exit(1)
+		}
+
+		opts = append(opts, admission.WithReferenceChecks(kclient))
+	}
+
 	mux := http.NewServeMux()
-	admit := admission.New(logger.With("component", "admissionwebhook"))
+	admit := admission.New(logger.With("component", "admissionwebhook"), defaults, opts...)
 	admit.Register(mux)
 
-	r := metrics.NewRegistry("prometheus_operator_admission_webhook")
-
 	mux.Handle("/metrics", promhttp.HandlerFor(r, promhttp.HandlerOpts{}))
 
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
@@ -82,7 +123,7 @@ func main() {
 		w.Write([]byte(`{"status":"up"}`))
 	})
 
-	srv, err := server.NewServer(logger, &serverConfig, mux)
+	srv, err := server.NewServer(logger, &serverConfig, mux, server.WithRegisterer(r))
 	if err != nil {
 		logger.Error("failed to create web server", "err", err)
 		os.Exit(1)
@@ -24,20 +24,24 @@ import (
 	"net/http/pprof"
 	"os"
 	"os/signal"
+	"slices"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/blang/semver/v4"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/prometheus/common/version"
 	"golang.org/x/sync/errgroup"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	discoveryv1 "k8s.io/api/discovery/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport"
 	k8sflag "k8s.io/component-base/cli/flag"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -50,9 +54,11 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/kubelet"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 	prometheusagentcontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/agent"
 	prometheuscontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/server"
 	"github.com/prometheus-operator/prometheus-operator/pkg/server"
@@ -96,6 +102,96 @@ func checkPrerequisites(
 	return true, nil
 }
 
+// remoteControllers reconcile the Prometheus and Alertmanager resources of a
+// remote cluster.
+type remoteControllers struct {
+	prometheus   *prometheuscontroller.Operator
+	alertmanager *alertmanagercontroller.Operator
+}
+
+func newRemoteControllers(
+	ctx context.Context,
+	logger *slog.Logger,
+	r prometheus.Registerer,
+	cfg operator.Config,
+	rc operator.RemoteCluster,
+	promOptions []prometheuscontroller.ControllerOption,
+	amOptions []alertmanagercontroller.ControllerOption,
+) (*remoteControllers, error) {
+	logger = logger.With("cluster", rc.Name)
+	r = prometheus.WrapRegistererWith(prometheus.Labels{"cluster": rc.Name}, r)
+
+	kclient, err := kubernetes.NewForConfig(rc.RESTConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	kubernetesVersion, err := kclient.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to request Kubernetes server version: %w", err)
+	}
+
+	cfg.KubernetesVersion, err = semver.ParseTolerant(kubernetesVersion.String())
+	if err != nil {
+		cfg.KubernetesVersion = semver.MustParse("1.16.0")
+		logger.Warn("failed to parse Kubernetes version", "version", kubernetesVersion.String(), "err", err)
+	}
+	logger.Info("connection established with the remote cluster", "kubernetes_version", cfg.KubernetesVersion.String())
+
+	if cfg.KubernetesVersion.GTE(semver.MustParse("1.21.0")) {
+		promOptions = append(slices.Clone(promOptions), prometheuscontroller.WithEndpointSlice())
+	}
+
+	po, err := prometheuscontroller.New(ctx, rc.RESTConfig, cfg, logger, r, promOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating prometheus controller failed: %w", err)
+	}
+
+	ao, err := alertmanagercontroller.New(ctx, rc.RESTConfig, cfg, logger, r, amOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating alertmanager controller failed: %w", err)
+	}
+
+	return &remoteControllers{
+		prometheus:   po,
+		alertmanager: ao,
+	}, nil
+}
+
+// logSettings merges the log settings of the settings file and of the
+// OperatorConfiguration resource, the latter taking precedence.
+type logSettings struct {
+	router *logging.Router
+
+	mtx                   sync.Mutex
+	file                  logging.Settings
+	operatorConfiguration logging.Settings
+}
+
+func (ls *logSettings) setFile(s logging.Settings) error {
+	ls.mtx.Lock()
+	defer ls.mtx.Unlock()
+
+	if err := ls.router.Update(s, ls.operatorConfiguration); err != nil {
+		return err
+	}
+	ls.file = s
+
+	return nil
+}
+
+func (ls *logSettings) setOperatorConfiguration(s logging.Settings) error {
+	ls.mtx.Lock()
+	defer ls.mtx.Unlock()
+
+	if err := ls.router.Update(ls.file, s); err != nil {
+		return err
+	}
+	ls.operatorConfiguration = s
+
+	return nil
+}
+
 const (
 	defaultReloaderCPU    = "10m"
 	defaultReloaderMemory = "50Mi"
@@ -106,7 +202,8 @@ const (
 var (
 	cfg = operator.DefaultConfig(defaultReloaderCPU, defaultReloaderMemory)
 
-	logConfig logging.Config
+	logConfig     logging.Config
+	logConfigFile string
 
 	impersonateUser string
 	apiServer       string
@@ -116,8 +213,22 @@ var (
 
 	serverConfig = server.DefaultConfig(":8080", false)
 
+	// Parameters for the profiling endpoints.
+	enablePprof        bool
+	pprofListenAddress string
+
 	disableUnmanagedPrometheusConfiguration bool
 
+	// Parameters for the Alertmanager configuration post-processor.
+	alertmanagerConfigPostProcessorURL     string
+	alertmanagerConfigPostProcessorTimeout time.Duration
+
+	// Parameters for the artifact store.
+	artifactStoreURL     string
+	artifactStoreTimeout time.Duration
+
+	enableWatchList bool
+
 	// Parameters for the kubelet endpoints controller.
 	kubeletObject        string
 	kubeletSelector      operator.LabelSelector
@@ -126,13 +237,30 @@ var (
 	kubeletEndpointSlice bool
 
 	featureGates = k8sflag.NewMapStringBool(ptr.To(map[string]bool{}))
+
+	// Name of the OperatorConfiguration resource.
+	operatorConfiguration string
+
+	// Default values set by the mutating admission webhook.
+	admissionDefaults admission.MonitorDefaults
+	// Whether the admission webhook warns about missing references.
+	admissionWarnMissingReferences bool
+	// Cost limits of the PrometheusRule expressions checked by the
+	// admission webhook.
+	admissionRuleCostLimits admission.RuleCostLimits
 )
 
 func parseFlags(fs *flag.FlagSet) {
 	// Web server settings.
 	server.RegisterFlags(fs, &serverConfig)
+	admission.RegisterFlags(fs, &admissionDefaults)
+	admission.RegisterRuleCostFlags(fs, &admissionRuleCostLimits)
+	fs.BoolVar(&admissionWarnMissingReferences, "admission.warn-missing-references", false, "Check that the Secrets and ConfigMaps referenced by the ServiceMonitors, PodMonitors, Probes, ScrapeConfigs and AlertmanagerConfigs exist when validating them. The missing references are returned as admission warnings, the objects aren't rejected. It requires the permissions to get the Secrets and ConfigMaps. Default: false.")
+	fs.BoolVar(&enablePprof, "debug.enable-pprof", false, "Expose the net/http/pprof profiling endpoints under /debug/pprof/. Default: false.")
+	fs.StringVar(&pprofListenAddress, "debug.pprof-listen-address", "", "Address on which to expose the profiling endpoints when --debug.enable-pprof is set. If empty, the endpoints are exposed on the web listener.")
 
 	// Kubernetes client-go settings.
+	fs.BoolVar(&enableWatchList, "enable-watch-list", false, "Use streaming lists (WatchList) instead of paginated LIST requests to populate the informer caches. It reduces the API server load and memory usage at start-up in large clusters. The operator falls back to LIST requests if the API server doesn't support the feature. Default: false.")
 	fs.StringVar(&impersonateUser, "as", "", "Username to impersonate. User could be a regular user or a service account in a namespace.")
 	fs.StringVar(&apiServer, "apiserver", "", "API Server addr, e.g. ' - NOT RECOMMENDED FOR PRODUCTION - http://127.0.0.1:8080'. Omit parameter to run in on-cluster mode and utilize the service account token.")
 	fs.StringVar(&tlsClientConfig.CertFile, "cert-file", "", " - NOT RECOMMENDED FOR PRODUCTION - Path to public TLS certificate file.")
@@ -143,7 +271,7 @@ func parseFlags(fs *flag.FlagSet) {
 	fs.StringVar(&kubeletObject, "kubelet-service", "", "Service/Endpoints object to write kubelets into in format \"namespace/name\"")
 	fs.Var(&kubeletSelector, "kubelet-selector", "Label selector to filter nodes.")
 	fs.Var(&nodeAddressPriority, "kubelet-node-address-priority", "Node address priority used by kubelet. Either 'internal' or 'external'. Default: 'internal'.")
-	fs.BoolVar(&kubeletEndpointSlice, "kubelet-endpointslice", false, "Create EndpointSlice objects for kubelet targets.")
+	fs.BoolVar(&kubeletEndpointSlice, "kubelet-endpointslice", false, "Create EndpointSlice objects for kubelet targets. The operator falls back to Endpoints objects if the Kubernetes API doesn't support EndpointSlice v1 or if it lacks the permissions on EndpointSlices.")
 	fs.BoolVar(&kubeletEndpoints, "kubelet-endpoints", true, "Create Endpoints objects for kubelet targets.")
 
 	// The Prometheus config reloader image is released along with the
@@ -175,17 +303,67 @@ func parseFlags(fs *flag.FlagSet) {
 	fs.StringVar(&cfg.LocalHost, "localhost", "localhost", "EXPERIMENTAL (could be removed in future releases) - Host used to communicate between local services on a pod. Fixes issues where localhost resolves incorrectly.")
 	fs.StringVar(&cfg.ClusterDomain, "cluster-domain", "", "The domain of the cluster. This is used to generate service FQDNs. If this is not specified, DNS search domain expansion is used instead.")
 
+	fs.IntVar(&cfg.Workers.Prometheus, "prometheus-workers", cfg.Workers.Prometheus, "Number of Prometheus resources reconciled concurrently.")
+	fs.IntVar(&cfg.Workers.PrometheusAgent, "prometheus-agent-workers", cfg.Workers.PrometheusAgent, "Number of PrometheusAgent resources reconciled concurrently.")
+	fs.IntVar(&cfg.Workers.Alertmanager, "alertmanager-workers", cfg.Workers.Alertmanager, "Number of Alertmanager resources reconciled concurrently.")
+	fs.IntVar(&cfg.Workers.ThanosRuler, "thanos-ruler-workers", cfg.Workers.ThanosRuler, "Number of ThanosRuler resources reconciled concurrently.")
+
+	fs.DurationVar(&cfg.ResyncPeriods.Prometheus, "prometheus-resync-period", cfg.ResyncPeriods.Prometheus, "Resync period of the informers used by the Prometheus and PrometheusAgent controllers. Every resync triggers the reconciliation of the Prometheus and PrometheusAgent resources. A value of 0 disables the periodic resync.")
+	fs.DurationVar(&cfg.ResyncPeriods.Alertmanager, "alertmanager-resync-period", cfg.ResyncPeriods.Alertmanager, "Resync period of the informers used by the Alertmanager controller. Every resync triggers the reconciliation of the Alertmanager resources. A value of 0 disables the periodic resync.")
+	fs.DurationVar(&cfg.ResyncPeriods.ThanosRuler, "thanos-ruler-resync-period", cfg.ResyncPeriods.ThanosRuler, "Resync period of the informers used by the ThanosRuler controller. Every resync triggers the reconciliation of the ThanosRuler resources. A value of 0 disables the periodic resync.")
+	fs.DurationVar(&cfg.ResyncPeriods.ScrapeConfig, "scrapeconfig-resync-period", cfg.ResyncPeriods.ScrapeConfig, "Resync period of the ScrapeConfig informers used by the Prometheus and PrometheusAgent controllers. A value of 0 disables the periodic resync.")
+
+	fs.BoolVar(&cfg.LeaderElection.Enabled, "leader-elect", false, "Enable the leader election to run several replicas of the operator. Only the leader reconciles the resources.")
+	fs.StringVar(&cfg.LeaderElection.LeaseName, "leader-election-lease-name", cfg.LeaderElection.LeaseName, "Name of the Lease object used for the leader election.")
+	fs.StringVar(&cfg.LeaderElection.LeaseNamespace, "leader-election-lease-namespace", "", "Namespace of the Lease object used for the leader election. Defaults to the namespace of the operator's service account.")
+	fs.DurationVar(&cfg.LeaderElection.LeaseDuration, "leader-election-lease-duration", cfg.LeaderElection.LeaseDuration, "Duration that the non-leader replicas wait before trying to acquire the leadership.")
+	fs.DurationVar(&cfg.LeaderElection.RenewDeadline, "leader-election-renew-deadline", cfg.LeaderElection.RenewDeadline, "Duration that the leader retries refreshing the leadership before giving it up.")
+	fs.DurationVar(&cfg.LeaderElection.RetryPeriod, "leader-election-retry-period", cfg.LeaderElection.RetryPeriod, "Duration between the attempts to acquire or renew the leadership.")
+
+	fs.BoolVar(&cfg.Sharding.Enabled, "sharding", false, "Enable the horizontal sharding to run several active replicas of the operator. The Alertmanager, Prometheus, PrometheusAgent and ThanosRuler resources are split between the replicas by consistent hashing of their namespace and name. It can't be used with --leader-elect.")
+	fs.StringVar(&cfg.Sharding.GroupName, "sharding-group-name", cfg.Sharding.GroupName, "Name of the sharding group. The replicas sharing the same group name and lease namespace split the resources between them.")
+	fs.StringVar(&cfg.Sharding.LeaseNamespace, "sharding-lease-namespace", "", "Namespace of the Lease objects used for the sharding membership. Defaults to the namespace of the operator's service account.")
+	fs.DurationVar(&cfg.Sharding.LeaseDuration, "sharding-lease-duration", cfg.Sharding.LeaseDuration, "Duration after which a replica which hasn't renewed its Lease is removed from the sharding group.")
+	fs.DurationVar(&cfg.Sharding.RenewInterval, "sharding-renew-interval", cfg.Sharding.RenewInterval, "Interval at which the replicas renew their Lease and refresh the sharding membership.")
+
+	fs.Var(&cfg.RemoteClusters.SecretSelector, "remote-cluster-secret-selector", "Label selector of the Secrets holding the kubeconfig (under the kubeconfig key) of remote clusters. The operator reconciles the Prometheus and Alertmanager resources of each remote cluster while the ServiceMonitors, PodMonitors, Probes, ScrapeConfigs and PrometheusRules are selected from the local cluster. The Secrets are read at start-up. If empty, the multi-cluster mode is disabled.")
+	fs.BoolVar(&cfg.SelfMonitoring.Enabled, "self-monitoring", false, "Create a ServiceMonitor scraping the operator, a PodMonitor scraping the managed workloads and their config-reloader sidecars in all namespaces, and a PrometheusRule with the baseline alerts. The resources are named \""+operator.SelfMonitoringName+"\".")
+	fs.StringVar(&cfg.SelfMonitoring.Namespace, "self-monitoring-namespace", "", "Namespace of the self-monitoring resources. Defaults to the namespace of the operator's service account.")
+	fs.Var(&cfg.SelfMonitoring.ServiceSelector, "self-monitoring-service-selector", "Label selector of the operator's Service scraped by the self-monitoring ServiceMonitor.")
+	fs.StringVar(&cfg.SelfMonitoring.PortName, "self-monitoring-port", cfg.SelfMonitoring.PortName, "Name of the operator's Service port scraped by the self-monitoring ServiceMonitor.")
+	fs.Var(&cfg.SelfMonitoring.Labels, "self-monitoring-labels", "Labels added to the self-monitoring resources (e.g. to match the selectors of the Prometheus resource).")
+
+	fs.StringVar(&cfg.RemoteClusters.SecretNamespace, "remote-cluster-secret-namespace", "", "Namespace of the remote cluster Secrets. Defaults to the namespace of the operator's service account.")
+
+	fs.Var(&cfg.Mode, "mode", "Mode of operation. Either 'reconcile' or 'audit'. In audit mode, the operator computes the desired objects and sends all the write requests to the API server as dry-run requests: nothing is persisted. The differences between the live and the desired StatefulSets, Secrets and ConfigMaps are logged and counted by the prometheus_operator_audit_changes_total metric. The artifact store and the OperatorExec configuration reloads (the exec requests can't be sent as dry-run requests) are disabled in audit mode and the mode can't be used with --leader-elect or --sharding (the Lease objects can't be written). Default: 'reconcile'.")
+
+	fs.IntVar(&cfg.MaxConcurrentWorkloadRollouts, "max-concurrent-workload-rollouts", 0, "Maximum number of StatefulSets (Prometheus, PrometheusAgent, Alertmanager and ThanosRuler) rolled out concurrently by the operator. The other updates are queued until a rollout completes. A value of 0 means no limit.")
+	fs.Float64Var(&cfg.GoRuntime.MemLimitRatio, "workload-auto-gomemlimit-ratio", 0, "Ratio of the memory limit of the Prometheus, Alertmanager and Thanos containers used to set their GOMEMLIMIT environment variable. The value should be greater than or equal to 0.0 and less than 1.0. The containers without memory limit are left unchanged. Default: 0.0 (disabled).")
+	fs.BoolVar(&cfg.GoRuntime.MaxProcs, "workload-auto-gomaxprocs", false, "Set the GOMAXPROCS environment variable of the Prometheus, Alertmanager and Thanos containers to their CPU limit rounded up to the next integer. The containers without CPU limit are left unchanged. Default: false.")
+	fs.BoolVar(&cfg.TLSAssetsProjection, "tls-assets-projection", false, "Mount the TLS assets referenced by the Prometheus, PrometheusAgent, Alertmanager and ThanosRuler configurations under paths preserving their namespace, name and key (e.g. <namespace>/secret/<name>/<key>). The assets located in the namespace of the workload are projected from their Secrets and ConfigMaps instead of being copied into the tls-assets Secrets. Changing the set of referenced assets updates the pod template.")
+	fs.Var(&cfg.StatefulSetRecreationPolicy, "statefulset-recreation-policy", "Policy used to delete the StatefulSets (Prometheus, PrometheusAgent, Alertmanager and ThanosRuler) which need to be recreated because of changes to immutable fields. With \"cascade\", the pods are deleted with the StatefulSet. With \"orphan\", the pods keep running and are adopted by the new StatefulSet (\"cascade\" is used when the selector changes).")
+
+	fs.IntVar(&cfg.StatusWriter.Workers, "status-writer-workers", cfg.StatusWriter.Workers, "Number of workers updating the status of the configuration resources (e.g. ServiceMonitor) concurrently. Only used when the StatusForConfigurationResources feature gate is enabled.")
+	fs.Float64Var(&cfg.StatusWriter.QPS, "status-writer-qps", cfg.StatusWriter.QPS, "Maximum number of status updates of the configuration resources per second. Only used when the StatusForConfigurationResources feature gate is enabled.")
+	fs.IntVar(&cfg.StatusWriter.Burst, "status-writer-burst", cfg.StatusWriter.Burst, "Maximum burst of status updates of the configuration resources. Only used when the StatusForConfigurationResources feature gate is enabled.")
+
 	fs.Var(&cfg.PromSelector, "prometheus-instance-selector", "Label selector to filter Prometheus and PrometheusAgent Custom Resources to watch.")
 	fs.Var(&cfg.AlertmanagerSelector, "alertmanager-instance-selector", "Label selector to filter Alertmanager Custom Resources to watch.")
 	fs.Var(&cfg.ThanosRulerSelector, "thanos-ruler-instance-selector", "Label selector to filter ThanosRuler Custom Resources to watch.")
-	fs.Var(&cfg.SecretListWatchFieldSelector, "secret-field-selector", "Field selector to filter Secrets to watch")
-	fs.Var(&cfg.SecretListWatchLabelSelector, "secret-label-selector", "Label selector to filter Secrets to watch")
+	fs.Var(&cfg.SecretListWatchFieldSelector, "secret-field-selector", "Field selector to filter Secrets to watch. The referenced Secrets which don't match the selector are reported in the Reconciled condition of the workload resources.")
+	fs.Var(&cfg.SecretListWatchLabelSelector, "secret-label-selector", "Label selector to filter Secrets to watch. The referenced Secrets which don't match the selector are reported in the Reconciled condition of the workload resources.")
 
 	fs.Float64Var(&memlimitRatio, "auto-gomemlimit-ratio", defaultMemlimitRatio, "The ratio of reserved GOMEMLIMIT memory to the detected maximum container or system memory. The value should be greater than 0.0 and less than 1.0. Default: 0.0 (disabled).")
 	fs.BoolVar(&disableUnmanagedPrometheusConfiguration, "disable-unmanaged-prometheus-configuration", false, "Disable support for unmanaged Prometheus configuration when all resource selectors are nil. As stated in the API documentation, unmanaged Prometheus configuration is a deprecated feature which can be avoided with '.spec.additionalScrapeConfigs' or the ScrapeConfig CRD. Default: false.")
+	fs.StringVar(&alertmanagerConfigPostProcessorURL, "alertmanager-config-post-processor-url", "", "URL of an HTTP endpoint which receives the generated Alertmanager configuration before it is written and returns the configuration to use (e.g. to enforce organization-wide receivers or routes). The request is a POST with a JSON body containing the namespace, name and config fields, the response must be a JSON body containing the config field. If empty, the configuration isn't post-processed.")
+	fs.DurationVar(&alertmanagerConfigPostProcessorTimeout, "alertmanager-config-post-processor-timeout", 10*time.Second, "Timeout of the requests to the Alertmanager configuration post-processor.")
+	fs.StringVar(&artifactStoreURL, "artifact-store-url", "", "URL of an HTTP endpoint which stores the generated Prometheus configuration in an external secret manager (e.g. Vault or AWS Secrets Manager) instead of a Kubernetes Secret. The endpoint receives PUT and DELETE requests with a JSON body containing the namespace, name and data fields. The pods mount the configuration with the Secrets Store CSI driver from the SecretProviderClass named after the configuration Secret. If empty, the configuration is stored in a Secret.")
+	fs.DurationVar(&artifactStoreTimeout, "artifact-store-timeout", 10*time.Second, "Timeout of the requests to the artifact store.")
+	fs.StringVar(&operatorConfiguration, "operator-configuration", "", "Name of the OperatorConfiguration resource whose settings take precedence over the equivalent command-line flags. The changes of the default images, config-reloader settings, labels and annotations are applied without restart. The operator exits to be restarted when the namespaces or the feature gates change. If empty, only the command-line flags are used.")
 	cfg.RegisterFeatureGatesFlags(fs, featureGates)
 
 	logging.RegisterFlags(fs, &logConfig)
+	fs.StringVar(&logConfigFile, "log-config-file", "", "Path to a YAML file defining the log level and format with per-component overrides (e.g. 'components: {prometheus-controller: {level: debug}}'). The file is reloaded when it changes and its settings take precedence over the --log-level and --log-format flags.")
 	versionutil.RegisterFlags(fs)
 
 	// No need to check for errors because Parse would exit on error.
@@ -200,12 +378,37 @@ func run(fs *flag.FlagSet) int {
 		return 0
 	}
 
-	logger, err := logging.NewLoggerSlog(logConfig)
+	router, err := logging.NewRouter(logConfig)
 	if err != nil {
 		stdlog.Fatal(err)
 	}
+	logger := router.Logger()
 	klog.SetSlogLogger(logger)
 
+	logSettings := &logSettings{router: router}
+	if logConfigFile != "" {
+		s, err := logging.LoadSettingsFile(logConfigFile)
+		if err != nil {
+			logger.Error("failed to load the log settings file", "err", err)
+			return 1
+		}
+
+		if err := logSettings.setFile(s); err != nil {
+			logger.Error("invalid log settings file", "err", err)
+			return 1
+		}
+	}
+
+	if err := admissionDefaults.Validate(); err != nil {
+		logger.Error("invalid admission webhook defaults", "err", err)
+		return 1
+	}
+
+	if err := admissionRuleCostLimits.Validate(); err != nil {
+		logger.Error("invalid admission webhook rule cost limits", "err", err)
+		return 1
+	}
+
 	if err := cfg.Gates.UpdateFeatureGates(*featureGates.Map); err != nil {
 		logger.Error("failed to update feature gates", "error", err)
 		return 1
@@ -215,22 +418,81 @@ func run(fs *flag.FlagSet) int {
 	goruntime.SetMaxProcs(logger)
 	goruntime.SetMemLimit(logger, memlimitRatio)
 
-	if len(cfg.Namespaces.AllowList) > 0 && len(cfg.Namespaces.DenyList) > 0 {
-		logger.Error(
-			"--namespaces and --deny-namespaces are mutually exclusive, only one should be provided",
-			"namespaces", cfg.Namespaces.AllowList,
-			"deny_namespaces", cfg.Namespaces.DenyList,
-		)
+	for flagName, workers := range map[string]int{
+		"--prometheus-workers":       cfg.Workers.Prometheus,
+		"--prometheus-agent-workers": cfg.Workers.PrometheusAgent,
+		"--alertmanager-workers":     cfg.Workers.Alertmanager,
+		"--thanos-ruler-workers":     cfg.Workers.ThanosRuler,
+	} {
+		if workers < 1 {
+			logger.Error(fmt.Sprintf("%s should be greater than 0", flagName), "workers", workers)
+			return 1
+		}
+	}
+
+	for flagName, period := range map[string]time.Duration{
+		"--prometheus-resync-period":   cfg.ResyncPeriods.Prometheus,
+		"--alertmanager-resync-period": cfg.ResyncPeriods.Alertmanager,
+		"--thanos-ruler-resync-period": cfg.ResyncPeriods.ThanosRuler,
+		"--scrapeconfig-resync-period": cfg.ResyncPeriods.ScrapeConfig,
+	} {
+		if period < 0 {
+			logger.Error(fmt.Sprintf("%s should not be negative", flagName), "period", period)
+			return 1
+		}
+	}
+
+	if cfg.LeaderElection.Enabled && cfg.LeaderElection.LeaseDuration <= cfg.LeaderElection.RenewDeadline {
+		logger.Error("--leader-election-lease-duration should be greater than --leader-election-renew-deadline")
+		return 1
+	}
+
+	if cfg.Sharding.Enabled {
+		if cfg.LeaderElection.Enabled {
+			logger.Error("--sharding and --leader-elect are mutually exclusive")
+			return 1
+		}
+
+		if cfg.Sharding.LeaseDuration <= cfg.Sharding.RenewInterval {
+			logger.Error("--sharding-lease-duration should be greater than --sharding-renew-interval")
+			return 1
+		}
+	}
+
+	// The Lease objects can't be written in audit mode.
+	if cfg.Mode == operator.AuditMode {
+		if cfg.LeaderElection.Enabled {
+			logger.Error("--mode=audit and --leader-elect are mutually exclusive")
+			return 1
+		}
+
+		if cfg.Sharding.Enabled {
+			logger.Error("--mode=audit and --sharding are mutually exclusive")
+			return 1
+		}
+	}
+
+	if cfg.MaxConcurrentWorkloadRollouts < 0 {
+		logger.Error("--max-concurrent-workload-rollouts should not be negative", "value", cfg.MaxConcurrentWorkloadRollouts)
+		return 1
+	}
+
+	if err := cfg.GoRuntime.Validate(); err != nil {
+		logger.Error("invalid --workload-auto-gomemlimit-ratio value", "err", err)
 		return 1
 	}
-	cfg.Namespaces.Finalize()
-	logger.Info("namespaces filtering configuration ", "config", cfg.Namespaces.String())
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wg, ctx := errgroup.WithContext(ctx)
 	r := metrics.NewRegistry("prometheus_operator")
 
 	k8sutil.MustRegisterClientGoMetrics(r)
+	k8sutil.EnableUpdateDiffs(logger.With("component", "object_updates"), r)
+
+	if enableWatchList {
+		logger.Info("Enabling streaming lists for the informers")
+		k8sutil.EnableWatchListClient()
+	}
 
 	restConfig, err := k8sutil.NewClusterConfig(k8sutil.ClusterConfig{
 		Host:      apiServer,
@@ -244,6 +506,13 @@ func run(fs *flag.FlagSet) int {
 		return 1
 	}
 
+	var auditWrapper transport.WrapperFunc
+	if cfg.Mode == operator.AuditMode {
+		logger.Warn("running in audit mode: the changes to the Kubernetes objects are reported but not applied")
+		auditWrapper = k8sutil.NewAuditTransport(logger, r)
+		restConfig.Wrap(auditWrapper)
+	}
+
 	kclient, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
 		logger.Error("failed to create Kubernetes client", "err", err)
@@ -267,6 +536,52 @@ func run(fs *flag.FlagSet) int {
 	}
 	logger.Info("connection established", "kubernetes_version", cfg.KubernetesVersion.String())
 
+	var ocWatcher *operator.OperatorConfigurationWatcher
+	if operatorConfiguration != "" {
+		mclient, err := monitoringclient.NewForConfig(restConfig)
+		if err != nil {
+			logger.Error("failed to create the monitoring client", "err", err)
+			cancel()
+			return 1
+		}
+
+		ocWatcher = operator.NewOperatorConfigurationWatcher(logger.With("component", "operator_configuration"), mclient, operatorConfiguration, cfg)
+		if cfg, err = ocWatcher.Load(ctx); err != nil {
+			logger.Error("failed to load the operator configuration", "err", err)
+			cancel()
+			return 1
+		}
+		logger.Info("operator configuration loaded", "name", operatorConfiguration, "feature_gates", cfg.Gates.String())
+
+		if err := logSettings.setOperatorConfiguration(cfg.LogSettings); err != nil {
+			logger.Error("invalid log settings in the operator configuration", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
+	// The feature gates are final once the operator configuration is loaded.
+	if cfg.Gates.Enabled(operator.ServerSideApplyFeature) {
+		logger.Info("Enabling server-side apply for the StatefulSets, DaemonSets, Services and Secrets")
+	}
+
+	if cfg.Gates.Enabled(operator.NativeSidecarContainersFeature) && !cfg.NativeSidecarsEnabled() {
+		logger.Warn("the native sidecar containers require Kubernetes >= "+operator.NativeSidecarsMinimumKubernetesVersion.String()+", ignoring the feature gate", "kubernetes_version", cfg.KubernetesVersion.String())
+	}
+
+	if len(cfg.Namespaces.AllowList) > 0 && len(cfg.Namespaces.DenyList) > 0 {
+		logger.Error(
+			"--namespaces and --deny-namespaces are mutually exclusive, only one should be provided",
+			"namespaces", cfg.Namespaces.AllowList,
+			"deny_namespaces", cfg.Namespaces.DenyList,
+		)
+		cancel()
+		return 1
+	}
+
+	cfg.Namespaces.Finalize()
+	logger.Info("namespaces filtering configuration ", "config", cfg.Namespaces.String())
+
 	var (
 		alertmanagerControllerOptions = []alertmanagercontroller.ControllerOption{}
 		promAgentControllerOptions    = []prometheusagentcontroller.ControllerOption{}
@@ -382,9 +697,72 @@ func run(fs *flag.FlagSet) int {
 		return 1
 	}
 
+	// The status writer is shared by all the controllers updating the status
+	// of the configuration resources.
+	var statusWriter *operator.StatusWriter
+	if cfg.Gates.Enabled(operator.StatusForConfigurationResourcesFeature) {
+		statusWriter = operator.NewStatusWriter(logger.With("component", "status_writer"), r, cfg.StatusWriter)
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithStatusWriter(statusWriter))
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithStatusWriter(statusWriter))
+	}
+
+	// The rollout budget is shared by all the controllers managing StatefulSets.
+	if cfg.MaxConcurrentWorkloadRollouts > 0 {
+		rolloutBudget := operator.NewRolloutBudget(r, cfg.MaxConcurrentWorkloadRollouts)
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithRolloutBudget(rolloutBudget))
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithRolloutBudget(rolloutBudget))
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithRolloutBudget(rolloutBudget))
+		thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithRolloutBudget(rolloutBudget))
+	}
+
+	// The sharder is shared by all the controllers reconciling workloads.
+	var sharder *operator.Sharder
+	if cfg.Sharding.Enabled {
+		sharder, err = operator.NewSharder(logger.With("component", "sharding"), kclient, r, cfg.Sharding)
+		if err != nil {
+			logger.Error("failed to create the sharder", "err", err)
+			cancel()
+			return 1
+		}
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithSharder(sharder))
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithSharder(sharder))
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithSharder(sharder))
+		thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithSharder(sharder))
+	}
+
+	var artifactStore operator.ArtifactStore
+	switch {
+	case artifactStoreURL == "":
+	case cfg.Mode == operator.AuditMode:
+		// The requests to the artifact store bypass the dry-run transport.
+		logger.Warn("the artifact store is disabled in audit mode", "url", artifactStoreURL)
+	default:
+		artifactStore = operator.NewWebhookArtifactStore(
+			artifactStoreURL,
+			&http.Client{Timeout: artifactStoreTimeout},
+		)
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithArtifactStore(artifactStore))
+	}
+
+	var amPostProcessors []alertmanagercontroller.ConfigPostProcessor
+	if alertmanagerConfigPostProcessorURL != "" {
+		amPostProcessors = append(amPostProcessors, alertmanagercontroller.NewWebhookConfigPostProcessor(
+			alertmanagerConfigPostProcessorURL,
+			&http.Client{Timeout: alertmanagerConfigPostProcessorTimeout},
+		))
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithConfigPostProcessors(amPostProcessors...))
+	}
+
+	// In multi-cluster mode, the metrics of the controllers get a cluster
+	// label which is empty for the local cluster.
+	var controllerRegisterer prometheus.Registerer = r
+	if cfg.RemoteClusters.Enabled() {
+		controllerRegisterer = prometheus.WrapRegistererWith(prometheus.Labels{"cluster": ""}, r)
+	}
+
 	var po *prometheuscontroller.Operator
 	if prometheusSupported {
-		po, err = prometheuscontroller.New(ctx, restConfig, cfg, logger, r, promControllerOptions...)
+		po, err = prometheuscontroller.New(ctx, restConfig, cfg, logger, controllerRegisterer, promControllerOptions...)
 		if err != nil {
 			logger.Error("instantiating prometheus controller failed", "err", err)
 			cancel()
@@ -446,7 +824,7 @@ func run(fs *flag.FlagSet) int {
 
 	var pao *prometheusagentcontroller.Operator
 	if prometheusAgentSupported {
-		pao, err = prometheusagentcontroller.New(ctx, restConfig, cfg, logger, r, promAgentControllerOptions...)
+		pao, err = prometheusagentcontroller.New(ctx, restConfig, cfg, logger, controllerRegisterer, promAgentControllerOptions...)
 		if err != nil {
 			logger.Error("instantiating prometheus-agent controller failed", "err", err)
 			cancel()
@@ -482,7 +860,7 @@ func run(fs *flag.FlagSet) int {
 
 	var ao *alertmanagercontroller.Operator
 	if alertmanagerSupported {
-		ao, err = alertmanagercontroller.New(ctx, restConfig, cfg, logger, r, alertmanagerControllerOptions...)
+		ao, err = alertmanagercontroller.New(ctx, restConfig, cfg, logger, controllerRegisterer, alertmanagerControllerOptions...)
 		if err != nil {
 			logger.Error("instantiating alertmanager controller failed", "err", err)
 			cancel()
@@ -518,7 +896,7 @@ func run(fs *flag.FlagSet) int {
 
 	var to *thanoscontroller.Operator
 	if thanosRulerSupported {
-		to, err = thanoscontroller.New(ctx, restConfig, cfg, logger, r, thanosControllerOptions...)
+		to, err = thanoscontroller.New(ctx, restConfig, cfg, logger, controllerRegisterer, thanosControllerOptions...)
 		if err != nil {
 			logger.Error("instantiating thanos controller failed", "err", err)
 			cancel()
@@ -526,9 +904,57 @@ func run(fs *flag.FlagSet) int {
 		}
 	}
 
+	var remotes []*remoteControllers
+	if cfg.RemoteClusters.Enabled() {
+		clusters, err := operator.LoadRemoteClusters(ctx, kclient, cfg.RemoteClusters)
+		if err != nil {
+			logger.Error("failed to load the remote clusters", "err", err)
+			cancel()
+			return 1
+		}
+
+		var (
+			remotePromOptions = []prometheuscontroller.ControllerOption{prometheuscontroller.WithConfigResourcesFrom(restConfig)}
+			remoteAmOptions   = []alertmanagercontroller.ControllerOption{}
+		)
+		if scrapeConfigSupported {
+			remotePromOptions = append(remotePromOptions, prometheuscontroller.WithScrapeConfig())
+		}
+		if disableUnmanagedPrometheusConfiguration {
+			remotePromOptions = append(remotePromOptions, prometheuscontroller.WithoutUnmanagedConfiguration())
+		}
+		if sharder != nil {
+			remotePromOptions = append(remotePromOptions, prometheuscontroller.WithSharder(sharder))
+			remoteAmOptions = append(remoteAmOptions, alertmanagercontroller.WithSharder(sharder))
+		}
+		if artifactStore != nil {
+			remotePromOptions = append(remotePromOptions, prometheuscontroller.WithArtifactStore(artifactStore))
+		}
+		if len(amPostProcessors) > 0 {
+			remoteAmOptions = append(remoteAmOptions, alertmanagercontroller.WithConfigPostProcessors(amPostProcessors...))
+		}
+
+		for _, rc := range clusters {
+			if auditWrapper != nil {
+				rc.RESTConfig.Wrap(auditWrapper)
+			}
+
+			rcs, err := newRemoteControllers(ctx, logger, r, cfg, rc, remotePromOptions, remoteAmOptions)
+			if err != nil {
+				logger.Error("instantiating the controllers of the remote cluster failed", "cluster", rc.Name, "err", err)
+				cancel()
+				return 1
+			}
+			remotes = append(remotes, rcs)
+		}
+	}
+
 	var kec *kubelet.Controller
 	if kubeletObject != "" {
-		opts := []kubelet.ControllerOption{kubelet.WithNodeAddressPriority(nodeAddressPriority.String())}
+		opts := []kubelet.ControllerOption{
+			kubelet.WithNodeAddressPriority(nodeAddressPriority.String()),
+			kubelet.WithFieldManager(cfg.ApplyFieldManager()),
+		}
 
 		kubeletService := strings.Split(kubeletObject, "/")
 		if len(kubeletService) != 2 {
@@ -537,35 +963,25 @@ func run(fs *flag.FlagSet) int {
 			return 1
 		}
 
-		if kubeletEndpointSlice {
-			allowed, errs, err := k8sutil.IsAllowed(
-				ctx,
-				kclient.AuthorizationV1().SelfSubjectAccessReviews(),
-				[]string{kubeletService[0]},
-				k8sutil.ResourceAttribute{
-					Group:    discoveryv1.SchemeGroupVersion.Group,
-					Version:  discoveryv1.SchemeGroupVersion.Version,
-					Resource: "endpointslices",
-					Verbs:    []string{"get", "list", "create", "update", "delete"},
-				})
-			if err != nil {
-				logger.Error(fmt.Sprintf("failed to check permissions on resource 'endpointslices' (group %q)", discoveryv1.SchemeGroupVersion.Group), "err", err)
-				cancel()
-				return 1
-			}
-
-			if !allowed {
-				for _, reason := range errs {
-					logger.Warn(fmt.Sprintf("missing permission on resource 'endpointslices' (group: %q)", discoveryv1.SchemeGroupVersion.Group), "reason", reason)
-				}
-			} else {
-				opts = append(opts, kubelet.WithEndpointSlice())
-			}
+		if kubeletEndpointSlice && !endpointSliceSupported {
+			logger.Warn("the Kubernetes API doesn't support EndpointSlice v1", "version", cfg.KubernetesVersion.String())
 		}
 
-		if kubeletEndpoints {
-			opts = append(opts, kubelet.WithEndpoints())
+		endpointsOpts, err := kubelet.EndpointsOptions(
+			ctx,
+			logger,
+			kclient.AuthorizationV1().SelfSubjectAccessReviews(),
+			kubeletService[0],
+			endpointSliceSupported,
+			kubeletEndpointSlice,
+			kubeletEndpoints,
+		)
+		if err != nil {
+			logger.Error("failed to configure the kubelet controller", "err", err)
+			cancel()
+			return 1
 		}
+		opts = append(opts, endpointsOpts...)
 
 		if kec, err = kubelet.New(
 			logger.With("component", "kubelet_endpoints"),
@@ -584,54 +1000,202 @@ func run(fs *flag.FlagSet) int {
 		}
 	}
 
+	var selfMonitoring *operator.SelfMonitoring
+	if cfg.SelfMonitoring.Enabled {
+		mclient, err := monitoringclient.NewForConfig(restConfig)
+		if err != nil {
+			logger.Error("failed to create the monitoring client", "err", err)
+			cancel()
+			return 1
+		}
+
+		if selfMonitoring, err = operator.NewSelfMonitoring(logger.With("component", "self_monitoring"), mclient, cfg); err != nil {
+			logger.Error("instantiating self-monitoring controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
 	if po == nil && pao == nil && ao == nil && to == nil && kec == nil {
 		logger.Error("no controller can be started, check the RBAC permissions of the service account")
 		cancel()
 		return 1
 	}
 
+	if ocWatcher != nil {
+		ocWatcher.Subscribe(func(c operator.Config) {
+			if err := logSettings.setOperatorConfiguration(c.LogSettings); err != nil {
+				logger.Error("failed to update the log settings", "err", err)
+			}
+		})
+		if po != nil {
+			ocWatcher.Subscribe(po.UpdateConfig)
+		}
+		if pao != nil {
+			ocWatcher.Subscribe(pao.UpdateConfig)
+		}
+		if ao != nil {
+			ocWatcher.Subscribe(ao.UpdateConfig)
+		}
+		if to != nil {
+			ocWatcher.Subscribe(to.UpdateConfig)
+		}
+		if kec != nil {
+			ocWatcher.Subscribe(kec.UpdateConfig)
+		}
+		if selfMonitoring != nil {
+			ocWatcher.Subscribe(selfMonitoring.UpdateConfig)
+		}
+		for _, rcs := range remotes {
+			ocWatcher.Subscribe(rcs.prometheus.UpdateConfig)
+			ocWatcher.Subscribe(rcs.alertmanager.UpdateConfig)
+		}
+	}
+
 	// Setup the web server.
 	mux := http.NewServeMux()
-	admit := admission.New(logger.With("component", "admissionwebhook"))
+	admissionOpts := []admission.Option{
+		admission.WithRuleCostLimits(admissionRuleCostLimits),
+		admission.WithRegisterer(r),
+	}
+	if admissionWarnMissingReferences {
+		admissionOpts = append(admissionOpts, admission.WithReferenceChecks(kclient))
+	}
+	admit := admission.New(logger.With("component", "admissionwebhook"), admissionDefaults, admissionOpts...)
 	admit.Register(mux)
 
 	r.MustRegister(cfg.Gates)
+	mux.Handle(operator.FeatureGatesPattern, cfg.Gates)
 
 	mux.Handle("/metrics", promhttp.HandlerFor(r, promhttp.HandlerOpts{}))
-	mux.Handle("/debug/pprof/", http.HandlerFunc(pprof.Index))
-	mux.Handle("/debug/pprof/cmdline", http.HandlerFunc(pprof.Cmdline))
-	mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
-	mux.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
-	mux.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
 	mux.Handle("/healthz", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
 
-	srv, err := server.NewServer(logger, &serverConfig, mux)
+	// The readiness endpoint checks the connectivity with the Kubernetes API
+	// and the state of the local controllers.
+	hc := operator.NewHealthChecker(5 * time.Second)
+	hc.Register("kubernetes-api", func(ctx context.Context) error {
+		return kclient.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Error()
+	})
+	if po != nil {
+		hc.Register("prometheus", po.HealthCheck)
+	}
+	if pao != nil {
+		hc.Register("prometheusagent", pao.HealthCheck)
+	}
+	if ao != nil {
+		hc.Register("alertmanager", ao.HealthCheck)
+	}
+	if to != nil {
+		hc.Register("thanos", to.HealthCheck)
+	}
+	mux.Handle("/readyz", hc)
+
+	if po != nil {
+		mux.Handle(prompkg.EffectiveConfigPattern, po.EffectiveConfigHandler())
+		mux.Handle(prompkg.SelectionPattern, po.SelectionHandler())
+	}
+
+	if ao != nil {
+		mux.Handle(alertmanagercontroller.RoutingTracePattern, ao.RoutingTraceHandler())
+	}
+
+	// The profiling endpoints are exposed either on the web listener or on a
+	// dedicated listener.
+	var pprofSrv *server.Server
+	if enablePprof {
+		if pprofListenAddress == "" {
+			registerPprofHandlers(mux)
+		} else {
+			pprofMux := http.NewServeMux()
+			registerPprofHandlers(pprofMux)
+
+			pprofServerConfig := server.DefaultConfig(pprofListenAddress, false)
+			pprofSrv, err = server.NewServer(logger.With("component", "pprof"), &pprofServerConfig, pprofMux)
+			if err != nil {
+				logger.Error("failed to create pprof server", "err", err)
+				cancel()
+				return 1
+			}
+		}
+	}
+
+	srv, err := server.NewServer(logger, &serverConfig, mux, server.WithKubernetesClient(kclient), server.WithRegisterer(r))
 	if err != nil {
 		logger.Error("failed to create web server", "err", err)
 		cancel()
 		return 1
 	}
 
-	// Start the web server.
+	// Start the web servers.
 	wg.Go(func() error { return srv.Serve(ctx) })
-
-	// Start the controllers.
-	if po != nil {
-		wg.Go(func() error { return po.Run(ctx) })
+	if pprofSrv != nil {
+		wg.Go(func() error { return pprofSrv.Serve(ctx) })
 	}
-	if pao != nil {
-		wg.Go(func() error { return pao.Run(ctx) })
+
+	if logConfigFile != "" {
+		wg.Go(func() error {
+			logging.WatchSettingsFile(ctx, logger.With("component", "log_settings"), logConfigFile, 10*time.Second, func(s logging.Settings) {
+				if err := logSettings.setFile(s); err != nil {
+					logger.Error("failed to update the log settings", "err", err)
+				}
+			})
+			return nil
+		})
 	}
-	if ao != nil {
-		wg.Go(func() error { return ao.Run(ctx) })
+
+	// Watch the operator configuration. The watcher returns an error when
+	// the operator needs to be restarted.
+	if ocWatcher != nil {
+		wg.Go(func() error { return ocWatcher.Run(ctx) })
 	}
-	if to != nil {
-		wg.Go(func() error { return to.Run(ctx) })
+
+	// Start the controllers.
+	runControllers := func(ctx context.Context) error {
+		wg, ctx := errgroup.WithContext(ctx)
+
+		if statusWriter != nil {
+			wg.Go(func() error {
+				statusWriter.Run(ctx)
+				return nil
+			})
+		}
+		if sharder != nil {
+			wg.Go(func() error { return sharder.Run(ctx) })
+		}
+		if po != nil {
+			wg.Go(func() error { return po.Run(ctx) })
+		}
+		if pao != nil {
+			wg.Go(func() error { return pao.Run(ctx) })
+		}
+		if ao != nil {
+			wg.Go(func() error { return ao.Run(ctx) })
+		}
+		if to != nil {
+			wg.Go(func() error { return to.Run(ctx) })
+		}
+		if kec != nil {
+			wg.Go(func() error { return kec.Run(ctx) })
+		}
+		if selfMonitoring != nil {
+			wg.Go(func() error { return selfMonitoring.Run(ctx) })
+		}
+		for _, rcs := range remotes {
+			wg.Go(func() error { return rcs.prometheus.Run(ctx) })
+			wg.Go(func() error { return rcs.alertmanager.Run(ctx) })
+		}
+
+		return wg.Wait()
 	}
-	if kec != nil {
-		wg.Go(func() error { return kec.Run(ctx) })
+
+	if cfg.LeaderElection.Enabled {
+		wg.Go(func() error {
+			return operator.RunWithLeaderElection(ctx, logger.With("component", "leader_election"), kclient, r, cfg.LeaderElection, runControllers)
+		})
+	} else {
+		wg.Go(func() error { return runControllers(ctx) })
 	}
 
 	term := make(chan os.Signal, 1)
@@ -646,6 +1210,11 @@ func run(fs *flag.FlagSet) int {
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Warn("server shutdown error", "err", err)
 	}
+	if pprofSrv != nil {
+		if err := pprofSrv.Shutdown(ctx); err != nil {
+			logger.Warn("pprof server shutdown error", "err", err)
+		}
+	}
 
 	cancel()
 	if err := wg.Wait(); err != nil {
@@ -656,6 +1225,15 @@ func run(fs *flag.FlagSet) int {
 	return 0
 }
 
+// registerPprofHandlers registers the profiling endpoints.
+func registerPprofHandlers(mux *http.ServeMux) {
+	mux.Handle("/debug/pprof/", http.HandlerFunc(pprof.Index))
+	mux.Handle("/debug/pprof/cmdline", http.HandlerFunc(pprof.Cmdline))
+	mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
+	mux.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
+	mux.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
+}
+
 func main() {
 	os.Exit(run(flag.CommandLine))
 }
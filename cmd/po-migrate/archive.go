@@ -0,0 +1,114 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// archivePath returns the path of the object in the archive.
+func archivePath(u *unstructured.Unstructured) string {
+	return path.Join(u.GetNamespace(), strings.ToLower(u.GetKind()), u.GetName()+".yaml")
+}
+
+// writeArchive writes the objects as YAML files into a gzipped tarball.
+func writeArchive(w io.Writer, objects []*unstructured.Unstructured) error {
+	sort.Slice(objects, func(i, j int) bool {
+		return archivePath(objects[i]) < archivePath(objects[j])
+	})
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	now := time.Now()
+	for _, o := range objects {
+		b, err := yaml.Marshal(o.Object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", archivePath(o), err)
+		}
+
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    archivePath(o),
+			Mode:    0600,
+			Size:    int64(len(b)),
+			ModTime: now,
+		}); err != nil {
+			return err
+		}
+
+		if _, err := tw.Write(b); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+// readArchive reads the objects from a gzipped tarball generated by
+// writeArchive.
+func readArchive(r io.Reader) ([]*unstructured.Unstructured, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	var (
+		tr      = tar.NewReader(gr)
+		objects []*unstructured.Unstructured
+	)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if hdr.Typeflag != tar.TypeReg || path.Ext(hdr.Name) != ".yaml" {
+			continue
+		}
+
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", hdr.Name, err)
+		}
+
+		o := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(b, &o.Object); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", hdr.Name, err)
+		}
+
+		objects = append(objects, o)
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,289 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// po-migrate exports the monitoring custom resources and the Secrets and
+// ConfigMaps that they reference into an archive, and imports them into
+// another cluster after verifying that all the references can be resolved.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
+)
+
+const fieldManager = "po-migrate"
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: %s <command> [flags]
+
+Commands:
+  export    Export the monitoring resources and the Secrets/ConfigMaps they reference into an archive.
+  import    Verify the references of the archived resources and apply them to the cluster.
+
+Run '%s <command> -h' for the command's flags.
+`, os.Args[0], os.Args[0])
+}
+
+func main() {
+	fs := flag.CommandLine
+	versionutil.RegisterFlags(fs)
+	fs.Usage = usage
+
+	// No need to check for errors because Parse would exit on error.
+	_ = fs.Parse(os.Args[1:])
+
+	if versionutil.ShouldPrintVersion() {
+		versionutil.Print(os.Stdout, "po-migrate")
+		os.Exit(0)
+	}
+
+	if fs.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	var (
+		ctx = context.Background()
+		err error
+	)
+	switch fs.Arg(0) {
+	case "export":
+		err = runExport(ctx, fs.Args()[1:])
+	case "import":
+		err = runImport(ctx, fs.Args()[1:])
+	default:
+		usage()
+		os.Exit(1)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newDynamicClient(kubeconfig string) (dynamic.Interface, error) {
+	cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+		&clientcmd.ConfigOverrides{},
+	).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the kubeconfig: %w", err)
+	}
+
+	return dynamic.NewForConfig(cfg)
+}
+
+func runExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	kubeconfig := fs.String("kubeconfig", os.Getenv("KUBECONFIG"), "Path to the kubeconfig file.")
+	namespace := fs.String("namespace", "", "Namespace to export (all namespaces if empty).")
+	output := fs.String("output", "", "Path of the archive to write.")
+	redactSecrets := fs.Bool("redact-secrets", false, "Remove the values of the exported Secrets. The Secrets must be recreated in the destination cluster before importing the archive.")
+	_ = fs.Parse(args)
+
+	if *output == "" {
+		fs.Usage()
+		return fmt.Errorf("please specify the 'output' flag")
+	}
+
+	client, err := newDynamicClient(*kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var (
+		objects []*unstructured.Unstructured
+		refs    = map[string]reference{}
+	)
+	for _, mr := range monitoringResources {
+		list, err := client.Resource(mr.gvr).Namespace(*namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Printf("skipping %s: resource not installed", mr.gvr.Resource)
+				continue
+			}
+			return fmt.Errorf("failed to list %s: %w", mr.gvr.Resource, err)
+		}
+
+		for i := range list.Items {
+			o := &list.Items[i]
+			sanitize(o)
+
+			rs, err := mr.references(o)
+			if err != nil {
+				return err
+			}
+
+			for _, ref := range rs {
+				refs[ref.objectKey()] = ref
+			}
+
+			objects = append(objects, o)
+		}
+	}
+
+	for _, ref := range refs {
+		gvr := configMapGVR
+		if ref.Kind == secretKind {
+			gvr = secretGVR
+		}
+
+		o, err := client.Resource(gvr).Namespace(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Printf("warning: %s/%s/%s referenced but not found", ref.Kind, ref.Namespace, ref.Name)
+				continue
+			}
+			return fmt.Errorf("failed to get %s/%s/%s: %w", ref.Kind, ref.Namespace, ref.Name, err)
+		}
+
+		if o.GetKind() == secretKind {
+			if t, _, _ := unstructured.NestedString(o.Object, "type"); t == string(v1.SecretTypeServiceAccountToken) {
+				log.Printf("skipping %s/%s/%s: service account tokens can't be migrated", ref.Kind, ref.Namespace, ref.Name)
+				continue
+			}
+
+			if *redactSecrets {
+				redact(o)
+			}
+		}
+
+		sanitize(o)
+		objects = append(objects, o)
+	}
+
+	f, err := os.OpenFile(*output, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := writeArchive(f, objects); err != nil {
+		return fmt.Errorf("failed to write the archive: %w", err)
+	}
+
+	log.Printf("exported %d objects to %s", len(objects), *output)
+	return f.Close()
+}
+
+func runImport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	kubeconfig := fs.String("kubeconfig", os.Getenv("KUBECONFIG"), "Path to the kubeconfig file.")
+	input := fs.String("input", "", "Path of the archive to read.")
+	dryRun := fs.Bool("dry-run", false, "Verify the archive and submit the objects in dry-run mode.")
+	_ = fs.Parse(args)
+
+	if *input == "" {
+		fs.Usage()
+		return fmt.Errorf("please specify the 'input' flag")
+	}
+
+	f, err := os.Open(*input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	objects, err := readArchive(f)
+	if err != nil {
+		return fmt.Errorf("failed to read the archive: %w", err)
+	}
+
+	client, err := newDynamicClient(*kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	problems, err := checkReferences(ctx, objects, func(ctx context.Context, kind, namespace, name string) (map[string]struct{}, bool, error) {
+		gvr := configMapGVR
+		if kind == secretKind {
+			gvr = secretGVR
+		}
+
+		o, err := client.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, false, nil
+			}
+			return nil, false, err
+		}
+
+		return dataKeys(o), true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("referential integrity check failed:\n  %s", strings.Join(problems, "\n  "))
+	}
+
+	// Apply the Secrets and ConfigMaps before the resources referencing them.
+	var (
+		order      = []schema.GroupVersionResource{configMapGVR, secretGVR}
+		byGVR      = map[schema.GroupVersionResource][]*unstructured.Unstructured{}
+		dryRunOpts []string
+	)
+	for _, mr := range monitoringResources {
+		order = append(order, mr.gvr)
+	}
+
+	for _, o := range objects {
+		gvr, _, err := resourceFor(o)
+		if err != nil {
+			return err
+		}
+
+		if gvr == secretGVR && isRedacted(o) {
+			continue
+		}
+
+		byGVR[gvr] = append(byGVR[gvr], o)
+	}
+
+	if *dryRun {
+		dryRunOpts = []string{metav1.DryRunAll}
+	}
+
+	var applied int
+	for _, gvr := range order {
+		for _, o := range byGVR[gvr] {
+			if _, err := client.Resource(gvr).Namespace(o.GetNamespace()).Apply(ctx, o.GetName(), o, metav1.ApplyOptions{
+				FieldManager: fieldManager,
+				Force:        true,
+				DryRun:       dryRunOpts,
+			}); err != nil {
+				return fmt.Errorf("failed to apply %s: %w", archivePath(o), err)
+			}
+			applied++
+		}
+	}
+
+	log.Printf("applied %d objects from %s", applied, *input)
+	return nil
+}
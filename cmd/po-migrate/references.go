@@ -0,0 +1,241 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+const (
+	secretKind    = "Secret"
+	configMapKind = "ConfigMap"
+)
+
+// reference represents a Secret or ConfigMap referenced by a monitoring
+// resource.
+type reference struct {
+	Kind      string
+	Namespace string
+	Name      string
+	// Key is empty when the whole object is referenced.
+	Key string
+	// Optional is true when the monitoring resource is valid even if the
+	// referenced object doesn't exist.
+	Optional bool
+}
+
+func (r reference) objectKey() string {
+	return r.Kind + "/" + r.Namespace + "/" + r.Name
+}
+
+func (r reference) String() string {
+	if r.Key == "" {
+		return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+	}
+
+	return fmt.Sprintf("%s %s/%s (key %q)", r.Kind, r.Namespace, r.Name, r.Key)
+}
+
+// collectReferences returns the Secrets and ConfigMaps referenced by the
+// given monitoring resource. All the references are relative to the
+// resource's namespace.
+func collectReferences(namespace string, obj any) []reference {
+	rc := &referenceCollector{
+		namespace: namespace,
+		seen:      map[reference]struct{}{},
+	}
+
+	switch o := obj.(type) {
+	case *monitoringv1.Prometheus:
+		rc.addNames(secretKind, o.Spec.Secrets)
+		rc.addNames(configMapKind, o.Spec.ConfigMaps)
+	case *monitoringv1alpha1.PrometheusAgent:
+		rc.addNames(secretKind, o.Spec.Secrets)
+		rc.addNames(configMapKind, o.Spec.ConfigMaps)
+	case *monitoringv1.Alertmanager:
+		rc.addNames(secretKind, o.Spec.Secrets)
+		rc.addNames(configMapKind, o.Spec.ConfigMaps)
+		// The operator generates a default configuration when the Secret
+		// doesn't exist.
+		if o.Spec.ConfigSecret != "" {
+			rc.add(reference{Kind: secretKind, Name: o.Spec.ConfigSecret, Optional: true})
+		}
+	}
+
+	rc.walk(reflect.ValueOf(obj))
+
+	sort.Slice(rc.refs, func(i, j int) bool {
+		if rc.refs[i].objectKey() != rc.refs[j].objectKey() {
+			return rc.refs[i].objectKey() < rc.refs[j].objectKey()
+		}
+
+		return rc.refs[i].Key < rc.refs[j].Key
+	})
+
+	return rc.refs
+}
+
+var (
+	objectMetaType            = reflect.TypeOf(metav1.ObjectMeta{})
+	typeMetaType              = reflect.TypeOf(metav1.TypeMeta{})
+	secretKeySelectorType     = reflect.TypeOf(v1.SecretKeySelector{})
+	configMapKeySelectorType  = reflect.TypeOf(v1.ConfigMapKeySelector{})
+	secretVolumeSourceType    = reflect.TypeOf(v1.SecretVolumeSource{})
+	configMapVolumeSourceType = reflect.TypeOf(v1.ConfigMapVolumeSource{})
+	secretProjectionType      = reflect.TypeOf(v1.SecretProjection{})
+	configMapProjectionType   = reflect.TypeOf(v1.ConfigMapProjection{})
+	secretEnvSourceType       = reflect.TypeOf(v1.SecretEnvSource{})
+	configMapEnvSourceType    = reflect.TypeOf(v1.ConfigMapEnvSource{})
+	tlsConfigType             = reflect.TypeOf(monitoringv1.TLSConfig{})
+	webTLSConfigType          = reflect.TypeOf(monitoringv1.WebTLSConfig{})
+)
+
+type referenceCollector struct {
+	namespace string
+	refs      []reference
+	seen      map[reference]struct{}
+}
+
+func (rc *referenceCollector) add(ref reference) {
+	if ref.Name == "" {
+		return
+	}
+
+	ref.Namespace = rc.namespace
+	if _, found := rc.seen[ref]; found {
+		return
+	}
+
+	rc.seen[ref] = struct{}{}
+	rc.refs = append(rc.refs, ref)
+}
+
+func (rc *referenceCollector) addNames(kind string, names []string) {
+	for _, name := range names {
+		rc.add(reference{Kind: kind, Name: name})
+	}
+}
+
+// walk traverses recursively the value and records the references to
+// Secrets and ConfigMaps.
+func (rc *referenceCollector) walk(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		rc.walk(v.Elem())
+
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			rc.walk(v.Index(i))
+		}
+
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			rc.walk(iter.Value())
+		}
+
+	case reflect.Struct:
+		if !rc.record(v) {
+			return
+		}
+
+		for i := range v.NumField() {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			rc.walk(v.Field(i))
+		}
+	}
+}
+
+// record records the references held by the struct value. It returns false
+// if the struct's fields shouldn't be traversed.
+func (rc *referenceCollector) record(v reflect.Value) bool {
+	switch v.Type() {
+	case objectMetaType, typeMetaType:
+		return false
+
+	case secretKeySelectorType:
+		s := v.Interface().(v1.SecretKeySelector)
+		rc.add(reference{Kind: secretKind, Name: s.Name, Key: s.Key, Optional: ptr.Deref(s.Optional, false)})
+		return false
+
+	case configMapKeySelectorType:
+		s := v.Interface().(v1.ConfigMapKeySelector)
+		rc.add(reference{Kind: configMapKind, Name: s.Name, Key: s.Key, Optional: ptr.Deref(s.Optional, false)})
+		return false
+
+	case secretVolumeSourceType:
+		s := v.Interface().(v1.SecretVolumeSource)
+		rc.add(reference{Kind: secretKind, Name: s.SecretName, Optional: ptr.Deref(s.Optional, false)})
+		return false
+
+	case configMapVolumeSourceType:
+		s := v.Interface().(v1.ConfigMapVolumeSource)
+		rc.add(reference{Kind: configMapKind, Name: s.Name, Optional: ptr.Deref(s.Optional, false)})
+		return false
+
+	case secretProjectionType:
+		s := v.Interface().(v1.SecretProjection)
+		rc.add(reference{Kind: secretKind, Name: s.Name, Optional: ptr.Deref(s.Optional, false)})
+		return false
+
+	case configMapProjectionType:
+		s := v.Interface().(v1.ConfigMapProjection)
+		rc.add(reference{Kind: configMapKind, Name: s.Name, Optional: ptr.Deref(s.Optional, false)})
+		return false
+
+	case secretEnvSourceType:
+		s := v.Interface().(v1.SecretEnvSource)
+		rc.add(reference{Kind: secretKind, Name: s.Name, Optional: ptr.Deref(s.Optional, false)})
+		return false
+
+	case configMapEnvSourceType:
+		s := v.Interface().(v1.ConfigMapEnvSource)
+		rc.add(reference{Kind: configMapKind, Name: s.Name, Optional: ptr.Deref(s.Optional, false)})
+		return false
+
+	case tlsConfigType:
+		c := v.Interface().(monitoringv1.TLSConfig)
+		rc.addCertificateSecret(c.CertificateSecret)
+
+	case webTLSConfigType:
+		c := v.Interface().(monitoringv1.WebTLSConfig)
+		rc.addCertificateSecret(c.CertificateSecret)
+	}
+
+	return true
+}
+
+func (rc *referenceCollector) addCertificateSecret(name *string) {
+	if name == nil {
+		return
+	}
+
+	rc.add(reference{Kind: secretKind, Name: *name, Key: v1.TLSCertKey})
+	rc.add(reference{Kind: secretKind, Name: *name, Key: v1.TLSPrivateKeyKey})
+}
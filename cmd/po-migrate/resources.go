@@ -0,0 +1,209 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+// redactedAnnotation is set on the exported Secrets whose values have been
+// removed.
+const redactedAnnotation = "operator.prometheus.io/redacted"
+
+type monitoringResource struct {
+	gvr       schema.GroupVersionResource
+	kind      string
+	newObject func() any
+}
+
+// monitoringResources lists the custom resources which are exported and
+// imported, in the order in which they are applied.
+var monitoringResources = []monitoringResource{
+	{monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName), monitoringv1.PrometheusRuleKind, func() any { return &monitoringv1.PrometheusRule{} }},
+	{monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ServiceMonitorName), monitoringv1.ServiceMonitorsKind, func() any { return &monitoringv1.ServiceMonitor{} }},
+	{monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PodMonitorName), monitoringv1.PodMonitorsKind, func() any { return &monitoringv1.PodMonitor{} }},
+	{monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ProbeName), monitoringv1.ProbesKind, func() any { return &monitoringv1.Probe{} }},
+	{monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ScrapeConfigName), monitoringv1alpha1.ScrapeConfigsKind, func() any { return &monitoringv1alpha1.ScrapeConfig{} }},
+	{monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerConfigName), monitoringv1alpha1.AlertmanagerConfigKind, func() any { return &monitoringv1alpha1.AlertmanagerConfig{} }},
+	{monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusName), monitoringv1.PrometheusesKind, func() any { return &monitoringv1.Prometheus{} }},
+	{monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.PrometheusAgentName), monitoringv1alpha1.PrometheusAgentsKind, func() any { return &monitoringv1alpha1.PrometheusAgent{} }},
+	{monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName), monitoringv1.AlertmanagersKind, func() any { return &monitoringv1.Alertmanager{} }},
+	{monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ThanosRulerName), monitoringv1.ThanosRulerKind, func() any { return &monitoringv1.ThanosRuler{} }},
+}
+
+var (
+	secretGVR    = v1.SchemeGroupVersion.WithResource("secrets")
+	configMapGVR = v1.SchemeGroupVersion.WithResource("configmaps")
+)
+
+// resourceFor returns the GroupVersionResource of the object and whether it
+// is a monitoring resource.
+func resourceFor(u *unstructured.Unstructured) (schema.GroupVersionResource, *monitoringResource, error) {
+	gvk := u.GroupVersionKind()
+	if gvk.GroupVersion() == v1.SchemeGroupVersion {
+		switch gvk.Kind {
+		case secretKind:
+			return secretGVR, nil, nil
+		case configMapKind:
+			return configMapGVR, nil, nil
+		}
+	}
+
+	for i, mr := range monitoringResources {
+		if mr.gvr.GroupVersion() == gvk.GroupVersion() && mr.kind == gvk.Kind {
+			return mr.gvr, &monitoringResources[i], nil
+		}
+	}
+
+	return schema.GroupVersionResource{}, nil, fmt.Errorf("unsupported kind %q", gvk.String())
+}
+
+// references returns the Secrets and ConfigMaps referenced by the monitoring
+// resource.
+func (mr *monitoringResource) references(u *unstructured.Unstructured) ([]reference, error) {
+	obj := mr.newObject()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return nil, fmt.Errorf("failed to convert %s %s/%s: %w", mr.kind, u.GetNamespace(), u.GetName(), err)
+	}
+
+	return collectReferences(u.GetNamespace(), obj), nil
+}
+
+// sanitize removes the fields which are specific to the source cluster.
+func sanitize(u *unstructured.Unstructured) {
+	for _, f := range []string{
+		"uid",
+		"resourceVersion",
+		"generation",
+		"creationTimestamp",
+		"deletionTimestamp",
+		"deletionGracePeriodSeconds",
+		"managedFields",
+		"ownerReferences",
+		"selfLink",
+	} {
+		unstructured.RemoveNestedField(u.Object, "metadata", f)
+	}
+
+	unstructured.RemoveNestedField(u.Object, "status")
+}
+
+// redact removes the values of the Secret while keeping the keys.
+func redact(u *unstructured.Unstructured) {
+	data, _, _ := unstructured.NestedMap(u.Object, "data")
+	for k := range data {
+		data[k] = ""
+	}
+	_ = unstructured.SetNestedMap(u.Object, data, "data")
+	unstructured.RemoveNestedField(u.Object, "stringData")
+
+	annotations := u.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[redactedAnnotation] = "true"
+	u.SetAnnotations(annotations)
+}
+
+func isRedacted(u *unstructured.Unstructured) bool {
+	return u.GetAnnotations()[redactedAnnotation] == "true"
+}
+
+// dataKeys returns the keys of the Secret or ConfigMap.
+func dataKeys(u *unstructured.Unstructured) map[string]struct{} {
+	keys := map[string]struct{}{}
+	for _, field := range []string{"data", "stringData", "binaryData"} {
+		m, _, _ := unstructured.NestedMap(u.Object, field)
+		for k := range m {
+			keys[k] = struct{}{}
+		}
+	}
+
+	return keys
+}
+
+// lookupFunc returns the keys of the Secret or ConfigMap in the destination
+// cluster and whether the object exists.
+type lookupFunc func(ctx context.Context, kind, namespace, name string) (map[string]struct{}, bool, error)
+
+// checkReferences verifies that all the Secrets and ConfigMaps referenced by
+// the monitoring resources are either included in the archive or already
+// present in the destination cluster. Redacted Secrets must exist in the
+// destination cluster. It returns the list of broken references.
+func checkReferences(ctx context.Context, objects []*unstructured.Unstructured, lookup lookupFunc) ([]string, error) {
+	archived := map[string]*unstructured.Unstructured{}
+	for _, o := range objects {
+		if _, mr, err := resourceFor(o); err == nil && mr == nil {
+			archived[o.GetKind()+"/"+o.GetNamespace()+"/"+o.GetName()] = o
+		}
+	}
+
+	var problems []string
+	for _, o := range objects {
+		_, mr, err := resourceFor(o)
+		if err != nil {
+			return nil, err
+		}
+
+		if mr == nil {
+			continue
+		}
+
+		refs, err := mr.references(o)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ref := range refs {
+			if ref.Optional {
+				continue
+			}
+
+			var (
+				keys  map[string]struct{}
+				found bool
+			)
+
+			if a, ok := archived[ref.objectKey()]; ok && !isRedacted(a) {
+				keys, found = dataKeys(a), true
+			} else {
+				keys, found, err = lookup(ctx, ref.Kind, ref.Namespace, ref.Name)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if !found {
+				problems = append(problems, fmt.Sprintf("%s %s/%s: %s not found", mr.kind, o.GetNamespace(), o.GetName(), ref))
+				continue
+			}
+
+			if _, ok := keys[ref.Key]; ref.Key != "" && !ok {
+				problems = append(problems, fmt.Sprintf("%s %s/%s: %s: key not found", mr.kind, o.GetNamespace(), o.GetName(), ref))
+			}
+		}
+	}
+
+	return problems, nil
+}
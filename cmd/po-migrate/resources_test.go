@@ -0,0 +1,193 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	t.Helper()
+
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+
+	return &unstructured.Unstructured{Object: m}
+}
+
+func newServiceMonitor() *monitoringv1.ServiceMonitor {
+	return &monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.ServiceMonitorsKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sm",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					Port: "web",
+					BasicAuth: &monitoringv1.BasicAuth{
+						Username: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "auth"}, Key: "user"},
+						Password: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "auth"}, Key: "password"},
+					},
+					TLSConfig: &monitoringv1.TLSConfig{
+						SafeTLSConfig: monitoringv1.SafeTLSConfig{
+							CA: monitoringv1.SecretOrConfigMap{
+								ConfigMap: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "ca"}, Key: "ca.crt"},
+							},
+						},
+					},
+				},
+				{
+					Port: "metrics",
+					BearerTokenSecret: &v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "token"},
+						Key:                  "token",
+						Optional:             ptr.To(true),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCollectReferences(t *testing.T) {
+	require.Equal(t, []reference{
+		{Kind: configMapKind, Namespace: "ns", Name: "ca", Key: "ca.crt"},
+		{Kind: secretKind, Namespace: "ns", Name: "auth", Key: "password"},
+		{Kind: secretKind, Namespace: "ns", Name: "auth", Key: "user"},
+		{Kind: secretKind, Namespace: "ns", Name: "token", Key: "token", Optional: true},
+	}, collectReferences("ns", newServiceMonitor()))
+
+	p := &monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				Secrets:    []string{"extra"},
+				ConfigMaps: []string{"extra"},
+				Web: &monitoringv1.PrometheusWebSpec{
+					WebConfigFileFields: monitoringv1.WebConfigFileFields{
+						TLSConfig: &monitoringv1.WebTLSConfig{CertificateSecret: ptr.To("web-tls")},
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, []reference{
+		{Kind: configMapKind, Namespace: "default", Name: "extra"},
+		{Kind: secretKind, Namespace: "default", Name: "extra"},
+		{Kind: secretKind, Namespace: "default", Name: "web-tls", Key: "tls.crt"},
+		{Kind: secretKind, Namespace: "default", Name: "web-tls", Key: "tls.key"},
+	}, collectReferences("default", p))
+}
+
+func TestCheckReferences(t *testing.T) {
+	sm := toUnstructured(t, newServiceMonitor())
+
+	auth := toUnstructured(t, &v1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: secretKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "auth", Namespace: "ns"},
+		Data:       map[string][]byte{"user": []byte("u"), "password": []byte("p")},
+	})
+
+	ca := toUnstructured(t, &v1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: configMapKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "ns"},
+		Data:       map[string]string{"ca.crt": "xxx"},
+	})
+
+	noCluster := func(context.Context, string, string, string) (map[string]struct{}, bool, error) {
+		return nil, false, nil
+	}
+
+	// All the references are included in the archive.
+	problems, err := checkReferences(context.Background(), []*unstructured.Unstructured{sm, auth, ca}, noCluster)
+	require.NoError(t, err)
+	require.Empty(t, problems)
+
+	// The ConfigMap is missing.
+	problems, err = checkReferences(context.Background(), []*unstructured.Unstructured{sm, auth}, noCluster)
+	require.NoError(t, err)
+	require.Equal(t, []string{`ServiceMonitor ns/sm: ConfigMap ns/ca (key "ca.crt") not found`}, problems)
+
+	// The ConfigMap exists in the cluster.
+	problems, err = checkReferences(context.Background(), []*unstructured.Unstructured{sm, auth}, func(_ context.Context, kind, namespace, name string) (map[string]struct{}, bool, error) {
+		if kind == configMapKind && namespace == "ns" && name == "ca" {
+			return map[string]struct{}{"ca.crt": {}}, true, nil
+		}
+		return nil, false, nil
+	})
+	require.NoError(t, err)
+	require.Empty(t, problems)
+
+	// The redacted Secret must exist in the cluster.
+	redact(auth)
+	problems, err = checkReferences(context.Background(), []*unstructured.Unstructured{sm, auth, ca}, noCluster)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`ServiceMonitor ns/sm: Secret ns/auth (key "password") not found`,
+		`ServiceMonitor ns/sm: Secret ns/auth (key "user") not found`,
+	}, problems)
+
+	problems, err = checkReferences(context.Background(), []*unstructured.Unstructured{sm, auth, ca}, func(_ context.Context, kind, _, _ string) (map[string]struct{}, bool, error) {
+		return map[string]struct{}{"user": {}}, kind == secretKind, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{`ServiceMonitor ns/sm: Secret ns/auth (key "password"): key not found`}, problems)
+}
+
+func TestArchive(t *testing.T) {
+	sm := toUnstructured(t, newServiceMonitor())
+	sm.SetResourceVersion("123")
+	sm.SetUID("abc")
+	sanitize(sm)
+
+	secret := toUnstructured(t, &v1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: secretKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "auth", Namespace: "ns"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	})
+	redact(secret)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeArchive(&buf, []*unstructured.Unstructured{sm, secret}))
+
+	objects, err := readArchive(&buf)
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+
+	require.Equal(t, "ns/secret/auth.yaml", archivePath(objects[0]))
+	require.True(t, isRedacted(objects[0]))
+	require.Equal(t, map[string]struct{}{"password": {}}, dataKeys(objects[0]))
+	data, _, _ := unstructured.NestedStringMap(objects[0].Object, "data")
+	require.Equal(t, map[string]string{"password": ""}, data)
+
+	require.Equal(t, "ns/servicemonitor/sm.yaml", archivePath(objects[1]))
+	require.Empty(t, objects[1].GetResourceVersion())
+	require.Empty(t, string(objects[1].GetUID()))
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/alecthomas/kingpin/v2"
 	"github.com/oklog/run"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/common/config"
 	"github.com/prometheus/common/version"
 	"github.com/prometheus/exporter-toolkit/web"
 	"github.com/thanos-io/thanos/pkg/reloader"
@@ -64,6 +65,12 @@ func main() {
 	cfgSubstFile := app.Flag("config-envsubst-file", "output file for environment variable substituted config file").
 		String()
 
+	cfgDir := app.Flag("config-dir", "directory of config files watched by the reloader (compressed files are decompressed)").
+		String()
+
+	cfgSubstDir := app.Flag("config-envsubst-dir", "output directory for the environment variable substituted files of the config directory").
+		String()
+
 	watchInterval := app.Flag("watch-interval", "how often the reloader re-reads the configuration file and directories; when set to 0, the program runs only once and exits").Default(defaultWatchInterval.String()).Duration()
 	delayInterval := app.Flag("delay-interval", "how long the reloader waits before reloading after it has detected a change").Default(defaultDelayInterval.String()).Duration()
 	retryInterval := app.Flag("retry-interval", "how long the reloader waits before retrying in case the endpoint returned an error").Default(defaultRetryInterval.String()).Duration()
@@ -108,6 +115,21 @@ func main() {
 	runtimeInfoURL := app.Flag("runtimeinfo-url", "URL to check the status of the runtime configuration").
 		Default("http://127.0.0.1:9090/api/v1/status/runtimeinfo").URL()
 
+	var reloadClientConfig config.HTTPClientConfig
+	reloadClientConfig.BasicAuth = &config.BasicAuth{}
+	app.Flag("reload-tls-ca-file", "CA certificate file used to verify the certificate of the server when the reload URL uses HTTPS. When empty, the certificate isn't verified.").
+		StringVar(&reloadClientConfig.TLSConfig.CAFile)
+	app.Flag("reload-tls-cert-file", "client certificate file presented to the server when the reload URL uses HTTPS").
+		StringVar(&reloadClientConfig.TLSConfig.CertFile)
+	app.Flag("reload-tls-key-file", "client private key file presented to the server when the reload URL uses HTTPS").
+		StringVar(&reloadClientConfig.TLSConfig.KeyFile)
+	app.Flag("reload-tls-server-name", "server name used to verify the certificate of the server when the reload URL uses HTTPS").
+		StringVar(&reloadClientConfig.TLSConfig.ServerName)
+	app.Flag("reload-basic-auth-username-file", "file containing the username for the basic authentication to the reload URL").
+		StringVar(&reloadClientConfig.BasicAuth.UsernameFile)
+	app.Flag("reload-basic-auth-password-file", "file containing the password for the basic authentication to the reload URL").
+		StringVar(&reloadClientConfig.BasicAuth.PasswordFile)
+
 	versionutil.RegisterIntoKingpinFlags(app)
 
 	if _, err := app.Parse(os.Args[1:]); err != nil {
@@ -152,8 +174,9 @@ func main() {
 	r := metrics.NewRegistry("prometheus_config_reloader")
 
 	var (
-		g           run.Group
-		ctx, cancel = context.WithCancel(context.Background())
+		g            run.Group
+		ctx, cancel  = context.WithCancel(context.Background())
+		reloadStatus *reloadStatusRecorder
 	)
 
 	{
@@ -167,13 +190,61 @@ func main() {
 			TolerateEnvVarExpansionErrors: true,
 		}
 
+		if strings.HasSuffix(*cfgFile, zstdExtension) {
+			// The reloader doesn't support zstd: the configuration file is
+			// decompressed into an intermediate file watched by the
+			// reloader instead.
+			if *cfgSubstFile == "" {
+				logger.Error("The config-envsubst-file flag is required with a zstd-compressed config file")
+				os.Exit(2)
+			}
+
+			zcf := newZstdConfigFile(*cfgFile, *cfgSubstFile)
+			if err := zcf.sync(); err != nil {
+				logger.Error("Failed to decompress the configuration file", "err", err)
+				os.Exit(2)
+			}
+			opts.CfgFile = zcf.dst
+
+			if *watchInterval != 0 {
+				g.Add(func() error {
+					return zcf.run(ctx, logger, zstdPollInterval)
+				}, func(error) {
+					cancel()
+				})
+			}
+		}
+
+		if *cfgDir != "" {
+			// Start from an empty output directory to remove the files of
+			// the previous runs which may not exist anymore.
+			if err := os.RemoveAll(*cfgSubstDir); err != nil {
+				logger.Error("Failed to clean the output directory", "err", err, "dir", *cfgSubstDir)
+				os.Exit(2)
+			}
+			if err := os.MkdirAll(*cfgSubstDir, 0o755); err != nil {
+				logger.Error("Failed to create the output directory", "err", err, "dir", *cfgSubstDir)
+				os.Exit(2)
+			}
+
+			opts.CfgDirs = []reloader.CfgDirOption{{Dir: *cfgDir, OutputDir: *cfgSubstDir}}
+		}
+
 		switch *reloadMethod {
 		case signalReloadMethod:
 			opts.RuntimeInfoURL = *runtimeInfoURL
 			opts.ProcessName = *processName
 		default:
 			opts.ReloadURL = *reloadURL
-			opts.HTTPClient = createHTTPClient(reloadTimeout)
+			opts.HTTPClient, err = createReloadHTTPClient(reloadTimeout, reloadClientConfig)
+			if err != nil {
+				logger.Error("Failed to create the HTTP client for the reload URL", "err", err)
+				os.Exit(2)
+			}
+
+			// Record the outcome of the reloads to expose it to the operator.
+			reloadStatus = newReloadStatusRecorder(opts.HTTPClient.Transport)
+			opts.HTTPClient.Transport = reloadStatus
 		}
 
 		rel := reloader.New(
@@ -195,6 +266,21 @@ func main() {
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`{"status":"up"}`))
 		})
+		// The readiness endpoint fails while the last reload failed.
+		http.HandleFunc(operator.ConfigReloaderReadinessPath, func(w http.ResponseWriter, _ *http.Request) {
+			if reloadStatus != nil {
+				if err := reloadStatus.ready(); err != nil {
+					http.Error(w, err.Error(), http.StatusServiceUnavailable)
+					return
+				}
+			}
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"status":"ready"}`))
+		})
+		if reloadStatus != nil {
+			http.Handle(operator.ConfigReloadStatusPath, reloadStatus)
+		}
 
 		srv := &http.Server{}
 
@@ -248,6 +334,46 @@ func createHTTPClient(timeout *time.Duration) http.Client {
 	}
 }
 
+// createReloadHTTPClient returns the HTTP client calling the reload URL. The
+// TLS credentials and basic authentication files are read for every new
+// connection which means that they can be renewed without restarting the
+// program.
+func createReloadHTTPClient(timeout *time.Duration, cfg config.HTTPClientConfig) (http.Client, error) {
+	if cfg.BasicAuth != nil && cfg.BasicAuth.UsernameFile == "" && cfg.BasicAuth.PasswordFile == "" {
+		cfg.BasicAuth = nil
+	}
+
+	if cfg.BasicAuth == nil && cfg.TLSConfig == (config.TLSConfig{}) {
+		return createHTTPClient(timeout), nil
+	}
+
+	// The certificate of the server is verified only when the CA is known.
+	cfg.TLSConfig.InsecureSkipVerify = cfg.TLSConfig.CAFile == ""
+
+	if err := cfg.Validate(); err != nil {
+		return http.Client{}, err
+	}
+
+	rt, err := config.NewRoundTripperFromConfig(
+		cfg,
+		"prometheus-config-reloader",
+		config.WithDialContextFunc((&net.Dialer{
+			Timeout:   *timeout,
+			KeepAlive: -1,
+		}).DialContext),
+		config.WithKeepAlivesDisabled(),
+		config.WithHTTP2Disabled(),
+	)
+	if err != nil {
+		return http.Client{}, err
+	}
+
+	return http.Client{
+		Timeout:   *timeout,
+		Transport: rt,
+	}, nil
+}
+
 func createOrdinalEnvvar(fromName string) error {
 	reg := regexp.MustCompile(`\d+$`)
 	val := reg.FindString(os.Getenv(fromName))
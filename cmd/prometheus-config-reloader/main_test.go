@@ -16,13 +16,18 @@ package main
 
 import (
 	"crypto/tls"
+	"encoding/pem"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/go-test/deep"
+	"github.com/prometheus/common/config"
+	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
@@ -81,3 +86,80 @@ func TestCreateHTTPClient(t *testing.T) {
 		}
 	})
 }
+
+func TestCreateReloadHTTPClient(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != "user" || p != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	writeFile := func(name string, content []byte) string {
+		f := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(f, content, 0o600))
+		return f
+	}
+
+	caFile := writeFile("ca.crt", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	usernameFile := writeFile("username", []byte("user"))
+	passwordFile := writeFile("password", []byte("secret"))
+
+	timeout := 5 * time.Second
+	for _, tc := range []struct {
+		name   string
+		cfg    config.HTTPClientConfig
+		status int
+		err    bool
+	}{
+		{
+			name: "no verification and no credentials",
+			cfg: config.HTTPClientConfig{
+				BasicAuth: &config.BasicAuth{},
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "ca and basic auth",
+			cfg: config.HTTPClientConfig{
+				BasicAuth: &config.BasicAuth{
+					UsernameFile: usernameFile,
+					PasswordFile: passwordFile,
+				},
+				TLSConfig: config.TLSConfig{
+					CAFile:     caFile,
+					ServerName: "example.com",
+				},
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "wrong server name",
+			cfg: config.HTTPClientConfig{
+				TLSConfig: config.TLSConfig{
+					CAFile:     caFile,
+					ServerName: "prometheus.invalid",
+				},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := createReloadHTTPClient(&timeout, tc.cfg)
+			require.NoError(t, err)
+
+			resp, err := client.Post(srv.URL+"/-/reload", "", nil)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			resp.Body.Close()
+			require.Equal(t, tc.status, resp.StatusCode)
+		})
+	}
+}
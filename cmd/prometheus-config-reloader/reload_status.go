@@ -0,0 +1,123 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// maxReloadErrorLength is the maximum length of the error message reported
+// for a failed reload.
+const maxReloadErrorLength = 1024
+
+// reloadStatusRecorder is an http.RoundTripper which records the outcome of
+// the reload requests sent to the reload URL. The last status is served by
+// its HTTP handler (404 until the first reload).
+type reloadStatusRecorder struct {
+	next http.RoundTripper
+
+	mtx    sync.Mutex
+	status *operator.ConfigReloadStatus
+}
+
+func newReloadStatusRecorder(next http.RoundTripper) *reloadStatusRecorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &reloadStatusRecorder{next: next}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *reloadStatusRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		r.record(err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		r.record("")
+		return resp, nil
+	}
+
+	// The body contains the reason of the failure (e.g. an invalid
+	// configuration). It is restored for the caller.
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		r.record(fmt.Sprintf("received %s response", resp.Status))
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+
+	msg := fmt.Sprintf("received %s response", resp.Status)
+	if body := strings.TrimSpace(string(b)); body != "" {
+		msg += ": " + body
+	}
+	if len(msg) > maxReloadErrorLength {
+		msg = msg[:maxReloadErrorLength] + "..."
+	}
+	r.record(msg)
+
+	return resp, nil
+}
+
+func (r *reloadStatusRecorder) record(errMsg string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.status = &operator.ConfigReloadStatus{
+		Success: errMsg == "",
+		Time:    time.Now().UTC(),
+		Error:   errMsg,
+	}
+}
+
+// ready returns an error if the last reload failed.
+func (r *reloadStatusRecorder) ready() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.status == nil || r.status.Success {
+		return nil
+	}
+
+	return fmt.Errorf("last reload failed: %s", r.status.Error)
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (r *reloadStatusRecorder) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	r.mtx.Lock()
+	status := r.status
+	r.mtx.Unlock()
+
+	if status == nil {
+		http.Error(w, "no reload triggered yet", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(status)
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestReloadStatusRecorder(t *testing.T) {
+	code := http.StatusInternalServerError
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(code)
+		if code != http.StatusOK {
+			_, _ = w.Write([]byte("failed to reload config: invalid configuration\n"))
+		}
+	}))
+	defer srv.Close()
+
+	recorder := newReloadStatusRecorder(nil)
+	client := &http.Client{Transport: recorder}
+
+	getStatus := func() (int, *operator.ConfigReloadStatus) {
+		w := httptest.NewRecorder()
+		recorder.ServeHTTP(w, httptest.NewRequest(http.MethodGet, operator.ConfigReloadStatusPath, nil))
+		if w.Code != http.StatusOK {
+			return w.Code, nil
+		}
+
+		var s operator.ConfigReloadStatus
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&s))
+		return w.Code, &s
+	}
+
+	status, _ := getStatus()
+	require.Equal(t, http.StatusNotFound, status)
+	require.NoError(t, recorder.ready())
+
+	// Failed reload.
+	resp, err := client.Post(srv.URL, "", nil)
+	require.NoError(t, err)
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, "failed to reload config: invalid configuration\n", string(b), "the response body should be preserved")
+
+	status, s := getStatus()
+	require.Equal(t, http.StatusOK, status)
+	require.False(t, s.Success)
+	require.Equal(t, "received 500 Internal Server Error response: failed to reload config: invalid configuration", s.Error)
+	require.ErrorContains(t, recorder.ready(), "last reload failed: received 500 Internal Server Error response")
+
+	// Successful reload.
+	code = http.StatusOK
+	resp, err = client.Post(srv.URL, "", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	_, s = getStatus()
+	require.True(t, s.Success)
+	require.Empty(t, s.Error)
+	require.NoError(t, recorder.ready())
+
+	// Unreachable endpoint.
+	srv.Close()
+	_, err = client.Post(srv.URL, "", nil)
+	require.Error(t, err)
+
+	_, s = getStatus()
+	require.False(t, s.Success)
+	require.NotEmpty(t, s.Error)
+}
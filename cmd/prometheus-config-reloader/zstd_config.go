@@ -0,0 +1,105 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	zstdExtension = ".zst"
+
+	// zstdPollInterval is how often the zstd-compressed configuration file
+	// is checked for changes.
+	zstdPollInterval = 5 * time.Second
+)
+
+// zstdConfigFile decompresses a zstd-compressed configuration file into an
+// intermediate file which is watched by the reloader (the reloader only
+// supports gzip-compressed files).
+type zstdConfigFile struct {
+	src  string
+	dst  string
+	hash [sha256.Size]byte
+}
+
+// newZstdConfigFile returns a zstdConfigFile decompressing src into a file
+// located in the same directory as the environment variable substituted
+// configuration file.
+func newZstdConfigFile(src, substFile string) *zstdConfigFile {
+	return &zstdConfigFile{
+		src: src,
+		dst: filepath.Join(filepath.Dir(substFile), strings.TrimSuffix(filepath.Base(src), zstdExtension)),
+	}
+}
+
+// sync decompresses the source file if its content changed since the last
+// call. The destination file is replaced atomically.
+func (z *zstdConfigFile) sync() error {
+	b, err := os.ReadFile(z.src)
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", z.src, err)
+	}
+
+	h := sha256.Sum256(b)
+	if bytes.Equal(h[:], z.hash[:]) {
+		return nil
+	}
+
+	cfg, err := operator.DecompressConfig(b)
+	if err != nil {
+		return fmt.Errorf("failed to decompress %q: %w", z.src, err)
+	}
+
+	tmp := z.dst + ".tmp"
+	if err := os.WriteFile(tmp, []byte(cfg), 0o644); err != nil {
+		return fmt.Errorf("failed to write %q: %w", tmp, err)
+	}
+
+	if err := os.Rename(tmp, z.dst); err != nil {
+		return fmt.Errorf("failed to rename %q: %w", tmp, err)
+	}
+
+	z.hash = h
+	return nil
+}
+
+// run decompresses the source file whenever it changes until the context
+// is canceled.
+func (z *zstdConfigFile) run(ctx context.Context, logger *slog.Logger, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := z.sync(); err != nil {
+				logger.Error("Failed to decompress the configuration file", "err", err)
+			}
+		}
+	}
+}
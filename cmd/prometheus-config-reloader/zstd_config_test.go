@@ -0,0 +1,67 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestZstdConfigFile(t *testing.T) {
+	var (
+		dir = t.TempDir()
+		src = filepath.Join(dir, "prometheus.yaml.zst")
+	)
+
+	write := func(cfg string) {
+		var buf bytes.Buffer
+		require.NoError(t, operator.ZstdConfig(&buf, []byte(cfg)))
+		require.NoError(t, os.WriteFile(src, buf.Bytes(), 0o644))
+	}
+
+	z := newZstdConfigFile(src, filepath.Join(dir, "out", "prometheus.env.yaml"))
+	require.Equal(t, filepath.Join(dir, "out", "prometheus.yaml"), z.dst)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "out"), 0o755))
+
+	// The source file doesn't exist yet.
+	require.Error(t, z.sync())
+
+	write("global: {}\n")
+	require.NoError(t, z.sync())
+	b, err := os.ReadFile(z.dst)
+	require.NoError(t, err)
+	require.Equal(t, "global: {}\n", string(b))
+
+	// The destination file isn't rewritten when the source didn't change.
+	require.NoError(t, os.Remove(z.dst))
+	require.NoError(t, z.sync())
+	require.NoFileExists(t, z.dst)
+
+	write("global:\n  scrape_interval: 10s\n")
+	require.NoError(t, z.sync())
+	b, err = os.ReadFile(z.dst)
+	require.NoError(t, err)
+	require.Equal(t, "global:\n  scrape_interval: 10s\n", string(b))
+
+	// Invalid content isn't decompressed.
+	require.NoError(t, os.WriteFile(src, []byte("invalid"), 0o644))
+	require.Error(t, z.sync())
+}
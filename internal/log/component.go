@@ -0,0 +1,344 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ComponentKey is the logger attribute identifying the component (e.g.
+// "prometheus-controller") which emits the log records.
+const ComponentKey = "component"
+
+// Settings defines the log level and format with per-component overrides.
+// Empty values inherit the settings of the lower layer.
+type Settings struct {
+	Level  string `json:"level,omitempty"`
+	Format string `json:"format,omitempty"`
+	// Components maps the component names to their level and format.
+	Components map[string]ComponentSettings `json:"components,omitempty"`
+}
+
+// ComponentSettings overrides the log level and format of a component.
+type ComponentSettings struct {
+	Level  string `json:"level,omitempty"`
+	Format string `json:"format,omitempty"`
+}
+
+// Validate returns an error if a level or a format is invalid.
+func (s Settings) Validate() error {
+	if err := validate(s.Level, s.Format); err != nil {
+		return err
+	}
+
+	for name, c := range s.Components {
+		if err := validate(c.Level, c.Format); err != nil {
+			return fmt.Errorf("component %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func validate(level, format string) error {
+	if level != "" {
+		if _, err := parseLevel(level); err != nil {
+			return err
+		}
+	}
+
+	if format != "" && !slices.Contains(AvailableLogFormats, strings.ToLower(format)) {
+		return fmt.Errorf("log format %s unknown, %v are possible values", format, AvailableLogFormats)
+	}
+
+	return nil
+}
+
+// componentConfig is the resolved level and format of a component.
+type componentConfig struct {
+	level  slog.Level
+	format string
+}
+
+type routerState struct {
+	// Incremented on every update to invalidate the cached handlers.
+	generation uint64
+	defaults   componentConfig
+	components map[string]componentConfig
+}
+
+func (s *routerState) config(component string) componentConfig {
+	if c, found := s.components[component]; found {
+		return c
+	}
+
+	return s.defaults
+}
+
+// Router is a slog handler which filters and formats the log records
+// depending on the component of the logger. The settings can be updated at
+// runtime.
+type Router struct {
+	base     Config
+	handlers map[string]slog.Handler
+	state    atomic.Pointer[routerState]
+}
+
+// NewRouter returns a Router writing to the standard output with the given
+// default level and format.
+func NewRouter(c Config) (*Router, error) {
+	return newRouter(os.Stdout, c)
+}
+
+func newRouter(w io.Writer, c Config) (*Router, error) {
+	opts := &slog.HandlerOptions{
+		// The level is checked by the router.
+		Level:       slog.Level(math.MinInt),
+		AddSource:   true,
+		ReplaceAttr: replaceSlogAttributes,
+	}
+
+	r := &Router{
+		base: c,
+		handlers: map[string]slog.Handler{
+			FormatLogFmt: slog.NewTextHandler(w, opts),
+			FormatJSON:   slog.NewJSONHandler(w, opts),
+		},
+	}
+
+	if err := r.Update(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Logger returns a logger using the router.
+func (r *Router) Logger() *slog.Logger {
+	return slog.New(&componentHandler{r: r, cache: &atomic.Pointer[cachedHandler]{}})
+}
+
+// Update applies the layers of settings in order on top of the base
+// configuration. The previous settings are kept if a layer is invalid.
+func (r *Router) Update(layers ...Settings) error {
+	level, format := r.base.Level, r.base.Format
+	components := map[string]ComponentSettings{}
+
+	for _, l := range layers {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+
+		if l.Level != "" {
+			level = l.Level
+		}
+		if l.Format != "" {
+			format = l.Format
+		}
+
+		for name, c := range l.Components {
+			prev := components[name]
+			if c.Level != "" {
+				prev.Level = c.Level
+			}
+			if c.Format != "" {
+				prev.Format = c.Format
+			}
+			components[name] = prev
+		}
+	}
+
+	defaults, err := resolve(level, format, componentConfig{})
+	if err != nil {
+		return err
+	}
+
+	state := &routerState{
+		defaults:   defaults,
+		components: make(map[string]componentConfig, len(components)),
+	}
+	if prev := r.state.Load(); prev != nil {
+		state.generation = prev.generation + 1
+	}
+
+	for name, c := range components {
+		state.components[name], err = resolve(c.Level, c.Format, defaults)
+		if err != nil {
+			return fmt.Errorf("component %q: %w", name, err)
+		}
+	}
+
+	r.state.Store(state)
+	return nil
+}
+
+func resolve(level, format string, defaults componentConfig) (componentConfig, error) {
+	c := defaults
+
+	if level != "" {
+		lvl, err := parseLevel(level)
+		if err != nil {
+			return c, err
+		}
+		c.level = lvl
+	}
+
+	if format != "" {
+		if err := validate("", format); err != nil {
+			return c, err
+		}
+		c.format = strings.ToLower(format)
+	}
+
+	return c, nil
+}
+
+type cachedHandler struct {
+	generation uint64
+	handler    slog.Handler
+}
+
+// componentHandler implements slog.Handler. The attributes and groups are
+// replayed on the handler matching the format of the component when the
+// settings change.
+type componentHandler struct {
+	r         *Router
+	component string
+	grouped   bool
+	ops       []func(slog.Handler) slog.Handler
+
+	cache *atomic.Pointer[cachedHandler]
+}
+
+var _ slog.Handler = &componentHandler{}
+
+func (h *componentHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.r.state.Load().config(h.component).level
+}
+
+func (h *componentHandler) Handle(ctx context.Context, rec slog.Record) error {
+	return h.handler().Handle(ctx, rec)
+}
+
+func (h *componentHandler) handler() slog.Handler {
+	state := h.r.state.Load()
+	if c := h.cache.Load(); c != nil && c.generation == state.generation {
+		return c.handler
+	}
+
+	handler := h.r.handlers[state.config(h.component).format]
+	for _, op := range h.ops {
+		handler = op(handler)
+	}
+	h.cache.Store(&cachedHandler{generation: state.generation, handler: handler})
+
+	return handler
+}
+
+func (h *componentHandler) with(op func(slog.Handler) slog.Handler) *componentHandler {
+	return &componentHandler{
+		r:         h.r,
+		component: h.component,
+		grouped:   h.grouped,
+		ops:       append(slices.Clip(h.ops), op),
+		cache:     &atomic.Pointer[cachedHandler]{},
+	}
+}
+
+func (h *componentHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h2 := h.with(func(sh slog.Handler) slog.Handler { return sh.WithAttrs(attrs) })
+	if !h.grouped {
+		for _, a := range attrs {
+			if a.Key == ComponentKey {
+				h2.component = a.Value.String()
+			}
+		}
+	}
+
+	return h2
+}
+
+func (h *componentHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	h2 := h.with(func(sh slog.Handler) slog.Handler { return sh.WithGroup(name) })
+	h2.grouped = true
+
+	return h2
+}
+
+// LoadSettingsFile reads the settings from a YAML file.
+func LoadSettingsFile(path string) (Settings, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	return parseSettings(path, b)
+}
+
+func parseSettings(path string, b []byte) (Settings, error) {
+	var s Settings
+	if err := yaml.UnmarshalStrict(b, &s); err != nil {
+		return s, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return s, s.Validate()
+}
+
+// WatchSettingsFile reads the settings file at the given interval until the
+// context is canceled and calls fn when the content changes. Invalid
+// settings are logged and ignored.
+func WatchSettingsFile(ctx context.Context, logger *slog.Logger, path string, interval time.Duration, fn func(Settings)) {
+	var last []byte
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		b, err := os.ReadFile(path)
+		switch {
+		case err != nil:
+			logger.Warn("failed to read the log settings file", "path", path, "err", err)
+		case !bytes.Equal(b, last):
+			last = b
+			s, err := parseSettings(path, b)
+			if err != nil {
+				logger.Error("ignoring invalid log settings", "path", path, "err", err)
+				break
+			}
+			fn(s)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
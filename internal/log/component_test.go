@@ -0,0 +1,138 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := newRouter(&buf, Config{Level: LevelInfo, Format: FormatLogFmt})
+	require.NoError(t, err)
+
+	logger := r.Logger()
+	prom := logger.With(ComponentKey, "prometheus-controller")
+	am := logger.With(ComponentKey, "alertmanager-controller", "key", "value")
+
+	lines := func() []string {
+		defer buf.Reset()
+		return strings.Split(strings.TrimSpace(buf.String()), "\n")
+	}
+
+	prom.Debug("prometheus debug")
+	prom.Info("prometheus info")
+	require.Equal(t, []string{"prometheus info"}, messages(lines()))
+
+	require.NoError(t, r.Update(Settings{
+		Level: LevelWarn,
+		Components: map[string]ComponentSettings{
+			"prometheus-controller": {Level: LevelDebug, Format: FormatJSON},
+		},
+	}))
+
+	prom.Debug("prometheus debug")
+	am.Info("alertmanager info")
+	am.Warn("alertmanager warn")
+	logger.Info("default info")
+
+	got := lines()
+	require.Len(t, got, 2)
+	require.True(t, strings.HasPrefix(got[0], "{"), got[0])
+	require.Contains(t, got[0], `"msg":"prometheus debug"`)
+	require.Contains(t, got[0], `"component":"prometheus-controller"`)
+	require.Contains(t, got[1], "msg=\"alertmanager warn\"")
+	require.Contains(t, got[1], "key=value")
+
+	// The last layer takes precedence.
+	require.NoError(t, r.Update(
+		Settings{Components: map[string]ComponentSettings{"prometheus-controller": {Level: LevelError}}},
+		Settings{Level: LevelDebug},
+	))
+
+	prom.Warn("prometheus warn")
+	logger.Debug("default debug")
+	require.Equal(t, []string{"default debug"}, messages(lines()))
+
+	// The previous settings are kept on error.
+	require.Error(t, r.Update(Settings{Level: "verbose"}))
+	logger.Debug("default debug")
+	require.Equal(t, []string{"default debug"}, messages(lines()))
+
+	// The settings are reset to the base configuration.
+	require.NoError(t, r.Update())
+	prom.Info("prometheus info")
+	logger.Debug("default debug")
+	require.Equal(t, []string{"prometheus info"}, messages(lines()))
+}
+
+func TestRouterIgnoresGroupedComponent(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := newRouter(&buf, Config{Level: LevelInfo, Format: FormatLogFmt})
+	require.NoError(t, err)
+
+	require.NoError(t, r.Update(Settings{
+		Components: map[string]ComponentSettings{"thanos-controller": {Level: LevelError}},
+	}))
+
+	r.Logger().WithGroup("request").With(ComponentKey, "thanos-controller").Info("grouped info")
+	require.Contains(t, buf.String(), "request.component=thanos-controller")
+}
+
+func TestParseSettings(t *testing.T) {
+	s, err := parseSettings("test.yaml", []byte(`
+level: warn
+components:
+  prometheus-controller:
+    level: debug
+    format: json
+`))
+	require.NoError(t, err)
+	require.Equal(t, Settings{
+		Level: LevelWarn,
+		Components: map[string]ComponentSettings{
+			"prometheus-controller": {Level: LevelDebug, Format: FormatJSON},
+		},
+	}, s)
+
+	_, err = parseSettings("test.yaml", []byte(`unknown: true`))
+	require.Error(t, err)
+
+	_, err = parseSettings("test.yaml", []byte(`
+components:
+  prometheus-controller:
+    format: xml
+`))
+	require.Error(t, err)
+}
+
+// messages extracts the msg field of logfmt lines.
+func messages(lines []string) []string {
+	var msgs []string
+	for _, l := range lines {
+		_, msg, found := strings.Cut(l, `msg="`)
+		if !found {
+			continue
+		}
+		msg, _, _ = strings.Cut(msg, `"`)
+		msgs = append(msgs, msg)
+	}
+
+	return msgs
+}
@@ -60,6 +60,34 @@
             },
             'for': '10m',
           },
+          {
+            alert: 'PrometheusOperatorResourceReconcileFailing',
+            expr: |||
+              (sum by (%(groupLabels)s,resource_hash) (rate(prometheus_operator_resource_reconcile_operations_total{%(prometheusOperatorSelector)s,outcome!="success"}[5m])) > 0) unless (sum by (%(groupLabels)s,resource_hash) (rate(prometheus_operator_resource_reconcile_operations_total{%(prometheusOperatorSelector)s,outcome="success"}[5m])) > 0)
+            ||| % $._config,
+            labels: {
+              severity: 'warning',
+            },
+            annotations: {
+              description: 'The {{ $labels.controller }} controller in {{ $labels.namespace }} namespace repeatedly fails to reconcile the object with the {{ $labels.resource_hash }} hash.',
+              summary: 'An object repeatedly fails to be reconciled.',
+            },
+            'for': '15m',
+          },
+          {
+            alert: 'PrometheusOperatorConfigSizeNearLimit',
+            expr: |||
+              max by (%(groupLabels)s,resource_hash) (prometheus_operator_generated_config_size_bytes{%(prometheusOperatorSelector)s,compression="gzip"}) > 0.8 * 1048576
+            ||| % $._config,
+            labels: {
+              severity: 'warning',
+            },
+            annotations: {
+              description: 'The compressed configuration generated by the {{ $labels.controller }} controller in {{ $labels.namespace }} namespace for the object with the {{ $labels.resource_hash }} hash is {{ $value | humanize1024 }}B, close to the 1MiB limit of the Secrets.',
+              summary: 'Generated configuration close to the size limit of the Secrets.',
+            },
+            'for': '15m',
+          },
           {
             alert: 'PrometheusOperatorStatusUpdateErrors',
             expr: |||
@@ -9025,6 +9025,24 @@
                             description: 'Whether or not to notify about resolved alerts.',
                             type: 'boolean',
                           },
+                          signingSecret: {
+                            description: "When defined, the operator provisions a Secret holding a random signing\nkey and configures Alertmanager to send the key in the `Authorization`\nheader of the webhook requests. The receiving service can verify the\nauthenticity of the notifications by comparing the header with the\nkey.\n\nIt can't be used with the `authorization`, `basicAuth`, `oauth2` and\n`bearerTokenSecret` fields of `httpConfig`.\nIt requires Alertmanager >= v0.22.0.",
+                            properties: {
+                              name: {
+                                description: "The name of the Secret in the namespace of the AlertmanagerConfig\nobject.\n\nWhen the Secret doesn't exist, the operator creates it with a random\nkey stored under the `key` field. An existing Secret is never modified\nwhich allows users to provide or rotate the key themselves.",
+                                minLength: 1,
+                                type: 'string',
+                              },
+                              type: {
+                                description: 'The authorization scheme of the `Authorization` header.\nDefaults to `Bearer`.',
+                                type: 'string',
+                              },
+                            },
+                            required: [
+                              'name',
+                            ],
+                            type: 'object',
+                          },
                           timeout: {
                             description: 'The maximum time to wait for a webhook request to complete, before failing the\nrequest and allowing it to be retried.\nIt requires Alertmanager >= v0.28.0.',
                             pattern: '^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$',
@@ -9762,6 +9780,11 @@
                 },
                 type: 'array',
               },
+              resolveTimeout: {
+                description: "Resolve timeout requested for the alerts matching the resource's\nnamespace.\n\nAlertmanager supports only a global resolve timeout. The operator\napproximates the requested behavior by setting the group interval of\nthe first-level route (unless it is already defined) to the requested\nvalue, clamped within the bounds defined by the Alertmanager resource\n(`spec.alertmanagerConfigResolveTimeoutBounds`). The value is ignored\nwhen the Alertmanager resource doesn't define bounds.",
+                pattern: '^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$',
+                type: 'string',
+              },
               route: {
                 description: "The Alertmanager route definition for alerts matching the resource's\nnamespace. If present, it will be added to the generated Alertmanager\nconfiguration as a first-level route.",
                 properties: {
@@ -27,6 +27,7 @@ local defaults = {
     if !std.setMember(labelName, ['app.kubernetes.io/version'])
   },
   enableAlertmanagerConfigV1beta1: false,
+  enableScrapeConfigV1beta1: false,
   kubeletService: 'kube-system/kubelet',
   kubeletEndpointsEnabled: true,
   kubeletEndpointSliceEnabled: false,
@@ -49,7 +50,11 @@ function(params) {
   '0probeCustomResourceDefinition': import 'probes-crd.json',
   '0prometheusruleCustomResourceDefinition': import 'prometheusrules-crd.json',
   '0thanosrulerCustomResourceDefinition': import 'thanosrulers-crd.json',
-  '0scrapeconfigCustomResourceDefinition': import 'scrapeconfigs-crd.json',
+  '0scrapeconfigCustomResourceDefinition': (import 'scrapeconfigs-crd.json') +
+                                           if po.config.enableScrapeConfigV1beta1 then
+                                             (import 'scrapeconfigs-v1beta1-crd.libsonnet')
+                                           else {},
+  '0operatorconfigurationCustomResourceDefinition': import 'operatorconfigurations-crd.json',
 
   clusterRoleBinding: {
     apiVersion: 'rbac.authorization.k8s.io/v1',
@@ -95,14 +100,22 @@ function(params) {
                  'thanosrulers/finalizers',
                  'thanosrulers/status',
                  'scrapeconfigs',
+                 'scrapeconfigs/status',
                  'servicemonitors',
                  'servicemonitors/status',
                  'podmonitors',
+                 'podmonitors/status',
                  'probes',
+                 'probes/status',
                  'prometheusrules',
                ],
                verbs: ['*'],
              },
+             {
+               apiGroups: ['monitoring.coreos.com'],
+               resources: ['operatorconfigurations'],
+               verbs: ['get', 'list', 'watch'],
+             },
              {
                apiGroups: ['apps'],
                resources: ['statefulsets'],
@@ -116,7 +129,12 @@ function(params) {
              {
                apiGroups: [''],
                resources: ['pods'],
-               verbs: ['list', 'delete'],
+               verbs: ['list', 'watch', 'delete'],
+             },
+             {
+               apiGroups: [''],
+               resources: ['pods/exec'],
+               verbs: ['create'],
              },
              {
                apiGroups: [''],
@@ -124,7 +142,12 @@ function(params) {
                  'services',
                  'services/finalizers',
                ],
-               verbs: ['get', 'create', 'update', 'delete'],
+               verbs: ['get', 'list', 'watch', 'create', 'update', 'patch', 'delete'],
+             },
+             {
+               apiGroups: [''],
+               resources: ['persistentvolumeclaims'],
+               verbs: ['list', 'delete'],
              },
              {
                apiGroups: [''],
@@ -151,6 +174,11 @@ function(params) {
                resources: ['storageclasses'],
                verbs: ['get'],
              },
+             {
+               apiGroups: ['coordination.k8s.io'],
+               resources: ['leases'],
+               verbs: ['get', 'list', 'create', 'update', 'delete'],
+             },
            ] + (
              if po.config.kubeletEndpointsEnabled then
                [
@@ -22,10 +22,12 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/prometheus/client_golang/prometheus"
 	v1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	kscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 
@@ -35,6 +37,7 @@ import (
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
 	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
 
 const (
@@ -43,6 +46,7 @@ const (
 	errUnmarshalAdmission        = "Cannot unmarshal admission request"
 	errUnmarshalRules            = "Cannot unmarshal rules from spec"
 	errUnmarshalConfig           = "Cannot unmarhsal config from spec"
+	errUnmarshalMonitor          = "Cannot unmarshal monitor"
 
 	group                  = "monitoring.coreos.com"
 	prometheusRuleResource = monitoringv1.PrometheusRuleName
@@ -54,6 +58,8 @@ const (
 	prometheusRuleValidatePath     = "/admission-prometheusrules/validate"
 	prometheusRuleMutatePath       = "/admission-prometheusrules/mutate"
 	alertmanagerConfigValidatePath = "/admission-alertmanagerconfigs/validate"
+	monitorsValidatePath           = "/admission-monitors/validate"
+	monitorsMutatePath             = "/admission-monitors/mutate"
 	convertPath                    = "/convert"
 )
 
@@ -68,31 +74,90 @@ var (
 		Group:    group,
 		Resource: alertManagerConfigResource,
 	}
+	serviceMonitorGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.ServiceMonitorName,
+	}
+	podMonitorGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.PodMonitorName,
+	}
+	probeGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.ProbeName,
+	}
+	scrapeConfigGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1alpha1.Version,
+		Resource: monitoringv1alpha1.ScrapeConfigName,
+	}
 )
 
 // Admission control for:
 // 1. PrometheusRules (validation, mutation) - ensuring created resources can be loaded by Promethues
 // 2. monitoringv1alpha1.AlertmanagerConfig (validation) - ensuring.
+// 3. ServiceMonitors, PodMonitors, Probes and ScrapeConfigs (validation) - ensuring that the Secret references are supported
+// and that the ScrapeConfigs' relabelings and service discovery configurations are valid.
+// 4. ServiceMonitors, PodMonitors and ScrapeConfigs (mutation) - setting the configured default values.
+// 5. Prometheus, PrometheusAgents, Alertmanagers and ThanosRulers (validation) - ensuring that the additional
+// arguments are supported by the version of the component.
+//
+// When the reference checks are enabled, the validation of the monitors and AlertmanagerConfigs
+// returns warnings for the referenced Secrets and ConfigMaps which don't exist.
+// When the rule cost limits are enabled, the validation of the PrometheusRules flags (or rejects)
+// the expressions which are likely to be expensive.
+//
+// The deprecations endpoint returns warnings (and updates a metric) for the deprecated fields
+// used by the Prometheus, PrometheusAgent, Alertmanager and ThanosRuler objects, the monitors
+// and the AlertmanagerConfigs. It never rejects the requests.
+//
+// It also serves a dry-run endpoint rendering the scrape jobs of ServiceMonitors, PodMonitors and ScrapeConfigs.
 type Admission struct {
-	logger *slog.Logger
-	wh     http.Handler
+	logger   *slog.Logger
+	wh       http.Handler
+	defaults MonitorDefaults
+	kclient  kubernetes.Interface
+
+	ruleCostLimits RuleCostLimits
+
+	deprecatedFieldsTotal *prometheus.CounterVec
 }
 
-func New(logger *slog.Logger) *Admission {
+// Option configures the Admission.
+type Option func(*Admission)
+
+func New(logger *slog.Logger, defaults MonitorDefaults, opts ...Option) *Admission {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(monitoringv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(monitoringv1beta1.AddToScheme(scheme))
 
-	return &Admission{
-		logger: logger,
-		wh:     conversion.NewWebhookHandler(scheme),
+	a := &Admission{
+		logger:   logger,
+		wh:       conversion.NewWebhookHandler(scheme),
+		defaults: defaults,
+
+		deprecatedFieldsTotal: newDeprecatedFieldsCounter(),
 	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
 }
 
 func (a *Admission) Register(mux *http.ServeMux) {
 	mux.HandleFunc(prometheusRuleValidatePath, a.servePrometheusRulesValidate)
 	mux.HandleFunc(prometheusRuleMutatePath, a.servePrometheusRulesMutate)
 	mux.HandleFunc(alertmanagerConfigValidatePath, a.serveAlertmanagerConfigValidate)
+	mux.HandleFunc(monitorsValidatePath, a.serveMonitorsValidate)
+	mux.HandleFunc(monitorsMutatePath, a.serveMonitorsMutate)
+	mux.HandleFunc(workloadsValidatePath, a.serveWorkloadsValidate)
+	mux.HandleFunc(deprecationsValidatePath, a.serveDeprecationsValidate)
+	mux.HandleFunc(dryRunPath, a.serveDryRun)
 	mux.HandleFunc(convertPath, a.serveConvert)
 }
 
@@ -110,6 +175,14 @@ func (a *Admission) serveAlertmanagerConfigValidate(w http.ResponseWriter, r *ht
 	a.serveAdmission(w, r, a.validateAlertmanagerConfig)
 }
 
+func (a *Admission) serveMonitorsValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, a.validateMonitors)
+}
+
+func (a *Admission) serveMonitorsMutate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, a.mutateMonitors)
+}
+
 func (a *Admission) serveConvert(w http.ResponseWriter, r *http.Request) {
 	a.wh.ServeHTTP(w, r)
 }
@@ -244,7 +317,27 @@ func (a *Admission) validatePrometheusRules(ar v1.AdmissionReview) *v1.Admission
 		return toAdmissionResponseFailure("Rules are not valid", prometheusRuleResource, errors)
 	}
 
-	return &v1.AdmissionResponse{Allowed: true}
+	reviewResponse := &v1.AdmissionResponse{Allowed: true}
+
+	violations := a.ruleCostLimits.check(promRule.Spec)
+	if len(violations) == 0 {
+		return reviewResponse
+	}
+
+	const m = "Expensive rule"
+	for _, err := range violations {
+		a.logger.Info(m, "err", err)
+	}
+
+	if a.ruleCostLimits.Mode == RuleCostReject {
+		return toAdmissionResponseFailure("Rules exceed the cost limits", prometheusRuleResource, violations)
+	}
+
+	for _, err := range violations {
+		reviewResponse.Warnings = append(reviewResponse.Warnings, err.Error())
+	}
+
+	return reviewResponse
 }
 
 func (a *Admission) validateAlertmanagerConfig(ar v1.AdmissionReview) *v1.AdmissionResponse {
@@ -289,5 +382,120 @@ func (a *Admission) validateAlertmanagerConfig(ar v1.AdmissionReview) *v1.Admiss
 		a.logger.Info(msg, "err", err)
 		return toAdmissionResponseFailure("AlertmanagerConfig is invalid", alertManagerConfigResource, []error{err})
 	}
-	return &v1.AdmissionResponse{Allowed: true}
+
+	var spec interface{}
+	switch o := amConf.(type) {
+	case *monitoringv1alpha1.AlertmanagerConfig:
+		spec = o.Spec
+	case *monitoringv1beta1.AlertmanagerConfig:
+		spec = o.Spec
+	}
+
+	return &v1.AdmissionResponse{
+		Allowed:  true,
+		Warnings: a.missingReferences(ar.Request.Namespace, spec),
+	}
+}
+
+// decodeMonitor returns the ServiceMonitor, PodMonitor, Probe or ScrapeConfig
+// object of the request. It returns a failure response if the object can't
+// be decoded.
+func (a *Admission) decodeMonitor(ar v1.AdmissionReview) (interface{}, *v1.AdmissionResponse) {
+	var obj interface{}
+	switch ar.Request.Resource {
+	case serviceMonitorGVR:
+		obj = &monitoringv1.ServiceMonitor{}
+	case podMonitorGVR:
+		obj = &monitoringv1.PodMonitor{}
+	case probeGVR:
+		obj = &monitoringv1.Probe{}
+	case scrapeConfigGVR:
+		obj = &monitoringv1alpha1.ScrapeConfig{}
+	default:
+		err := fmt.Errorf("expected resource to be one of %v, %v, %v or %v, but received %v", serviceMonitorGVR, podMonitorGVR, probeGVR, scrapeConfigGVR, ar.Request.Resource)
+		a.logger.Warn("", "err", err)
+		return nil, toAdmissionResponseFailure("Unexpected resource kind", ar.Request.Resource.Resource, []error{err})
+	}
+
+	if err := json.Unmarshal(ar.Request.Object.Raw, obj); err != nil {
+		a.logger.Info(errUnmarshalMonitor, "err", err)
+		return nil, toAdmissionResponseFailure(errUnmarshalMonitor, ar.Request.Resource.Resource, []error{err})
+	}
+
+	return obj, nil
+}
+
+func (a *Admission) validateMonitors(ar v1.AdmissionReview) *v1.AdmissionResponse {
+	a.logger.Debug("Validating monitors")
+
+	obj, resp := a.decodeMonitor(ar)
+	if resp != nil {
+		return resp
+	}
+
+	var spec interface{}
+	switch o := obj.(type) {
+	case *monitoringv1.ServiceMonitor:
+		spec = o.Spec
+	case *monitoringv1.PodMonitor:
+		spec = o.Spec
+	case *monitoringv1.Probe:
+		spec = o.Spec
+	case *monitoringv1alpha1.ScrapeConfig:
+		spec = o.Spec
+	}
+
+	errors := validateSecretReferences("spec", spec)
+	if len(errors) != 0 {
+		const m = "Invalid secret reference"
+		for _, err := range errors {
+			a.logger.Info(m, "err", err)
+		}
+
+		return toAdmissionResponseFailure("Secret references are not valid", ar.Request.Resource.Resource, errors)
+	}
+
+	if sc, ok := obj.(*monitoringv1alpha1.ScrapeConfig); ok {
+		if errors := prompkg.ValidateScrapeConfig(sc); len(errors) != 0 {
+			const m = "Invalid ScrapeConfig"
+			for _, err := range errors {
+				a.logger.Info(m, "err", err)
+			}
+
+			return toAdmissionResponseFailure("ScrapeConfig is not valid", ar.Request.Resource.Resource, errors)
+		}
+	}
+
+	return &v1.AdmissionResponse{
+		Allowed:  true,
+		Warnings: a.missingReferences(ar.Request.Namespace, spec),
+	}
+}
+
+func (a *Admission) mutateMonitors(ar v1.AdmissionReview) *v1.AdmissionResponse {
+	a.logger.Debug("Mutating monitors")
+
+	obj, resp := a.decodeMonitor(ar)
+	if resp != nil {
+		return resp
+	}
+
+	reviewResponse := &v1.AdmissionResponse{Allowed: true}
+
+	ops := a.defaults.patches(obj)
+	if len(ops) == 0 {
+		return reviewResponse
+	}
+
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		a.logger.Warn("failed to encode the patch", "err", err)
+		return toAdmissionResponseFailure("Cannot encode the patch", ar.Request.Resource.Resource, []error{err})
+	}
+
+	pt := v1.PatchTypeJSONPatch
+	reviewResponse.PatchType = &pt
+	reviewResponse.Patch = patch
+
+	return reviewResponse
 }
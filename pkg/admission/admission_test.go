@@ -24,13 +24,23 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"gotest.tools/v3/golden"
 	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
 )
@@ -127,6 +137,83 @@ func TestAdmitBadRuleWithBooleanInAnnotations(t *testing.T) {
 	}
 }
 
+func TestPrometheusRulesCostLimits(t *testing.T) {
+	spec := monitoringv1.PrometheusRuleSpec{
+		Groups: []monitoringv1.RuleGroup{{
+			Name: "test",
+			Rules: []monitoringv1.Rule{
+				{Record: "job:up:sum", Expr: intstr.FromString(`sum by (job) (up)`)},
+				{Record: "job:all:count", Expr: intstr.FromString(`count by (job) ({job="api"})`)},
+				{Alert: "AnyPodDown", Expr: intstr.FromString(`up{pod=~".*"} == 0`)},
+				{Record: "job:up:max_over_time", Expr: intstr.FromString(`max_over_time(sum by (job) (up)[30d:5m])`)},
+			},
+		}},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		limits  RuleCostLimits
+		allowed bool
+		causes  []string
+	}{
+		{
+			name:    "disabled",
+			allowed: true,
+		},
+		{
+			name:    "warn",
+			limits:  RuleCostLimits{Mode: RuleCostWarn, MaxSubqueryRange: 7 * 24 * time.Hour},
+			allowed: true,
+			causes: []string{
+				`groups[0].rules[1] (job:all:count): selector {job="api"} has no metric name`,
+				`groups[0].rules[2] (AnyPodDown): selector up{pod=~".*"} has a regular expression matching any value (pod=~".*")`,
+				`groups[0].rules[3] (job:up:max_over_time): subquery range 30d is greater than the maximum of 1w`,
+			},
+		},
+		{
+			name:   "reject",
+			limits: RuleCostLimits{Mode: RuleCostReject},
+			causes: []string{
+				`groups[0].rules[1] (job:all:count): selector {job="api"} has no metric name`,
+				`groups[0].rules[2] (AnyPodDown): selector up{pod=~".*"} has a regular expression matching any value (pod=~".*")`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := server(New(slog.New(slog.DiscardHandler), MonitorDefaults{}, WithRuleCostLimits(tc.limits)).servePrometheusRulesValidate)
+			t.Cleanup(ts.Close)
+
+			raw, err := json.Marshal(&monitoringv1.PrometheusRule{Spec: spec})
+			require.NoError(t, err)
+
+			b, err := json.Marshal(&v1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+				Request: &v1.AdmissionRequest{
+					UID:       "87c5df7f-5090-11e9-b9b4-02425473f309",
+					Resource:  prometheusRuleGVR,
+					Namespace: "monitoring",
+					Operation: v1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			})
+			require.NoError(t, err)
+
+			resp := sendAdmissionReview(t, ts, b)
+			require.Equal(t, tc.allowed, resp.Response.Allowed)
+			if tc.allowed {
+				require.Equal(t, tc.causes, resp.Response.Warnings)
+				return
+			}
+
+			causes := make([]string, 0, len(resp.Response.Result.Details.Causes))
+			for _, c := range resp.Response.Result.Details.Causes {
+				causes = append(causes, c.Message)
+			}
+			require.Equal(t, tc.causes, causes)
+		})
+	}
+}
+
 func TestMutateNonStringsToStrings(t *testing.T) {
 	request := golden.Get(t, "nonStringsInLabelsAnnotations.golden")
 	ts := server(api().servePrometheusRulesMutate)
@@ -231,6 +318,604 @@ func TestAlertmanagerConfigAdmission(t *testing.T) {
 	}
 }
 
+func TestMonitorsAdmission(t *testing.T) {
+	ts := server(api().serveMonitorsValidate)
+	t.Cleanup(ts.Close)
+
+	secretRef := func(name string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			Key:                  "token",
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		resource metav1.GroupVersionResource
+		obj      runtime.Object
+		allowed  bool
+		causes   []string
+		message  string
+	}{
+		{
+			name:     "servicemonitor with local secret",
+			resource: serviceMonitorGVR,
+			obj: &monitoringv1.ServiceMonitor{
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{{BearerTokenSecret: secretRef("token")}},
+				},
+			},
+			allowed: true,
+		},
+		{
+			name:     "servicemonitor with cross-namespace secret",
+			resource: serviceMonitorGVR,
+			obj: &monitoringv1.ServiceMonitor{
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{
+						{},
+						{
+							BasicAuth: &monitoringv1.BasicAuth{
+								Username: *secretRef("other/credentials"),
+								Password: *secretRef("credentials"),
+							},
+						},
+					},
+				},
+			},
+			causes:  []string{"spec.endpoints[1].basicAuth.username"},
+			message: "cross-namespace secret references aren't supported",
+		},
+		{
+			name:     "probe with cross-namespace secret",
+			resource: probeGVR,
+			obj: &monitoringv1.Probe{
+				Spec: monitoringv1.ProbeSpec{
+					Authorization: &monitoringv1.SafeAuthorization{Credentials: secretRef("other/token")},
+				},
+			},
+			causes:  []string{"spec.authorization.credentials"},
+			message: "cross-namespace secret references aren't supported",
+		},
+		{
+			name:     "scrapeconfig with cross-namespace secret",
+			resource: scrapeConfigGVR,
+			obj: &v1alpha1.ScrapeConfig{
+				Spec: v1alpha1.ScrapeConfigSpec{
+					ConsulSDConfigs: []v1alpha1.ConsulSDConfig{{TokenRef: secretRef("other/token")}},
+				},
+			},
+			causes:  []string{"spec.consulSDConfigs[0].tokenRef"},
+			message: "cross-namespace secret references aren't supported",
+		},
+		{
+			name:     "valid scrapeconfig",
+			resource: scrapeConfigGVR,
+			obj: &v1alpha1.ScrapeConfig{
+				Spec: v1alpha1.ScrapeConfigSpec{
+					RelabelConfigs: []monitoringv1.RelabelConfig{{Action: "lowercase", SourceLabels: []monitoringv1.LabelName{"foo"}, TargetLabel: "bar"}},
+					DNSSDConfigs:   []v1alpha1.DNSSDConfig{{Names: []string{"example.com"}}},
+				},
+			},
+			allowed: true,
+		},
+		{
+			name:     "scrapeconfig with invalid relabelings",
+			resource: scrapeConfigGVR,
+			obj: &v1alpha1.ScrapeConfig{
+				Spec: v1alpha1.ScrapeConfigSpec{
+					RelabelConfigs:       []monitoringv1.RelabelConfig{{Regex: "[a-z"}},
+					MetricRelabelConfigs: []monitoringv1.RelabelConfig{{}, {Action: "hashmod", TargetLabel: "foo"}},
+				},
+			},
+			causes: []string{"relabelings", "metricRelabelings"},
+		},
+		{
+			name:     "scrapeconfig with invalid service discovery",
+			resource: scrapeConfigGVR,
+			obj: &v1alpha1.ScrapeConfig{
+				Spec: v1alpha1.ScrapeConfigSpec{
+					DNSSDConfigs: []v1alpha1.DNSSDConfig{{Names: []string{"example.com"}, Type: ptr.To(v1alpha1.DNSRecordTypeA)}},
+					KubernetesSDConfigs: []v1alpha1.KubernetesSDConfig{{
+						Role:      v1alpha1.KubernetesRoleNode,
+						Selectors: []v1alpha1.K8SSelectorConfig{{Role: v1alpha1.KubernetesRolePod}},
+					}},
+				},
+			},
+			causes: []string{"kubernetesSDConfigs[0]", "dnsSDConfigs[0]"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.obj)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(&v1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+				Request: &v1.AdmissionRequest{
+					UID:       "87c5df7f-5090-11e9-b9b4-02425473f309",
+					Resource:  tc.resource,
+					Namespace: "monitoring",
+					Operation: v1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			})
+			require.NoError(t, err)
+
+			resp := sendAdmissionReview(t, ts, b)
+			require.Equal(t, tc.allowed, resp.Response.Allowed)
+			if tc.allowed {
+				return
+			}
+
+			require.Len(t, resp.Response.Result.Details.Causes, len(tc.causes))
+			for i, cause := range tc.causes {
+				require.True(t, strings.HasPrefix(resp.Response.Result.Details.Causes[i].Message, cause+": "), resp.Response.Result.Details.Causes[i].Message)
+				require.Contains(t, resp.Response.Result.Details.Causes[i].Message, tc.message)
+			}
+		})
+	}
+}
+
+func TestWorkloadsAdmission(t *testing.T) {
+	ts := server(api().serveWorkloadsValidate)
+	t.Cleanup(ts.Close)
+
+	for _, tc := range []struct {
+		name     string
+		resource metav1.GroupVersionResource
+		obj      runtime.Object
+		causes   []string
+	}{
+		{
+			name:     "prometheus with valid arguments",
+			resource: prometheusGVR,
+			obj: &monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						AdditionalArgs: []monitoringv1.Argument{{Name: "storage.tsdb.no-lockfile"}},
+					},
+				},
+			},
+		},
+		{
+			name:     "prometheus with invalid arguments",
+			resource: prometheusGVR,
+			obj: &monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Version: "v3.0.0",
+						AdditionalArgs: []monitoringv1.Argument{
+							{Name: "storage.tsdb.retention", Value: "1d"},
+							{Name: "storage.tsdb.no-lock-file"},
+						},
+					},
+					Thanos: &monitoringv1.ThanosSpec{
+						AdditionalArgs: []monitoringv1.Argument{
+							{Name: "min-time", Value: "-1d"},
+							{Name: "min-time", Value: "-2d"},
+						},
+					},
+				},
+			},
+			causes: []string{
+				`spec.additionalArgs[0]: flag "storage.tsdb.retention" isn't supported by Prometheus >= 3.0.0 (got 3.0.0)`,
+				`spec.additionalArgs[1]: unknown flag "storage.tsdb.no-lock-file" for Prometheus 3.0.0`,
+				`spec.thanos.additionalArgs[1]: flag "min-time" already set by spec.thanos.additionalArgs[0]`,
+			},
+		},
+		{
+			name:     "prometheusagent with server flag",
+			resource: prometheusAgentGVR,
+			obj: &v1alpha1.PrometheusAgent{
+				Spec: v1alpha1.PrometheusAgentSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Version:        "v3.0.0",
+						AdditionalArgs: []monitoringv1.Argument{{Name: "query.timeout", Value: "1m"}},
+					},
+				},
+			},
+			causes: []string{
+				`spec.additionalArgs[0]: unknown flag "query.timeout" for Prometheus 3.0.0`,
+			},
+		},
+		{
+			name:     "alertmanager with flag requiring a more recent version",
+			resource: alertmanagerGVR,
+			obj: &monitoringv1.Alertmanager{
+				Spec: monitoringv1.AlertmanagerSpec{
+					Version:        "v0.25.0",
+					AdditionalArgs: []monitoringv1.Argument{{Name: "cluster.label", Value: "foo"}},
+				},
+			},
+			causes: []string{
+				`spec.additionalArgs[0]: flag "cluster.label" requires Alertmanager >= 0.26.0 (got 0.25.0)`,
+			},
+		},
+		{
+			name:     "thanosruler with valid arguments",
+			resource: thanosRulerGVR,
+			obj: &monitoringv1.ThanosRuler{
+				Spec: monitoringv1.ThanosRulerSpec{
+					AdditionalArgs: []monitoringv1.Argument{{Name: "restore-ignored-label", Value: "team"}},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.obj)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(&v1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+				Request: &v1.AdmissionRequest{
+					UID:       "87c5df7f-5090-11e9-b9b4-02425473f309",
+					Resource:  tc.resource,
+					Namespace: "monitoring",
+					Operation: v1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			})
+			require.NoError(t, err)
+
+			resp := sendAdmissionReview(t, ts, b)
+			require.Equal(t, len(tc.causes) == 0, resp.Response.Allowed)
+			if len(tc.causes) == 0 {
+				return
+			}
+
+			require.Len(t, resp.Response.Result.Details.Causes, len(tc.causes))
+			for i, cause := range tc.causes {
+				require.Equal(t, cause, resp.Response.Result.Details.Causes[i].Message)
+			}
+		})
+	}
+}
+
+func TestMissingReferencesWarnings(t *testing.T) {
+	kclient := fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "monitoring"},
+			Data:       map[string][]byte{"username": []byte("admin")},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "monitoring"},
+			Data:       map[string]string{"ca.crt": "xxx"},
+		},
+	)
+
+	a := New(slog.New(slog.DiscardHandler), MonitorDefaults{}, WithReferenceChecks(kclient))
+	monitors := server(a.serveMonitorsValidate)
+	t.Cleanup(monitors.Close)
+	amcs := server(a.serveAlertmanagerConfigValidate)
+	t.Cleanup(amcs.Close)
+
+	for _, tc := range []struct {
+		name     string
+		ts       *httptest.Server
+		resource metav1.GroupVersionResource
+		obj      runtime.Object
+		warnings []string
+	}{
+		{
+			name:     "servicemonitor with existing references",
+			ts:       monitors,
+			resource: serviceMonitorGVR,
+			obj: &monitoringv1.ServiceMonitor{
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{{
+						BasicAuth: &monitoringv1.BasicAuth{
+							Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "username"},
+						},
+						TLSConfig: &monitoringv1.TLSConfig{
+							SafeTLSConfig: monitoringv1.SafeTLSConfig{
+								CA: monitoringv1.SecretOrConfigMap{
+									ConfigMap: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "ca"}, Key: "ca.crt"},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name:     "servicemonitor with missing references",
+			ts:       monitors,
+			resource: serviceMonitorGVR,
+			obj: &monitoringv1.ServiceMonitor{
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{{
+						BasicAuth: &monitoringv1.BasicAuth{
+							Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "username"},
+							Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "password"},
+						},
+						TLSConfig: &monitoringv1.TLSConfig{
+							SafeTLSConfig: monitoringv1.SafeTLSConfig{
+								CA: monitoringv1.SecretOrConfigMap{
+									ConfigMap: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "typo"}, Key: "ca.crt"},
+								},
+							},
+						},
+					}},
+				},
+			},
+			warnings: []string{
+				`spec.endpoints[0].tlsConfig.ca.configMap: ConfigMap "typo" not found in namespace "monitoring"`,
+				`spec.endpoints[0].basicAuth.password: key "password" not found in Secret "credentials"`,
+			},
+		},
+		{
+			name:     "podmonitor with optional missing reference",
+			ts:       monitors,
+			resource: podMonitorGVR,
+			obj: &monitoringv1.PodMonitor{
+				Spec: monitoringv1.PodMonitorSpec{
+					PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "token"}, Key: "token", Optional: ptr.To(true)},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name:     "alertmanagerconfig with missing reference",
+			ts:       amcs,
+			resource: metav1.GroupVersionResource{Group: group, Version: v1beta1.Version, Resource: alertManagerConfigResource},
+			obj: &v1beta1.AlertmanagerConfig{
+				Spec: v1beta1.AlertmanagerConfigSpec{
+					Route: &v1beta1.Route{Receiver: "slack"},
+					Receivers: []v1beta1.Receiver{{
+						Name:         "slack",
+						SlackConfigs: []v1beta1.SlackConfig{{APIURL: &v1beta1.SecretKeySelector{Name: "slack", Key: "url"}}},
+					}},
+				},
+			},
+			warnings: []string{
+				`spec.receivers[0].slackConfigs[0].apiURL: Secret "slack" not found in namespace "monitoring"`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.obj)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(&v1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+				Request: &v1.AdmissionRequest{
+					UID:       "87c5df7f-5090-11e9-b9b4-02425473f309",
+					Resource:  tc.resource,
+					Namespace: "monitoring",
+					Operation: v1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			})
+			require.NoError(t, err)
+
+			resp := sendAdmissionReview(t, tc.ts, b)
+			require.True(t, resp.Response.Allowed, resp.Response.Result)
+			require.Equal(t, tc.warnings, resp.Response.Warnings)
+		})
+	}
+}
+
+func TestDeprecationWarnings(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	a := New(slog.New(slog.DiscardHandler), MonitorDefaults{}, WithRegisterer(reg))
+	ts := server(a.serveDeprecationsValidate)
+	t.Cleanup(ts.Close)
+
+	for _, tc := range []struct {
+		name     string
+		resource metav1.GroupVersionResource
+		obj      runtime.Object
+		warnings []string
+	}{
+		{
+			name:     "prometheus without deprecated fields",
+			resource: metav1.GroupVersionResource{Group: group, Version: monitoringv1.Version, Resource: monitoringv1.PrometheusName},
+			obj: &monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{Image: ptr.To("quay.io/prometheus/prometheus:v3.0.0")},
+				},
+			},
+		},
+		{
+			name:     "prometheus with deprecated fields",
+			resource: metav1.GroupVersionResource{Group: group, Version: monitoringv1.Version, Resource: monitoringv1.PrometheusName},
+			obj: &monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						RemoteWrite: []monitoringv1.RemoteWriteSpec{{URL: "http://example.com", BearerTokenFile: "/etc/token"}},
+					},
+					BaseImage: "quay.io/prometheus/prometheus",
+					Tag:       "v3.0.0",
+					Thanos:    &monitoringv1.ThanosSpec{ListenLocal: true},
+				},
+			},
+			warnings: []string{
+				"spec.baseImage: deprecated field, use 'image' instead",
+				"spec.tag: deprecated field, use 'image' instead",
+				"spec.remoteWrite[0].bearerTokenFile: deprecated field, use 'authorization' instead",
+				"spec.thanos.listenLocal: deprecated field, use 'grpcListenLocal' and 'httpListenLocal' instead",
+			},
+		},
+		{
+			name:     "servicemonitor with deprecated fields",
+			resource: serviceMonitorGVR,
+			obj: &monitoringv1.ServiceMonitor{
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{
+						{Port: "web"},
+						{Port: "metrics", BearerTokenFile: "/etc/token"},
+					},
+				},
+			},
+			warnings: []string{
+				"spec.endpoints[1].bearerTokenFile: deprecated field, use 'authorization' instead",
+			},
+		},
+		{
+			name:     "podmonitor with deprecated fields",
+			resource: podMonitorGVR,
+			obj: &monitoringv1.PodMonitor{
+				Spec: monitoringv1.PodMonitorSpec{
+					PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{TargetPort: ptr.To(intstr.FromInt32(8080))}},
+				},
+			},
+			warnings: []string{
+				"spec.podMetricsEndpoints[0].targetPort: deprecated field, use 'port' or 'portNumber' instead",
+			},
+		},
+		{
+			name:     "unsupported resource",
+			resource: prometheusRuleGVR,
+			obj:      &monitoringv1.PrometheusRule{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.obj)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(&v1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+				Request: &v1.AdmissionRequest{
+					UID:       "87c5df7f-5090-11e9-b9b4-02425473f309",
+					Resource:  tc.resource,
+					Namespace: "monitoring",
+					Operation: v1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			})
+			require.NoError(t, err)
+
+			resp := sendAdmissionReview(t, ts, b)
+			require.True(t, resp.Response.Allowed, resp.Response.Result)
+			require.Equal(t, tc.warnings, resp.Response.Warnings)
+		})
+	}
+
+	require.Equal(t, 1.0, testutil.ToFloat64(a.deprecatedFieldsTotal.WithLabelValues(monitoringv1.ServiceMonitorName, "spec.endpoints.bearerTokenFile")))
+	require.Equal(t, 1.0, testutil.ToFloat64(a.deprecatedFieldsTotal.WithLabelValues(monitoringv1.PrometheusName, "spec.remoteWrite.bearerTokenFile")))
+}
+
+func TestMonitorsMutation(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		defaults MonitorDefaults
+		resource metav1.GroupVersionResource
+		obj      runtime.Object
+		expected runtime.Object
+	}{
+		{
+			name:     "servicemonitor without defaults",
+			resource: serviceMonitorGVR,
+			obj: &monitoringv1.ServiceMonitor{
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{{Interval: "30s"}},
+				},
+			},
+		},
+		{
+			name:     "servicemonitor",
+			defaults: MonitorDefaults{ScrapeTimeoutRatio: 0.5, Scheme: "https", ScrapeClass: "default"},
+			resource: serviceMonitorGVR,
+			obj: &monitoringv1.ServiceMonitor{
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{
+						{Interval: "30s"},
+						{Interval: "1m", ScrapeTimeout: "10s", Scheme: "http"},
+						{},
+					},
+				},
+			},
+			expected: &monitoringv1.ServiceMonitor{
+				Spec: monitoringv1.ServiceMonitorSpec{
+					ScrapeClassName: ptr.To("default"),
+					Endpoints: []monitoringv1.Endpoint{
+						{Interval: "30s", ScrapeTimeout: "15s", Scheme: "https"},
+						{Interval: "1m", ScrapeTimeout: "10s", Scheme: "http"},
+						{Scheme: "https"},
+					},
+				},
+			},
+		},
+		{
+			name:     "podmonitor",
+			defaults: MonitorDefaults{ScrapeTimeoutRatio: 0.8, ScrapeClass: "default"},
+			resource: podMonitorGVR,
+			obj: &monitoringv1.PodMonitor{
+				Spec: monitoringv1.PodMonitorSpec{
+					ScrapeClassName:     ptr.To("other"),
+					PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Interval: "1m"}},
+				},
+			},
+			expected: &monitoringv1.PodMonitor{
+				Spec: monitoringv1.PodMonitorSpec{
+					ScrapeClassName:     ptr.To("other"),
+					PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Interval: "1m", ScrapeTimeout: "48s"}},
+				},
+			},
+		},
+		{
+			name:     "scrapeconfig",
+			defaults: MonitorDefaults{ScrapeTimeoutRatio: 0.5, Scheme: "https", ScrapeClass: "default"},
+			resource: scrapeConfigGVR,
+			obj: &v1alpha1.ScrapeConfig{
+				Spec: v1alpha1.ScrapeConfigSpec{
+					ScrapeInterval: ptr.To(monitoringv1.Duration("15s")),
+				},
+			},
+			expected: &v1alpha1.ScrapeConfig{
+				Spec: v1alpha1.ScrapeConfigSpec{
+					ScrapeClassName: ptr.To("default"),
+					ScrapeInterval:  ptr.To(monitoringv1.Duration("15s")),
+					ScrapeTimeout:   ptr.To(monitoringv1.Duration("7s500ms")),
+					Scheme:          ptr.To("HTTPS"),
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := api()
+			a.defaults = tc.defaults
+			ts := server(a.serveMonitorsMutate)
+			t.Cleanup(ts.Close)
+
+			raw, err := json.Marshal(tc.obj)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(&v1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+				Request: &v1.AdmissionRequest{
+					UID:       "87c5df7f-5090-11e9-b9b4-02425473f309",
+					Resource:  tc.resource,
+					Namespace: "monitoring",
+					Operation: v1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			})
+			require.NoError(t, err)
+
+			resp := sendAdmissionReview(t, ts, b)
+			require.True(t, resp.Response.Allowed)
+			if tc.expected == nil {
+				require.Nil(t, resp.Response.Patch)
+				return
+			}
+
+			patch, err := jsonpatch.DecodePatch(resp.Response.Patch)
+			require.NoError(t, err)
+
+			patched, err := patch.Apply(raw)
+			require.NoError(t, err)
+
+			expected, err := json.Marshal(tc.expected)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expected), string(patched))
+		})
+	}
+}
+
 func TestAlertmanagerConfigConversion(t *testing.T) {
 	ts := server(api().serveConvert)
 	t.Cleanup(ts.Close)
@@ -310,9 +995,205 @@ func TestAlertmanagerConfigConversion(t *testing.T) {
 	}
 }
 
+func TestScrapeConfigConversion(t *testing.T) {
+	ts := server(api().serveConvert)
+	t.Cleanup(ts.Close)
+
+	for _, tc := range []struct {
+		from string
+		to   string
+		spec string
+
+		checkFn func(converted []byte) error
+	}{
+		{
+			from: "v1alpha1",
+			to:   "v1beta1",
+			spec: `{"openstackSDConfigs": [{"role": "Instance", "region": "RegionOne"}], "ovhcloudSDConfigs": [{"applicationKey": "key", "applicationSecret": {"name": "ovh", "key": "secret"}, "consumerKey": {"name": "ovh", "key": "consumer"}, "service": "VPS"}], "scrapeClass": "default"}`,
+			checkFn: func(converted []byte) error {
+				o := v1beta1.ScrapeConfig{}
+
+				if err := json.Unmarshal(converted, &o); err != nil {
+					return err
+				}
+
+				if len(o.Spec.OpenStackSDConfigs) != 1 {
+					return fmt.Errorf("expecting 1 item in spec.openStackSDConfigs, got %d", len(o.Spec.OpenStackSDConfigs))
+				}
+
+				if len(o.Spec.OVHCloudSDConfigs) != 1 {
+					return fmt.Errorf("expecting 1 item in spec.ovhCloudSDConfigs, got %d", len(o.Spec.OVHCloudSDConfigs))
+				}
+
+				if o.Spec.ScrapeClass == nil || *o.Spec.ScrapeClass != "default" {
+					return fmt.Errorf("expecting spec.scrapeClass to be %q, got %v", "default", o.Spec.ScrapeClass)
+				}
+
+				return nil
+			},
+		},
+		{
+			from: "v1beta1",
+			to:   "v1alpha1",
+			spec: `{"lightsailSDConfigs": [{"region": "us-east-1"}], "relabelings": [{"action": "drop", "sourceLabels": ["__meta_lightsail_state"], "regex": "stopped"}]}`,
+			checkFn: func(converted []byte) error {
+				o := v1alpha1.ScrapeConfig{}
+
+				if err := json.Unmarshal(converted, &o); err != nil {
+					return err
+				}
+
+				if len(o.Spec.LightSailSDConfigs) != 1 {
+					return fmt.Errorf("expecting 1 item in spec.lightSailSDConfigs, got %d", len(o.Spec.LightSailSDConfigs))
+				}
+
+				if len(o.Spec.RelabelConfigs) != 1 {
+					return fmt.Errorf("expecting 1 item in spec.relabelings, got %d", len(o.Spec.RelabelConfigs))
+				}
+
+				return nil
+			},
+		},
+	} {
+		t.Run(tc.from+">"+tc.to, func(t *testing.T) {
+			resp := sendConversionReview(t, ts, buildConversionReview(t, "ScrapeConfig", tc.from, tc.to, tc.spec))
+			require.Equal(t, "Success", resp.Response.Result.Status, "Unexpected conversion result, wanted 'Success' but got %v - (result=%v)",
+				resp.Response.Result.Status,
+				resp.Response.Result)
+
+			require.Len(t, resp.Response.ConvertedObjects, 1, "expected 1 converted object, got %d", len(resp.Response.ConvertedObjects))
+
+			err := tc.checkFn(resp.Response.ConvertedObjects[0].Raw)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	ts := server(api().serveDryRun)
+	t.Cleanup(ts.Close)
+
+	sm := &monitoringv1.ServiceMonitor{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "monitoring.coreos.com/v1", Kind: monitoringv1.ServiceMonitorsKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "monitoring"},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Endpoints: []monitoringv1.Endpoint{{
+				Port: "web",
+				Authorization: &monitoringv1.SafeAuthorization{
+					Credentials: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "token"},
+						Key:                  "token",
+					},
+				},
+			}},
+		},
+	}
+
+	sc := &v1alpha1.ScrapeConfig{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "monitoring.coreos.com/v1alpha1", Kind: v1alpha1.ScrapeConfigsKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: v1alpha1.ScrapeConfigSpec{
+			StaticConfigs:  []v1alpha1.StaticConfig{{Targets: []v1alpha1.Target{"localhost:9090"}}},
+			RelabelConfigs: []monitoringv1.RelabelConfig{{Action: "keepequal", SourceLabels: []monitoringv1.LabelName{"foo"}, TargetLabel: "bar"}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		req    DryRunRequest
+		obj    runtime.Object
+		status int
+		job    string
+		reason string
+	}{
+		{
+			name: "servicemonitor",
+			obj:  sm,
+			req: DryRunRequest{
+				Secrets: []corev1.Secret{{
+					ObjectMeta: metav1.ObjectMeta{Name: "token"},
+					Data:       map[string][]byte{"token": []byte("secret")},
+				}},
+			},
+			status: http.StatusOK,
+			job:    "serviceMonitor/monitoring/test/0",
+		},
+		{
+			name:   "servicemonitor with missing secret",
+			obj:    sm,
+			status: http.StatusUnprocessableEntity,
+			reason: "MissingSecretKey",
+		},
+		{
+			name:   "scrapeconfig",
+			obj:    sc,
+			status: http.StatusOK,
+			job:    "scrapeConfig/default/test",
+		},
+		{
+			name:   "scrapeconfig with unsupported relabel action",
+			obj:    sc,
+			req:    DryRunRequest{Version: "v2.40.0"},
+			status: http.StatusUnprocessableEntity,
+			reason: "UnsupportedVersionField",
+		},
+		{
+			name:   "unsupported kind",
+			obj:    &monitoringv1.Probe{TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ProbesKind}},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "valid additional configs without object",
+			req:    DryRunRequest{AdditionalScrapeConfigs: "- job_name: node\n", AdditionalAlertManagerConfigs: "- static_configs:\n  - targets: [alertmanager:9093]\n"},
+			status: http.StatusOK,
+		},
+		{
+			name:   "scrapeconfig with invalid additional scrape configs",
+			obj:    sc,
+			req:    DryRunRequest{AdditionalScrapeConfigs: "- job_name: node\n- job_name: node\n"},
+			status: http.StatusUnprocessableEntity,
+			reason: "InvalidConfiguration",
+		},
+		{
+			name:   "invalid additional alertmanager configs",
+			req:    DryRunRequest{AdditionalAlertManagerConfigs: "- api_version: v3\n"},
+			status: http.StatusUnprocessableEntity,
+			reason: "InvalidConfiguration",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.obj != nil {
+				raw, err := json.Marshal(tc.obj)
+				require.NoError(t, err)
+
+				tc.req.Object = runtime.RawExtension{Raw: raw}
+			}
+			b, err := json.Marshal(tc.req)
+			require.NoError(t, err)
+
+			resp, err := http.Post(ts.URL, "application/json", bytes.NewReader(b))
+			require.NoError(t, err)
+			t.Cleanup(func() { resp.Body.Close() })
+
+			require.Equal(t, tc.status, resp.StatusCode)
+			if tc.status == http.StatusBadRequest {
+				return
+			}
+
+			var dr DryRunResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&dr))
+			require.Equal(t, tc.reason, dr.Reason, dr.Error)
+			if tc.job != "" {
+				require.Contains(t, dr.ScrapeConfigs, "job_name: "+tc.job+"\n")
+			}
+		})
+	}
+}
+
 func api() *Admission {
 	a := New(
 		slog.New(slog.DiscardHandler),
+		MonitorDefaults{},
 	)
 
 	return a
@@ -405,6 +1286,11 @@ func buildAdmissionReviewFromAlertmanagerConfigSpec(t *testing.T, version, spec
 }
 
 func buildConversionReviewFromAlertmanagerConfigSpec(t *testing.T, from, to, spec string) []byte {
+	t.Helper()
+	return buildConversionReview(t, alertManagerConfigKind, from, to, spec)
+}
+
+func buildConversionReview(t *testing.T, kind, from, to, spec string) []byte {
 	t.Helper()
 	tmpl := fmt.Sprintf(`
 {
@@ -430,7 +1316,7 @@ func buildConversionReviewFromAlertmanagerConfigSpec(t *testing.T, from, to, spe
 `,
 		to,
 		from,
-		alertManagerConfigKind,
+		kind,
 		spec)
 	return []byte(tmpl)
 }
@@ -0,0 +1,197 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+)
+
+const deprecationsValidatePath = "/admission-deprecations/validate"
+
+// deprecatedFields lists the deprecated fields of the API types (by Go field
+// name) with the suggested replacement.
+var deprecatedFields = map[reflect.Type]map[string]string{
+	reflect.TypeOf(monitoringv1.PrometheusSpec{}): {
+		"BaseImage":                          "use 'image' instead",
+		"Tag":                                "use 'image' instead",
+		"SHA":                                "use 'image' instead",
+		"PrometheusRulesExcludedFromEnforce": "use 'excludedFromEnforcement' instead",
+		"AllowOverlappingBlocks":             "it has no effect for Prometheus >= 2.39.0",
+	},
+	reflect.TypeOf(monitoringv1.ThanosSpec{}): {
+		"BaseImage":   "use 'image' instead",
+		"Tag":         "use 'image' instead",
+		"SHA":         "use 'image' instead",
+		"ListenLocal": "use 'grpcListenLocal' and 'httpListenLocal' instead",
+	},
+	reflect.TypeOf(monitoringv1.AlertmanagerSpec{}): {
+		"BaseImage": "use 'image' instead",
+		"Tag":       "use 'image' instead",
+		"SHA":       "use 'image' instead",
+	},
+	reflect.TypeOf(monitoringv1.ThanosRulerSpec{}): {
+		"PrometheusRulesExcludedFromEnforce": "use 'excludedFromEnforcement' instead",
+	},
+	reflect.TypeOf(monitoringv1.StorageSpec{}): {
+		"DisableMountSubPath": "the subPath usage will be removed in a future release",
+	},
+	reflect.TypeOf(monitoringv1.RemoteWriteSpec{}): {
+		"BearerTokenFile": "use 'authorization' instead",
+		"BearerToken":     "use 'authorization' instead",
+	},
+	reflect.TypeOf(monitoringv1.RemoteReadSpec{}): {
+		"BearerTokenFile": "use 'authorization' instead",
+		"BearerToken":     "use 'authorization' instead",
+	},
+	reflect.TypeOf(monitoringv1.APIServerConfig{}): {
+		"BearerTokenFile": "use 'authorization' instead",
+		"BearerToken":     "use 'authorization' instead",
+	},
+	reflect.TypeOf(monitoringv1.AlertmanagerEndpoints{}): {
+		"BearerTokenFile": "use 'authorization' instead",
+	},
+	reflect.TypeOf(monitoringv1.Endpoint{}): {
+		"BearerTokenFile":   "use 'authorization' instead",
+		"BearerTokenSecret": "use 'authorization' instead",
+	},
+	reflect.TypeOf(monitoringv1.PodMetricsEndpoint{}): {
+		"TargetPort":        "use 'port' or 'portNumber' instead",
+		"BearerTokenSecret": "use 'authorization' instead",
+	},
+	reflect.TypeOf(monitoringv1alpha1.Matcher{}): {
+		"Regex": "use 'matchType' instead",
+	},
+}
+
+var (
+	deprecationsResources = map[metav1.GroupVersionResource]func() any{
+		prometheusGVR:      func() any { return &monitoringv1.Prometheus{} },
+		prometheusAgentGVR: func() any { return &monitoringv1alpha1.PrometheusAgent{} },
+		alertmanagerGVR:    func() any { return &monitoringv1.Alertmanager{} },
+		thanosRulerGVR:     func() any { return &monitoringv1.ThanosRuler{} },
+		serviceMonitorGVR:  func() any { return &monitoringv1.ServiceMonitor{} },
+		podMonitorGVR:      func() any { return &monitoringv1.PodMonitor{} },
+		probeGVR:           func() any { return &monitoringv1.Probe{} },
+		scrapeConfigGVR:    func() any { return &monitoringv1alpha1.ScrapeConfig{} },
+		{Group: group, Version: monitoringv1alpha1.Version, Resource: alertManagerConfigResource}: func() any { return &monitoringv1alpha1.AlertmanagerConfig{} },
+		{Group: group, Version: monitoringv1beta1.Version, Resource: alertManagerConfigResource}:  func() any { return &monitoringv1beta1.AlertmanagerConfig{} },
+	}
+
+	indexRe = regexp.MustCompile(`\[[^\]]*\]`)
+)
+
+// newDeprecatedFieldsCounter returns the metric counting the admission
+// requests using deprecated fields.
+func newDeprecatedFieldsCounter() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "prometheus_operator_admission_deprecated_fields_total",
+			Help: "Number of admission requests for objects using a deprecated field.",
+		},
+		[]string{"resource", "field"},
+	)
+}
+
+// WithRegisterer registers the metrics of the admission webhook.
+func WithRegisterer(reg prometheus.Registerer) Option {
+	return func(a *Admission) {
+		reg.MustRegister(a.deprecatedFieldsTotal)
+	}
+}
+
+// deprecation is a deprecated field set in an object.
+type deprecation struct {
+	path    string
+	message string
+}
+
+func (d deprecation) String() string {
+	return fmt.Sprintf("%s: deprecated field, %s", d.path, d.message)
+}
+
+// findDeprecations returns the deprecated fields which are set in the object.
+func findDeprecations(path string, obj any) []deprecation {
+	var deprecations []deprecation
+	walk(path, reflect.ValueOf(obj), func(path string, v reflect.Value) bool {
+		fields, found := deprecatedFields[v.Type()]
+		if !found {
+			return false
+		}
+
+		for i := range v.NumField() {
+			f := v.Type().Field(i)
+			msg, found := fields[f.Name]
+			if !found || v.Field(i).IsZero() {
+				continue
+			}
+
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			deprecations = append(deprecations, deprecation{path: path + "." + name, message: msg})
+		}
+
+		// The struct may contain other types with deprecated fields (e.g.
+		// the remote-write configuration of the Prometheus spec).
+		return false
+	})
+
+	return deprecations
+}
+
+func (a *Admission) serveDeprecationsValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, a.validateDeprecations)
+}
+
+// validateDeprecations never rejects the requests: it returns an admission
+// warning for each deprecated field used by the object and updates the
+// deprecated fields metric.
+func (a *Admission) validateDeprecations(ar v1.AdmissionReview) *v1.AdmissionResponse {
+	a.logger.Debug("Checking deprecated fields")
+
+	newObj, found := deprecationsResources[ar.Request.Resource]
+	if !found {
+		a.logger.Debug("unsupported resource for the deprecation checks", "resource", ar.Request.Resource)
+		return &v1.AdmissionResponse{Allowed: true}
+	}
+
+	obj := newObj()
+	if err := json.Unmarshal(ar.Request.Object.Raw, obj); err != nil {
+		a.logger.Info("failed to unmarshal the object for the deprecation checks", "err", err)
+		return &v1.AdmissionResponse{Allowed: true}
+	}
+
+	var warnings []string
+	for _, d := range findDeprecations("spec", reflect.ValueOf(obj).Elem().FieldByName("Spec").Interface()) {
+		warnings = append(warnings, d.String())
+		a.deprecatedFieldsTotal.WithLabelValues(ar.Request.Resource.Resource, indexRe.ReplaceAllString(d.path, "")).Inc()
+	}
+
+	return &v1.AdmissionResponse{
+		Allowed:  true,
+		Warnings: warnings,
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+const dryRunPath = "/dry-run"
+
+// DryRunRequest is the body of the requests sent to the dry-run endpoint.
+type DryRunRequest struct {
+	// Object is the ServiceMonitor, PodMonitor or ScrapeConfig to render.
+	// The apiVersion and kind fields are required. It can be omitted when
+	// only the additional configurations are validated.
+	Object runtime.RawExtension `json:"object"`
+	// AdditionalScrapeConfigs and AdditionalAlertManagerConfigs are the
+	// contents of the Secrets referenced by the additionalScrapeConfigs and
+	// additionalAlertManagerConfigs fields of the Prometheus object.
+	// They are validated before rendering the object.
+	AdditionalScrapeConfigs       string `json:"additionalScrapeConfigs,omitempty"`
+	AdditionalAlertManagerConfigs string `json:"additionalAlertManagerConfigs,omitempty"`
+	// Prometheus is the Prometheus object for which the configuration is
+	// generated (e.g. to define the scrape classes). Optional.
+	Prometheus *monitoringv1.Prometheus `json:"prometheus,omitempty"`
+	// Version is the Prometheus version. It overrides the version of the
+	// Prometheus object. Defaults to the version of the operator.
+	Version string `json:"version,omitempty"`
+	// Secrets and ConfigMaps referenced by the object. Their namespace
+	// defaults to the namespace of the object.
+	Secrets    []corev1.Secret    `json:"secrets,omitempty"`
+	ConfigMaps []corev1.ConfigMap `json:"configMaps,omitempty"`
+}
+
+// DryRunResponse is the body of the responses returned by the dry-run
+// endpoint.
+type DryRunResponse struct {
+	// ScrapeConfigs are the rendered scrape jobs in YAML format.
+	ScrapeConfigs string `json:"scrapeConfigs,omitempty"`
+	// Reason and Error are set when the operator would reject the object.
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// serveDryRun renders the scrape jobs of the object in the request like the
+// operator would do, after validating the additional configurations (if any).
+// It replies with 200 when the request is valid and with 422 when the
+// operator would reject it.
+func (a *Admission) serveDryRun(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed, want POST", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req DryRunRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("cannot decode request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := validateAdditionalConfigs(req); err != nil {
+		a.writeDryRunResponse(w, http.StatusUnprocessableEntity, DryRunResponse{
+			Reason: string(promoperator.InvalidConfigurationReason),
+			Error:  err.Error(),
+		})
+		return
+	}
+
+	if len(req.Object.Raw) == 0 && (req.AdditionalScrapeConfigs != "" || req.AdditionalAlertManagerConfigs != "") {
+		a.writeDryRunResponse(w, http.StatusOK, DryRunResponse{})
+		return
+	}
+
+	obj, err := decodeDryRunObject(req.Object.Raw)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if m.GetNamespace() == "" {
+		m.SetNamespace(metav1.NamespaceDefault)
+	}
+
+	p := &monitoringv1.Prometheus{}
+	if req.Prometheus != nil {
+		p = req.Prometheus.DeepCopy()
+	}
+	if req.Version != "" {
+		p.Spec.Version = req.Version
+	}
+
+	objs := make([]runtime.Object, 0, len(req.Secrets)+len(req.ConfigMaps))
+	for i := range req.Secrets {
+		if req.Secrets[i].Namespace == "" {
+			req.Secrets[i].Namespace = m.GetNamespace()
+		}
+		objs = append(objs, &req.Secrets[i])
+	}
+	for i := range req.ConfigMaps {
+		if req.ConfigMaps[i].Namespace == "" {
+			req.ConfigMaps[i].Namespace = m.GetNamespace()
+		}
+		objs = append(objs, &req.ConfigMaps[i])
+	}
+	kclient := fake.NewClientset(objs...)
+
+	var (
+		resp   DryRunResponse
+		status = http.StatusOK
+	)
+
+	b, err := prometheus.GenerateScrapeJobs(r.Context(), a.logger, p, obj, assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1()))
+	if err != nil {
+		resp.Reason = string(promoperator.RejectionReasonOf(err))
+		resp.Error = err.Error()
+		status = http.StatusUnprocessableEntity
+	} else {
+		resp.ScrapeConfigs = string(b)
+	}
+
+	a.writeDryRunResponse(w, status, resp)
+}
+
+func (a *Admission) writeDryRunResponse(w http.ResponseWriter, status int, resp DryRunResponse) {
+	respBytes, err := json.Marshal(resp)
+	if err != nil {
+		a.logger.Error("Cannot serialize response", "err", err)
+		http.Error(w, fmt.Sprintf("could not serialize response: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(respBytes); err != nil {
+		a.logger.Error("Cannot write response", "err", err)
+	}
+}
+
+func validateAdditionalConfigs(req DryRunRequest) error {
+	if err := prometheus.ValidateAdditionalScrapeConfigs([]byte(req.AdditionalScrapeConfigs)); err != nil {
+		return fmt.Errorf("additionalScrapeConfigs: %w", err)
+	}
+
+	if err := prometheus.ValidateAdditionalAlertmanagerConfigs([]byte(req.AdditionalAlertManagerConfigs)); err != nil {
+		return fmt.Errorf("additionalAlertManagerConfigs: %w", err)
+	}
+
+	return nil
+}
+
+func decodeDryRunObject(raw []byte) (runtime.Object, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("object is required")
+	}
+
+	var tm metav1.TypeMeta
+	if err := json.Unmarshal(raw, &tm); err != nil {
+		return nil, fmt.Errorf("cannot decode object: %w", err)
+	}
+
+	var obj runtime.Object
+	switch tm.Kind {
+	case monitoringv1.ServiceMonitorsKind:
+		obj = &monitoringv1.ServiceMonitor{}
+	case monitoringv1.PodMonitorsKind:
+		obj = &monitoringv1.PodMonitor{}
+	case monitoringv1alpha1.ScrapeConfigsKind:
+		obj = &monitoringv1alpha1.ScrapeConfig{}
+	default:
+		return nil, fmt.Errorf("unsupported kind %q, expecting %s, %s or %s", tm.Kind, monitoringv1.ServiceMonitorsKind, monitoringv1.PodMonitorsKind, monitoringv1alpha1.ScrapeConfigsKind)
+	}
+
+	if err := json.Unmarshal(raw, obj); err != nil {
+		return nil, fmt.Errorf("cannot decode %s: %w", tm.Kind, err)
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+)
+
+// referencesCheckTimeout is the maximum duration of the requests checking
+// the references of an object.
+const referencesCheckTimeout = 5 * time.Second
+
+var (
+	configMapKeySelectorType     = reflect.TypeOf(v1.ConfigMapKeySelector{})
+	v1beta1SecretKeySelectorType = reflect.TypeOf(monitoringv1beta1.SecretKeySelector{})
+)
+
+// objectReference is a reference to a key of a Secret or ConfigMap in the
+// namespace of the object.
+type objectReference struct {
+	path     string
+	kind     string
+	name     string
+	key      string
+	optional bool
+}
+
+// WithReferenceChecks enables the checks of the Secrets and ConfigMaps
+// referenced by the monitors and AlertmanagerConfigs. The missing objects
+// and keys are reported as admission warnings, the requests aren't rejected.
+func WithReferenceChecks(kclient kubernetes.Interface) Option {
+	return func(a *Admission) {
+		a.kclient = kclient
+	}
+}
+
+// objectReferences returns the Secret and ConfigMap keys referenced by the
+// object.
+func objectReferences(path string, obj any) []objectReference {
+	var refs []objectReference
+	walk(path, reflect.ValueOf(obj), func(path string, v reflect.Value) bool {
+		switch v.Type() {
+		case secretKeySelectorType:
+			sel := v.Interface().(v1.SecretKeySelector)
+			refs = append(refs, objectReference{path: path, kind: "Secret", name: sel.Name, key: sel.Key, optional: ptr.Deref(sel.Optional, false)})
+		case v1beta1SecretKeySelectorType:
+			sel := v.Interface().(monitoringv1beta1.SecretKeySelector)
+			refs = append(refs, objectReference{path: path, kind: "Secret", name: sel.Name, key: sel.Key})
+		case configMapKeySelectorType:
+			sel := v.Interface().(v1.ConfigMapKeySelector)
+			refs = append(refs, objectReference{path: path, kind: "ConfigMap", name: sel.Name, key: sel.Key, optional: ptr.Deref(sel.Optional, false)})
+		default:
+			return false
+		}
+
+		return true
+	})
+
+	return refs
+}
+
+// missingReferences returns a warning for each non-optional reference of the
+// object to a Secret or ConfigMap (or a key) which doesn't exist in the
+// namespace. It returns nil if the checks aren't enabled.
+//
+// The objects are often created in any order (e.g. by GitOps tools) so a
+// missing reference doesn't reject the request.
+func (a *Admission) missingReferences(namespace string, obj any) []string {
+	if a.kclient == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), referencesCheckTimeout)
+	defer cancel()
+
+	var (
+		warnings []string
+		// The objects are fetched once per request.
+		keys = map[string]map[string]struct{}{}
+	)
+	for _, ref := range objectReferences("spec", obj) {
+		if ref.optional || ref.name == "" {
+			continue
+		}
+
+		id := ref.kind + "/" + ref.name
+		objKeys, found := keys[id]
+		if !found {
+			var err error
+			objKeys, err = a.getKeys(ctx, namespace, ref.kind, ref.name)
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					a.logger.Debug("failed to check the reference", "kind", ref.kind, "name", ref.name, "namespace", namespace, "err", err)
+					continue
+				}
+
+				objKeys = nil
+			}
+			keys[id] = objKeys
+		}
+
+		if objKeys == nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %s %q not found in namespace %q", ref.path, ref.kind, ref.name, namespace))
+			continue
+		}
+
+		if _, found := objKeys[ref.key]; !found {
+			warnings = append(warnings, fmt.Sprintf("%s: key %q not found in %s %q", ref.path, ref.key, ref.kind, ref.name))
+		}
+	}
+
+	return warnings
+}
+
+func (a *Admission) getKeys(ctx context.Context, namespace, kind, name string) (map[string]struct{}, error) {
+	keys := map[string]struct{}{}
+
+	switch kind {
+	case "Secret":
+		s, err := a.kclient.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		for k := range s.Data {
+			keys[k] = struct{}{}
+		}
+		for k := range s.StringData {
+			keys[k] = struct{}{}
+		}
+
+	case "ConfigMap":
+		cm, err := a.kclient.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		for k := range cm.Data {
+			keys[k] = struct{}{}
+		}
+		for k := range cm.BinaryData {
+			keys[k] = struct{}{}
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+// MonitorDefaults are the values set by the mutating webhook on the
+// ServiceMonitors, PodMonitors and ScrapeConfigs which don't define them.
+// The zero value doesn't set anything.
+type MonitorDefaults struct {
+	// ScrapeTimeoutRatio is the ratio of the scrape interval used as the
+	// scrape timeout when the interval is defined but not the timeout.
+	ScrapeTimeoutRatio float64
+	// Scheme is the scheme of the scrape requests ("http" or "https").
+	Scheme string
+	// ScrapeClass is the name of the scrape class.
+	ScrapeClass string
+}
+
+// RegisterFlags registers the command-line flags configuring the defaults.
+func RegisterFlags(fs *flag.FlagSet, d *MonitorDefaults) {
+	fs.Float64Var(&d.ScrapeTimeoutRatio, "admission.default-scrape-timeout-ratio", d.ScrapeTimeoutRatio, "Ratio of the scrape interval set as the scrape timeout by the /admission-monitors/mutate endpoint for the ServiceMonitors, PodMonitors and ScrapeConfigs which define an interval but no timeout. The value should be greater than 0.0 and less than or equal to 1.0. Default: 0.0 (disabled).")
+	fs.StringVar(&d.Scheme, "admission.default-scheme", d.Scheme, "Scheme (http or https) set by the /admission-monitors/mutate endpoint for the ServiceMonitors, PodMonitors and ScrapeConfigs which don't define it. Default: empty (disabled).")
+	fs.StringVar(&d.ScrapeClass, "admission.default-scrape-class", d.ScrapeClass, "Scrape class set by the /admission-monitors/mutate endpoint for the ServiceMonitors, PodMonitors and ScrapeConfigs which don't define it. Default: empty (disabled).")
+}
+
+// Validate returns an error if the defaults are invalid.
+func (d MonitorDefaults) Validate() error {
+	if d.ScrapeTimeoutRatio < 0 || d.ScrapeTimeoutRatio > 1 {
+		return fmt.Errorf("invalid scrape timeout ratio %v: the value should be between 0.0 and 1.0", d.ScrapeTimeoutRatio)
+	}
+
+	switch d.Scheme {
+	case "", "http", "https":
+	default:
+		return fmt.Errorf("invalid scheme %q: expecting http or https", d.Scheme)
+	}
+
+	return nil
+}
+
+// scrapeTimeout returns the scrape timeout for the given interval. It
+// returns false if no timeout should be set.
+func (d MonitorDefaults) scrapeTimeout(interval monitoringv1.Duration) (monitoringv1.Duration, bool) {
+	if d.ScrapeTimeoutRatio == 0 || interval == "" {
+		return "", false
+	}
+
+	// Invalid intervals are rejected by the API server.
+	i, err := model.ParseDuration(string(interval))
+	if err != nil {
+		return "", false
+	}
+
+	timeout := time.Duration(float64(i) * d.ScrapeTimeoutRatio).Truncate(time.Millisecond)
+	if timeout <= 0 {
+		return "", false
+	}
+
+	return monitoringv1.Duration(model.Duration(timeout).String()), true
+}
+
+type jsonPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+func addOperation(path string, value any) jsonPatchOperation {
+	return jsonPatchOperation{Op: "add", Path: path, Value: value}
+}
+
+// patches returns the JSON patch operations setting the defaults on the
+// object.
+func (d MonitorDefaults) patches(obj any) []jsonPatchOperation {
+	var ops []jsonPatchOperation
+
+	switch o := obj.(type) {
+	case *monitoringv1.ServiceMonitor:
+		for i, ep := range o.Spec.Endpoints {
+			path := fmt.Sprintf("/spec/endpoints/%d", i)
+			if d.Scheme != "" && ep.Scheme == "" {
+				ops = append(ops, addOperation(path+"/scheme", d.Scheme))
+			}
+
+			if timeout, ok := d.scrapeTimeout(ep.Interval); ok && ep.ScrapeTimeout == "" {
+				ops = append(ops, addOperation(path+"/scrapeTimeout", timeout))
+			}
+		}
+
+		if d.ScrapeClass != "" && o.Spec.ScrapeClassName == nil {
+			ops = append(ops, addOperation("/spec/scrapeClass", d.ScrapeClass))
+		}
+
+	case *monitoringv1.PodMonitor:
+		for i, ep := range o.Spec.PodMetricsEndpoints {
+			path := fmt.Sprintf("/spec/podMetricsEndpoints/%d", i)
+			if d.Scheme != "" && ep.Scheme == "" {
+				ops = append(ops, addOperation(path+"/scheme", d.Scheme))
+			}
+
+			if timeout, ok := d.scrapeTimeout(ep.Interval); ok && ep.ScrapeTimeout == "" {
+				ops = append(ops, addOperation(path+"/scrapeTimeout", timeout))
+			}
+		}
+
+		if d.ScrapeClass != "" && o.Spec.ScrapeClassName == nil {
+			ops = append(ops, addOperation("/spec/scrapeClass", d.ScrapeClass))
+		}
+
+	case *monitoringv1alpha1.ScrapeConfig:
+		// The ScrapeConfig CRD uses uppercase values for the scheme.
+		if d.Scheme != "" && o.Spec.Scheme == nil {
+			ops = append(ops, addOperation("/spec/scheme", strings.ToUpper(d.Scheme)))
+		}
+
+		if o.Spec.ScrapeInterval != nil && o.Spec.ScrapeTimeout == nil {
+			if timeout, ok := d.scrapeTimeout(*o.Spec.ScrapeInterval); ok {
+				ops = append(ops, addOperation("/spec/scrapeTimeout", timeout))
+			}
+		}
+
+		if d.ScrapeClass != "" && o.Spec.ScrapeClassName == nil {
+			ops = append(ops, addOperation("/spec/scrapeClass", d.ScrapeClass))
+		}
+	}
+
+	return ops
+}
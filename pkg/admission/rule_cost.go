@@ -0,0 +1,146 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	// RuleCostWarn returns admission warnings for the expensive rules.
+	RuleCostWarn = "warn"
+	// RuleCostReject rejects the PrometheusRules with expensive rules.
+	RuleCostReject = "reject"
+)
+
+// RuleCostLimits configures the heuristics flagging the rule expressions
+// which are likely to be expensive for Prometheus. The zero value disables
+// the checks.
+type RuleCostLimits struct {
+	// Mode is either empty (disabled), RuleCostWarn or RuleCostReject.
+	Mode string
+	// MaxSubqueryRange is the maximum range of the subqueries. Zero means no
+	// limit.
+	MaxSubqueryRange time.Duration
+}
+
+// RegisterRuleCostFlags registers the command-line flags configuring the
+// rule cost checks.
+func RegisterRuleCostFlags(fs *flag.FlagSet, l *RuleCostLimits) {
+	fs.StringVar(&l.Mode, "admission.rule-cost-check", l.Mode, "Check the expressions of the PrometheusRules validated by the /admission-prometheusrules/validate endpoint for expensive patterns (selectors without metric name, regular expressions matching any value, subqueries with a long range). Valid values are 'warn' (return admission warnings) and 'reject' (reject the PrometheusRules). Default: empty (disabled).")
+	fs.DurationVar(&l.MaxSubqueryRange, "admission.rule-cost-max-subquery-range", l.MaxSubqueryRange, "Maximum range of the subqueries in the rule expressions when --admission.rule-cost-check is set. Default: 0 (no limit).")
+}
+
+// Validate returns an error if the limits are invalid.
+func (l RuleCostLimits) Validate() error {
+	switch l.Mode {
+	case "", RuleCostWarn, RuleCostReject:
+	default:
+		return fmt.Errorf("invalid rule cost check mode %q: expecting %s or %s", l.Mode, RuleCostWarn, RuleCostReject)
+	}
+
+	if l.MaxSubqueryRange < 0 {
+		return fmt.Errorf("invalid maximum subquery range %v: the value should be positive", l.MaxSubqueryRange)
+	}
+
+	return nil
+}
+
+// WithRuleCostLimits enables the cost checks of the PrometheusRule
+// expressions.
+func WithRuleCostLimits(l RuleCostLimits) Option {
+	return func(a *Admission) {
+		a.ruleCostLimits = l
+	}
+}
+
+// check returns the violations of the limits for the rules. The rules are
+// expected to be valid.
+func (l RuleCostLimits) check(spec monitoringv1.PrometheusRuleSpec) []error {
+	if l.Mode == "" {
+		return nil
+	}
+
+	var errs []error
+	for i, g := range spec.Groups {
+		for j, r := range g.Rules {
+			expr, err := parser.ParseExpr(r.Expr.String())
+			if err != nil {
+				// Invalid expressions are rejected by the rule validation.
+				continue
+			}
+
+			name := r.Record
+			if r.Alert != "" {
+				name = r.Alert
+			}
+
+			for _, violation := range l.checkExpr(expr) {
+				errs = append(errs, fmt.Errorf("groups[%d].rules[%d] (%s): %s", i, j, name, violation))
+			}
+		}
+	}
+
+	return errs
+}
+
+func (l RuleCostLimits) checkExpr(expr parser.Expr) []string {
+	var violations []string
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		switch n := node.(type) {
+		case *parser.VectorSelector:
+			if !hasMetricName(n) {
+				violations = append(violations, fmt.Sprintf("selector %s has no metric name", n.String()))
+			}
+
+			for _, m := range n.LabelMatchers {
+				if m.Type == labels.MatchRegexp && (m.Value == ".*" || m.Value == ".+") {
+					violations = append(violations, fmt.Sprintf("selector %s has a regular expression matching any value (%s)", n.String(), m.String()))
+				}
+			}
+
+		case *parser.SubqueryExpr:
+			if l.MaxSubqueryRange > 0 && n.Range > l.MaxSubqueryRange {
+				violations = append(violations, fmt.Sprintf("subquery range %s is greater than the maximum of %s", model.Duration(n.Range), model.Duration(l.MaxSubqueryRange)))
+			}
+		}
+
+		return nil
+	})
+
+	return violations
+}
+
+func hasMetricName(vs *parser.VectorSelector) bool {
+	if vs.Name != "" {
+		return true
+	}
+
+	for _, m := range vs.LabelMatchers {
+		if m.Name == model.MetricNameLabel && m.Type == labels.MatchEqual && m.Value != "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+var secretKeySelectorType = reflect.TypeOf(v1.SecretKeySelector{})
+
+// validateSecretReferences walks through the object and returns an error for
+// each Secret reference which isn't supported by the operator (e.g.
+// cross-namespace references).
+func validateSecretReferences(path string, obj any) []error {
+	var errs []error
+	walkSecretKeySelectors(path, reflect.ValueOf(obj), func(path string, sel v1.SecretKeySelector) {
+		if err := assets.ValidateSecretKeySelector(sel); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+		}
+	})
+
+	return errs
+}
+
+func walkSecretKeySelectors(path string, v reflect.Value, fn func(string, v1.SecretKeySelector)) {
+	walk(path, v, func(path string, v reflect.Value) bool {
+		if v.Type() != secretKeySelectorType {
+			return false
+		}
+
+		fn(path, v.Interface().(v1.SecretKeySelector))
+		return true
+	})
+}
+
+// walk calls visit for each struct value of the object with its JSON path.
+// The fields of the struct aren't walked when visit returns true.
+func walk(path string, v reflect.Value, visit func(string, reflect.Value) bool) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			walk(path, v.Elem(), visit)
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			walk(fmt.Sprintf("%s[%d]", path, i), v.Index(i), visit)
+		}
+
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			walk(fmt.Sprintf("%s[%v]", path, iter.Key()), iter.Value(), visit)
+		}
+
+	case reflect.Struct:
+		if visit(path, v) {
+			return
+		}
+
+		for i := range v.NumField() {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+			switch {
+			case name == "-":
+				continue
+			case f.Anonymous || opts == "inline":
+				walk(path, v.Field(i), visit)
+				continue
+			case name == "":
+				name = f.Name
+			}
+
+			walk(path+"."+name, v.Field(i), visit)
+		}
+	}
+}
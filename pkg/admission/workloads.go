@@ -0,0 +1,178 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/blang/semver/v4"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	errUnmarshalWorkload = "Cannot unmarshal workload"
+
+	workloadsValidatePath = "/admission-workloads/validate"
+)
+
+var (
+	prometheusGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.PrometheusName,
+	}
+	prometheusAgentGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1alpha1.Version,
+		Resource: monitoringv1alpha1.PrometheusAgentName,
+	}
+	alertmanagerGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.AlertmanagerName,
+	}
+	thanosRulerGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.ThanosRulerName,
+	}
+)
+
+// flagCheck is a list of additional arguments to validate against a flag
+// catalog.
+type flagCheck struct {
+	path    string
+	catalog *operator.FlagCatalog
+	version string
+	args    []monitoringv1.Argument
+}
+
+func (a *Admission) serveWorkloadsValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, a.validateWorkloads)
+}
+
+// validateWorkloads validates the additional arguments of the Prometheus,
+// PrometheusAgent, Alertmanager and ThanosRuler objects against the flags
+// supported by the version of the component.
+func (a *Admission) validateWorkloads(ar v1.AdmissionReview) *v1.AdmissionResponse {
+	a.logger.Debug("Validating workloads")
+
+	var (
+		checks []flagCheck
+		raw    = ar.Request.Object.Raw
+		err    error
+	)
+	switch ar.Request.Resource {
+	case prometheusGVR:
+		p := &monitoringv1.Prometheus{}
+		if err = json.Unmarshal(raw, p); err == nil {
+			checks = append(checks, flagCheck{
+				path:    "spec.additionalArgs",
+				catalog: operator.PrometheusServerFlags,
+				version: operator.StringValOrDefault(p.Spec.Version, operator.DefaultPrometheusVersion),
+				args:    p.Spec.AdditionalArgs,
+			})
+
+			if p.Spec.Thanos != nil {
+				checks = append(checks, flagCheck{
+					path:    "spec.thanos.additionalArgs",
+					catalog: operator.ThanosSidecarFlags,
+					version: ptr.Deref(p.Spec.Thanos.Version, operator.DefaultThanosVersion),
+					args:    p.Spec.Thanos.AdditionalArgs,
+				})
+			}
+		}
+
+	case prometheusAgentGVR:
+		p := &monitoringv1alpha1.PrometheusAgent{}
+		if err = json.Unmarshal(raw, p); err == nil {
+			checks = append(checks, flagCheck{
+				path:    "spec.additionalArgs",
+				catalog: operator.PrometheusAgentFlags,
+				version: operator.StringValOrDefault(p.Spec.Version, operator.DefaultPrometheusVersion),
+				args:    p.Spec.AdditionalArgs,
+			})
+		}
+
+	case alertmanagerGVR:
+		am := &monitoringv1.Alertmanager{}
+		if err = json.Unmarshal(raw, am); err == nil {
+			checks = append(checks, flagCheck{
+				path:    "spec.additionalArgs",
+				catalog: operator.AlertmanagerFlags,
+				version: operator.StringValOrDefault(am.Spec.Version, operator.DefaultAlertmanagerVersion),
+				args:    am.Spec.AdditionalArgs,
+			})
+		}
+
+	case thanosRulerGVR:
+		tr := &monitoringv1.ThanosRuler{}
+		if err = json.Unmarshal(raw, tr); err == nil {
+			checks = append(checks, flagCheck{
+				path:    "spec.additionalArgs",
+				catalog: operator.ThanosRulerFlags,
+				version: operator.StringValOrDefault(ptr.Deref(tr.Spec.Version, ""), operator.DefaultThanosVersion),
+				args:    tr.Spec.AdditionalArgs,
+			})
+		}
+
+	default:
+		err := fmt.Errorf("expected resource to be one of %v, %v, %v or %v, but received %v", prometheusGVR, prometheusAgentGVR, alertmanagerGVR, thanosRulerGVR, ar.Request.Resource)
+		a.logger.Warn("", "err", err)
+		return toAdmissionResponseFailure("Unexpected resource kind", ar.Request.Resource.Resource, []error{err})
+	}
+
+	if err != nil {
+		a.logger.Info(errUnmarshalWorkload, "err", err)
+		return toAdmissionResponseFailure(errUnmarshalWorkload, ar.Request.Resource.Resource, []error{err})
+	}
+
+	var errs []error
+	for _, c := range checks {
+		version, err := semver.ParseTolerant(c.version)
+		if err != nil {
+			// The operator reports the invalid versions at reconcile time.
+			a.logger.Debug("failed to parse the version", "version", c.version, "err", err)
+			continue
+		}
+
+		if err := c.catalog.ValidateArgs(c.path, version, c.args); err != nil {
+			if joined, ok := err.(interface{ Unwrap() []error }); ok {
+				errs = append(errs, joined.Unwrap()...)
+				continue
+			}
+
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 0 {
+		for _, err := range errs {
+			a.logger.Info("Invalid additional argument", "err", err)
+		}
+
+		return toAdmissionResponseFailure("Additional arguments are not valid", ar.Request.Resource.Resource, errs)
+	}
+
+	return &v1.AdmissionResponse{Allowed: true}
+}
@@ -226,11 +226,12 @@ func (one *otherNamespaceEnforcer) processRoute(crKey types.NamespacedName, r *r
 // The API is public because it's used by Grafana Alloy (https://github.com/grafana/alloy).
 // Note that the project makes no API stability guarantees.
 type ConfigBuilder struct {
-	cfg       *alertmanagerConfig
-	logger    *slog.Logger
-	amVersion semver.Version
-	store     *assets.StoreBuilder
-	enforcer  enforcer
+	cfg                  *alertmanagerConfig
+	logger               *slog.Logger
+	amVersion            semver.Version
+	store                *assets.StoreBuilder
+	enforcer             enforcer
+	resolveTimeoutBounds *monitoringv1.DurationBounds
 }
 
 func NewConfigBuilder(logger *slog.Logger, amVersion semver.Version, store *assets.StoreBuilder, am *monitoringv1.Alertmanager) *ConfigBuilder {
@@ -239,10 +240,64 @@ func NewConfigBuilder(logger *slog.Logger, amVersion semver.Version, store *asse
 		amVersion: amVersion,
 		store:     store,
 		enforcer:  getEnforcer(am.Spec.AlertmanagerConfigMatcherStrategy, amVersion, am.Namespace),
+
+		resolveTimeoutBounds: am.Spec.AlertmanagerConfigResolveTimeoutBounds,
 	}
 	return cg
 }
 
+// parseDurationBounds returns the lower and upper bounds of the interval.
+func parseDurationBounds(b *monitoringv1.DurationBounds) (model.Duration, model.Duration, error) {
+	minDuration, err := model.ParseDuration(string(b.Min))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid min value: %w", err)
+	}
+
+	maxDuration, err := model.ParseDuration(string(b.Max))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid max value: %w", err)
+	}
+
+	if minDuration > maxDuration {
+		return 0, 0, fmt.Errorf("min value (%s) is greater than max value (%s)", b.Min, b.Max)
+	}
+
+	return minDuration, maxDuration, nil
+}
+
+// applyResolveTimeout sets the group interval of the route to the resolve
+// timeout requested by the AlertmanagerConfig resource, clamped within the
+// bounds of the Alertmanager resource. The route is left untouched when the
+// group interval is already defined or when no bounds are configured.
+func (cb *ConfigBuilder) applyResolveTimeout(r *route, amc *monitoringv1alpha1.AlertmanagerConfig) error {
+	if amc.Spec.ResolveTimeout == nil || cb.resolveTimeoutBounds == nil || r.GroupInterval != "" {
+		return nil
+	}
+
+	requested, err := model.ParseDuration(string(*amc.Spec.ResolveTimeout))
+	if err != nil {
+		return fmt.Errorf("invalid resolveTimeout: %w", err)
+	}
+
+	minDuration, maxDuration, err := parseDurationBounds(cb.resolveTimeoutBounds)
+	if err != nil {
+		return fmt.Errorf("invalid resolve timeout bounds: %w", err)
+	}
+
+	clamped := min(max(requested, minDuration), maxDuration)
+	if clamped != requested {
+		cb.logger.Debug("resolve timeout clamped within the bounds",
+			"alertmanagerconfig", types.NamespacedName{Namespace: amc.Namespace, Name: amc.Name},
+			"requested", requested,
+			"clamped", clamped,
+		)
+	}
+
+	r.GroupInterval = clamped.String()
+
+	return nil
+}
+
 func getEnforcer(matcherStrategy monitoringv1.AlertmanagerConfigMatcherStrategy, amVersion semver.Version, amNamespace string) enforcer {
 	var e enforcer
 	switch matcherStrategy.Type {
@@ -367,15 +422,15 @@ func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs m
 			continue
 		}
 
-		subRoutes = append(subRoutes,
-			cb.enforcer.processRoute(
-				crKey,
-				cb.convertRoute(
-					amConfigs[amConfigIdentifier].Spec.Route,
-					crKey,
-				),
-			),
+		r := cb.convertRoute(
+			amConfigs[amConfigIdentifier].Spec.Route,
+			crKey,
 		)
+		if err := cb.applyResolveTimeout(r, amConfigs[amConfigIdentifier]); err != nil {
+			return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
+		}
+
+		subRoutes = append(subRoutes, cb.enforcer.processRoute(crKey, r))
 
 		for _, receiver := range amConfigs[amConfigIdentifier].Spec.Receivers {
 			receivers, err := cb.convertReceiver(ctx, &receiver, crKey)
@@ -789,6 +844,27 @@ func (cb *ConfigBuilder) convertWebhookConfig(ctx context.Context, in monitoring
 	}
 	out.HTTPConfig = httpConfig
 
+	if in.SigningSecret != nil {
+		key, err := cb.store.GetSecretKey(ctx, crKey.Namespace, webhookSigningKeySelector(in.SigningSecret))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the webhook signing key: %w", err)
+		}
+
+		authType := in.SigningSecret.Type
+		if authType == "" {
+			authType = "Bearer"
+		}
+
+		if out.HTTPConfig == nil {
+			out.HTTPConfig = &httpClientConfig{}
+		}
+
+		out.HTTPConfig.Authorization = &authorization{
+			Type:        authType,
+			Credentials: key,
+		}
+	}
+
 	if in.MaxAlerts > 0 {
 		out.MaxAlerts = in.MaxAlerts
 	}
@@ -3667,6 +3667,54 @@ func TestGenerateConfig(t *testing.T) {
 			},
 			golden: "CR_with_WebhookConfig_with_Timeout_Setup_Older_Version.golden",
 		},
+		{
+			name:      "CR with WebhookConfig with Signing Secret",
+			amVersion: &version28,
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "webhook-signing-key",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"key": []byte("0123456789abcdef"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "test",
+								WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+									{
+										URL: ptr.To("https://example.com/"),
+										SigningSecret: &monitoringv1alpha1.WebhookSigningSecret{
+											Name: "webhook-signing-key",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			golden: "CR_with_WebhookConfig_with_Signing_Secret.golden",
+		},
 	}
 
 	logger := newNopLogger(t)
@@ -6034,6 +6082,87 @@ func TestConvertHTTPConfig(t *testing.T) {
 	}
 }
 
+func TestApplyResolveTimeout(t *testing.T) {
+	bounds := &monitoringv1.DurationBounds{Min: "1m", Max: "10m"}
+
+	for _, tc := range []struct {
+		name           string
+		bounds         *monitoringv1.DurationBounds
+		resolveTimeout *monitoringv1.Duration
+		groupInterval  string
+		expected       string
+		expectErr      bool
+	}{
+		{
+			name:           "no bounds",
+			resolveTimeout: ptr.To(monitoringv1.Duration("5m")),
+		},
+		{
+			name:   "no resolve timeout",
+			bounds: bounds,
+		},
+		{
+			name:           "within bounds",
+			bounds:         bounds,
+			resolveTimeout: ptr.To(monitoringv1.Duration("5m")),
+			expected:       "5m",
+		},
+		{
+			name:           "below min",
+			bounds:         bounds,
+			resolveTimeout: ptr.To(monitoringv1.Duration("30s")),
+			expected:       "1m",
+		},
+		{
+			name:           "above max",
+			bounds:         bounds,
+			resolveTimeout: ptr.To(monitoringv1.Duration("1h")),
+			expected:       "10m",
+		},
+		{
+			name:           "group interval already defined",
+			bounds:         bounds,
+			resolveTimeout: ptr.To(monitoringv1.Duration("5m")),
+			groupInterval:  "2m",
+			expected:       "2m",
+		},
+		{
+			name:           "invalid bounds",
+			bounds:         &monitoringv1.DurationBounds{Min: "10m", Max: "1m"},
+			resolveTimeout: ptr.To(monitoringv1.Duration("5m")),
+			expectErr:      true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cb := NewConfigBuilder(
+				newNopLogger(t),
+				semver.Version{Major: 0, Minor: 28},
+				nil,
+				&monitoringv1.Alertmanager{
+					Spec: monitoringv1.AlertmanagerSpec{
+						AlertmanagerConfigResolveTimeoutBounds: tc.bounds,
+					},
+				},
+			)
+
+			r := &route{Receiver: "test", GroupInterval: tc.groupInterval}
+			err := cb.applyResolveTimeout(r, &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "amc", Namespace: "ns"},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					ResolveTimeout: tc.resolveTimeout,
+				},
+			})
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, r.GroupInterval)
+		})
+	}
+}
+
 func newNopLogger(t *testing.T) *slog.Logger {
 	t.Helper()
 	return slog.New(slog.DiscardHandler)
@@ -54,6 +54,11 @@ type Config struct {
 	secretName          string
 }
 
+// SecretName returns the name of the Secret storing the cluster TLS config.
+func SecretName(a *monitoringv1.Alertmanager) string {
+	return fmt.Sprintf("alertmanager-%s-cluster-tls-config", a.Name)
+}
+
 // New creates a new ClusterTLSConfig.
 // All volumes related to the cluster TLS config will be mounted via the `mountingDir`.
 // The Secret where the cluster TLS config will be stored will be named `secretName`.
@@ -61,7 +66,7 @@ type Config struct {
 // or "cluster-tls-client-config-" respectively, for server and client credentials.
 func New(mountingDir string, a *monitoringv1.Alertmanager) (*Config, error) {
 	clusterTLSConfig := a.Spec.ClusterTLS
-	secretName := fmt.Sprintf("alertmanager-%s-cluster-tls-config", a.Name)
+	secretName := SecretName(a)
 
 	if clusterTLSConfig == nil {
 		return &Config{
@@ -88,7 +93,8 @@ func New(mountingDir string, a *monitoringv1.Alertmanager) (*Config, error) {
 		return nil, errors.New("invalid client TLS configuration: certificate is required")
 	}
 
-	serverTLSCreds = webconfig.NewTLSReferences(path.Join(mountingDir, serverTLSCredDir), serverTLSConfig.KeySecret, serverTLSConfig.Cert, serverTLSConfig.ClientCA)
+	serverCert, serverKeySecret := serverTLSConfig.CertAndKeySecret()
+	serverTLSCreds = webconfig.NewTLSReferences(path.Join(mountingDir, serverTLSCredDir), serverKeySecret, serverCert, serverTLSConfig.ClientCA)
 	clientTLSCreds = webconfig.NewTLSReferences(path.Join(mountingDir, clientTLSCredDir), *clientTLSConfig.KeySecret, clientTLSConfig.Cert, clientTLSConfig.CA)
 
 	return &Config{
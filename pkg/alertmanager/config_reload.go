@@ -0,0 +1,87 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// pollConfigReload refreshes regularly the status of the configuration
+// reloads triggered by the config-reloader sidecars of the Alertmanager pods.
+func (c *Operator) pollConfigReload(ctx context.Context) {
+	ticker := time.NewTicker(operator.ConfigReloadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.refreshConfigReload(ctx)
+		}
+	}
+}
+
+// refreshConfigReload collects concurrently the reload status from the ready
+// pods of all the Alertmanager objects. Only the objects whose condition
+// changed are enqueued for a status update.
+func (c *Operator) refreshConfigReload(ctx context.Context) {
+	var (
+		objects = map[string]*monitoringv1.Alertmanager{}
+		pods    = map[string][]v1.Pod{}
+	)
+	_ = c.alrtInfs.ListAll(labels.Everything(), func(o interface{}) {
+		am := o.(*monitoringv1.Alertmanager)
+		key := am.Namespace + "/" + am.Name
+
+		// The config-reloader's web server isn't reachable when
+		// listenLocal is true and it uses the same TLS configuration as
+		// Alertmanager.
+		if am.Spec.ListenLocal || (am.Spec.Web != nil && am.Spec.Web.TLSConfig != nil) {
+			if c.configReload.Forget(key) {
+				c.rr.EnqueueForStatus(am)
+			}
+			return
+		}
+
+		objects[key] = am
+		pods[key] = []v1.Pod{}
+		if err := c.podInfs.ListAllByNamespace(am.Namespace, labels.SelectorFromSet(makeSelectorLabels(am.Name)), func(o interface{}) {
+			pods[key] = append(pods[key], *o.(*v1.Pod))
+		}); err != nil {
+			c.logger.Debug("failed to list pods", "key", key, "err", err)
+		}
+	})
+
+	changed := c.configReload.RefreshAll(ctx, c.logger, pods)
+
+	keys := map[string]struct{}{}
+	for key, am := range objects {
+		keys[key] = struct{}{}
+		c.configReload.ReportPersistentFailures(c.eventRecorder, am, key)
+
+		if _, found := changed[key]; found {
+			c.rr.EnqueueForStatus(am)
+		}
+	}
+	c.configReload.Retain(keys)
+}
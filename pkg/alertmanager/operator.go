@@ -20,9 +20,12 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"path"
+	"reflect"
+	"slices"
 	"strings"
-	"time"
+	"sync"
 
 	"github.com/blang/semver/v4"
 	"github.com/mitchellh/hashstructure"
@@ -40,6 +43,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/clustertlsconfig"
 	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/validation"
@@ -57,7 +61,6 @@ import (
 )
 
 const (
-	resyncPeriod   = 5 * time.Minute
 	controllerName = "alertmanager-controller"
 )
 
@@ -71,6 +74,23 @@ type Config struct {
 	AlertmanagerDefaultBaseImage string
 	Annotations                  operator.Map
 	Labels                       operator.Map
+	NativeSidecars               bool
+	GoRuntime                    operator.GoRuntimeConfig
+	TLSAssetsProjection          bool
+}
+
+func newConfig(c operator.Config) Config {
+	return Config{
+		LocalHost:                    c.LocalHost,
+		ClusterDomain:                c.ClusterDomain,
+		ReloaderConfig:               c.ReloaderConfig,
+		AlertmanagerDefaultBaseImage: c.AlertmanagerDefaultBaseImage,
+		Annotations:                  c.Annotations,
+		Labels:                       c.Labels,
+		NativeSidecars:               c.NativeSidecarsEnabled(),
+		GoRuntime:                    c.GoRuntime,
+		TLSAssetsProjection:          c.TLSAssetsProjection,
+	}
 }
 
 // Operator manages the lifecycle of the Alertmanager statefulsets and their
@@ -93,23 +113,65 @@ type Operator struct {
 	alrtCfgInfs *informers.ForResource
 	secrInfs    *informers.ForResource
 	ssetInfs    *informers.ForResource
+	podInfs     *informers.ForResource
 
 	rr *operator.ResourceReconciler
 
 	metrics         *operator.Metrics
 	reconciliations *operator.ReconciliationTracker
+	refIndex        *operator.ReferenceIndex
 
 	eventRecorder record.EventRecorder
 
 	canReadStorageClass bool
 
 	config Config
+	// Protects the config field which is updated when the operator
+	// configuration changes.
+	configMtx sync.RWMutex
+
+	// Field manager of the server-side apply requests (empty when disabled).
+	fieldManager string
 
 	configResourcesStatusEnabled bool
+
+	// Reported in the status of the reconciled resources.
+	operatorInfo *monitoringv1.OperatorInfo
+
+	// Selectors restricting the watched Secrets.
+	secretLabelSelector labels.Selector
+	secretFieldSelector fields.Selector
+
+	peers        *peerChecker
+	configReload *operator.ConfigReloadTracker
+
+	// Limits the number of StatefulSets rolled out concurrently.
+	rolloutBudget *operator.RolloutBudget
+
+	// Deletes the StatefulSets which can't be updated.
+	ssetRecreator *operator.StatefulSetRecreator
+
+	// Splits the objects between the operator replicas.
+	sharder *operator.Sharder
+
+	// Modify the generated configuration before it is written.
+	postProcessors []ConfigPostProcessor
+
+	// Last configuration generated for each Alertmanager.
+	routingTracer *RoutingTracer
 }
 
 type ControllerOption func(*Operator)
 
+// WithConfigPostProcessors tells the controller to run the post-processors
+// (in order) on the Alertmanager configuration before writing it to the
+// generated configuration Secret.
+func WithConfigPostProcessors(pps ...ConfigPostProcessor) ControllerOption {
+	return func(o *Operator) {
+		o.postProcessors = append(o.postProcessors, pps...)
+	}
+}
+
 // WithStorageClassValidation tells that the controller should verify that the
 // Prometheus spec references a valid StorageClass name.
 func WithStorageClassValidation() ControllerOption {
@@ -118,6 +180,22 @@ func WithStorageClassValidation() ControllerOption {
 	}
 }
 
+// WithRolloutBudget tells the controller to wait for a slot of the budget
+// before updating the StatefulSet.
+func WithRolloutBudget(b *operator.RolloutBudget) ControllerOption {
+	return func(o *Operator) {
+		o.rolloutBudget = b
+	}
+}
+
+// WithSharder tells the controller to reconcile only the objects assigned to
+// the operator replica.
+func WithSharder(s *operator.Sharder) ControllerOption {
+	return func(o *Operator) {
+		o.sharder = s
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -151,23 +229,23 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 
 		metrics:         operator.NewMetrics(r),
 		reconciliations: &operator.ReconciliationTracker{},
+		refIndex:        operator.NewReferenceIndex(r),
 		eventRecorder:   c.EventRecorderFactory(client, controllerName),
 
 		controllerID: c.ControllerID,
 
-		config: Config{
-			LocalHost:                    c.LocalHost,
-			ClusterDomain:                c.ClusterDomain,
-			ReloaderConfig:               c.ReloaderConfig,
-			AlertmanagerDefaultBaseImage: c.AlertmanagerDefaultBaseImage,
-			Annotations:                  c.Annotations,
-			Labels:                       c.Labels,
-		},
+		config:                       newConfig(c),
+		fieldManager:                 c.ApplyFieldManager(),
 		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
+		operatorInfo:                 c.Gates.OperatorInfo(),
+		peers:                        newPeerChecker(),
+		configReload:                 operator.NewConfigReloadTracker(),
 	}
 	for _, opt := range options {
 		opt(o)
 	}
+	o.ssetRecreator = operator.NewStatefulSetRecreator(c.StatefulSetRecreationPolicy, o.metrics, o.eventRecorder)
+	o.secretLabelSelector, o.secretFieldSelector = c.SecretWatchSelectors()
 
 	if err := o.bootstrap(ctx, c); err != nil {
 		return nil, err
@@ -181,6 +259,8 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		monitoringv1.AlertmanagersKind,
 		r,
 		o.controllerID,
+		o.sharder,
+		c.Workers.Alertmanager,
 	)
 
 	return o, nil
@@ -188,6 +268,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 
 func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error {
 	c.metrics.MustRegister(c.reconciliations)
+	c.routingTracer = NewRoutingTracer()
 
 	var err error
 	c.alrtInfs, err = informers.NewInformersForResource(
@@ -195,7 +276,7 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 			config.Namespaces.AlertmanagerAllowList,
 			config.Namespaces.DenyList,
 			c.mclient,
-			resyncPeriod,
+			config.ResyncPeriods.Alertmanager,
 			func(options *metav1.ListOptions) {
 				options.LabelSelector = config.AlertmanagerSelector.String()
 			},
@@ -217,7 +298,7 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 			config.Namespaces.AlertmanagerConfigAllowList,
 			config.Namespaces.DenyList,
 			c.mclient,
-			resyncPeriod,
+			config.ResyncPeriods.Alertmanager,
 			nil,
 		),
 		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerConfigName),
@@ -231,7 +312,7 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 			config.Namespaces.AlertmanagerConfigAllowList,
 			config.Namespaces.DenyList,
 			c.mdClient,
-			resyncPeriod,
+			config.ResyncPeriods.Alertmanager,
 			func(options *metav1.ListOptions) {
 				options.FieldSelector = config.SecretListWatchFieldSelector.String()
 				options.LabelSelector = config.SecretListWatchLabelSelector.String()
@@ -249,7 +330,7 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 			config.Namespaces.AlertmanagerAllowList,
 			config.Namespaces.DenyList,
 			c.kclient,
-			resyncPeriod,
+			config.ResyncPeriods.Alertmanager,
 			nil,
 		),
 		appsv1.SchemeGroupVersion.WithResource("statefulsets"),
@@ -258,6 +339,26 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 		return fmt.Errorf("error creating statefulset informers: %w", err)
 	}
 
+	// The pods are used to collect the status of the configuration reloads.
+	c.podInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			config.Namespaces.AlertmanagerAllowList,
+			config.Namespaces.DenyList,
+			c.kclient,
+			config.ResyncPeriods.Alertmanager,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = labels.SelectorFromSet(labels.Set{
+					"app.kubernetes.io/managed-by": "prometheus-operator",
+					"app.kubernetes.io/name":       "alertmanager",
+				}).String()
+			},
+		),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourcePods)),
+	)
+	if err != nil {
+		return fmt.Errorf("error creating pod informers: %w", err)
+	}
+
 	newNamespaceInformer := func(o *Operator, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
 		lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
 			ctx,
@@ -276,7 +377,7 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 		return cache.NewSharedIndexInformer(
 			o.metrics.NewInstrumentedListerWatcher(lw),
 			&v1.Namespace{},
-			resyncPeriod,
+			config.ResyncPeriods.Alertmanager,
 			cache.Indexers{},
 		), nil
 	}
@@ -297,8 +398,10 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 	return nil
 }
 
-// waitForCacheSync waits for the informers' caches to be synced.
-func (c *Operator) waitForCacheSync(ctx context.Context) error {
+// namedInformers returns the informers of the controller. The informers
+// which haven't been created (e.g. when the CRD isn't installed) are skipped.
+func (c *Operator) namedInformers() operator.NamedInformers {
+	var ni operator.NamedInformers
 	for _, infs := range []struct {
 		name                 string
 		informersForResource *informers.ForResource
@@ -307,23 +410,22 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"AlertmanagerConfig", c.alrtCfgInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
+		{"Pod", c.podInfs},
 	} {
-		for _, inf := range infs.informersForResource.GetInformers() {
-			if !operator.WaitForNamedCacheSync(ctx, "alertmanager", c.logger.With("informer", infs.name), inf.Informer()) {
-				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
-			}
-		}
+		ni = ni.AppendForResource(infs.name, infs.informersForResource)
 	}
 
-	for _, inf := range []struct {
-		name     string
-		informer cache.SharedIndexInformer
-	}{
-		{"AlertmanagerNamespace", c.nsAlrtInf},
-		{"AlertmanagerConfigNamespace", c.nsAlrtCfgInf},
-	} {
-		if !operator.WaitForNamedCacheSync(ctx, "alertmanager", c.logger.With("informer", inf.name), inf.informer) {
-			return fmt.Errorf("failed to sync cache for %s informer", inf.name)
+	return append(ni,
+		operator.NamedInformer{Name: "AlertmanagerNamespace", Informer: c.nsAlrtInf},
+		operator.NamedInformer{Name: "AlertmanagerConfigNamespace", Informer: c.nsAlrtCfgInf},
+	)
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (c *Operator) waitForCacheSync(ctx context.Context) error {
+	for _, inf := range c.namedInformers() {
+		if !operator.WaitForNamedCacheSync(ctx, "alertmanager", c.logger.With("informer", inf.Name), inf.Informer) {
+			return fmt.Errorf("failed to sync cache for %s informer", inf.Name)
 		}
 	}
 
@@ -331,6 +433,12 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 	return nil
 }
 
+// HealthCheck returns an error if the controller is running and either one of
+// its informers isn't synced or one of its queues is stuck.
+func (c *Operator) HealthCheck(context.Context) error {
+	return c.rr.CheckHealth(c.namedInformers())
+}
+
 // addHandlers adds the eventhandlers to the informers.
 func (c *Operator) addHandlers() {
 	c.alrtInfs.AddEventHandler(c.rr)
@@ -345,12 +453,12 @@ func (c *Operator) addHandlers() {
 		c.enqueueForNamespace,
 	))
 
-	c.secrInfs.AddEventHandler(operator.NewEventHandler(
+	c.secrInfs.AddEventHandler(operator.NewObjectEventHandler(
 		c.logger,
 		c.accessor,
 		c.metrics,
-		"Secret",
-		c.enqueueForNamespace,
+		operator.SecretKind,
+		c.enqueueForSecret,
 	))
 
 	// The controller needs to watch the namespaces in which the
@@ -363,6 +471,30 @@ func (c *Operator) addHandlers() {
 	})
 }
 
+// enqueueForSecret enqueues the Alertmanager objects affected by a change of
+// the given Secret: the objects referencing it, the objects owning it (e.g.
+// the configuration Secret) and the objects which haven't been reconciled yet.
+func (c *Operator) enqueueForSecret(ns, name string) {
+	keys := c.refIndex.Lookup(operator.SecretKind, ns, name)
+
+	err := c.alrtInfs.ListAll(labels.Everything(), func(obj interface{}) {
+		am := obj.(*monitoringv1.Alertmanager)
+		key := am.Namespace + "/" + am.Name
+
+		if slices.Contains(keys, key) ||
+			!c.refIndex.Has(key) ||
+			(am.Namespace == ns && (name == defaultConfigSecretName(am) || strings.HasPrefix(name, prefixedName(am.Name)))) {
+			c.rr.EnqueueForReconciliation(am)
+		}
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Alertmanager instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
 // enqueueForNamespace enqueues all Alertmanager object keys that belong to the
 // given namespace or select objects in the given namespace.
 func (c *Operator) enqueueForNamespace(nsName string) {
@@ -421,6 +553,7 @@ func (c *Operator) Run(ctx context.Context) error {
 	go c.alrtCfgInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
+	go c.podInfs.Start(ctx.Done())
 	go c.nsAlrtCfgInf.Run(ctx.Done())
 	if c.nsAlrtInf != c.nsAlrtCfgInf {
 		go c.nsAlrtInf.Run(ctx.Done())
@@ -439,6 +572,8 @@ func (c *Operator) Run(ctx context.Context) error {
 
 	// TODO(simonpasquier): watch for Alertmanager pods instead of polling.
 	go operator.StatusPoller(ctx, c)
+	go c.checkPeers(ctx)
+	go c.pollConfigReload(ctx)
 
 	c.metrics.Ready().Set(1)
 	<-ctx.Done()
@@ -517,6 +652,8 @@ func (c *Operator) Sync(ctx context.Context, key string) error {
 }
 
 func (c *Operator) sync(ctx context.Context, key string) error {
+	config := c.currentConfig()
+
 	am, err := operator.GetObjectFromKey[*monitoringv1.Alertmanager](c.alrtInfs, key)
 	if err != nil {
 		return err
@@ -524,6 +661,9 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if am == nil {
 		c.reconciliations.ForgetObject(key)
+		c.refIndex.Forget(key)
+		c.routingTracer.Delete(key)
+		c.rolloutBudget.Forget(rolloutOwner(key))
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
@@ -533,7 +673,8 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
-	if am.Spec.Paused {
+	if operator.IsPaused(am, am.Spec.Paused) {
+		c.logger.Info("the resource is paused, not reconciling", "key", key)
 		return nil
 	}
 
@@ -542,21 +683,37 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	logger.Info("sync alertmanager")
 
+	if ptr.Deref(am.Spec.AdditionalPeersHealthCheck, false) {
+		am.Spec.AdditionalPeers = c.peers.activePeers(am.Spec.AdditionalPeers)
+	}
+
 	if err := operator.CheckStorageClass(ctx, c.canReadStorageClass, c.kclient, am.Spec.Storage); err != nil {
 		return err
 	}
 
 	assetStore := assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
+	if config.TLSAssetsProjection {
+		assetStore.EnableTLSAssetsProjection(am.Namespace)
+	}
+	// Index the Secrets referenced by the object even when the reconciliation
+	// fails so that the object gets enqueued again when they change.
+	defer func() {
+		secrets, configMaps := assetStore.References()
+		c.refIndex.Set(key, secrets, configMaps)
+	}()
 
 	if err := c.provisionAlertmanagerConfiguration(ctx, am, assetStore); err != nil {
+		c.eventRecorder.Eventf(am, v1.EventTypeWarning, operator.ConfigGenerationFailedEvent, "Failed to generate the configuration: %v", err)
 		return fmt.Errorf("provision alertmanager configuration: %w", err)
 	}
 
-	tlsShardedSecret, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, c.newTLSAssetSecret(am))
+	tlsShardedSecret, err := operator.ReconcileTLSAssets(ctx, assetStore, c.kclient, c.newTLSAssetSecret(am), c.fieldManager)
 	if err != nil {
 		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
 
+	operator.ReportUnwatchedSecrets(c.reconciliations, c.eventRecorder, am, key, assetStore.UnwatchedSecrets(c.secretLabelSelector, c.secretFieldSelector))
+
 	if err := c.createOrUpdateWebConfigSecret(ctx, am); err != nil {
 		return fmt.Errorf("failed to synchronize the web config secret: %w", err)
 	}
@@ -568,6 +725,20 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return fmt.Errorf("failed to synchronize the cluster TLS config secret: %w", err)
 	}
 
+	children := k8sutil.ExpectedChildren{}
+	children.Add(
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourceSecrets)),
+		append(
+			tlsShardedSecret.SecretNames(),
+			generatedConfigSecretName(am.Name),
+			webConfigSecretName(am.Name),
+			clustertlsconfig.SecretName(am),
+		)...,
+	)
+	if err := operator.PruneChildren(ctx, logger, c.mdClient, am, children); err != nil {
+		return fmt.Errorf("failed to prune obsolete objects: %w", err)
+	}
+
 	svcClient := c.kclient.CoreV1().Services(am.Namespace)
 	if am.Spec.ServiceName != nil {
 		selectorLabels := makeSelectorLabels(am.Name)
@@ -576,7 +747,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		}
 	} else {
 		// Create governing service if it doesn't exist.
-		if _, err = k8sutil.CreateOrUpdateService(ctx, svcClient, makeStatefulSetService(am, c.config)); err != nil {
+		if _, err = k8sutil.CreateOrUpdateService(ctx, svcClient, makeStatefulSetService(am, config), c.fieldManager); err != nil {
 			return fmt.Errorf("synchronizing governing service failed: %w", err)
 		}
 	}
@@ -596,19 +767,23 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
-	newSSetInputHash, err := createSSetInputHash(*am, c.config, tlsShardedSecret, existingStatefulSet.Spec)
+	newSSetInputHash, err := createSSetInputHash(*am, config, tlsShardedSecret, existingStatefulSet.Spec)
 	if err != nil {
 		return err
 	}
 
-	sset, err := makeStatefulSet(logger, am, c.config, newSSetInputHash, tlsShardedSecret)
+	sset, err := makeStatefulSet(logger, am, config, newSSetInputHash, tlsShardedSecret)
 	if err != nil {
-		return fmt.Errorf("failed to generate statefulset: %w", err)
+		return k8sutil.NewInvalidSpecError(fmt.Errorf("failed to generate statefulset: %w", err))
 	}
 	operator.SanitizeSTS(sset)
 
+	c.reconciliations.SetWarning(key, operator.RolloutPendingReason, "")
 	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationName] {
 		logger.Debug("new statefulset generation inputs match current, skipping any actions")
+		if operator.StatefulSetRolledOut(existingStatefulSet) {
+			c.rolloutBudget.Release(rolloutOwner(key), sset.Name)
+		}
 		return nil
 	}
 
@@ -622,23 +797,20 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
-	err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
-	sErr, ok := err.(*apierrors.StatusError)
-
-	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
-		c.metrics.StsDeleteCreateCounter().Inc()
+	if ok, position := c.rolloutBudget.Acquire(rolloutOwner(key), sset.Name); !ok {
+		logger.Debug("postponing the update of the statefulset until the rollout budget allows it", "position", position)
+		c.reconciliations.SetWarning(key, operator.RolloutPendingReason, c.rolloutBudget.RolloutPendingMessage(sset.Name, position))
+		c.rr.EnqueueForReconciliationAfter(am, operator.RolloutBudgetRetryInterval)
+		return nil
+	}
 
-		// Gather only reason for failed update
-		failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
-		for i, cause := range sErr.ErrStatus.Details.Causes {
-			failMsg[i] = cause.Message
-		}
+	err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset, c.fieldManager)
+	recreated, rErr := c.ssetRecreator.Recreate(ctx, logger, ssetClient, am, existingStatefulSet, sset, err)
+	if rErr != nil {
+		return rErr
+	}
 
-		logger.Info("recreating Alertmanager StatefulSet because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
-		propagationPolicy := metav1.DeletePropagationForeground
-		if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
-			return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
-		}
+	if recreated {
 		return nil
 	}
 
@@ -706,9 +878,22 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 
 	a.Status.Selector = selector.String()
 	availableCondition := stsReporter.Update(a)
+	a.Status.Rollout = stsReporter.RolloutStatus()
 	reconciledCondition := c.reconciliations.GetCondition(key, a.Generation)
-	a.Status.Conditions = operator.UpdateConditions(a.Status.Conditions, availableCondition, reconciledCondition)
-	a.Status.Paused = a.Spec.Paused
+	paused := operator.IsPaused(a, a.Spec.Paused)
+	a.Status.Conditions = operator.UpdateConditions(
+		a.Status.Conditions,
+		append(
+			[]monitoringv1.Condition{availableCondition, reconciledCondition, operator.PausedCondition(paused, a.Generation)},
+			c.configReload.Conditions(key, a.Generation)...,
+		)...,
+	)
+	a.Status.Paused = paused
+	a.Status.OperatorInfo = c.operatorInfo
+
+	if ptr.Deref(a.Spec.AdditionalPeersHealthCheck, false) {
+		a.Status.UnreachableAdditionalPeers = c.peers.unreachablePeers(a.Spec.AdditionalPeers)
+	}
 
 	if _, err = c.mclient.MonitoringV1().Alertmanagers(a.Namespace).ApplyStatus(ctx, ApplyConfigurationFromAlertmanager(a, true), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
 		c.logger.Info("failed to apply alertmanager status subresource, trying again without scale fields", "err", err)
@@ -832,6 +1017,11 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 			return fmt.Errorf("failed to retrieve configuration from secret: %w", err)
 		}
 
+		amRawConfiguration, err = c.postProcessConfig(ctx, am, amRawConfiguration)
+		if err != nil {
+			return fmt.Errorf("failed to post-process the configuration: %w", err)
+		}
+
 		err = c.createOrUpdateGeneratedConfigSecret(ctx, am, amRawConfiguration, additionalData)
 		if err != nil {
 			return fmt.Errorf("create or update generated config secret failed: %w", err)
@@ -845,6 +1035,12 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 		return fmt.Errorf("failed to select AlertmanagerConfig objects: %w", err)
 	}
 
+	if am.Spec.AlertmanagerConfigResolveTimeoutBounds != nil {
+		if _, _, err := parseDurationBounds(am.Spec.AlertmanagerConfigResolveTimeoutBounds); err != nil {
+			return k8sutil.NewInvalidSpecError(fmt.Errorf("invalid alertmanagerConfigResolveTimeoutBounds: %w", err))
+		}
+	}
+
 	var (
 		additionalData map[string][]byte
 		cfgBuilder     = NewConfigBuilder(namespacedLogger, version, store, am)
@@ -860,7 +1056,7 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 
 		err = cfgBuilder.initializeFromAlertmanagerConfig(ctx, am.Spec.AlertmanagerConfiguration.Global, globalAmConfig)
 		if err != nil {
-			return fmt.Errorf("failed to initialize from global AlertmangerConfig: %w", err)
+			return k8sutil.NewInvalidSpecError(fmt.Errorf("failed to initialize from global AlertmangerConfig: %w", err))
 		}
 
 		for _, v := range am.Spec.AlertmanagerConfiguration.Templates {
@@ -885,12 +1081,12 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 
 		err = cfgBuilder.InitializeFromRawConfiguration(amRawConfiguration)
 		if err != nil {
-			return fmt.Errorf("failed to initialize from secret: %w", err)
+			return k8sutil.NewInvalidSpecError(fmt.Errorf("failed to initialize from secret: %w", err))
 		}
 	}
 
 	if err := cfgBuilder.AddAlertmanagerConfigs(ctx, amConfigs); err != nil {
-		return fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
+		return k8sutil.NewInvalidSpecError(fmt.Errorf("failed to generate Alertmanager configuration: %w", err))
 	}
 
 	generatedConfig, err := cfgBuilder.MarshalJSON()
@@ -898,6 +1094,11 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 		return fmt.Errorf("failed to marshal configuration: %w", err)
 	}
 
+	generatedConfig, err = c.postProcessConfig(ctx, am, generatedConfig)
+	if err != nil {
+		return fmt.Errorf("failed to post-process the configuration: %w", err)
+	}
+
 	err = c.createOrUpdateGeneratedConfigSecret(ctx, am, generatedConfig, additionalData)
 	if err != nil {
 		return fmt.Errorf("failed to create or update the generated configuration secret: %w", err)
@@ -907,14 +1108,16 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 }
 
 func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte, additionalData map[string][]byte) error {
+	config := c.currentConfig()
+
 	generatedConfigSecret := &v1.Secret{
 		Data: map[string][]byte{},
 	}
 
 	operator.UpdateObject(
 		generatedConfigSecret,
-		operator.WithLabels(c.config.Labels),
-		operator.WithAnnotations(c.config.Annotations),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
 		operator.WithManagingOwner(am),
 		operator.WithName(generatedConfigSecretName(am.Name)),
 	)
@@ -929,12 +1132,19 @@ func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *
 	}
 	generatedConfigSecret.Data[alertmanagerConfigFileCompressed] = buf.Bytes()
 
+	if err := operator.CheckSecretSize(generatedConfigSecret); err != nil {
+		c.eventRecorder.Event(am, v1.EventTypeWarning, operator.SecretTooLargeEvent, err.Error())
+		return err
+	}
+
 	sClient := c.kclient.CoreV1().Secrets(am.Namespace)
-	err := k8sutil.CreateOrUpdateSecret(ctx, sClient, generatedConfigSecret)
+	err := k8sutil.CreateOrUpdateSecret(ctx, sClient, generatedConfigSecret, c.fieldManager)
 	if err != nil {
 		return fmt.Errorf("failed to update generated config secret: %w", err)
 	}
 
+	c.routingTracer.Set(am.Namespace+"/"+am.Name, conf)
+
 	return nil
 }
 
@@ -994,7 +1204,12 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 	res := make(map[string]*monitoringv1alpha1.AlertmanagerConfig, len(amConfigs))
 
 	for namespaceAndName, amc := range amConfigs {
-		if err := checkAlertmanagerConfigResource(ctx, amc, amVersion, store); err != nil {
+		err := c.provisionWebhookSigningSecrets(ctx, amc)
+		if err == nil {
+			err = checkAlertmanagerConfigResource(ctx, amc, amVersion, store)
+		}
+
+		if err != nil {
 			rejected++
 			c.logger.Warn(
 				"skipping alertmanagerconfig",
@@ -1346,6 +1561,19 @@ func checkWebhookConfigs(
 			}
 		}
 
+		if config.SigningSecret != nil {
+			if !amVersion.GTE(semver.MustParse("0.22.0")) {
+				return fmt.Errorf(
+					"'signingSecret' config set in 'webhookConfig' but supported in Alertmanager >= 0.22.0 only - current %s",
+					amVersion.String(),
+				)
+			}
+
+			if _, err := store.GetSecretKey(ctx, namespace, webhookSigningKeySelector(config.SigningSecret)); err != nil {
+				return err
+			}
+		}
+
 		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
 			return err
 		}
@@ -1689,14 +1917,16 @@ func configureHTTPConfigInStore(ctx context.Context, httpConfig *monitoringv1alp
 }
 
 func (c *Operator) newTLSAssetSecret(am *monitoringv1.Alertmanager) *v1.Secret {
+	config := c.currentConfig()
+
 	s := &v1.Secret{
 		Data: make(map[string][]byte),
 	}
 
 	operator.UpdateObject(
 		s,
-		operator.WithLabels(c.config.Labels),
-		operator.WithAnnotations(c.config.Annotations),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
 		operator.WithManagingOwner(am),
 		operator.WithName(fmt.Sprintf("%s-tls-assets", prefixedName(am.Name))),
 		operator.WithNamespace(am.Namespace),
@@ -1706,6 +1936,8 @@ func (c *Operator) newTLSAssetSecret(am *monitoringv1.Alertmanager) *v1.Secret {
 }
 
 func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, a *monitoringv1.Alertmanager) error {
+	config := c.currentConfig()
+
 	var fields monitoringv1.WebConfigFileFields
 	if a.Spec.Web != nil {
 		fields = a.Spec.Web.WebConfigFileFields
@@ -1723,12 +1955,12 @@ func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, a *monitor
 	s := &v1.Secret{}
 	operator.UpdateObject(
 		s,
-		operator.WithLabels(c.config.Labels),
-		operator.WithAnnotations(c.config.Annotations),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
 		operator.WithManagingOwner(a),
 	)
 
-	if err := webConfig.CreateOrUpdateWebConfigSecret(ctx, c.kclient.CoreV1().Secrets(a.Namespace), s); err != nil {
+	if err := webConfig.CreateOrUpdateWebConfigSecret(ctx, c.kclient.CoreV1().Secrets(a.Namespace), s, c.fieldManager); err != nil {
 		return fmt.Errorf("failed to reconcile web config secret: %w", err)
 	}
 
@@ -1736,6 +1968,8 @@ func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, a *monitor
 }
 
 func (c *Operator) createOrUpdateClusterTLSConfigSecret(ctx context.Context, a *monitoringv1.Alertmanager) error {
+	config := c.currentConfig()
+
 	clusterTLSConfig, err := clustertlsconfig.New(clusterTLSConfigDir, a)
 	if err != nil {
 		return fmt.Errorf("failed to initialize the configuration: %w", err)
@@ -1756,12 +1990,12 @@ func (c *Operator) createOrUpdateClusterTLSConfigSecret(ctx context.Context, a *
 	}
 	operator.UpdateObject(
 		s,
-		operator.WithLabels(c.config.Labels),
-		operator.WithAnnotations(c.config.Annotations),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
 		operator.WithManagingOwner(a),
 	)
 
-	if err = k8sutil.CreateOrUpdateSecret(ctx, c.kclient.CoreV1().Secrets(a.Namespace), s); err != nil {
+	if err = k8sutil.CreateOrUpdateSecret(ctx, c.kclient.CoreV1().Secrets(a.Namespace), s, c.fieldManager); err != nil {
 		return fmt.Errorf("failed to reconcile secret: %w", err)
 	}
 
@@ -1817,5 +2051,55 @@ func ApplyConfigurationFromAlertmanager(a *monitoringv1.Alertmanager, updateScal
 		)
 	}
 
+	if a.Status.Rollout != nil {
+		asac.WithRollout(operator.RolloutStatusApplyConfiguration(a.Status.Rollout))
+	}
+
+	if len(a.Status.UnreachableAdditionalPeers) > 0 {
+		asac.WithUnreachableAdditionalPeers(a.Status.UnreachableAdditionalPeers...)
+	}
+
+	if oi := a.Status.OperatorInfo; oi != nil {
+		asac.WithOperatorInfo(monitoringv1ac.OperatorInfo().WithVersion(oi.Version).WithEnabledFeatureGates(oi.EnabledFeatureGates...))
+	}
+
 	return monitoringv1ac.Alertmanager(a.Name, a.Namespace).WithStatus(asac)
 }
+
+// rolloutOwner returns the identifier of the Alertmanager object in the rollout
+// budget.
+func rolloutOwner(key string) string {
+	return monitoringv1.AlertmanagersKind + "/" + key
+}
+
+// RoutingTraceHandler returns the HTTP handler evaluating the routing of
+// synthetic alerts against the generated configurations (see
+// RoutingTracePattern).
+func (c *Operator) RoutingTraceHandler() http.Handler {
+	return c.routingTracer
+}
+
+func (c *Operator) currentConfig() Config {
+	c.configMtx.RLock()
+	defer c.configMtx.RUnlock()
+
+	return c.config
+}
+
+// UpdateConfig applies the operator's configuration and reconciles all the
+// Alertmanager objects when the parameters of the controller have changed.
+func (c *Operator) UpdateConfig(cfg operator.Config) {
+	config := newConfig(cfg)
+
+	c.configMtx.Lock()
+	changed := !reflect.DeepEqual(c.config, config)
+	c.config = config
+	c.configMtx.Unlock()
+
+	if !changed {
+		return
+	}
+
+	c.logger.Info("operator configuration changed, reconciling all the objects")
+	c.rr.EnqueueAll()
+}
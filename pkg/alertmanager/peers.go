@@ -0,0 +1,137 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	peersCheckInterval = 30 * time.Second
+	peersCheckTimeout  = 5 * time.Second
+)
+
+// peerChecker checks the reachability of the additional peers.
+type peerChecker struct {
+	mtx sync.Mutex
+	// Result of the last check, indexed by peer address.
+	reachable map[string]bool
+
+	dial func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+func newPeerChecker() *peerChecker {
+	d := &net.Dialer{Timeout: peersCheckTimeout}
+	return &peerChecker{
+		reachable: map[string]bool{},
+		dial:      d.DialContext,
+	}
+}
+
+func (pc *peerChecker) check(ctx context.Context, peer string) bool {
+	ctx, cancel := context.WithTimeout(ctx, peersCheckTimeout)
+	defer cancel()
+
+	conn, err := pc.dial(ctx, "tcp", peer)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+
+	return true
+}
+
+// update checks the reachability of the given peers. It returns true if the
+// list of active peers has changed.
+func (pc *peerChecker) update(ctx context.Context, peers []string) bool {
+	before := pc.activePeers(peers)
+
+	results := make(map[string]bool, len(peers))
+	for _, peer := range peers {
+		results[peer] = pc.check(ctx, peer)
+	}
+
+	pc.mtx.Lock()
+	for peer, ok := range results {
+		pc.reachable[peer] = ok
+	}
+	pc.mtx.Unlock()
+
+	return !slices.Equal(before, pc.activePeers(peers))
+}
+
+// unreachablePeers returns the peers which failed the last check.
+func (pc *peerChecker) unreachablePeers(peers []string) []string {
+	pc.mtx.Lock()
+	defer pc.mtx.Unlock()
+
+	var ret []string
+	for _, peer := range peers {
+		if ok, found := pc.reachable[peer]; found && !ok {
+			ret = append(ret, peer)
+		}
+	}
+
+	return ret
+}
+
+// activePeers returns the peers which should be configured. Peers which
+// haven't been checked yet are considered reachable. If none of the peers is
+// reachable, all the peers are returned.
+func (pc *peerChecker) activePeers(peers []string) []string {
+	unreachable := pc.unreachablePeers(peers)
+	if len(unreachable) == len(peers) {
+		return peers
+	}
+
+	return slices.DeleteFunc(slices.Clone(peers), func(peer string) bool {
+		return slices.Contains(unreachable, peer)
+	})
+}
+
+// checkPeers checks regularly the additional peers of the Alertmanager
+// objects which define `spec.additionalPeersHealthCheck`.
+func (c *Operator) checkPeers(ctx context.Context) {
+	ticker := time.NewTicker(peersCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = c.alrtInfs.ListAll(labels.Everything(), func(o interface{}) {
+				am := o.(*monitoringv1.Alertmanager)
+				if !ptr.Deref(am.Spec.AdditionalPeersHealthCheck, false) || len(am.Spec.AdditionalPeers) == 0 {
+					return
+				}
+
+				if c.peers.update(ctx, am.Spec.AdditionalPeers) {
+					c.logger.Info("additional peers changed", "key", am.Namespace+"/"+am.Name, "unreachable", c.peers.unreachablePeers(am.Spec.AdditionalPeers))
+					c.rr.EnqueueForReconciliation(am)
+				}
+			})
+		}
+	}
+}
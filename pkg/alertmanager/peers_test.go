@@ -0,0 +1,69 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerChecker(t *testing.T) {
+	reachable := map[string]bool{}
+	pc := newPeerChecker()
+	pc.dial = func(_ context.Context, _, address string) (net.Conn, error) {
+		if !reachable[address] {
+			return nil, errors.New("connection refused")
+		}
+
+		c1, c2 := net.Pipe()
+		c2.Close()
+		return c1, nil
+	}
+
+	peers := []string{"am-0.eu:9094", "am-0.us:9094"}
+
+	// Before the first check, all the peers are active.
+	require.Equal(t, peers, pc.activePeers(peers))
+	require.Empty(t, pc.unreachablePeers(peers))
+
+	reachable["am-0.eu:9094"] = true
+	reachable["am-0.us:9094"] = true
+	require.False(t, pc.update(context.Background(), peers))
+	require.Equal(t, peers, pc.activePeers(peers))
+
+	// One region disappears.
+	reachable["am-0.us:9094"] = false
+	require.True(t, pc.update(context.Background(), peers))
+	require.Equal(t, []string{"am-0.eu:9094"}, pc.activePeers(peers))
+	require.Equal(t, []string{"am-0.us:9094"}, pc.unreachablePeers(peers))
+	require.False(t, pc.update(context.Background(), peers))
+
+	// None of the peers is reachable.
+	reachable["am-0.eu:9094"] = false
+	require.True(t, pc.update(context.Background(), peers))
+	require.Equal(t, peers, pc.activePeers(peers))
+	require.Equal(t, peers, pc.unreachablePeers(peers))
+
+	// All the regions are back.
+	reachable["am-0.eu:9094"] = true
+	reachable["am-0.us:9094"] = true
+	require.False(t, pc.update(context.Background(), peers))
+	require.Equal(t, peers, pc.activePeers(peers))
+	require.Empty(t, pc.unreachablePeers(peers))
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/prometheus/alertmanager/config"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+)
+
+// ConfigPostProcessor modifies the Alertmanager configuration generated by
+// the operator before it is written to the generated configuration Secret
+// (e.g. to inject mandatory receivers or to remove disallowed integrations).
+type ConfigPostProcessor interface {
+	// Process receives the configuration in YAML format and returns the
+	// modified configuration.
+	Process(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte) ([]byte, error)
+}
+
+// ConfigPostProcessorFunc is an adapter to use ordinary functions as
+// ConfigPostProcessor.
+type ConfigPostProcessorFunc func(context.Context, *monitoringv1.Alertmanager, []byte) ([]byte, error)
+
+// Process implements the ConfigPostProcessor interface.
+func (f ConfigPostProcessorFunc) Process(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte) ([]byte, error) {
+	return f(ctx, am, conf)
+}
+
+// ConfigPostProcessorRequest is the payload sent by the webhook
+// post-processor.
+type ConfigPostProcessorRequest struct {
+	// Namespace of the Alertmanager resource.
+	Namespace string `json:"namespace"`
+	// Name of the Alertmanager resource.
+	Name string `json:"name"`
+	// Configuration generated by the operator (YAML).
+	Config string `json:"config"`
+}
+
+// ConfigPostProcessorResponse is the payload expected from the webhook
+// post-processor.
+type ConfigPostProcessorResponse struct {
+	// Modified configuration (YAML).
+	Config string `json:"config"`
+}
+
+type webhookPostProcessor struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookConfigPostProcessor returns a ConfigPostProcessor which sends the
+// configuration to an external HTTP endpoint. The endpoint receives a
+// ConfigPostProcessorRequest (POST method) and it must reply with a 200
+// status code and a ConfigPostProcessorResponse.
+func NewWebhookConfigPostProcessor(url string, client *http.Client) ConfigPostProcessor {
+	return &webhookPostProcessor{
+		url:    url,
+		client: client,
+	}
+}
+
+// Process implements the ConfigPostProcessor interface.
+func (w *webhookPostProcessor) Process(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte) ([]byte, error) {
+	b, err := json.Marshal(ConfigPostProcessorRequest{
+		Namespace: am.Namespace,
+		Name:      am.Name,
+		Config:    string(conf),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("unexpected status %q: %s", resp.Status, string(msg))
+	}
+
+	var res ConfigPostProcessorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("failed to decode the response: %w", err)
+	}
+
+	return []byte(res.Config), nil
+}
+
+// postProcessConfig runs the post-processors on the configuration. The
+// resulting configuration must be valid.
+func (c *Operator) postProcessConfig(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte) ([]byte, error) {
+	if len(c.postProcessors) == 0 {
+		return conf, nil
+	}
+
+	for i, pp := range c.postProcessors {
+		var err error
+		conf, err = pp.Process(ctx, am, conf)
+		if err != nil {
+			return nil, fmt.Errorf("post-processor %d failed: %w", i, err)
+		}
+	}
+
+	if _, err := config.Load(string(conf)); err != nil {
+		return nil, k8sutil.NewInvalidSpecError(fmt.Errorf("invalid post-processed configuration: %w", err))
+	}
+
+	return conf, nil
+}
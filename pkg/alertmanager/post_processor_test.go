@@ -0,0 +1,121 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const postProcessorInputConfig = `route:
+  receiver: "null"
+receivers:
+- name: "null"
+`
+
+func TestWebhookConfigPostProcessor(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ConfigPostProcessorRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if req.Name == "broken" {
+			http.Error(w, "policy violation", http.StatusUnprocessableEntity)
+			return
+		}
+
+		require.Equal(t, "default", req.Namespace)
+		require.Equal(t, postProcessorInputConfig, req.Config)
+		_ = json.NewEncoder(w).Encode(ConfigPostProcessorResponse{
+			Config: req.Config + "- name: audit\n",
+		})
+	}))
+	defer srv.Close()
+
+	pp := NewWebhookConfigPostProcessor(srv.URL, srv.Client())
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+	}
+
+	b, err := pp.Process(context.Background(), am, []byte(postProcessorInputConfig))
+	require.NoError(t, err)
+	require.Equal(t, postProcessorInputConfig+"- name: audit\n", string(b))
+
+	am.Name = "broken"
+	_, err = pp.Process(context.Background(), am, []byte(postProcessorInputConfig))
+	require.ErrorContains(t, err, "policy violation")
+}
+
+func TestPostProcessConfig(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+	}
+	appendReceiver := func(name string) ConfigPostProcessor {
+		return ConfigPostProcessorFunc(func(_ context.Context, _ *monitoringv1.Alertmanager, b []byte) ([]byte, error) {
+			return append(b, []byte("- name: "+name+"\n")...), nil
+		})
+	}
+
+	for _, tc := range []struct {
+		name           string
+		postProcessors []ConfigPostProcessor
+		expected       string
+		err            bool
+	}{
+		{
+			name:     "no post-processor",
+			expected: postProcessorInputConfig,
+		},
+		{
+			name:           "post-processors run in order",
+			postProcessors: []ConfigPostProcessor{appendReceiver("first"), appendReceiver("second")},
+			expected:       postProcessorInputConfig + "- name: first\n- name: second\n",
+		},
+		{
+			name:           "invalid configuration",
+			postProcessors: []ConfigPostProcessor{appendReceiver("first"), appendReceiver("first")},
+			err:            true,
+		},
+		{
+			name: "post-processor error",
+			postProcessors: []ConfigPostProcessor{
+				ConfigPostProcessorFunc(func(context.Context, *monitoringv1.Alertmanager, []byte) ([]byte, error) {
+					return nil, http.ErrHandlerTimeout
+				}),
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Operator{postProcessors: tc.postProcessors}
+
+			b, err := o.postProcessConfig(context.Background(), am, []byte(postProcessorInputConfig))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(b))
+		})
+	}
+}
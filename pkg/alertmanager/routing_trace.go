@@ -0,0 +1,397 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/timeinterval"
+	"github.com/prometheus/common/model"
+)
+
+// RoutingTracePattern is the HTTP route pattern of the RoutingTracer
+// handler.
+const RoutingTracePattern = "POST /api/v1/alertmanagers/{namespace}/{name}/routing-trace"
+
+// RoutingTraceRequest is the payload of the routing trace requests. It
+// describes a synthetic alert.
+type RoutingTraceRequest struct {
+	// Labels of the alert.
+	Labels map[string]string `json:"labels"`
+	// Annotations of the alert. They don't influence the routing.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Time at which the time intervals are evaluated (default: now).
+	Time *time.Time `json:"time,omitempty"`
+	// AlertmanagerConfig ("<namespace>/<name>") whose routes are tested.
+	// When defined, the `namespace` label is set to the namespace of the
+	// AlertmanagerConfig unless the alert already has it.
+	AlertmanagerConfig string `json:"alertmanagerConfig,omitempty"`
+}
+
+// RoutingTraceResponse is the result of a routing trace.
+type RoutingTraceResponse struct {
+	// Labels of the alert used for the routing.
+	Labels map[string]string `json:"labels"`
+	// Time at which the time intervals have been evaluated.
+	Time time.Time `json:"time"`
+	// Routes evaluated for the alert in depth-first order.
+	Routes []RouteTrace `json:"routes"`
+	// Receivers which would be notified (muted routes excluded).
+	Receivers []string `json:"receivers"`
+	// Whether at least one route generated from the requested
+	// AlertmanagerConfig has been selected.
+	AlertmanagerConfigMatched *bool `json:"alertmanagerConfigMatched,omitempty"`
+}
+
+// RouteTrace describes the evaluation of a route.
+type RouteTrace struct {
+	// Identifier of the route in the routing tree.
+	ID string `json:"id"`
+	// Depth of the route in the routing tree (0 for the root route).
+	Depth int `json:"depth"`
+	// Matchers of the route.
+	Matchers string `json:"matchers"`
+	// Receiver of the route (inherited from the parent route if not set).
+	Receiver string `json:"receiver"`
+	// AlertmanagerConfig ("<namespace>/<name>") which generated the route.
+	AlertmanagerConfig string `json:"alertmanagerConfig,omitempty"`
+	// Whether the alert matches the matchers of the route.
+	Matched bool `json:"matched"`
+	// Whether the route is selected to handle the alert.
+	Selected bool `json:"selected"`
+	// Whether the evaluation continues with the sibling routes after a
+	// match.
+	Continue bool `json:"continue"`
+	// Mute time intervals of the route which are active at the evaluated
+	// time.
+	ActiveMuteTimeIntervals []string `json:"activeMuteTimeIntervals,omitempty"`
+	// Active time intervals of the route which are active at the evaluated
+	// time.
+	ActiveTimeIntervals []string `json:"activeTimeIntervals,omitempty"`
+	// Whether the notifications of the selected route are muted at the
+	// evaluated time.
+	Muted bool `json:"muted"`
+}
+
+// RoutingTracer records the last configuration generated for each
+// Alertmanager resource. It returns the routing trace of synthetic alerts
+// against the merged configuration (including the AlertmanagerConfig
+// resources).
+type RoutingTracer struct {
+	mtx     sync.RWMutex
+	configs map[string][]byte
+}
+
+// NewRoutingTracer returns an empty RoutingTracer.
+func NewRoutingTracer() *RoutingTracer {
+	return &RoutingTracer{
+		configs: map[string][]byte{},
+	}
+}
+
+// Set records the configuration generated for the Alertmanager resource
+// identified by its "<namespace>/<name>" key.
+func (rt *RoutingTracer) Set(key string, conf []byte) {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+
+	rt.configs[key] = conf
+}
+
+// Delete forgets the configuration of the Alertmanager resource.
+func (rt *RoutingTracer) Delete(key string) {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+
+	delete(rt.configs, key)
+}
+
+// Trace evaluates the routing of the alert against the configuration of the
+// Alertmanager resource identified by key.
+//
+// It returns false if no configuration has been recorded for the
+// Alertmanager resource.
+func (rt *RoutingTracer) Trace(key string, req RoutingTraceRequest) (*RoutingTraceResponse, bool, error) {
+	rt.mtx.RLock()
+	b, found := rt.configs[key]
+	rt.mtx.RUnlock()
+	if !found {
+		return nil, false, nil
+	}
+
+	cfg, err := config.Load(string(b))
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to parse the configuration: %w", err)
+	}
+
+	resp, err := traceRoutes(cfg, req)
+	return resp, true, err
+}
+
+// labelSet returns the labels of the alert used for the routing.
+func (req RoutingTraceRequest) labelSet() (model.LabelSet, error) {
+	lset := model.LabelSet{}
+	for k, v := range req.Labels {
+		lset[model.LabelName(k)] = model.LabelValue(v)
+	}
+
+	if req.AlertmanagerConfig != "" {
+		ns, name, _ := strings.Cut(req.AlertmanagerConfig, "/")
+		if ns == "" || name == "" {
+			return nil, fmt.Errorf("invalid alertmanagerConfig %q: expected <namespace>/<name>", req.AlertmanagerConfig)
+		}
+
+		if _, found := lset["namespace"]; !found {
+			lset["namespace"] = model.LabelValue(ns)
+		}
+	}
+
+	if len(lset) == 0 {
+		return nil, errors.New("the alert has no label")
+	}
+
+	if err := lset.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid labels: %w", err)
+	}
+
+	return lset, nil
+}
+
+func traceRoutes(cfg *config.Config, req RoutingTraceRequest) (*RoutingTraceResponse, error) {
+	lset, err := req.labelSet()
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Route == nil {
+		return nil, errors.New("the configuration has no route")
+	}
+
+	intervals := map[string][]timeinterval.TimeInterval{}
+	for _, ti := range cfg.MuteTimeIntervals {
+		intervals[ti.Name] = ti.TimeIntervals
+	}
+	for _, ti := range cfg.TimeIntervals {
+		intervals[ti.Name] = ti.TimeIntervals
+	}
+
+	now := time.Now().UTC()
+	if req.Time != nil {
+		now = req.Time.UTC()
+	}
+
+	t := &tracer{
+		lset:       lset,
+		now:        now,
+		intervener: timeinterval.NewIntervener(intervals),
+	}
+	if _, err := t.walk(cfg.Route, "", 0, "", 0); err != nil {
+		return nil, err
+	}
+
+	resp := &RoutingTraceResponse{
+		Labels:    make(map[string]string, len(lset)),
+		Time:      now,
+		Routes:    t.routes,
+		Receivers: []string{},
+	}
+	for k, v := range lset {
+		resp.Labels[string(k)] = string(v)
+	}
+
+	var amConfigMatched bool
+	for _, r := range t.routes {
+		if !r.Selected {
+			continue
+		}
+
+		if req.AlertmanagerConfig != "" && r.AlertmanagerConfig == req.AlertmanagerConfig {
+			amConfigMatched = true
+		}
+
+		if !r.Muted && !slices.Contains(resp.Receivers, r.Receiver) {
+			resp.Receivers = append(resp.Receivers, r.Receiver)
+		}
+	}
+
+	if req.AlertmanagerConfig != "" {
+		resp.AlertmanagerConfigMatched = &amConfigMatched
+	}
+
+	return resp, nil
+}
+
+type tracer struct {
+	lset       model.LabelSet
+	now        time.Time
+	intervener *timeinterval.Intervener
+
+	routes []RouteTrace
+}
+
+// walk follows the same logic as the Alertmanager's dispatch.Route.Match()
+// while recording the evaluated routes. The receiver is inherited from the
+// parent route when not defined. The route identifier is computed like
+// dispatch.Route.ID() from the parent's identifier and the route's index.
+//
+// It returns true if the route or one of its children is selected.
+func (t *tracer) walk(r *config.Route, parentID string, idx int, receiver string, depth int) (bool, error) {
+	if r.Receiver != "" {
+		receiver = r.Receiver
+	}
+
+	matchers := routeMatchers(r)
+	id := matchers.String()
+	if depth > 0 {
+		id = parentID + "/" + id + "/" + strconv.Itoa(idx)
+	}
+
+	i := len(t.routes)
+	t.routes = append(t.routes, RouteTrace{
+		ID:                 id,
+		Depth:              depth,
+		Matchers:           matchers.String(),
+		Receiver:           receiver,
+		AlertmanagerConfig: alertmanagerConfigFromReceiver(receiver),
+		Continue:           r.Continue,
+	})
+
+	if !matchers.Matches(t.lset) {
+		return false, nil
+	}
+	t.routes[i].Matched = true
+
+	var selected bool
+	for j, cr := range r.Routes {
+		matched, err := t.walk(cr, id, j, receiver, depth+1)
+		if err != nil {
+			return false, err
+		}
+
+		selected = selected || matched
+
+		if matched && !cr.Continue {
+			break
+		}
+	}
+
+	if selected {
+		return true, nil
+	}
+
+	// If no child route matches, the current route is selected.
+	t.routes[i].Selected = true
+
+	muted, names, err := t.intervener.Mutes(r.MuteTimeIntervals, t.now)
+	if err != nil {
+		return false, err
+	}
+	sort.Strings(names)
+	t.routes[i].ActiveMuteTimeIntervals = names
+	t.routes[i].Muted = muted
+
+	if len(r.ActiveTimeIntervals) > 0 {
+		active, names, err := t.intervener.Mutes(r.ActiveTimeIntervals, t.now)
+		if err != nil {
+			return false, err
+		}
+		sort.Strings(names)
+		t.routes[i].ActiveTimeIntervals = names
+		t.routes[i].Muted = t.routes[i].Muted || !active
+	}
+
+	return true, nil
+}
+
+// routeMatchers returns the matchers of the route, including the deprecated
+// match and match_re fields.
+func routeMatchers(r *config.Route) labels.Matchers {
+	var matchers labels.Matchers
+	for ln, lv := range r.Match {
+		m, err := labels.NewMatcher(labels.MatchEqual, ln, lv)
+		if err != nil {
+			// The configuration has already been validated.
+			continue
+		}
+		matchers = append(matchers, m)
+	}
+
+	for ln, lv := range r.MatchRE {
+		m, err := labels.NewMatcher(labels.MatchRegexp, ln, lv.String())
+		if err != nil {
+			continue
+		}
+		matchers = append(matchers, m)
+	}
+
+	matchers = append(matchers, r.Matchers...)
+	sort.Sort(matchers)
+
+	return matchers
+}
+
+// alertmanagerConfigFromReceiver returns the "<namespace>/<name>" key of the
+// AlertmanagerConfig which generated the receiver (see
+// makeNamespacedString()).
+func alertmanagerConfigFromReceiver(receiver string) string {
+	parts := strings.SplitN(receiver, "/", 3)
+	if len(parts) != 3 {
+		return ""
+	}
+
+	return parts[0] + "/" + parts[1]
+}
+
+// ServeHTTP implements the http.Handler interface. The request's path must
+// match RoutingTracePattern.
+func (rt *RoutingTracer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	key := req.PathValue("namespace") + "/" + req.PathValue("name")
+
+	var traceReq RoutingTraceRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, req.Body, 1<<20)).Decode(&traceReq); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode the request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := traceReq.labelSet(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, found, err := rt.Trace(key, traceReq)
+	if !found {
+		http.Error(w, fmt.Sprintf("no configuration generated for Alertmanager %q", key), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+const routingTraceConfig = `
+route:
+  receiver: "null"
+  routes:
+  - receiver: ns1/amc/team-a
+    matchers:
+    - namespace="ns1"
+    continue: true
+    routes:
+    - receiver: ns1/amc/critical
+      matchers:
+      - severity="critical"
+      mute_time_intervals:
+      - ns1/amc/weekend
+  - receiver: ns2/amc/team-b
+    matchers:
+    - namespace="ns2"
+    active_time_intervals:
+    - ns2/amc/office-hours
+  - receiver: catch-all
+receivers:
+- name: "null"
+- name: ns1/amc/team-a
+- name: ns1/amc/critical
+- name: ns2/amc/team-b
+- name: catch-all
+mute_time_intervals:
+- name: ns1/amc/weekend
+  time_intervals:
+  - weekdays: [saturday, sunday]
+time_intervals:
+- name: ns2/amc/office-hours
+  time_intervals:
+  - times:
+    - start_time: "09:00"
+      end_time: "17:00"
+`
+
+func TestRoutingTrace(t *testing.T) {
+	// Saturday.
+	saturday := time.Date(2025, time.June, 7, 12, 0, 0, 0, time.UTC)
+	// Monday evening.
+	monday := time.Date(2025, time.June, 9, 20, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name string
+		req  RoutingTraceRequest
+
+		receivers []string
+		selected  []string
+		muted     []string
+		amcfg     *bool
+	}{
+		{
+			name: "nested route muted",
+			req: RoutingTraceRequest{
+				Labels:             map[string]string{"severity": "critical"},
+				Time:               &saturday,
+				AlertmanagerConfig: "ns1/amc",
+			},
+			receivers: []string{"catch-all"},
+			selected:  []string{"ns1/amc/critical", "catch-all"},
+			muted:     []string{"ns1/amc/critical"},
+			amcfg:     ptr.To(true),
+		},
+		{
+			name: "nested route not muted",
+			req: RoutingTraceRequest{
+				Labels: map[string]string{"namespace": "ns1", "severity": "critical"},
+				Time:   &monday,
+			},
+			receivers: []string{"ns1/amc/critical", "catch-all"},
+			selected:  []string{"ns1/amc/critical", "catch-all"},
+		},
+		{
+			name: "parent route selected",
+			req: RoutingTraceRequest{
+				Labels:             map[string]string{"severity": "warning"},
+				Time:               &monday,
+				AlertmanagerConfig: "ns1/amc",
+			},
+			receivers: []string{"ns1/amc/team-a", "catch-all"},
+			selected:  []string{"ns1/amc/team-a", "catch-all"},
+			amcfg:     ptr.To(true),
+		},
+		{
+			name: "outside of active time intervals",
+			req: RoutingTraceRequest{
+				Labels:             map[string]string{"severity": "warning"},
+				Time:               &monday,
+				AlertmanagerConfig: "ns2/amc",
+			},
+			receivers: []string{},
+			selected:  []string{"ns2/amc/team-b"},
+			muted:     []string{"ns2/amc/team-b"},
+			amcfg:     ptr.To(true),
+		},
+		{
+			name: "no AlertmanagerConfig matched",
+			req: RoutingTraceRequest{
+				Labels:             map[string]string{"severity": "warning"},
+				Time:               &monday,
+				AlertmanagerConfig: "ns3/amc",
+			},
+			receivers: []string{"catch-all"},
+			selected:  []string{"catch-all"},
+			amcfg:     ptr.To(false),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rt := NewRoutingTracer()
+			rt.Set("default/main", []byte(routingTraceConfig))
+
+			resp, found, err := rt.Trace("default/main", tc.req)
+			require.NoError(t, err)
+			require.True(t, found)
+
+			require.Equal(t, tc.receivers, resp.Receivers)
+			require.Equal(t, tc.amcfg, resp.AlertmanagerConfigMatched)
+
+			var selected, muted []string
+			for _, r := range resp.Routes {
+				if r.Selected {
+					selected = append(selected, r.Receiver)
+				}
+				if r.Muted {
+					muted = append(muted, r.Receiver)
+				}
+			}
+			require.Equal(t, tc.selected, selected)
+			require.Equal(t, tc.muted, muted)
+		})
+	}
+}
+
+func TestRoutingTraceHandler(t *testing.T) {
+	rt := NewRoutingTracer()
+	rt.Set("default/main", []byte(routingTraceConfig))
+
+	mux := http.NewServeMux()
+	mux.Handle(RoutingTracePattern, rt)
+
+	for _, tc := range []struct {
+		name string
+		path string
+		body string
+
+		status int
+	}{
+		{
+			name:   "valid request",
+			path:   "/api/v1/alertmanagers/default/main/routing-trace",
+			body:   `{"labels":{"namespace":"ns1"}}`,
+			status: http.StatusOK,
+		},
+		{
+			name:   "unknown alertmanager",
+			path:   "/api/v1/alertmanagers/default/other/routing-trace",
+			body:   `{"labels":{"namespace":"ns1"}}`,
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "invalid payload",
+			path:   "/api/v1/alertmanagers/default/main/routing-trace",
+			body:   `{"labels":`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "no labels",
+			path:   "/api/v1/alertmanagers/default/main/routing-trace",
+			body:   `{}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid alertmanagerConfig",
+			path:   "/api/v1/alertmanagers/default/main/routing-trace",
+			body:   `{"labels":{"foo":"bar"},"alertmanagerConfig":"amc"}`,
+			status: http.StatusBadRequest,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body)))
+
+			require.Equal(t, tc.status, w.Code)
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			var resp RoutingTraceResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			require.Equal(t, []string{"ns1/amc/team-a", "catch-all"}, resp.Receivers)
+			require.Equal(t, `{}`, resp.Routes[0].ID)
+			require.Equal(t, `{}/{namespace="ns1"}/0`, resp.Routes[1].ID)
+		})
+	}
+}
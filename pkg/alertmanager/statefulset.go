@@ -494,7 +494,12 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 		},
 	}
 
-	var configReloaderWebConfigFile string
+	var (
+		configReloaderWebConfigFile string
+		reloadClient                operator.ReloadClientFiles
+		reloadClientVols            []v1.Volume
+		reloadClientMounts          []v1.VolumeMount
+	)
 
 	watchedDirectories := []string{alertmanagerConfigDir}
 	configReloaderVolumeMounts := []v1.VolumeMount{
@@ -653,6 +658,14 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 
 		configReloaderWebConfigFile = confArg.Value
 		configReloaderVolumeMounts = append(configReloaderVolumeMounts, configMount...)
+
+		reloadClient, reloadClientVols, reloadClientMounts, err = webConfig.GetReloadClientParameters(a.Spec.ConfigReloader)
+		if err != nil {
+			return nil, err
+		}
+
+		volumes = append(volumes, reloadClientVols...)
+		configReloaderVolumeMounts = append(configReloaderVolumeMounts, reloadClientMounts...)
 	}
 
 	if version.GTE(semver.MustParse("0.24.0")) {
@@ -682,6 +695,10 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 		alertmanagerURIScheme = "https"
 	}
 
+	if err := operator.AlertmanagerFlags.ValidateArgs("spec.additionalArgs", version, a.Spec.AdditionalArgs); err != nil {
+		return nil, err
+	}
+
 	containerArgs, err := operator.BuildArgs(amArgs, a.Spec.AdditionalArgs)
 	if err != nil {
 		return nil, err
@@ -705,7 +722,7 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 					Drop: []v1.Capability{"ALL"},
 				},
 			},
-			Env: []v1.EnvVar{
+			Env: append([]v1.EnvVar{
 				{
 					// Necessary for '--cluster.listen-address' flag
 					Name: "POD_IP",
@@ -715,12 +732,13 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 						},
 					},
 				},
-			},
+			}, config.GoRuntime.EnvVars(a.Spec.Resources)...),
 			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
 		},
 		operator.CreateConfigReloader(
 			"config-reloader",
 			operator.ReloaderConfig(config.ReloaderConfig),
+			operator.ReloaderOverrides(a.Spec.ConfigReloader),
 			operator.ReloaderURL(url.URL{
 				Scheme: alertmanagerURIScheme,
 				Host:   config.LocalHost + ":9093",
@@ -734,6 +752,7 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 			operator.VolumeMounts(configReloaderVolumeMounts),
 			operator.Shard(-1),
 			operator.WebConfigFile(configReloaderWebConfigFile),
+			operator.ReloadClient(reloadClient),
 			operator.ConfigFile(path.Join(alertmanagerConfigDir, alertmanagerConfigFileCompressed)),
 			operator.ConfigEnvsubstFile(path.Join(alertmanagerConfigOutDir, alertmanagerConfigEnvsubstFilename)),
 			operator.ImagePullPolicy(a.Spec.ImagePullPolicy),
@@ -754,6 +773,7 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 		operator.CreateConfigReloader(
 			"init-config-reloader",
 			operator.ReloaderConfig(config.ReloaderConfig),
+			operator.ReloaderOverrides(a.Spec.ConfigReloader),
 			operator.InitContainer(),
 			operator.LogFormat(a.Spec.LogFormat),
 			operator.LogLevel(a.Spec.LogLevel),
@@ -771,6 +791,10 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 		return nil, fmt.Errorf("failed to merge init containers spec: %w", err)
 	}
 
+	if config.NativeSidecars {
+		containers, initContainers = k8sutil.MoveToNativeSidecars(containers, initContainers, "config-reloader")
+	}
+
 	spec := appsv1.StatefulSetSpec{
 		ServiceName:     getServiceName(a),
 		Replicas:        a.Spec.Replicas,
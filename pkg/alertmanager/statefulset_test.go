@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
@@ -1542,3 +1543,136 @@ func TestStatefulSetEnableServiceLinks(t *testing.T) {
 		}
 	}
 }
+
+func TestNativeSidecars(t *testing.T) {
+	c := defaultTestConfig
+	c.NativeSidecars = true
+	sset, err := makeStatefulSet(nil, &monitoringv1.Alertmanager{}, c, "", &operator.ShardedSecret{})
+	require.NoError(t, err)
+
+	spec := sset.Spec.Template.Spec
+	require.Len(t, spec.Containers, 1)
+	require.Equal(t, "alertmanager", spec.Containers[0].Name)
+
+	require.Len(t, spec.InitContainers, 2)
+	require.Equal(t, "init-config-reloader", spec.InitContainers[0].Name)
+	require.Equal(t, "config-reloader", spec.InitContainers[1].Name)
+	require.Equal(t, ptr.To(v1.ContainerRestartPolicyAlways), spec.InitContainers[1].RestartPolicy)
+}
+
+func TestConfigReloaderWatchedVolumeMounts(t *testing.T) {
+	sset, err := makeStatefulSet(nil, &monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "extra-templates",
+					VolumeSource: v1.VolumeSource{
+						ConfigMap: &v1.ConfigMapVolumeSource{
+							LocalObjectReference: v1.LocalObjectReference{Name: "extra-templates"},
+						},
+					},
+				},
+			},
+			ConfigReloader: &monitoringv1.ConfigReloaderSpec{
+				WatchedVolumeMounts: []v1.VolumeMount{
+					{
+						Name:      "extra-templates",
+						MountPath: "/etc/alertmanager/extra-templates",
+						ReadOnly:  true,
+					},
+				},
+			},
+		},
+	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	require.NoError(t, err)
+
+	for _, c := range []v1.Container{sset.Spec.Template.Spec.InitContainers[0], sset.Spec.Template.Spec.Containers[1]} {
+		require.Contains(t, c.Name, "config-reloader")
+		require.Contains(t, c.Args, "--watched-dir=/etc/alertmanager/extra-templates")
+		require.Contains(t, c.VolumeMounts, v1.VolumeMount{
+			Name:      "extra-templates",
+			MountPath: "/etc/alertmanager/extra-templates",
+			ReadOnly:  true,
+		})
+	}
+}
+
+func TestConfigReloaderReloadClient(t *testing.T) {
+	sset, err := makeStatefulSet(nil, &monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			Web: &monitoringv1.AlertmanagerWebSpec{
+				WebConfigFileFields: monitoringv1.WebConfigFileFields{
+					TLSConfig: &monitoringv1.WebTLSConfig{
+						CertificateSecret: ptr.To("web-tls"),
+						ClientCA: monitoringv1.SecretOrConfigMap{
+							Secret: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{Name: "web-tls"},
+								Key:                  "ca.crt",
+							},
+						},
+						ClientAuthType: ptr.To("RequireAndVerifyClientCert"),
+					},
+				},
+			},
+			ConfigReloader: &monitoringv1.ConfigReloaderSpec{
+				ReloadClient: &monitoringv1.ConfigReloaderClientConfig{
+					CA: monitoringv1.SecretOrConfigMap{
+						Secret: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "web-tls"},
+							Key:                  "ca.crt",
+						},
+					},
+				},
+			},
+		},
+	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	require.NoError(t, err)
+
+	reloader := sset.Spec.Template.Spec.Containers[1]
+	require.Equal(t, "config-reloader", reloader.Name)
+	require.Contains(t, reloader.Args, "--reload-url=https://localhost:9093/-/reload")
+	require.Contains(t, reloader.Args, "--reload-tls-ca-file=/etc/alertmanager/web_config/reload-client/secret/web-tls-ca/ca.crt")
+	// The config-reloader presents the certificate of the web server.
+	require.Contains(t, reloader.Args, "--reload-tls-cert-file=/etc/alertmanager/web_config/secret/web-tls-cert/tls.crt")
+	require.Contains(t, reloader.Args, "--reload-tls-key-file=/etc/alertmanager/web_config/secret/web-tls-key/tls.key")
+
+	var found bool
+	for _, m := range reloader.VolumeMounts {
+		if m.MountPath != "/etc/alertmanager/web_config/reload-client/secret/web-tls-ca" {
+			continue
+		}
+
+		found = true
+		require.True(t, slices.ContainsFunc(sset.Spec.Template.Spec.Volumes, func(v v1.Volume) bool {
+			return v.Name == m.Name && v.Secret != nil && v.Secret.SecretName == "web-tls"
+		}))
+	}
+	require.True(t, found)
+}
+
+func TestGoRuntimeEnvVars(t *testing.T) {
+	config := defaultTestConfig
+	config.GoRuntime = operator.GoRuntimeConfig{MemLimitRatio: 0.5, MaxProcs: true}
+
+	sset, err := makeStatefulSet(nil, &monitoringv1.Alertmanager{
+		Spec: monitoringv1.AlertmanagerSpec{
+			Resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{
+					v1.ResourceMemory: resource.MustParse("512Mi"),
+					v1.ResourceCPU:    resource.MustParse("500m"),
+				},
+			},
+		},
+	}, config, "", &operator.ShardedSecret{})
+	require.NoError(t, err)
+
+	am := sset.Spec.Template.Spec.Containers[0]
+	require.Equal(t, "alertmanager", am.Name)
+	require.Contains(t, am.Env, v1.EnvVar{Name: "GOMEMLIMIT", Value: "268435456"})
+	require.Contains(t, am.Env, v1.EnvVar{Name: "GOMAXPROCS", Value: "1"})
+
+	// The config-reloader isn't affected.
+	for _, env := range sset.Spec.Template.Spec.Containers[1].Env {
+		require.NotEqual(t, "GOMEMLIMIT", env.Name)
+	}
+}
@@ -180,6 +180,12 @@ func validateWebhookConfigs(configs []monitoringv1alpha1.WebhookConfig) error {
 			}
 		}
 
+		if config.SigningSecret != nil && config.HTTPConfig != nil {
+			if config.HTTPConfig.Authorization != nil || config.HTTPConfig.BasicAuth != nil || config.HTTPConfig.OAuth2 != nil || config.HTTPConfig.BearerTokenSecret != nil {
+				return errors.New("'signingSecret' is not compatible with authorization, basicAuth, oauth2 and bearerTokenSecret")
+			}
+		}
+
 		if err := config.HTTPConfig.Validate(); err != nil {
 			return err
 		}
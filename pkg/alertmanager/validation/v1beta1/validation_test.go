@@ -120,6 +120,33 @@ func TestValidateAlertmanagerConfig(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "Test fail to validate webhook config - signing secret with bearer token",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "different",
+							WebhookConfigs: []monitoringv1beta1.WebhookConfig{
+								{
+									URL: ptr.To("http://example.com"),
+									SigningSecret: &monitoringv1beta1.WebhookSigningSecret{
+										Name: "webhook-signing-key",
+									},
+									HTTPConfig: &monitoringv1beta1.HTTPConfig{
+										BearerTokenSecret: &monitoringv1beta1.SecretKeySelector{
+											Name: "creds",
+											Key:  "token",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "Test fail to validate wechat config - invalid URL",
 			in: &monitoringv1beta1.AlertmanagerConfig{
@@ -0,0 +1,127 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	// webhookSigningKey is the key of the Secret holding the webhook
+	// signing key.
+	webhookSigningKey = "key"
+
+	// webhookSigningKeyLength is the number of random bytes of the signing
+	// keys generated by the operator.
+	webhookSigningKeyLength = 32
+)
+
+func webhookSigningKeySelector(s *monitoringv1alpha1.WebhookSigningSecret) v1.SecretKeySelector {
+	return v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: s.Name},
+		Key:                  webhookSigningKey,
+	}
+}
+
+// provisionWebhookSigningSecrets creates the signing Secrets referenced by
+// the webhook receivers of the AlertmanagerConfig which don't exist yet.
+// Existing Secrets are left untouched.
+//
+// The Secrets are owned by the AlertmanagerConfig object so they are garbage
+// collected when it is deleted.
+func (c *Operator) provisionWebhookSigningSecrets(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig) error {
+	sClient := c.kclient.CoreV1().Secrets(amc.Namespace)
+	provisioned := map[string]struct{}{}
+
+	for _, receiver := range amc.Spec.Receivers {
+		for _, wh := range receiver.WebhookConfigs {
+			if wh.SigningSecret == nil {
+				continue
+			}
+
+			name := wh.SigningSecret.Name
+			if _, found := provisioned[name]; found {
+				continue
+			}
+			provisioned[name] = struct{}{}
+
+			_, err := sClient.Get(ctx, name, metav1.GetOptions{})
+			if err == nil {
+				continue
+			}
+
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get the webhook signing secret %q: %w", name, err)
+			}
+
+			s, err := c.newWebhookSigningSecret(amc, name)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sClient.Create(ctx, s, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+				return fmt.Errorf("failed to create the webhook signing secret %q: %w", name, err)
+			}
+
+			c.logger.Info("webhook signing secret created", "secret", name, "namespace", amc.Namespace, "alertmanagerconfig", amc.Name)
+		}
+	}
+
+	return nil
+}
+
+func (c *Operator) newWebhookSigningSecret(amc *monitoringv1alpha1.AlertmanagerConfig, name string) (*v1.Secret, error) {
+	b := make([]byte, webhookSigningKeyLength)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("failed to generate the webhook signing key: %w", err)
+	}
+
+	s := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+					Kind:       monitoringv1alpha1.AlertmanagerConfigKind,
+					Name:       amc.Name,
+					UID:        amc.UID,
+				},
+			},
+		},
+		Data: map[string][]byte{
+			webhookSigningKey: []byte(hex.EncodeToString(b)),
+		},
+	}
+
+	config := c.currentConfig()
+	operator.UpdateObject(
+		s,
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithName(name),
+		operator.WithNamespace(amc.Namespace),
+	)
+
+	return s, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestProvisionWebhookSigningSecrets(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "existing",
+				Namespace: "ns",
+			},
+			Data: map[string][]byte{
+				"key": []byte("user-provided"),
+			},
+		},
+	)
+
+	o := &Operator{
+		kclient: c,
+		logger:  newNopLogger(t),
+		config: Config{
+			Labels: operator.Map{"team": "a"},
+		},
+	}
+
+	amc := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "amc",
+			Namespace: "ns",
+			UID:       "1234",
+		},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Receivers: []monitoringv1alpha1.Receiver{
+				{
+					Name: "a",
+					WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+						{
+							URL:           ptr.To("https://example.com/a"),
+							SigningSecret: &monitoringv1alpha1.WebhookSigningSecret{Name: "generated"},
+						},
+						{
+							URL:           ptr.To("https://example.com/b"),
+							SigningSecret: &monitoringv1alpha1.WebhookSigningSecret{Name: "existing"},
+						},
+						{
+							URL: ptr.To("https://example.com/c"),
+						},
+					},
+				},
+				{
+					Name: "b",
+					WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+						{
+							URL:           ptr.To("https://example.com/d"),
+							SigningSecret: &monitoringv1alpha1.WebhookSigningSecret{Name: "generated"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, o.provisionWebhookSigningSecrets(context.Background(), amc))
+
+	s, err := c.CoreV1().Secrets("ns").Get(context.Background(), "generated", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, s.Data["key"], 2*webhookSigningKeyLength)
+	require.Equal(t, "a", s.Labels["team"])
+	require.Len(t, s.OwnerReferences, 1)
+	require.Equal(t, monitoringv1alpha1.AlertmanagerConfigKind, s.OwnerReferences[0].Kind)
+	require.Equal(t, "amc", s.OwnerReferences[0].Name)
+	key := s.Data["key"]
+
+	// The existing Secret isn't modified.
+	s, err = c.CoreV1().Secrets("ns").Get(context.Background(), "existing", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "user-provided", string(s.Data["key"]))
+	require.Empty(t, s.OwnerReferences)
+
+	// The generated key is stable across reconciliations.
+	require.NoError(t, o.provisionWebhookSigningSecrets(context.Background(), amc))
+	s, err = c.CoreV1().Secrets("ns").Get(context.Background(), "generated", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, key, s.Data["key"])
+}
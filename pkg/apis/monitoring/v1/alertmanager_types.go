@@ -176,6 +176,10 @@ type AlertmanagerSpec struct {
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 	// Define resources requests and limits for single Pods.
 	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// Overrides the settings of the 'init-config-reloader'
+	// and 'config-reloader' containers defined at the operator level.
+	// +optional
+	ConfigReloader *ConfigReloaderSpec `json:"configReloader,omitempty"`
 	// If specified, the pod's scheduling constraints.
 	Affinity *v1.Affinity `json:"affinity,omitempty"`
 	// If specified, the pod's tolerations.
@@ -234,6 +238,18 @@ type AlertmanagerSpec struct {
 	PriorityClassName string `json:"priorityClassName,omitempty"`
 	// AdditionalPeers allows injecting a set of additional Alertmanagers to peer with to form a highly available cluster.
 	AdditionalPeers []string `json:"additionalPeers,omitempty"`
+	// When true, the operator regularly checks whether the additional peers
+	// are reachable (TCP connection) and configures Alertmanager only with
+	// the reachable peers. The unreachable peers are added back when they
+	// become reachable again. If none of the additional peers is reachable,
+	// all of them are kept.
+	//
+	// The unreachable peers are reported in `status.unreachableAdditionalPeers`.
+	//
+	// Note that a change of the list of peers triggers a rolling update of
+	// the Alertmanager pods.
+	// +optional
+	AdditionalPeersHealthCheck *bool `json:"additionalPeersHealthCheck,omitempty"`
 	// ClusterAdvertiseAddress is the explicit address to advertise in cluster.
 	// Needs to be provided for non RFC1918 [1] (public) addresses.
 	// [1] RFC1918: https://tools.ietf.org/html/rfc1918
@@ -264,6 +280,15 @@ type AlertmanagerSpec struct {
 	// process incoming alerts.
 	AlertmanagerConfigMatcherStrategy AlertmanagerConfigMatcherStrategy `json:"alertmanagerConfigMatcherStrategy,omitempty"`
 
+	// Defines the bounds of the resolve timeout which the AlertmanagerConfig
+	// resources can request with `spec.resolveTimeout`. The requested values
+	// are clamped within the bounds.
+	//
+	// If not defined, the requested values are ignored.
+	//
+	// +optional
+	AlertmanagerConfigResolveTimeoutBounds *DurationBounds `json:"alertmanagerConfigResolveTimeoutBounds,omitempty"`
+
 	// Minimum number of seconds for which a newly created pod should be ready
 	// without any of its container crashing for it to be considered available.
 	// Defaults to 0 (pod will be considered available as soon as it is ready)
@@ -354,6 +379,17 @@ const (
 	NoneConfigMatcherStrategyType AlertmanagerConfigMatcherStrategyType = "None"
 )
 
+// DurationBounds defines an interval of durations.
+type DurationBounds struct {
+	// Lower bound of the interval (inclusive).
+	// +required
+	Min Duration `json:"min"`
+
+	// Upper bound of the interval (inclusive).
+	// +required
+	Max Duration `json:"max"`
+}
+
 // AlertmanagerConfiguration defines the Alertmanager configuration.
 // +k8s:openapi-gen=true
 type AlertmanagerConfiguration struct {
@@ -436,6 +472,9 @@ type AlertmanagerStatus struct {
 	AvailableReplicas int32 `json:"availableReplicas"`
 	// Total number of unavailable pods targeted by this Alertmanager object.
 	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// Progress of the rollout of the Alertmanager pods.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
 	// The selector used to match the pods targeted by this Alertmanager object.
 	Selector string `json:"selector,omitempty"`
 	// The current state of the Alertmanager object.
@@ -443,6 +482,16 @@ type AlertmanagerStatus struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+	// The additional peers which are excluded from the cluster because they
+	// aren't reachable. It is only set when `spec.additionalPeersHealthCheck`
+	// is true.
+	// +listType=set
+	// +optional
+	UnreachableAdditionalPeers []string `json:"unreachableAdditionalPeers,omitempty"`
+	// Information about the operator which reconciled the resource
+	// (version and enabled feature gates).
+	// +optional
+	OperatorInfo *OperatorInfo `json:"operatorInfo,omitempty"`
 }
 
 func (a *Alertmanager) ExpectedReplicas() int {
@@ -30,6 +30,10 @@ const (
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="pmon"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Bindings",type="integer",JSONPath=".status.totalBindings",description="The number of workload resources which select the resource"
+// +kubebuilder:printcolumn:name="Accepted",type="integer",JSONPath=".status.acceptedBindings",description="The number of workload resources which accepted the resource"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // The `PodMonitor` custom resource definition (CRD) defines how `Prometheus` and `PrometheusAgent` can scrape metrics from a group of pods.
 // Among other things, it allows to specify:
@@ -44,6 +48,14 @@ type PodMonitor struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Specification of desired Pod selection for target discovery by Prometheus.
 	Spec PodMonitorSpec `json:"spec"`
+	// This Status subresource is under active development and is updated only when the
+	// "StatusForConfigurationResources" feature gate is enabled.
+	//
+	// Most recent observed status of the PodMonitor. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ConfigResourceStatus `json:"status,omitempty"`
 }
 
 // DeepCopyObject implements the runtime.Object interface.
@@ -29,6 +29,7 @@ const (
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="prb"
+// +kubebuilder:subresource:status
 
 // The `Probe` custom resource definition (CRD) defines how to scrape metrics from prober exporters such as the [blackbox exporter](https://github.com/prometheus/blackbox_exporter).
 //
@@ -42,6 +43,14 @@ type Probe struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Specification of desired Ingress selection for target discovery by Prometheus.
 	Spec ProbeSpec `json:"spec"`
+	// This Status subresource is under active development and is updated only when the
+	// "StatusForConfigurationResources" feature gate is enabled.
+	//
+	// Most recent observed status of the Probe. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ConfigResourceStatus `json:"status,omitempty"`
 }
 
 // DeepCopyObject implements the runtime.Object interface.
@@ -201,6 +201,28 @@ type CommonPrometheusFields struct {
 	// +optional
 	ScrapeConfigNamespaceSelector *metav1.LabelSelector `json:"scrapeConfigNamespaceSelector,omitempty"`
 
+	// Defines how the operator handles ServiceMonitors and ScrapeConfigs
+	// which would scrape the same targets with the same labels, resulting in
+	// the ingestion of duplicated samples.
+	//
+	// Two ServiceMonitors are considered duplicates when they select the same
+	// Services in the same namespaces and define an endpoint with identical
+	// port, path, scheme, parameters and relabelings. Two ScrapeConfigs are
+	// considered duplicates when they define the same `jobName` and identical
+	// specifications.
+	//
+	// * `Warn`: the operator reports the duplicated resources in the
+	// `DuplicateTargets` condition of the status and emits warning events.
+	// * `Drop`: in addition to the warning events, the operator ignores the
+	// resources for which every endpoint is a duplicate, keeping the first one
+	// in the `<namespace>/<name>` order.
+	//
+	// If not defined, the operator assumes the `Warn` value.
+	//
+	// +kubebuilder:validation:Enum=Warn;Drop
+	// +optional
+	DuplicateTargetsPolicy *DuplicateTargetsPolicy `json:"duplicateTargetsPolicy,omitempty"`
+
 	// Version of Prometheus being deployed. The operator uses this information
 	// to generate the Prometheus StatefulSet + configuration files.
 	//
@@ -401,6 +423,11 @@ type CommonPrometheusFields struct {
 	// Defines the resources requests and limits of the 'prometheus' container.
 	Resources v1.ResourceRequirements `json:"resources,omitempty"`
 
+	// Overrides the settings of the 'init-config-reloader'
+	// and 'config-reloader' containers defined at the operator level.
+	// +optional
+	ConfigReloader *ConfigReloaderSpec `json:"configReloader,omitempty"`
+
 	// Defines on which Nodes the Pods are scheduled.
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
@@ -855,15 +882,58 @@ type CommonPrometheusFields struct {
 
 	// Defines the strategy used to reload the Prometheus configuration.
 	// If not specified, the configuration is reloaded using the /-/reload HTTP endpoint.
+	//
+	// With `OperatorExec`, the pods run no config-reloader sidecar. The
+	// operator writes the configuration into the Prometheus container and
+	// sends a SIGHUP signal to the process with the Kubernetes exec API (the
+	// operator needs the `create` permission on `pods/exec`). The Prometheus
+	// image must provide the `sh`, `cat`, `mv` and `kill` commands. It isn't
+	// compatible with the publication of the configuration to an artifact
+	// store.
 	// +optional
 	ReloadStrategy *ReloadStrategyType `json:"reloadStrategy,omitempty"`
 
+	// Defines the compression of the configuration stored in the
+	// configuration Secret.
+	//
+	// With `Zstd`, large configurations are roughly half the size they are
+	// with `Gzip` (default) which pushes out the size limit of the Secret.
+	// It requires a config-reloader image which decompresses zstd (v0.85.0
+	// and above), otherwise the operator falls back to `Gzip`. The key of
+	// the configuration Secret is `prometheus.yaml.zst` instead of
+	// `prometheus.yaml.gz`.
+	// +optional
+	ConfigCompression *ConfigCompressionType `json:"configCompression,omitempty"`
+
+	// Defines how the operator coalesces the updates of the configuration
+	// Secret when the configuration inputs change frequently (e.g. many
+	// ServiceMonitors updated during a Helm upgrade).
+	//
+	// If not specified, the Secret is updated on every change.
+	// +optional
+	ConfigUpdateDebounce *ConfigUpdateDebounce `json:"configUpdateDebounce,omitempty"`
+
 	// Defines the maximum time that the `prometheus` container's startup probe will wait before being considered failed. The startup probe will return success after the WAL replay is complete.
 	// If set, the value should be greater than 60 (seconds). Otherwise it will be equal to 600 seconds (15 minutes).
 	// +optional
 	// +kubebuilder:validation:Minimum=60
 	MaximumStartupDurationSeconds *int32 `json:"maximumStartupDurationSeconds,omitempty"`
 
+	// Defines how the maximum startup duration of the `prometheus` container
+	// is computed.
+	//
+	// With `Fixed` (default), the maximum startup duration is the value of
+	// `maximumStartupDurationSeconds`.
+	//
+	// With `Auto`, the maximum startup duration is derived from the size of
+	// the storage volume (2 minutes plus 30 seconds per GiB) so that large
+	// instances aren't killed during the WAL replay while small instances
+	// still fail fast. If set, `maximumStartupDurationSeconds` is the lower
+	// bound. When the size of the storage volume is unknown (e.g. an
+	// `emptyDir` volume without size limit), it behaves like `Fixed`.
+	// +optional
+	MaximumStartupDurationMode *StartupDurationModeType `json:"maximumStartupDurationMode,omitempty"`
+
 	// List of scrape classes to expose to scraping objects such as
 	// PodMonitors, ServiceMonitors, Probes and ScrapeConfigs.
 	//
@@ -968,7 +1038,7 @@ const (
 	ValuesNameEscapingScheme      NameEscapingSchemeOptions = "Values"
 )
 
-// +kubebuilder:validation:Enum=HTTP;ProcessSignal
+// +kubebuilder:validation:Enum=HTTP;ProcessSignal;OperatorExec
 type ReloadStrategyType string
 
 const (
@@ -977,8 +1047,56 @@ const (
 
 	// ProcessSignalReloadStrategyType reloads the configuration by sending a SIGHUP signal to the process.
 	ProcessSignalReloadStrategyType ReloadStrategyType = "ProcessSignal"
+
+	// OperatorExecReloadStrategyType reloads the configuration without the
+	// config-reloader sidecar: the operator writes the configuration into the
+	// pods and sends a SIGHUP signal to the process using the Kubernetes exec
+	// API.
+	OperatorExecReloadStrategyType ReloadStrategyType = "OperatorExec"
 )
 
+// +kubebuilder:validation:Enum=Gzip;Zstd
+type ConfigCompressionType string
+
+const (
+	// GzipConfigCompression compresses the configuration with gzip.
+	GzipConfigCompression ConfigCompressionType = "Gzip"
+
+	// ZstdConfigCompression compresses the configuration with zstd.
+	ZstdConfigCompression ConfigCompressionType = "Zstd"
+)
+
+// +kubebuilder:validation:Enum=Fixed;Auto
+type StartupDurationModeType string
+
+const (
+	// FixedStartupDurationMode uses the maximum startup duration defined by
+	// the user.
+	FixedStartupDurationMode StartupDurationModeType = "Fixed"
+
+	// AutoStartupDurationMode derives the maximum startup duration from the
+	// size of the storage volume.
+	AutoStartupDurationMode StartupDurationModeType = "Auto"
+)
+
+// ConfigUpdateDebounce defines the debounce window applied to the updates of
+// the configuration Secret.
+type ConfigUpdateDebounce struct {
+	// Time without any change of the configuration inputs after which the
+	// operator updates the configuration Secret.
+	//
+	// +required
+	Window Duration `json:"window"`
+
+	// Maximum time between the first pending change and the update of the
+	// configuration Secret. It bounds the delay when the inputs change
+	// continuously.
+	//
+	// If not specified, the value is 5 times the window.
+	// +optional
+	MaxDelay *Duration `json:"maxDelay,omitempty"`
+}
+
 // +kubebuilder:validation:Enum=Endpoints;EndpointSlice
 type ServiceDiscoveryRole string
 
@@ -1013,6 +1131,13 @@ func (cpf *CommonPrometheusFields) WebRoutePrefix() string {
 // +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
+// +kubebuilder:printcolumn:name="Shards",type="integer",JSONPath=".status.shards",description="The number of shards",priority=1
+// +kubebuilder:printcolumn:name="ServiceMonitors",type="integer",JSONPath=".status.selectedResources.serviceMonitors.selected",description="The number of selected ServiceMonitors",priority=1
+// +kubebuilder:printcolumn:name="PodMonitors",type="integer",JSONPath=".status.selectedResources.podMonitors.selected",description="The number of selected PodMonitors",priority=1
+// +kubebuilder:printcolumn:name="Probes",type="integer",JSONPath=".status.selectedResources.probes.selected",description="The number of selected Probes",priority=1
+// +kubebuilder:printcolumn:name="ScrapeConfigs",type="integer",JSONPath=".status.selectedResources.scrapeConfigs.selected",description="The number of selected ScrapeConfigs",priority=1
+// +kubebuilder:printcolumn:name="Rules",type="integer",JSONPath=".status.selectedResources.prometheusRules.selected",description="The number of selected PrometheusRules",priority=1
+// +kubebuilder:printcolumn:name="Last Reconcile",type="date",JSONPath=".status.lastReconcileTime",description="The time of the last reconciliation",priority=1
 // +kubebuilder:subresource:status
 // +kubebuilder:subresource:scale:specpath=.spec.shards,statuspath=.status.shards,selectorpath=.status.selector
 // +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale
@@ -1088,11 +1213,47 @@ type PrometheusSpec struct {
 	// +optional
 	ShardRetentionPolicy *ShardRetentionPolicy `json:"shardRetentionPolicy,omitempty"`
 
+	// Defines how the operator applies changes of the number of shards.
+	//
+	// When defined, the operator caches the active targets discovered by the
+	// Prometheus pods and reports under `status.shardScaling` an estimation
+	// of the number of targets which move to another shard when `spec.shards`
+	// changes.
+	//
+	// +optional
+	ShardScaling *ShardScalingSpec `json:"shardScaling,omitempty"`
+
 	// When true, the Prometheus compaction is disabled.
 	// When `spec.thanos.objectStorageConfig` or `spec.objectStorageConfigFile` are defined, the operator automatically
 	// disables block compaction to avoid race conditions during block uploads (as the Thanos documentation recommends).
 	DisableCompaction bool `json:"disableCompaction,omitempty"`
 
+	// Minimum duration of the TSDB blocks (`--storage.tsdb.min-block-duration`).
+	//
+	// When the compaction is disabled (either explicitly or because the Thanos
+	// sidecar uploads the blocks to object storage), the minimum and maximum
+	// durations must be equal. If only one of them is defined, the other one
+	// takes the same value and if none is defined, they default to
+	// `spec.thanos.blockSize`.
+	//
+	// Otherwise it defaults to the Prometheus default value (2h).
+	//
+	// +optional
+	MinBlockDuration *Duration `json:"minBlockDuration,omitempty"`
+
+	// Maximum duration of the TSDB blocks (`--storage.tsdb.max-block-duration`).
+	// It must be greater than or equal to `minBlockDuration`.
+	//
+	// When the compaction is disabled (either explicitly or because the Thanos
+	// sidecar uploads the blocks to object storage), the minimum and maximum
+	// durations must be equal.
+	//
+	// Otherwise it defaults to the Prometheus default value (10% of the
+	// retention time with a maximum of 31d).
+	//
+	// +optional
+	MaxBlockDuration *Duration `json:"maxBlockDuration,omitempty"`
+
 	// Defines the configuration of the Prometheus rules' engine.
 	Rules Rules `json:"rules,omitempty"`
 	// Defines the list of PrometheusRule objects to which the namespace label
@@ -1205,8 +1366,157 @@ type PrometheusSpec struct {
 	// For more information:
 	// https://prometheus.io/docs/prometheus/latest/querying/api/#tsdb-admin-apis
 	EnableAdminAPI bool `json:"enableAdminAPI,omitempty"`
+
+	// Defines a dedicated Service for the remote write receiver endpoint.
+	//
+	// When defined and `spec.enableRemoteWriteReceiver` is true, the
+	// operator creates a Service named `prometheus-<name>-remote-write`
+	// which exposes the web port of the Prometheus pods for the ingest
+	// traffic. It allows to manage the ingest traffic (e.g. with network
+	// policies or load-balancers) independently from the query traffic.
+	//
+	// The receiving endpoint shares the TLS settings of the web server
+	// (`spec.web.tlsConfig`), client authentication can be enforced with
+	// `spec.web.tlsConfig.clientAuthType` and the accepted message versions
+	// are controlled by `spec.remoteWriteReceiverMessageVersions`.
+	//
+	// +optional
+	RemoteWriteReceiverService *RemoteWriteReceiverServiceSpec `json:"remoteWriteReceiverService,omitempty"`
+
+	// Defines the emergency mode of Prometheus.
+	//
+	// When enabled, the operator removes from the generated configuration
+	// all the scrape jobs except the ones matching `spec.emergencyMode.keepJobs`.
+	// It allows to quickly reduce the load of an overloaded Prometheus
+	// during incident response. The full configuration is restored when
+	// the emergency mode is disabled.
+	//
+	// +optional
+	EmergencyMode *EmergencyModeSpec `json:"emergencyMode,omitempty"`
+
+	// Defines a warmup check for the Prometheus pods.
+	//
+	// When defined, the readiness probe of the `prometheus` container
+	// succeeds only after the WAL replay has completed and the warmup query
+	// has been evaluated successfully. It keeps the new replicas out of the
+	// Service endpoints until they can serve queries (e.g. after a restart).
+	//
+	// +optional
+	Warmup *WarmupSpec `json:"warmup,omitempty"`
+
+	// Defines what happens to the objects managed by the operator when the
+	// Prometheus resource is deleted.
+	//
+	// * `Delete`: the StatefulSets, Secrets, ConfigMaps and Services are
+	// garbage-collected and the operator deletes the PersistentVolumeClaims
+	// created from the storage template.
+	// * `Retain`: the operator removes the owner references from the
+	// StatefulSets, Secrets, ConfigMaps and Services which keep running
+	// (orphaned) together with the PersistentVolumeClaims.
+	//
+	// When defined, the operator adds the `monitoring.coreos.com/deletion-policy`
+	// finalizer to the resource. If empty, the objects are garbage-collected
+	// and the PersistentVolumeClaims are kept.
+	//
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// DeletionPolicy defines the fate of the managed objects when the resource is
+// deleted.
+// +kubebuilder:validation:Enum=Delete;Retain
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the managed objects and the
+	// PersistentVolumeClaims.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyRetain orphans the managed objects.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// WarmupSpec defines the warmup check of the Prometheus pods.
+// +k8s:openapi-gen=true
+type WarmupSpec struct {
+	// PromQL expression evaluated against the local Prometheus instance by
+	// the readiness probe (e.g. `up`). The check fails when the HTTP API
+	// returns an error.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Query string `json:"query"`
+
+	// Number of seconds after which the readiness probe times out.
+	//
+	// Defaults to 3 seconds.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// EmergencyModeSpec defines the emergency mode of Prometheus.
+// +k8s:openapi-gen=true
+type EmergencyModeSpec struct {
+	// When true, only the scrape jobs matching `keepJobs` are configured.
+	// +required
+	Enabled bool `json:"enabled"`
+
+	// List of regular expressions matched against the job names of the
+	// scrape configurations (`job_name`). The jobs matching any of the
+	// expressions are kept when the emergency mode is enabled. The
+	// expressions are fully anchored (e.g. `serviceMonitor/kube-system/.*`).
+	//
+	// The job names generated by the operator have the following format:
+	// * `serviceMonitor/<namespace>/<name>/<endpoint index>`
+	// * `podMonitor/<namespace>/<name>/<endpoint index>`
+	// * `probe/<namespace>/<name>`
+	// * `scrapeConfig/<namespace>/<name>`
+	//
+	// If empty, all the scrape jobs are removed.
+	//
+	// +listType=set
+	// +optional
+	KeepJobs []string `json:"keepJobs,omitempty"`
+}
+
+// RemoteWriteReceiverServiceSpec defines the Service exposing the remote
+// write receiver endpoint.
+// +k8s:openapi-gen=true
+type RemoteWriteReceiverServiceSpec struct {
+	// Type of the Service.
+	//
+	// If not defined, it defaults to `ClusterIP`.
+	//
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	// +optional
+	Type *v1.ServiceType `json:"type,omitempty"`
+
+	// Port number exposed by the Service.
+	//
+	// If not defined, it defaults to 9090.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// Labels added to the Service.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to the Service.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+type DuplicateTargetsPolicy string
+
+const (
+	WarnDuplicateTargetsPolicy DuplicateTargetsPolicy = "Warn"
+	DropDuplicateTargetsPolicy DuplicateTargetsPolicy = "Drop"
+)
+
 type WhenScaledRetentionType string
 
 var (
@@ -1234,6 +1544,49 @@ type ShardRetentionPolicy struct {
 	Retain *RetainConfig `json:"retain,omitempty"`
 }
 
+// ConfirmShardsAnnotation is the annotation used to confirm the new number
+// of shards when `spec.shardScaling.confirm` is true.
+const ConfirmShardsAnnotation = "operator.prometheus.io/confirm-shards"
+
+type ShardScalingSpec struct {
+	// When true, the operator doesn't apply a change of `spec.shards` until
+	// the `operator.prometheus.io/confirm-shards` annotation is set to the
+	// new number of shards on the Prometheus object. In the meantime, the
+	// operator keeps running the current number of shards and
+	// `status.shardScaling` reports the planned movement of targets.
+	//
+	// +optional
+	Confirm *bool `json:"confirm,omitempty"`
+}
+
+// ShardScalingStatus reports the planned and observed movement of targets
+// between shards.
+type ShardScalingStatus struct {
+	// Number of shards currently deployed by the operator.
+	// +required
+	CurrentShards int32 `json:"currentShards"`
+	// Number of shards requested by `spec.shards`.
+	// +required
+	RequestedShards int32 `json:"requestedShards"`
+	// True when the requested number of shards waits for confirmation.
+	// +optional
+	PendingConfirmation bool `json:"pendingConfirmation,omitempty"`
+	// Number of active targets known to the operator.
+	// +required
+	Targets int32 `json:"targets"`
+	// Estimated number of targets which will be scraped by a different shard
+	// once the requested number of shards is applied.
+	// +required
+	PlannedTargetMoves int32 `json:"plannedTargetMoves"`
+	// Number of targets which have effectively moved to a different shard
+	// since the last change of the number of shards.
+	// +optional
+	ObservedTargetMoves *int32 `json:"observedTargetMoves,omitempty"`
+	// Time when the targets were last retrieved from the Prometheus pods.
+	// +optional
+	LastTargetsUpdateTime *metav1.Time `json:"lastTargetsUpdateTime,omitempty"`
+}
+
 type PrometheusTracingConfig struct {
 	// Client used to export the traces. Supported values are `http` or `grpc`.
 	// +kubebuilder:validation:Enum=http;grpc
@@ -1304,6 +1657,40 @@ type PrometheusStatus struct {
 	Shards int32 `json:"shards,omitempty"`
 	// The selector used to match the pods targeted by this Prometheus resource.
 	Selector string `json:"selector,omitempty"`
+	// Reports the movement of targets between shards when
+	// `spec.shardScaling` is defined.
+	// +optional
+	ShardScaling *ShardScalingStatus `json:"shardScaling,omitempty"`
+	// The active endpoints of the remote writes which define
+	// `failoverURLs`.
+	// +optional
+	RemoteWriteEndpoints []RemoteWriteEndpointStatus `json:"remoteWriteEndpoints,omitempty"`
+	// The state of the remote write queues, collected from the metrics
+	// exposed by the pods.
+	// Only reported for PrometheusAgent resources.
+	// +listType=atomic
+	// +optional
+	RemoteWriteQueues []RemoteWriteQueueStatus `json:"remoteWriteQueues,omitempty"`
+	// Information about the operator which reconciled the resource
+	// (version and enabled feature gates).
+	// +optional
+	OperatorInfo *OperatorInfo `json:"operatorInfo,omitempty"`
+	// Summary of the configuration resources selected by the resource,
+	// updated at each reconciliation.
+	// Only reported for Prometheus resources.
+	// +optional
+	SelectedResources *SelectedResourcesStatus `json:"selectedResources,omitempty"`
+	// Names of the Secrets holding the scrape configuration files when the
+	// generated configuration exceeds the size limit of a single Secret and
+	// is split across multiple Secrets.
+	// Only reported for Prometheus resources.
+	// +listType=atomic
+	// +optional
+	ScrapeConfigSecrets []string `json:"scrapeConfigSecrets,omitempty"`
+	// Time of the last reconciliation of the resource by the operator,
+	// whether it succeeded or not.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
 }
 
 // AlertingSpec defines parameters for alerting configuration of Prometheus servers.
@@ -1496,6 +1883,8 @@ type ThanosSpec struct {
 	// set to a multiple of 120 times your longest scrape or rule interval. For
 	// example, 30s * 120 = 1h.
 	//
+	// It is ignored when `spec.minBlockDuration` or `spec.maxBlockDuration` is defined.
+	//
 	// +kubebuilder:default:="2h"
 	BlockDuration Duration `json:"blockSize,omitempty"`
 
@@ -1532,6 +1921,22 @@ type RemoteWriteSpec struct {
 	// +required
 	URL string `json:"url"`
 
+	// List of standby URLs for the remote write endpoint.
+	//
+	// When defined, the operator regularly checks whether the endpoints are
+	// reachable (TCP connection) and configures Prometheus to send samples
+	// to the first reachable URL, in order: `url` then `failoverURLs`. If
+	// none of the endpoints is reachable, `url` is used.
+	//
+	// All the URLs share the same remote write configuration
+	// (authentication, TLS, queue, ...).
+	//
+	// The active endpoint is reported in `status.remoteWriteEndpoints`.
+	//
+	// +listType=set
+	// +optional
+	FailoverURLs []string `json:"failoverURLs,omitempty"`
+
 	// The name of the remote write queue, it must be unique if specified. The
 	// name is used in metrics and logging in order to differentiate queues.
 	//
@@ -1686,6 +2091,86 @@ type RemoteWriteSpec struct {
 	RoundRobinDNS *bool `json:"roundRobinDNS,omitempty"`
 }
 
+// RemoteWriteEndpointStatus reports the active endpoint of a remote write
+// which defines failover URLs.
+// +k8s:openapi-gen=true
+type RemoteWriteEndpointStatus struct {
+	// The primary URL of the remote write (`url` field).
+	// +required
+	URL string `json:"url"`
+	// The URL to which Prometheus currently sends samples.
+	// +required
+	ActiveURL string `json:"activeURL"`
+}
+
+// RemoteWriteQueueStatus reports the state of a remote write queue
+// aggregated over all the pods.
+// +k8s:openapi-gen=true
+type RemoteWriteQueueStatus struct {
+	// The name of the remote write queue (`remote_name` label).
+	// +optional
+	Name string `json:"name,omitempty"`
+	// The URL of the remote write endpoint.
+	// +required
+	URL string `json:"url"`
+	// The highest difference between the timestamp of the most recent
+	// sample appended to the WAL and the timestamp of the most recent sample
+	// sent to the endpoint.
+	// +required
+	Lag Duration `json:"lag"`
+	// The number of samples waiting in the queue.
+	// +required
+	PendingSamples int64 `json:"pendingSamples"`
+	// The number of shards used to send samples to the endpoint.
+	// +required
+	Shards int32 `json:"shards"`
+	// The maximum number of shards which can be used for the endpoint.
+	// +optional
+	MaxShards int32 `json:"maxShards,omitempty"`
+	// The number of samples dropped or failed to be sent since the previous
+	// update.
+	// +required
+	DroppedSamples int64 `json:"droppedSamples"`
+	// The time of the last update.
+	// +required
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// SelectedResourcesStatus summarizes the configuration resources selected by
+// a Prometheus resource.
+// +k8s:openapi-gen=true
+type SelectedResourcesStatus struct {
+	// The ServiceMonitors matching the selectors.
+	// +optional
+	ServiceMonitors *SelectionSummary `json:"serviceMonitors,omitempty"`
+	// The PodMonitors matching the selectors.
+	// +optional
+	PodMonitors *SelectionSummary `json:"podMonitors,omitempty"`
+	// The Probes matching the selectors.
+	// +optional
+	Probes *SelectionSummary `json:"probes,omitempty"`
+	// The ScrapeConfigs matching the selectors.
+	// +optional
+	ScrapeConfigs *SelectionSummary `json:"scrapeConfigs,omitempty"`
+	// The PrometheusRules matching the selectors.
+	// +optional
+	PrometheusRules *SelectionSummary `json:"prometheusRules,omitempty"`
+}
+
+// SelectionSummary counts the configuration resources of a kind matching the
+// selectors.
+// +k8s:openapi-gen=true
+type SelectionSummary struct {
+	// The number of resources matching the selectors, including the
+	// rejected resources.
+	// +required
+	Selected int32 `json:"selected"`
+	// The number of selected resources rejected by the operator (e.g.
+	// because of an invalid configuration).
+	// +required
+	Rejected int32 `json:"rejected"`
+}
+
 // +kubebuilder:validation:Enum=V1.0;V2.0
 type RemoteWriteMessageVersion string
 
@@ -2165,6 +2650,19 @@ type ShardStatus struct {
 	AvailableReplicas int32 `json:"availableReplicas"`
 	// Total number of unavailable pods targeted by this shard.
 	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// Progress of the rollout of the shard's pods.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+	// Generation of the resource from which the shard's StatefulSet was
+	// last generated. The shard's StatefulSet is out-of-date when it is
+	// lower than `metadata.generation`.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Reason why the rollout of the shard's pods is waiting (e.g. a pod which
+	// can't be scheduled or a container which keeps crashing).
+	// Empty when the rollout is complete or progressing.
+	// +optional
+	RolloutBlockedReason string `json:"rolloutBlockedReason,omitempty"`
 }
 
 type TSDBSpec struct {
@@ -2329,6 +2827,16 @@ type ScrapeClass struct {
 	//
 	// +optional
 	AttachMetadata *AttachMetadata `json:"attachMetadata,omitempty"`
+
+	// ProxyConfig defines the proxy settings to use for the scrape.
+	// It will only apply if the scrape object doesn't define any proxy
+	// settings.
+	//
+	// The Secrets referenced by `proxyConnectHeader` must be in the
+	// same namespace as the Prometheus or PrometheusAgent object.
+	//
+	// +optional
+	ProxyConfig *ProxyConfig `json:"proxyConfig,omitempty"`
 }
 
 // TranslationStrategyOption represents a translation strategy option for the OTLP endpoint.
@@ -110,6 +110,13 @@ type Rule struct {
 	// +optional
 	For *Duration `json:"for,omitempty"`
 	// KeepFiringFor defines how long an alert will continue firing after the condition that triggered it has cleared.
+	//
+	// It requires Prometheus >= v2.42.0 or Thanos >= v0.34.0. The operator
+	// removes the field from the generated configuration when the version of the
+	// Prometheus or ThanosRuler resource doesn't support it and reports a warning
+	// in the `Reconciled` condition of the resource.
+	//
+	// Only valid for alerting rules.
 	// +optional
 	KeepFiringFor *NonEmptyDuration `json:"keep_firing_for,omitempty"`
 	// Labels to add or overwrite.
@@ -29,6 +29,9 @@ const (
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="smon"
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Bindings",type="integer",JSONPath=".status.totalBindings",description="The number of workload resources which select the resource"
+// +kubebuilder:printcolumn:name="Accepted",type="integer",JSONPath=".status.acceptedBindings",description="The number of workload resources which accepted the resource"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // The `ServiceMonitor` custom resource definition (CRD) defines how `Prometheus` and `PrometheusAgent` can scrape metrics from a group of services.
 // Among other things, it allows to specify:
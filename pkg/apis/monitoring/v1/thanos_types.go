@@ -115,6 +115,11 @@ type ThanosRulerSpec struct {
 	// If not provided, no requests/limits will be set
 	Resources v1.ResourceRequirements `json:"resources,omitempty"`
 
+	// Overrides the settings of the 'config-reloader'
+	// container defined at the operator level.
+	// +optional
+	ConfigReloader *ConfigReloaderSpec `json:"configReloader,omitempty"`
+
 	// If specified, the pod's scheduling constraints.
 	// +optional
 	Affinity *v1.Affinity `json:"affinity,omitempty"`
@@ -465,6 +470,34 @@ type ThanosRulerSpec struct {
 	// +kubebuilder:validation:Minimum:=0
 	// +optional
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// Defines the self-monitoring resources of the ThanosRuler instance.
+	//
+	// When defined, the operator creates a ServiceMonitor scraping the Thanos
+	// Ruler pods and a PrometheusRule with baseline alerting rules (rule
+	// evaluation failures, query errors). Both objects are named
+	// `thanos-ruler-<name>` and they are deleted when the field is removed.
+	//
+	// +optional
+	SelfMonitoring *ThanosRulerSelfMonitoring `json:"selfMonitoring,omitempty"`
+}
+
+// ThanosRulerSelfMonitoring defines the self-monitoring resources of
+// ThanosRuler.
+type ThanosRulerSelfMonitoring struct {
+	// Labels added to the ServiceMonitor and PrometheusRule objects (e.g. to
+	// match the `serviceMonitorSelector` and `ruleSelector` fields of the
+	// Prometheus resource monitoring the ThanosRuler instance).
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Interval at which the Thanos Ruler pods are scraped.
+	//
+	// If empty, Prometheus uses the global scrape interval.
+	//
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
 }
 
 // ThanosRulerWebSpec defines the configuration of the ThanosRuler web server.
@@ -497,6 +530,10 @@ type ThanosRulerStatus struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+	// Information about the operator which reconciled the resource
+	// (version and enabled feature gates).
+	// +optional
+	OperatorInfo *OperatorInfo `json:"operatorInfo,omitempty"`
 }
 
 func (tr *ThanosRuler) ExpectedReplicas() int {
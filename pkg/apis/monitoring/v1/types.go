@@ -222,6 +222,41 @@ type ArbitraryFSAccessThroughSMsConfig struct {
 	Deny bool `json:"deny,omitempty"`
 }
 
+// OperatorInfo describes the Prometheus operator instance which reconciled
+// the Prometheus, PrometheusAgent, Alertmanager or ThanosRuler resource.
+// +k8s:deepcopy-gen=true
+type OperatorInfo struct {
+	// Version of the operator.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// The feature gates enabled in the operator.
+	// +listType=set
+	// +optional
+	EnabledFeatureGates []string `json:"enabledFeatureGates,omitempty"`
+}
+
+// RolloutStatus describes the progress of the rollout of the pods managed by a
+// StatefulSet.
+// +k8s:deepcopy-gen=true
+type RolloutStatus struct {
+	// Revision of the pods which haven't been updated yet.
+	// +optional
+	CurrentRevision string `json:"currentRevision,omitempty"`
+	// Number of pods running the current revision.
+	CurrentReplicas int32 `json:"currentReplicas"`
+	// Revision of the pods being rolled out.
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty"`
+	// Number of pods running the update revision.
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// Number of available pods (ready for at least minReadySeconds) running
+	// the update revision.
+	UpdatedAvailableReplicas int32 `json:"updatedAvailableReplicas"`
+	// Whether all the desired pods run the update revision and are
+	// available.
+	Complete bool `json:"complete"`
+}
+
 // Condition represents the state of the resources associated with the
 // Prometheus, Alertmanager or ThanosRuler resource.
 // +k8s:deepcopy-gen=true
@@ -275,6 +310,57 @@ const (
 	// - False: the controller rejected the configuration due to an error.
 	// - Unknown: the operator couldn't determine the condition status.
 	Accepted ConditionType = "Accepted"
+	// Paused indicates whether the reconciliation of the workload resource is
+	// paused, either by the `spec.paused` field or by the
+	// `operator.prometheus.io/paused: "true"` annotation.
+	// The possible status values for this condition type are:
+	// - True: the operator doesn't modify the underlying resources (including
+	// the generated configuration).
+	// - False: the reconciliation isn't paused.
+	Paused ConditionType = "Paused"
+	// RemoteWriteLagging indicates whether the samples are sent to the
+	// remote write endpoints with a significant delay.
+	// Only reported for PrometheusAgent resources.
+	// The possible status values for this condition type are:
+	// - True: the lag of at least one remote write queue exceeds the threshold.
+	// - False: all the remote write queues are up-to-date.
+	// - Unknown: the operator couldn't collect the remote write metrics.
+	RemoteWriteLagging ConditionType = "RemoteWriteLagging"
+	// DroppingSamples indicates whether samples are dropped or failed to be
+	// sent to the remote write endpoints.
+	// Only reported for PrometheusAgent resources.
+	// The possible status values for this condition type are:
+	// - True: samples were dropped since the previous update.
+	// - False: no sample was dropped since the previous update.
+	// - Unknown: the operator couldn't collect the remote write metrics.
+	DroppingSamples ConditionType = "DroppingSamples"
+	// ConfigOutOfSync indicates whether the last configuration reload
+	// triggered by the config-reloader sidecar failed.
+	// Only reported for Prometheus and Alertmanager resources.
+	// The possible status values for this condition type are:
+	// - True: the last reload failed for at least one pod, the running
+	// configuration differs from the generated configuration.
+	// - False: the last reload succeeded for all the pods.
+	// - Unknown: the operator couldn't collect the reload status.
+	ConfigOutOfSync ConditionType = "ConfigOutOfSync"
+	// DuplicateTargets indicates whether selected ServiceMonitors or
+	// ScrapeConfigs scrape the same targets with the same labels.
+	// Only reported for Prometheus and PrometheusAgent resources.
+	// The possible status values for this condition type are:
+	// - True: at least two resources scrape the same targets, the message
+	// lists the duplicated resources.
+	// - False: no duplicated resource was detected.
+	DuplicateTargets ConditionType = "DuplicateTargets"
+	// CustomResourceDiscoveryFailed indicates whether the operator failed to
+	// discover the targets of the custom resource service discovery
+	// configurations of the selected ScrapeConfigs.
+	// Only reported for Prometheus resources.
+	// The possible status values for this condition type are:
+	// - True: the custom resources of at least one configuration couldn't be
+	// listed (e.g. the operator lacks the permission) or the expressions
+	// failed to evaluate, the last discovered targets are kept.
+	// - False: the targets of all the configurations were discovered.
+	CustomResourceDiscoveryFailed ConditionType = "CustomResourceDiscoveryFailed"
 )
 
 // +kubebuilder:validation:MinLength=1
@@ -418,6 +504,19 @@ type WebTLSConfig struct {
 	// +optional
 	KeyFile *string `json:"keyFile,omitempty"`
 
+	// Name of a Secret containing the TLS certificate and private key for
+	// the web server under the `tls.crt` and `tls.key` keys.
+	//
+	// This is the layout of the Secrets managed by cert-manager for
+	// `Certificate` resources. The mounted files are refreshed when the
+	// certificate is renewed.
+	//
+	// It is mutually exclusive with `cert`, `certFile`, `keySecret` and `keyFile`.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CertificateSecret *string `json:"certificateSecret,omitempty"`
+
 	// Secret or ConfigMap containing the CA certificate for client certificate
 	// authentication to the server.
 	//
@@ -508,6 +607,20 @@ func (c *WebTLSConfig) Validate() error {
 		return errors.New("cannot specify both keyFile and keySecret")
 	}
 
+	if c.CertificateSecret != nil {
+		if *c.CertificateSecret == "" {
+			return errors.New("certificateSecret cannot be empty")
+		}
+
+		hasCert := (c.CertFile != nil && *c.CertFile != "") || c.Cert != (SecretOrConfigMap{})
+		hasKey := (c.KeyFile != nil && *c.KeyFile != "") || c.KeySecret != (v1.SecretKeySelector{})
+		if hasCert || hasKey {
+			return errors.New("cannot specify certificateSecret with cert, certFile, keySecret or keyFile")
+		}
+
+		return nil
+	}
+
 	if (c.KeyFile == nil || *c.KeyFile == "") && c.KeySecret == (v1.SecretKeySelector{}) {
 		return errors.New("TLS private key must be defined")
 	}
@@ -519,6 +632,22 @@ func (c *WebTLSConfig) Validate() error {
 	return nil
 }
 
+// CertAndKeySecret returns the references to the TLS certificate and private
+// key of the web server.
+// If `certificateSecret` is defined, they point to the `tls.crt` and `tls.key`
+// keys of the Secret.
+func (c *WebTLSConfig) CertAndKeySecret() (SecretOrConfigMap, v1.SecretKeySelector) {
+	if c.CertificateSecret == nil {
+		return c.Cert, c.KeySecret
+	}
+
+	ref := v1.LocalObjectReference{Name: *c.CertificateSecret}
+	return SecretOrConfigMap{
+			Secret: &v1.SecretKeySelector{LocalObjectReference: ref, Key: v1.TLSCertKey},
+		},
+		v1.SecretKeySelector{LocalObjectReference: ref, Key: v1.TLSPrivateKeyKey}
+}
+
 // LabelName is a valid Prometheus label name which may only contain ASCII
 // letters, numbers, as well as underscores.
 //
@@ -896,6 +1025,52 @@ type TLSConfig struct {
 	CertFile string `json:"certFile,omitempty"`
 	// Path to the client key file in the Prometheus container for the targets.
 	KeyFile string `json:"keyFile,omitempty"`
+
+	// Name of a Secret in the same namespace containing the client
+	// certificate and private key under the `tls.crt` and `tls.key` keys.
+	// When neither `ca` nor `caFile` is defined, the `ca.crt` key of the
+	// Secret (if present) is used as the certificate authority.
+	//
+	// This is the layout of the Secrets managed by cert-manager for
+	// `Certificate` resources. The configuration is updated whenever the
+	// Secret changes (e.g. when the certificate is renewed).
+	//
+	// It is mutually exclusive with `cert`, `keySecret`, `certFile` and `keyFile`.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CertificateSecret *string `json:"certificateSecret,omitempty"`
+}
+
+// CertificateSecretCAKey is the key of the CA certificate in Secrets
+// referenced by `certificateSecret`.
+const CertificateSecretCAKey = "ca.crt"
+
+// SafeTLSConfigWithCertificateSecret returns the SafeTLSConfig with the
+// client certificate and key referencing the `certificateSecret` Secret.
+// When hasCA is true and no CA is defined, the CA references the
+// CertificateSecretCAKey key of the Secret.
+//
+// It returns the SafeTLSConfig unmodified if `certificateSecret` isn't defined.
+func (c *TLSConfig) SafeTLSConfigWithCertificateSecret(hasCA bool) SafeTLSConfig {
+	safeTLSConfig := c.SafeTLSConfig
+	if c.CertificateSecret == nil {
+		return safeTLSConfig
+	}
+
+	ref := v1.LocalObjectReference{Name: *c.CertificateSecret}
+	safeTLSConfig.Cert = SecretOrConfigMap{
+		Secret: &v1.SecretKeySelector{LocalObjectReference: ref, Key: v1.TLSCertKey},
+	}
+	safeTLSConfig.KeySecret = &v1.SecretKeySelector{LocalObjectReference: ref, Key: v1.TLSPrivateKeyKey}
+
+	if hasCA && c.CA == (SecretOrConfigMap{}) && c.CAFile == "" {
+		safeTLSConfig.CA = SecretOrConfigMap{
+			Secret: &v1.SecretKeySelector{LocalObjectReference: ref, Key: CertificateSecretCAKey},
+		}
+	}
+
+	return safeTLSConfig
 }
 
 // Validate semantically validates the given TLSConfig.
@@ -926,6 +1101,16 @@ func (c *TLSConfig) Validate() error {
 		return fmt.Errorf("cannot specify both keyFile and keySecret")
 	}
 
+	if c.CertificateSecret != nil {
+		if *c.CertificateSecret == "" {
+			return fmt.Errorf("certificateSecret cannot be empty")
+		}
+
+		if c.CertFile != "" || c.Cert != (SecretOrConfigMap{}) || c.KeyFile != "" || c.KeySecret != nil {
+			return fmt.Errorf("cannot specify certificateSecret with cert, certFile, keySecret or keyFile")
+		}
+	}
+
 	hasCert := c.CertFile != "" || c.Cert != (SecretOrConfigMap{})
 	hasKey := c.KeyFile != "" || c.KeySecret != nil
 
@@ -1028,6 +1213,12 @@ type ConfigResourceStatus struct {
 	// The list of workload resources (Prometheus or PrometheusAgent) which select the configuration resource.
 	// +optional
 	Bindings []WorkloadBinding `json:"bindings,omitempty"`
+	// The number of workload resources which select the configuration resource.
+	// +optional
+	TotalBindings int32 `json:"totalBindings,omitempty"`
+	// The number of workload resources which have accepted the configuration resource.
+	// +optional
+	AcceptedBindings int32 `json:"acceptedBindings,omitempty"`
 }
 
 // WorkloadBinding is a link between a configuration resource and a workload resource.
@@ -1054,6 +1245,13 @@ type WorkloadBinding struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []ConfigResourceCondition `json:"conditions,omitempty"`
+	// The scrape statistics of the targets generated by the configuration
+	// resource for the referenced Prometheus object.
+	//
+	// The statistics are only reported for Prometheus objects and require
+	// the operator to reach the Prometheus API.
+	// +optional
+	ScrapeStatistics *ScrapeStatistics `json:"scrapeStatistics,omitempty"`
 }
 
 // ConfigResourceCondition describes the status of configuration resources linked to Prometheus, PrometheusAgent, Alertmanager, or ThanosRuler.
@@ -1082,3 +1280,134 @@ type ConfigResourceCondition struct {
 	// condition is out of date with respect to the current state of the object.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
+
+// ScrapeStatistics summarizes the scrape health of the targets generated by a
+// configuration resource, as reported by the Prometheus targets API.
+// +k8s:openapi-gen=true
+type ScrapeStatistics struct {
+	// The number of active targets.
+	// +required
+	Targets int32 `json:"targets"`
+	// The number of targets which haven't been scraped for more than 2
+	// scrape intervals.
+	// +required
+	StaleTargets int32 `json:"staleTargets"`
+	// The median of the last scrape duration of the targets.
+	// +optional
+	LastScrapeDurationP50 Duration `json:"lastScrapeDurationP50,omitempty"`
+	// The 90th percentile of the last scrape duration of the targets.
+	// +optional
+	LastScrapeDurationP90 Duration `json:"lastScrapeDurationP90,omitempty"`
+	// The 99th percentile of the last scrape duration of the targets.
+	// +optional
+	LastScrapeDurationP99 Duration `json:"lastScrapeDurationP99,omitempty"`
+	// The median of the scrape interval drift of the targets, in percent of
+	// the scrape interval.
+	//
+	// The drift is the delay of the next scrape compared to the configured
+	// scrape interval when the statistics are computed.
+	// +optional
+	IntervalDriftPercentP50 int32 `json:"intervalDriftPercentP50,omitempty"`
+	// The 90th percentile of the scrape interval drift of the targets, in
+	// percent of the scrape interval.
+	// +optional
+	IntervalDriftPercentP90 int32 `json:"intervalDriftPercentP90,omitempty"`
+	// The 99th percentile of the scrape interval drift of the targets, in
+	// percent of the scrape interval.
+	// +optional
+	IntervalDriftPercentP99 int32 `json:"intervalDriftPercentP99,omitempty"`
+	// The time when the statistics have been computed.
+	// +required
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// ConfigReloaderSpec overrides the settings of the `init-config-reloader` and
+// `config-reloader` containers which are defined at the operator level.
+// +k8s:openapi-gen=true
+type ConfigReloaderSpec struct {
+	// The container image of the config-reloader. It takes precedence over
+	// the `--prometheus-config-reloader` operator flag.
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// The resource requests and limits of the config-reloader containers.
+	// When defined, they replace the values of the
+	// `--config-reloader-{cpu,memory}-{request,limit}` operator flags.
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// Additional volume mounts of the config-reloader containers. The
+	// config-reloader watches the mount paths and reloads the configuration
+	// when their content changes (e.g. ConfigMaps with rule fragments or web
+	// configuration files mounted with `spec.volumes` and `spec.volumeMounts`).
+	//
+	// The volumes must be defined in `spec.volumes`.
+	// +optional
+	WatchedVolumeMounts []v1.VolumeMount `json:"watchedVolumeMounts,omitempty"`
+	// When true, the readiness probe of the config-reloader container fails
+	// while the last configuration reload failed. Because the pods aren't
+	// ready, the rollouts halt instead of continuing with a configuration
+	// which can't be loaded.
+	//
+	// It has no effect with the `ProcessSignal` reload strategy.
+	// +optional
+	FailReadinessOnReloadError *bool `json:"failReadinessOnReloadError,omitempty"`
+	// Defines how the config-reloader calls the reload endpoint when the web
+	// server requires TLS or authentication.
+	// +optional
+	ReloadClient *ConfigReloaderClientConfig `json:"reloadClient,omitempty"`
+}
+
+// ConfigReloaderClientConfig defines the HTTP client settings used by the
+// config-reloader to call the reload endpoint.
+//
+// +k8s:openapi-gen=true
+type ConfigReloaderClientConfig struct {
+	// Scheme of the reload URL.
+	//
+	// When not defined, the scheme is `https` if the web server has TLS
+	// enabled and `http` otherwise.
+	//
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	Scheme *string `json:"scheme,omitempty"`
+	// Secret or ConfigMap containing the CA certificate used to verify the
+	// certificate of the web server.
+	//
+	// When not defined, the certificate of the web server isn't verified.
+	//
+	// +optional
+	CA SecretOrConfigMap `json:"ca,omitempty"`
+	// Used to verify the hostname of the web server's certificate.
+	// +optional
+	ServerName *string `json:"serverName,omitempty"`
+	// Name of a Secret containing the client certificate and private key
+	// under the `tls.crt` and `tls.key` keys.
+	//
+	// When not defined and the web server requires client certificates
+	// (`RequireAnyClientCert` or `RequireAndVerifyClientCert` client
+	// authentication types), the config-reloader presents the certificate of
+	// the web server.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CertificateSecret *string `json:"certificateSecret,omitempty"`
+	// Basic authentication credentials sent with the reload requests.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+}
+
+// Validate semantically validates the given ConfigReloaderClientConfig.
+func (c *ConfigReloaderClientConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if err := c.CA.Validate(); err != nil {
+		return fmt.Errorf("invalid CA: %w", err)
+	}
+
+	if c.CertificateSecret != nil && *c.CertificateSecret == "" {
+		return errors.New("certificateSecret cannot be empty")
+	}
+
+	return nil
+}
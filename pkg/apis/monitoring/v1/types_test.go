@@ -261,6 +261,48 @@ func TestValidateTLSConfig(t *testing.T) {
 			},
 			err: true,
 		},
+		{
+			name: "certificateSecret",
+			config: &TLSConfig{
+				CertificateSecret: func(s string) *string { return &s }("tls"),
+			},
+			err: false,
+		},
+		{
+			name: "certificateSecret and caFile",
+			config: &TLSConfig{
+				CAFile:            "cafile",
+				CertificateSecret: func(s string) *string { return &s }("tls"),
+			},
+			err: false,
+		},
+		{
+			name: "certificateSecret and certFile",
+			config: &TLSConfig{
+				CertFile:          "certfile",
+				KeyFile:           "keyfile",
+				CertificateSecret: func(s string) *string { return &s }("tls"),
+			},
+			err: true,
+		},
+		{
+			name: "certificateSecret and keySecret",
+			config: &TLSConfig{
+				SafeTLSConfig: SafeTLSConfig{
+					Cert:      SecretOrConfigMap{Secret: &v1.SecretKeySelector{}},
+					KeySecret: &v1.SecretKeySelector{},
+				},
+				CertificateSecret: func(s string) *string { return &s }("tls"),
+			},
+			err: true,
+		},
+		{
+			name: "empty certificateSecret",
+			config: &TLSConfig{
+				CertificateSecret: func(s string) *string { return &s }(""),
+			},
+			err: true,
+		},
 		{
 			name:   "tlsconfig nil",
 			config: nil,
@@ -375,6 +417,34 @@ func TestValidateWebTlsConfig(t *testing.T) {
 			},
 			err: true,
 		},
+		{
+			name: "certificateSecret and caFile",
+			config: &WebTLSConfig{
+				ClientCAFile:      func(s string) *string { return &s }("cafile"),
+				CertificateSecret: func(s string) *string { return &s }("tls"),
+			},
+		},
+		{
+			name: "certificateSecret and certFile",
+			config: &WebTLSConfig{
+				CertFile:          func(s string) *string { return &s }("certfile"),
+				CertificateSecret: func(s string) *string { return &s }("tls"),
+			},
+			err: true,
+		},
+		{
+			name: "certificateSecret and keySecret",
+			config: &WebTLSConfig{
+				KeySecret: v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: "test-secret",
+					},
+					Key: "tls.key",
+				},
+				CertificateSecret: func(s string) *string { return &s }("tls"),
+			},
+			err: true,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			err := tc.config.Validate()
@@ -399,6 +399,11 @@ func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 		}
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.ConfigReloader != nil {
+		in, out := &in.ConfigReloader, &out.ConfigReloader
+		*out = new(ConfigReloaderSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
 		*out = new(corev1.Affinity)
@@ -462,6 +467,11 @@ func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalPeersHealthCheck != nil {
+		in, out := &in.AdditionalPeersHealthCheck, &out.AdditionalPeersHealthCheck
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ClusterLabel != nil {
 		in, out := &in.ClusterLabel, &out.ClusterLabel
 		*out = new(string)
@@ -478,6 +488,11 @@ func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.AlertmanagerConfigMatcherStrategy = in.AlertmanagerConfigMatcherStrategy
+	if in.AlertmanagerConfigResolveTimeoutBounds != nil {
+		in, out := &in.AlertmanagerConfigResolveTimeoutBounds, &out.AlertmanagerConfigResolveTimeoutBounds
+		*out = new(DurationBounds)
+		**out = **in
+	}
 	if in.MinReadySeconds != nil {
 		in, out := &in.MinReadySeconds, &out.MinReadySeconds
 		*out = new(uint32)
@@ -545,6 +560,11 @@ func (in *AlertmanagerSpec) DeepCopy() *AlertmanagerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertmanagerStatus) DeepCopyInto(out *AlertmanagerStatus) {
 	*out = *in
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]Condition, len(*in))
@@ -552,6 +572,16 @@ func (in *AlertmanagerStatus) DeepCopyInto(out *AlertmanagerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UnreachableAdditionalPeers != nil {
+		in, out := &in.UnreachableAdditionalPeers, &out.UnreachableAdditionalPeers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OperatorInfo != nil {
+		in, out := &in.OperatorInfo, &out.OperatorInfo
+		*out = new(OperatorInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerStatus.
@@ -824,6 +854,11 @@ func (in *CommonPrometheusFields) DeepCopyInto(out *CommonPrometheusFields) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DuplicateTargetsPolicy != nil {
+		in, out := &in.DuplicateTargetsPolicy, &out.DuplicateTargetsPolicy
+		*out = new(DuplicateTargetsPolicy)
+		**out = **in
+	}
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
 		*out = new(string)
@@ -911,6 +946,11 @@ func (in *CommonPrometheusFields) DeepCopyInto(out *CommonPrometheusFields) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.ConfigReloader != nil {
+		in, out := &in.ConfigReloader, &out.ConfigReloader
+		*out = new(ConfigReloaderSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
@@ -1136,11 +1176,26 @@ func (in *CommonPrometheusFields) DeepCopyInto(out *CommonPrometheusFields) {
 		*out = new(ReloadStrategyType)
 		**out = **in
 	}
+	if in.ConfigCompression != nil {
+		in, out := &in.ConfigCompression, &out.ConfigCompression
+		*out = new(ConfigCompressionType)
+		**out = **in
+	}
+	if in.ConfigUpdateDebounce != nil {
+		in, out := &in.ConfigUpdateDebounce, &out.ConfigUpdateDebounce
+		*out = new(ConfigUpdateDebounce)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MaximumStartupDurationSeconds != nil {
 		in, out := &in.MaximumStartupDurationSeconds, &out.MaximumStartupDurationSeconds
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MaximumStartupDurationMode != nil {
+		in, out := &in.MaximumStartupDurationMode, &out.MaximumStartupDurationMode
+		*out = new(StartupDurationModeType)
+		**out = **in
+	}
 	if in.ScrapeClasses != nil {
 		in, out := &in.ScrapeClasses, &out.ScrapeClasses
 		*out = make([]ScrapeClass, len(*in))
@@ -1206,6 +1261,84 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigReloaderClientConfig) DeepCopyInto(out *ConfigReloaderClientConfig) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(string)
+		**out = **in
+	}
+	in.CA.DeepCopyInto(&out.CA)
+	if in.ServerName != nil {
+		in, out := &in.ServerName, &out.ServerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertificateSecret != nil {
+		in, out := &in.CertificateSecret, &out.CertificateSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigReloaderClientConfig.
+func (in *ConfigReloaderClientConfig) DeepCopy() *ConfigReloaderClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigReloaderClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigReloaderSpec) DeepCopyInto(out *ConfigReloaderSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WatchedVolumeMounts != nil {
+		in, out := &in.WatchedVolumeMounts, &out.WatchedVolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailReadinessOnReloadError != nil {
+		in, out := &in.FailReadinessOnReloadError, &out.FailReadinessOnReloadError
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReloadClient != nil {
+		in, out := &in.ReloadClient, &out.ReloadClient
+		*out = new(ConfigReloaderClientConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigReloaderSpec.
+func (in *ConfigReloaderSpec) DeepCopy() *ConfigReloaderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigReloaderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigResourceCondition) DeepCopyInto(out *ConfigResourceCondition) {
 	*out = *in
@@ -1244,6 +1377,26 @@ func (in *ConfigResourceStatus) DeepCopy() *ConfigResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigUpdateDebounce) DeepCopyInto(out *ConfigUpdateDebounce) {
+	*out = *in
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigUpdateDebounce.
+func (in *ConfigUpdateDebounce) DeepCopy() *ConfigUpdateDebounce {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigUpdateDebounce)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoreV1TopologySpreadConstraint) DeepCopyInto(out *CoreV1TopologySpreadConstraint) {
 	*out = *in
@@ -1284,6 +1437,21 @@ func (in *CoreV1TopologySpreadConstraint) DeepCopy() *CoreV1TopologySpreadConstr
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DurationBounds) DeepCopyInto(out *DurationBounds) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DurationBounds.
+func (in *DurationBounds) DeepCopy() *DurationBounds {
+	if in == nil {
+		return nil
+	}
+	out := new(DurationBounds)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmbeddedObjectMetadata) DeepCopyInto(out *EmbeddedObjectMetadata) {
 	*out = *in
@@ -1332,6 +1500,26 @@ func (in *EmbeddedPersistentVolumeClaim) DeepCopy() *EmbeddedPersistentVolumeCla
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmergencyModeSpec) DeepCopyInto(out *EmergencyModeSpec) {
+	*out = *in
+	if in.KeepJobs != nil {
+		in, out := &in.KeepJobs, &out.KeepJobs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmergencyModeSpec.
+func (in *EmergencyModeSpec) DeepCopy() *EmergencyModeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EmergencyModeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Endpoint) DeepCopyInto(out *Endpoint) {
 	*out = *in
@@ -1929,6 +2117,26 @@ func (in *ObjectReference) DeepCopy() *ObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorInfo) DeepCopyInto(out *OperatorInfo) {
+	*out = *in
+	if in.EnabledFeatureGates != nil {
+		in, out := &in.EnabledFeatureGates, &out.EnabledFeatureGates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorInfo.
+func (in *OperatorInfo) DeepCopy() *OperatorInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodDNSConfig) DeepCopyInto(out *PodDNSConfig) {
 	*out = *in
@@ -2094,6 +2302,7 @@ func (in *PodMonitor) DeepCopyInto(out *PodMonitor) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodMonitor.
@@ -2226,6 +2435,7 @@ func (in *Probe) DeepCopyInto(out *Probe) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probe.
@@ -2598,6 +2808,21 @@ func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 		*out = new(ShardRetentionPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShardScaling != nil {
+		in, out := &in.ShardScaling, &out.ShardScaling
+		*out = new(ShardScalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinBlockDuration != nil {
+		in, out := &in.MinBlockDuration, &out.MinBlockDuration
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.MaxBlockDuration != nil {
+		in, out := &in.MaxBlockDuration, &out.MaxBlockDuration
+		*out = new(Duration)
+		**out = **in
+	}
 	out.Rules = in.Rules
 	if in.PrometheusRulesExcludedFromEnforce != nil {
 		in, out := &in.PrometheusRulesExcludedFromEnforce, &out.PrometheusRulesExcludedFromEnforce
@@ -2656,6 +2881,26 @@ func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 		*out = new(Duration)
 		**out = **in
 	}
+	if in.RemoteWriteReceiverService != nil {
+		in, out := &in.RemoteWriteReceiverService, &out.RemoteWriteReceiverService
+		*out = new(RemoteWriteReceiverServiceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EmergencyMode != nil {
+		in, out := &in.EmergencyMode, &out.EmergencyMode
+		*out = new(EmergencyModeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Warmup != nil {
+		in, out := &in.Warmup, &out.Warmup
+		*out = new(WarmupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusSpec.
@@ -2681,8 +2926,46 @@ func (in *PrometheusStatus) DeepCopyInto(out *PrometheusStatus) {
 	if in.ShardStatuses != nil {
 		in, out := &in.ShardStatuses, &out.ShardStatuses
 		*out = make([]ShardStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ShardScaling != nil {
+		in, out := &in.ShardScaling, &out.ShardScaling
+		*out = new(ShardScalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteWriteEndpoints != nil {
+		in, out := &in.RemoteWriteEndpoints, &out.RemoteWriteEndpoints
+		*out = make([]RemoteWriteEndpointStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.RemoteWriteQueues != nil {
+		in, out := &in.RemoteWriteQueues, &out.RemoteWriteQueues
+		*out = make([]RemoteWriteQueueStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OperatorInfo != nil {
+		in, out := &in.OperatorInfo, &out.OperatorInfo
+		*out = new(OperatorInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelectedResources != nil {
+		in, out := &in.SelectedResources, &out.SelectedResources
+		*out = new(SelectedResourcesStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScrapeConfigSecrets != nil {
+		in, out := &in.ScrapeConfigSecrets, &out.ScrapeConfigSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusStatus.
@@ -2986,9 +3269,84 @@ func (in *RemoteReadSpec) DeepCopy() *RemoteReadSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteWriteEndpointStatus) DeepCopyInto(out *RemoteWriteEndpointStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteWriteEndpointStatus.
+func (in *RemoteWriteEndpointStatus) DeepCopy() *RemoteWriteEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteWriteEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteWriteQueueStatus) DeepCopyInto(out *RemoteWriteQueueStatus) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteWriteQueueStatus.
+func (in *RemoteWriteQueueStatus) DeepCopy() *RemoteWriteQueueStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteWriteQueueStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteWriteReceiverServiceSpec) DeepCopyInto(out *RemoteWriteReceiverServiceSpec) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(corev1.ServiceType)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteWriteReceiverServiceSpec.
+func (in *RemoteWriteReceiverServiceSpec) DeepCopy() *RemoteWriteReceiverServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteWriteReceiverServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteWriteSpec) DeepCopyInto(out *RemoteWriteSpec) {
 	*out = *in
+	if in.FailoverURLs != nil {
+		in, out := &in.FailoverURLs, &out.FailoverURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -3111,6 +3469,21 @@ func (in *RetainConfig) DeepCopy() *RetainConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rule) DeepCopyInto(out *Rule) {
 	*out = *in
@@ -3350,6 +3723,11 @@ func (in *ScrapeClass) DeepCopyInto(out *ScrapeClass) {
 		*out = new(AttachMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProxyConfig != nil {
+		in, out := &in.ProxyConfig, &out.ProxyConfig
+		*out = new(ProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeClass.
@@ -3362,6 +3740,22 @@ func (in *ScrapeClass) DeepCopy() *ScrapeClass {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeStatistics) DeepCopyInto(out *ScrapeStatistics) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeStatistics.
+func (in *ScrapeStatistics) DeepCopy() *ScrapeStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretOrConfigMap) DeepCopyInto(out *SecretOrConfigMap) {
 	*out = *in
@@ -3387,6 +3781,61 @@ func (in *SecretOrConfigMap) DeepCopy() *SecretOrConfigMap {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectedResourcesStatus) DeepCopyInto(out *SelectedResourcesStatus) {
+	*out = *in
+	if in.ServiceMonitors != nil {
+		in, out := &in.ServiceMonitors, &out.ServiceMonitors
+		*out = new(SelectionSummary)
+		**out = **in
+	}
+	if in.PodMonitors != nil {
+		in, out := &in.PodMonitors, &out.PodMonitors
+		*out = new(SelectionSummary)
+		**out = **in
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(SelectionSummary)
+		**out = **in
+	}
+	if in.ScrapeConfigs != nil {
+		in, out := &in.ScrapeConfigs, &out.ScrapeConfigs
+		*out = new(SelectionSummary)
+		**out = **in
+	}
+	if in.PrometheusRules != nil {
+		in, out := &in.PrometheusRules, &out.PrometheusRules
+		*out = new(SelectionSummary)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectedResourcesStatus.
+func (in *SelectedResourcesStatus) DeepCopy() *SelectedResourcesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectedResourcesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectionSummary) DeepCopyInto(out *SelectionSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectionSummary.
+func (in *SelectionSummary) DeepCopy() *SelectionSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectionSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceMonitor) DeepCopyInto(out *ServiceMonitor) {
 	*out = *in
@@ -3550,9 +3999,58 @@ func (in *ShardRetentionPolicy) DeepCopy() *ShardRetentionPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardScalingSpec) DeepCopyInto(out *ShardScalingSpec) {
+	*out = *in
+	if in.Confirm != nil {
+		in, out := &in.Confirm, &out.Confirm
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardScalingSpec.
+func (in *ShardScalingSpec) DeepCopy() *ShardScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardScalingStatus) DeepCopyInto(out *ShardScalingStatus) {
+	*out = *in
+	if in.ObservedTargetMoves != nil {
+		in, out := &in.ObservedTargetMoves, &out.ObservedTargetMoves
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastTargetsUpdateTime != nil {
+		in, out := &in.LastTargetsUpdateTime, &out.LastTargetsUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardScalingStatus.
+func (in *ShardScalingStatus) DeepCopy() *ShardScalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardScalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShardStatus) DeepCopyInto(out *ShardStatus) {
 	*out = *in
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardStatus.
@@ -3620,6 +4118,11 @@ func (in *StorageSpec) DeepCopy() *StorageSpec {
 func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
 	*out = *in
 	in.SafeTLSConfig.DeepCopyInto(&out.SafeTLSConfig)
+	if in.CertificateSecret != nil {
+		in, out := &in.CertificateSecret, &out.CertificateSecret
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
@@ -3695,6 +4198,33 @@ func (in *ThanosRulerList) DeepCopy() *ThanosRulerList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosRulerSelfMonitoring) DeepCopyInto(out *ThanosRulerSelfMonitoring) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosRulerSelfMonitoring.
+func (in *ThanosRulerSelfMonitoring) DeepCopy() *ThanosRulerSelfMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosRulerSelfMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThanosRulerSpec) DeepCopyInto(out *ThanosRulerSpec) {
 	*out = *in
@@ -3726,6 +4256,11 @@ func (in *ThanosRulerSpec) DeepCopyInto(out *ThanosRulerSpec) {
 		}
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.ConfigReloader != nil {
+		in, out := &in.ConfigReloader, &out.ConfigReloader
+		*out = new(ConfigReloaderSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
 		*out = new(corev1.Affinity)
@@ -3934,6 +4469,11 @@ func (in *ThanosRulerSpec) DeepCopyInto(out *ThanosRulerSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.SelfMonitoring != nil {
+		in, out := &in.SelfMonitoring, &out.SelfMonitoring
+		*out = new(ThanosRulerSelfMonitoring)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosRulerSpec.
@@ -3956,6 +4496,11 @@ func (in *ThanosRulerStatus) DeepCopyInto(out *ThanosRulerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OperatorInfo != nil {
+		in, out := &in.OperatorInfo, &out.OperatorInfo
+		*out = new(OperatorInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosRulerStatus.
@@ -4078,6 +4623,26 @@ func (in *TopologySpreadConstraint) DeepCopy() *TopologySpreadConstraint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmupSpec) DeepCopyInto(out *WarmupSpec) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmupSpec.
+func (in *WarmupSpec) DeepCopy() *WarmupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebConfigFileFields) DeepCopyInto(out *WebConfigFileFields) {
 	*out = *in
@@ -4158,6 +4723,11 @@ func (in *WebTLSConfig) DeepCopyInto(out *WebTLSConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CertificateSecret != nil {
+		in, out := &in.CertificateSecret, &out.CertificateSecret
+		*out = new(string)
+		**out = **in
+	}
 	in.ClientCA.DeepCopyInto(&out.ClientCA)
 	if in.ClientCAFile != nil {
 		in, out := &in.ClientCAFile, &out.ClientCAFile
@@ -4216,6 +4786,11 @@ func (in *WorkloadBinding) DeepCopyInto(out *WorkloadBinding) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ScrapeStatistics != nil {
+		in, out := &in.ScrapeStatistics, &out.ScrapeStatistics
+		*out = new(ScrapeStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadBinding.
@@ -86,6 +86,19 @@ type AlertmanagerConfigSpec struct {
 	// List of MuteTimeInterval specifying when the routes should be muted.
 	// +optional
 	MuteTimeIntervals []MuteTimeInterval `json:"muteTimeIntervals,omitempty"`
+
+	// Resolve timeout requested for the alerts matching the resource's
+	// namespace.
+	//
+	// Alertmanager supports only a global resolve timeout. The operator
+	// approximates the requested behavior by setting the group interval of
+	// the first-level route (unless it is already defined) to the requested
+	// value, clamped within the bounds defined by the Alertmanager resource
+	// (`spec.alertmanagerConfigResolveTimeoutBounds`). The value is ignored
+	// when the Alertmanager resource doesn't define bounds.
+	//
+	// +optional
+	ResolveTimeout *monitoringv1.Duration `json:"resolveTimeout,omitempty"`
 }
 
 // Route defines a node in the routing tree.
@@ -508,6 +521,35 @@ type WebhookConfig struct {
 	// It requires Alertmanager >= v0.28.0.
 	// +optional
 	Timeout *monitoringv1.Duration `json:"timeout,omitempty"`
+	// When defined, the operator provisions a Secret holding a random signing
+	// key and configures Alertmanager to send the key in the `Authorization`
+	// header of the webhook requests. The receiving service can verify the
+	// authenticity of the notifications by comparing the header with the
+	// key.
+	//
+	// It can't be used with the `authorization`, `basicAuth`, `oauth2` and
+	// `bearerTokenSecret` fields of `httpConfig`.
+	// It requires Alertmanager >= v0.22.0.
+	// +optional
+	SigningSecret *WebhookSigningSecret `json:"signingSecret,omitempty"`
+}
+
+// WebhookSigningSecret defines the Secret provisioned by the operator to
+// authenticate the webhook notifications.
+type WebhookSigningSecret struct {
+	// The name of the Secret in the namespace of the AlertmanagerConfig
+	// object.
+	//
+	// When the Secret doesn't exist, the operator creates it with a random
+	// key stored under the `key` field. An existing Secret is never modified
+	// which allows users to provide or rotate the key themselves.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// The authorization scheme of the `Authorization` header.
+	// Defaults to `Bearer`.
+	// +optional
+	Type string `json:"type,omitempty"`
 }
 
 // OpsGenieConfig configures notifications via OpsGenie.
@@ -0,0 +1,228 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	OperatorConfigurationsKind   = "OperatorConfiguration"
+	OperatorConfigurationName    = "operatorconfigurations"
+	OperatorConfigurationKindKey = "operatorconfiguration"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",scope=Cluster,shortName="pocfg"
+// +kubebuilder:storageversion
+
+// The `OperatorConfiguration` custom resource definition (CRD) defines the
+// settings of the Prometheus operator.
+//
+// The operator reads the resource named by the `--operator-configuration`
+// flag. The fields defined in the resource take precedence over the
+// equivalent command-line flags.
+//
+// The default images, the config-reloader settings, the labels and the
+// annotations are applied without restarting the operator. Changing the
+// namespaces or the feature gates requires a restart of the operator which
+// exits to be restarted by the kubelet.
+type OperatorConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OperatorConfigurationSpec `json:"spec"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *OperatorConfiguration) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// OperatorConfigurationList is a list of OperatorConfigurations.
+// +k8s:openapi-gen=true
+type OperatorConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of OperatorConfigurations
+	Items []OperatorConfiguration `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *OperatorConfigurationList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// OperatorConfigurationSpec defines the settings of the operator.
+// +k8s:openapi-gen=true
+type OperatorConfigurationSpec struct {
+	// Default container images used for the managed workloads.
+	// +optional
+	Images *OperatorImages `json:"images,omitempty"`
+
+	// Settings of the config-reloader sidecar container.
+	// +optional
+	ConfigReloader *ConfigReloaderSettings `json:"configReloader,omitempty"`
+
+	// Namespaces watched by the operator.
+	//
+	// When defined, it replaces the namespaces configured by the
+	// command-line flags.
+	//
+	// Changing the field restarts the operator.
+	// +optional
+	Namespaces *OperatorNamespaces `json:"namespaces,omitempty"`
+
+	// Feature gates to enable or disable. The values are merged with the
+	// `--feature-gates` flag.
+	//
+	// Changing the field restarts the operator.
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// Labels added to all the resources created by the operator.
+	//
+	// When defined, it replaces the labels configured by the `--labels` flag.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to all the resources created by the operator.
+	//
+	// When defined, it replaces the annotations configured by the
+	// `--annotations` flag.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Logging settings of the operator. The values take precedence over the
+	// `--log-level` and `--log-format` flags and over the settings file
+	// defined by the `--log-config-file` flag.
+	// +optional
+	Logging *OperatorLogging `json:"logging,omitempty"`
+}
+
+// OperatorImages defines the default container images.
+// +k8s:openapi-gen=true
+type OperatorImages struct {
+	// Default base image for Prometheus (path without tag/version).
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	PrometheusDefaultBaseImage *string `json:"prometheusDefaultBaseImage,omitempty"`
+	// Default base image for Alertmanager (path without tag/version).
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	AlertmanagerDefaultBaseImage *string `json:"alertmanagerDefaultBaseImage,omitempty"`
+	// Default base image for Thanos (path without tag/version).
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ThanosDefaultBaseImage *string `json:"thanosDefaultBaseImage,omitempty"`
+	// Image of the config-reloader sidecar container.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	PrometheusConfigReloader *string `json:"prometheusConfigReloader,omitempty"`
+}
+
+// ConfigReloaderSettings defines the settings of the config-reloader sidecar
+// container.
+// +k8s:openapi-gen=true
+type ConfigReloaderSettings struct {
+	// CPU requests of the container. Value "0" disables the requests.
+	// +optional
+	CPURequest *resource.Quantity `json:"cpuRequest,omitempty"`
+	// CPU limits of the container. Value "0" disables the limits.
+	// +optional
+	CPULimit *resource.Quantity `json:"cpuLimit,omitempty"`
+	// Memory requests of the container. Value "0" disables the requests.
+	// +optional
+	MemoryRequest *resource.Quantity `json:"memoryRequest,omitempty"`
+	// Memory limits of the container. Value "0" disables the limits.
+	// +optional
+	MemoryLimit *resource.Quantity `json:"memoryLimit,omitempty"`
+	// Whether to enable the liveness, readiness and startup probes of the
+	// container.
+	// +optional
+	EnableProbes *bool `json:"enableProbes,omitempty"`
+}
+
+// OperatorNamespaces defines the namespaces watched by the operator.
+// +k8s:openapi-gen=true
+type OperatorNamespaces struct {
+	// Namespaces to scope the interaction of the operator with the API
+	// server. It is mutually exclusive with `denyList`.
+	// +listType=set
+	// +optional
+	AllowList []string `json:"allowList,omitempty"`
+	// Namespaces excluded from the interaction of the operator with the API
+	// server. It is mutually exclusive with `allowList`.
+	// +listType=set
+	// +optional
+	DenyList []string `json:"denyList,omitempty"`
+	// Namespaces where the Prometheus and PrometheusAgent resources are
+	// watched. It takes precedence over `allowList` and `denyList`.
+	// +listType=set
+	// +optional
+	PrometheusAllowList []string `json:"prometheusAllowList,omitempty"`
+	// Namespaces where the Alertmanager resources are watched. It takes
+	// precedence over `allowList` and `denyList`.
+	// +listType=set
+	// +optional
+	AlertmanagerAllowList []string `json:"alertmanagerAllowList,omitempty"`
+	// Namespaces where the AlertmanagerConfig resources are watched. It
+	// takes precedence over `allowList` and `denyList`.
+	// +listType=set
+	// +optional
+	AlertmanagerConfigAllowList []string `json:"alertmanagerConfigAllowList,omitempty"`
+	// Namespaces where the ThanosRuler resources are watched. It takes
+	// precedence over `allowList` and `denyList`.
+	// +listType=set
+	// +optional
+	ThanosRulerAllowList []string `json:"thanosRulerAllowList,omitempty"`
+}
+
+// OperatorLogging defines the logging settings of the operator.
+// +k8s:openapi-gen=true
+type OperatorLogging struct {
+	// Log level of the components without override.
+	// +kubebuilder:validation:Enum=all;debug;info;warn;error;none
+	// +optional
+	Level *string `json:"level,omitempty"`
+	// Log format of the components without override.
+	// +kubebuilder:validation:Enum=logfmt;json
+	// +optional
+	Format *string `json:"format,omitempty"`
+	// Per-component overrides, keyed by the name of the component (e.g.
+	// `prometheus-controller` or `alertmanager-controller`).
+	// +optional
+	Components map[string]ComponentLogging `json:"components,omitempty"`
+}
+
+// ComponentLogging overrides the logging settings of a component.
+// +k8s:openapi-gen=true
+type ComponentLogging struct {
+	// Log level of the component.
+	// +kubebuilder:validation:Enum=all;debug;info;warn;error;none
+	// +optional
+	Level *string `json:"level,omitempty"`
+	// Log format of the component.
+	// +kubebuilder:validation:Enum=logfmt;json
+	// +optional
+	Format *string `json:"format,omitempty"`
+}
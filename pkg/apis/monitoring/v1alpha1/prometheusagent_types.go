@@ -104,6 +104,18 @@ type PrometheusAgentSpec struct {
 	// +optional
 	Mode *PrometheusAgentMode `json:"mode,omitempty"`
 
+	// ScrapeProxyConfig defines the proxy settings (e.g. a SOCKS5 or HTTP
+	// CONNECT egress proxy) to use for all the scrape jobs of the agent.
+	//
+	// It only applies to the scrape jobs for which neither the scrape object
+	// nor its scrape class define proxy settings.
+	//
+	// The Secrets referenced by `proxyConnectHeader` must be in the same
+	// namespace as the PrometheusAgent object.
+	//
+	// +optional
+	ScrapeProxyConfig *monitoringv1.ProxyConfig `json:"scrapeProxyConfig,omitempty"`
+
 	monitoringv1.CommonPrometheusFields `json:",inline"`
 }
 
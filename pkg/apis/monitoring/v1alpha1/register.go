@@ -49,6 +49,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&AlertmanagerConfig{},
 		&AlertmanagerConfigList{},
+		&OperatorConfiguration{},
+		&OperatorConfigurationList{},
 		&PrometheusAgent{},
 		&PrometheusAgentList{},
 		&ScrapeConfig{},
@@ -111,6 +111,7 @@ type K8SSelectorConfig struct {
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="scfg"
 // +kubebuilder:storageversion
+// +kubebuilder:subresource:status
 
 // ScrapeConfig defines a namespaced Prometheus scrape_config to be aggregated across
 // multiple namespaces into the Prometheus configuration.
@@ -119,6 +120,14 @@ type ScrapeConfig struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Spec ScrapeConfigSpec `json:"spec"`
+	// This Status subresource is under active development and is updated only when the
+	// "StatusForConfigurationResources" feature gate is enabled.
+	//
+	// Most recent observed status of the ScrapeConfig. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status v1.ConfigResourceStatus `json:"status,omitempty"`
 }
 
 // DeepCopyObject implements the runtime.Object interface.
@@ -223,6 +232,18 @@ type ScrapeConfigSpec struct {
 	// IonosSDConfigs defines a list of IONOS service discovery configurations.
 	// +optional
 	IonosSDConfigs []IonosSDConfig `json:"ionosSDConfigs,omitempty"`
+	// CustomResourceSDConfigs defines a list of service discovery
+	// configurations extracting the targets from custom resources (e.g.
+	// objects managed by third-party operators).
+	//
+	// The operator lists the custom resources in the namespace of the
+	// ScrapeConfig and writes the discovered targets to files which are
+	// consumed by Prometheus with the file-based service discovery. The
+	// service account of the operator needs the `list` permission on the
+	// custom resources, the failures are reported by the
+	// `CustomResourceDiscoveryFailed` condition of the Prometheus status.
+	// +optional
+	CustomResourceSDConfigs []CustomResourceSDConfig `json:"customResourceSDConfigs,omitempty"`
 	// RelabelConfigs defines how to rewrite the target's labels before scraping.
 	// Prometheus Operator automatically adds relabelings for a few standard Kubernetes fields.
 	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
@@ -1469,3 +1490,53 @@ type IonosSDConfig struct {
 	// +optional
 	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
 }
+
+// CustomResourceSDConfig discovers scrape targets from custom resources.
+//
+// The expressions use the Common Expression Language (CEL) and are evaluated
+// against each object which is available as the `object` variable (e.g.
+// `object.status.endpoint`). Accessing a missing field is an error: the
+// expressions should use the `has()` macro or the optional field selection
+// (e.g. `object.?status.?endpoint.orValue("")`) for the fields which may not
+// be set.
+//
+// The targets have the following meta labels:
+// * `__meta_customresource_namespace`: the namespace of the object.
+// * `__meta_customresource_name`: the name of the object.
+// +k8s:openapi-gen=true
+type CustomResourceSDConfig struct {
+	// API group of the custom resources (e.g. `example.com`).
+	// +required
+	Group string `json:"group"`
+	// API version of the custom resources (e.g. `v1`).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Version string `json:"version"`
+	// Plural name of the custom resources (e.g. `databases`).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Resource string `json:"resource"`
+	// Label selector to filter the custom resources.
+	// If not defined, all the custom resources in the namespace of the
+	// ScrapeConfig are selected.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// CEL expression extracting the address (`host:port`) of the target
+	// from the object. The expression must return a string or a list of
+	// strings, one target is created for each value. Objects for which the
+	// expression returns an empty string, an empty list or null are ignored.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Address string `json:"address"`
+	// Labels assigned to the targets. The keys are the label names and the
+	// values are CEL expressions evaluated against the object which must
+	// return a string. The label isn't set when the expression returns an
+	// empty string or null.
+	// +mapType:=atomic
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Interval at which the operator lists the custom resources.
+	// Defaults to 1m.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+}
@@ -22,6 +22,7 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -95,6 +96,11 @@ func (in *AlertmanagerConfigSpec) DeepCopyInto(out *AlertmanagerConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResolveTimeout != nil {
+		in, out := &in.ResolveTimeout, &out.ResolveTimeout
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigSpec.
@@ -213,6 +219,71 @@ func (in *AzureSDConfig) DeepCopy() *AzureSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentLogging) DeepCopyInto(out *ComponentLogging) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentLogging.
+func (in *ComponentLogging) DeepCopy() *ComponentLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigReloaderSettings) DeepCopyInto(out *ConfigReloaderSettings) {
+	*out = *in
+	if in.CPURequest != nil {
+		in, out := &in.CPURequest, &out.CPURequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CPULimit != nil {
+		in, out := &in.CPULimit, &out.CPULimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MemoryRequest != nil {
+		in, out := &in.MemoryRequest, &out.MemoryRequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MemoryLimit != nil {
+		in, out := &in.MemoryLimit, &out.MemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EnableProbes != nil {
+		in, out := &in.EnableProbes, &out.EnableProbes
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigReloaderSettings.
+func (in *ConfigReloaderSettings) DeepCopy() *ConfigReloaderSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigReloaderSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsulSDConfig) DeepCopyInto(out *ConsulSDConfig) {
 	*out = *in
@@ -326,6 +397,38 @@ func (in *ConsulSDConfig) DeepCopy() *ConsulSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResourceSDConfig) DeepCopyInto(out *CustomResourceSDConfig) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResourceSDConfig.
+func (in *CustomResourceSDConfig) DeepCopy() *CustomResourceSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResourceSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSSDConfig) DeepCopyInto(out *DNSSDConfig) {
 	*out = *in
@@ -1776,6 +1879,216 @@ func (in *OpenStackSDConfig) DeepCopy() *OpenStackSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfiguration) DeepCopyInto(out *OperatorConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfiguration.
+func (in *OperatorConfiguration) DeepCopy() *OperatorConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigurationList) DeepCopyInto(out *OperatorConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OperatorConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigurationList.
+func (in *OperatorConfigurationList) DeepCopy() *OperatorConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigurationSpec) DeepCopyInto(out *OperatorConfigurationSpec) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(OperatorImages)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigReloader != nil {
+		in, out := &in.ConfigReloader, &out.ConfigReloader
+		*out = new(ConfigReloaderSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = new(OperatorNamespaces)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(OperatorLogging)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigurationSpec.
+func (in *OperatorConfigurationSpec) DeepCopy() *OperatorConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorImages) DeepCopyInto(out *OperatorImages) {
+	*out = *in
+	if in.PrometheusDefaultBaseImage != nil {
+		in, out := &in.PrometheusDefaultBaseImage, &out.PrometheusDefaultBaseImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.AlertmanagerDefaultBaseImage != nil {
+		in, out := &in.AlertmanagerDefaultBaseImage, &out.AlertmanagerDefaultBaseImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.ThanosDefaultBaseImage != nil {
+		in, out := &in.ThanosDefaultBaseImage, &out.ThanosDefaultBaseImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrometheusConfigReloader != nil {
+		in, out := &in.PrometheusConfigReloader, &out.PrometheusConfigReloader
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorImages.
+func (in *OperatorImages) DeepCopy() *OperatorImages {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorLogging) DeepCopyInto(out *OperatorLogging) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(string)
+		**out = **in
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make(map[string]ComponentLogging, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorLogging.
+func (in *OperatorLogging) DeepCopy() *OperatorLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorNamespaces) DeepCopyInto(out *OperatorNamespaces) {
+	*out = *in
+	if in.AllowList != nil {
+		in, out := &in.AllowList, &out.AllowList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DenyList != nil {
+		in, out := &in.DenyList, &out.DenyList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrometheusAllowList != nil {
+		in, out := &in.PrometheusAllowList, &out.PrometheusAllowList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlertmanagerAllowList != nil {
+		in, out := &in.AlertmanagerAllowList, &out.AlertmanagerAllowList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlertmanagerConfigAllowList != nil {
+		in, out := &in.AlertmanagerConfigAllowList, &out.AlertmanagerConfigAllowList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ThanosRulerAllowList != nil {
+		in, out := &in.ThanosRulerAllowList, &out.ThanosRulerAllowList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorNamespaces.
+func (in *OperatorNamespaces) DeepCopy() *OperatorNamespaces {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorNamespaces)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpsGenieConfig) DeepCopyInto(out *OpsGenieConfig) {
 	*out = *in
@@ -1972,6 +2285,11 @@ func (in *PrometheusAgentSpec) DeepCopyInto(out *PrometheusAgentSpec) {
 		*out = new(PrometheusAgentMode)
 		**out = **in
 	}
+	if in.ScrapeProxyConfig != nil {
+		in, out := &in.ScrapeProxyConfig, &out.ScrapeProxyConfig
+		*out = new(monitoringv1.ProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	in.CommonPrometheusFields.DeepCopyInto(&out.CommonPrometheusFields)
 }
 
@@ -2361,6 +2679,7 @@ func (in *ScrapeConfig) DeepCopyInto(out *ScrapeConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeConfig.
@@ -2566,6 +2885,13 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CustomResourceSDConfigs != nil {
+		in, out := &in.CustomResourceSDConfigs, &out.CustomResourceSDConfigs
+		*out = make([]CustomResourceSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RelabelConfigs != nil {
 		in, out := &in.RelabelConfigs, &out.RelabelConfigs
 		*out = make([]monitoringv1.RelabelConfig, len(*in))
@@ -3089,6 +3415,11 @@ func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 		*out = new(monitoringv1.Duration)
 		**out = **in
 	}
+	if in.SigningSecret != nil {
+		in, out := &in.SigningSecret, &out.SigningSecret
+		*out = new(WebhookSigningSecret)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookConfig.
@@ -3100,3 +3431,18 @@ func (in *WebhookConfig) DeepCopy() *WebhookConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSigningSecret) DeepCopyInto(out *WebhookSigningSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSigningSecret.
+func (in *WebhookSigningSecret) DeepCopy() *WebhookSigningSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSigningSecret)
+	in.DeepCopyInto(out)
+	return out
+}
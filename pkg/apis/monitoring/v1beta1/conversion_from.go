@@ -351,12 +351,13 @@ func convertWebexConfigFrom(in v1alpha1.WebexConfig) WebexConfig {
 
 func convertWebhookConfigFrom(in v1alpha1.WebhookConfig) WebhookConfig {
 	return WebhookConfig{
-		SendResolved: in.SendResolved,
-		URL:          in.URL,
-		URLSecret:    convertSecretKeySelectorFrom(in.URLSecret),
-		HTTPConfig:   convertHTTPConfigFrom(in.HTTPConfig),
-		MaxAlerts:    in.MaxAlerts,
-		Timeout:      in.Timeout,
+		SendResolved:  in.SendResolved,
+		URL:           in.URL,
+		URLSecret:     convertSecretKeySelectorFrom(in.URLSecret),
+		HTTPConfig:    convertHTTPConfigFrom(in.HTTPConfig),
+		MaxAlerts:     in.MaxAlerts,
+		Timeout:       in.Timeout,
+		SigningSecret: (*WebhookSigningSecret)(in.SigningSecret),
 	}
 }
 
@@ -620,6 +621,7 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 	dst.Spec.Route = r
+	dst.Spec.ResolveTimeout = src.Spec.ResolveTimeout
 
 	return nil
 }
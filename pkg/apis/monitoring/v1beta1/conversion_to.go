@@ -347,12 +347,13 @@ func convertWebexConfigTo(in WebexConfig) v1alpha1.WebexConfig {
 
 func convertWebhookConfigTo(in WebhookConfig) v1alpha1.WebhookConfig {
 	return v1alpha1.WebhookConfig{
-		SendResolved: in.SendResolved,
-		URL:          in.URL,
-		URLSecret:    convertSecretKeySelectorTo(in.URLSecret),
-		HTTPConfig:   convertHTTPConfigTo(in.HTTPConfig),
-		MaxAlerts:    in.MaxAlerts,
-		Timeout:      in.Timeout,
+		SendResolved:  in.SendResolved,
+		URL:           in.URL,
+		URLSecret:     convertSecretKeySelectorTo(in.URLSecret),
+		HTTPConfig:    convertHTTPConfigTo(in.HTTPConfig),
+		MaxAlerts:     in.MaxAlerts,
+		Timeout:       in.Timeout,
+		SigningSecret: (*v1alpha1.WebhookSigningSecret)(in.SigningSecret),
 	}
 }
 
@@ -617,6 +618,7 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 	dst.Spec.Route = r
+	dst.Spec.ResolveTimeout = src.Spec.ResolveTimeout
 
 	return nil
 }
@@ -0,0 +1,153 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+// ConvertTo converts from this version (v1beta1) to the Hub version (v1alpha1).
+func (src *ScrapeConfig) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.ScrapeConfig)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1alpha1.ScrapeConfigSpec{
+		JobName:                  src.Spec.JobName,
+		StaticConfigs:            src.Spec.StaticConfigs,
+		FileSDConfigs:            src.Spec.FileSDConfigs,
+		HTTPSDConfigs:            src.Spec.HTTPSDConfigs,
+		KubernetesSDConfigs:      src.Spec.KubernetesSDConfigs,
+		ConsulSDConfigs:          src.Spec.ConsulSDConfigs,
+		DNSSDConfigs:             src.Spec.DNSSDConfigs,
+		EC2SDConfigs:             src.Spec.EC2SDConfigs,
+		AzureSDConfigs:           src.Spec.AzureSDConfigs,
+		GCESDConfigs:             src.Spec.GCESDConfigs,
+		OpenStackSDConfigs:       src.Spec.OpenStackSDConfigs,
+		DigitalOceanSDConfigs:    src.Spec.DigitalOceanSDConfigs,
+		KumaSDConfigs:            src.Spec.KumaSDConfigs,
+		EurekaSDConfigs:          src.Spec.EurekaSDConfigs,
+		DockerSDConfigs:          src.Spec.DockerSDConfigs,
+		LinodeSDConfigs:          src.Spec.LinodeSDConfigs,
+		HetznerSDConfigs:         src.Spec.HetznerSDConfigs,
+		NomadSDConfigs:           src.Spec.NomadSDConfigs,
+		DockerSwarmSDConfigs:     src.Spec.DockerSwarmSDConfigs,
+		PuppetDBSDConfigs:        src.Spec.PuppetDBSDConfigs,
+		LightSailSDConfigs:       src.Spec.LightsailSDConfigs,
+		OVHCloudSDConfigs:        src.Spec.OVHCloudSDConfigs,
+		ScalewaySDConfigs:        src.Spec.ScalewaySDConfigs,
+		IonosSDConfigs:           src.Spec.IonosSDConfigs,
+		CustomResourceSDConfigs:  src.Spec.CustomResourceSDConfigs,
+		RelabelConfigs:           src.Spec.Relabelings,
+		MetricsPath:              src.Spec.MetricsPath,
+		ScrapeInterval:           src.Spec.ScrapeInterval,
+		ScrapeTimeout:            src.Spec.ScrapeTimeout,
+		ScrapeProtocols:          src.Spec.ScrapeProtocols,
+		FallbackScrapeProtocol:   src.Spec.FallbackScrapeProtocol,
+		HonorTimestamps:          src.Spec.HonorTimestamps,
+		TrackTimestampsStaleness: src.Spec.TrackTimestampsStaleness,
+		HonorLabels:              src.Spec.HonorLabels,
+		Params:                   src.Spec.Params,
+		Scheme:                   src.Spec.Scheme,
+		EnableCompression:        src.Spec.EnableCompression,
+		EnableHTTP2:              src.Spec.EnableHTTP2,
+		BasicAuth:                src.Spec.BasicAuth,
+		Authorization:            src.Spec.Authorization,
+		OAuth2:                   src.Spec.OAuth2,
+		TLSConfig:                src.Spec.TLSConfig,
+		SampleLimit:              src.Spec.SampleLimit,
+		TargetLimit:              src.Spec.TargetLimit,
+		LabelLimit:               src.Spec.LabelLimit,
+		LabelNameLengthLimit:     src.Spec.LabelNameLengthLimit,
+		LabelValueLengthLimit:    src.Spec.LabelValueLengthLimit,
+		NativeHistogramConfig:    src.Spec.NativeHistogramConfig,
+		KeepDroppedTargets:       src.Spec.KeepDroppedTargets,
+		MetricRelabelConfigs:     src.Spec.MetricRelabelings,
+		ProxyConfig:              src.Spec.ProxyConfig,
+		NameValidationScheme:     src.Spec.NameValidationScheme,
+		NameEscapingScheme:       src.Spec.NameEscapingScheme,
+		ScrapeClassName:          src.Spec.ScrapeClass,
+	}
+	dst.Status = src.Status
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version (v1beta1).
+func (dst *ScrapeConfig) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.ScrapeConfig)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = ScrapeConfigSpec{
+		JobName:                  src.Spec.JobName,
+		StaticConfigs:            src.Spec.StaticConfigs,
+		FileSDConfigs:            src.Spec.FileSDConfigs,
+		HTTPSDConfigs:            src.Spec.HTTPSDConfigs,
+		KubernetesSDConfigs:      src.Spec.KubernetesSDConfigs,
+		ConsulSDConfigs:          src.Spec.ConsulSDConfigs,
+		DNSSDConfigs:             src.Spec.DNSSDConfigs,
+		EC2SDConfigs:             src.Spec.EC2SDConfigs,
+		AzureSDConfigs:           src.Spec.AzureSDConfigs,
+		GCESDConfigs:             src.Spec.GCESDConfigs,
+		OpenStackSDConfigs:       src.Spec.OpenStackSDConfigs,
+		DigitalOceanSDConfigs:    src.Spec.DigitalOceanSDConfigs,
+		KumaSDConfigs:            src.Spec.KumaSDConfigs,
+		EurekaSDConfigs:          src.Spec.EurekaSDConfigs,
+		DockerSDConfigs:          src.Spec.DockerSDConfigs,
+		LinodeSDConfigs:          src.Spec.LinodeSDConfigs,
+		HetznerSDConfigs:         src.Spec.HetznerSDConfigs,
+		NomadSDConfigs:           src.Spec.NomadSDConfigs,
+		DockerSwarmSDConfigs:     src.Spec.DockerSwarmSDConfigs,
+		PuppetDBSDConfigs:        src.Spec.PuppetDBSDConfigs,
+		LightsailSDConfigs:       src.Spec.LightSailSDConfigs,
+		OVHCloudSDConfigs:        src.Spec.OVHCloudSDConfigs,
+		ScalewaySDConfigs:        src.Spec.ScalewaySDConfigs,
+		IonosSDConfigs:           src.Spec.IonosSDConfigs,
+		CustomResourceSDConfigs:  src.Spec.CustomResourceSDConfigs,
+		Relabelings:              src.Spec.RelabelConfigs,
+		MetricsPath:              src.Spec.MetricsPath,
+		ScrapeInterval:           src.Spec.ScrapeInterval,
+		ScrapeTimeout:            src.Spec.ScrapeTimeout,
+		ScrapeProtocols:          src.Spec.ScrapeProtocols,
+		FallbackScrapeProtocol:   src.Spec.FallbackScrapeProtocol,
+		HonorTimestamps:          src.Spec.HonorTimestamps,
+		TrackTimestampsStaleness: src.Spec.TrackTimestampsStaleness,
+		HonorLabels:              src.Spec.HonorLabels,
+		Params:                   src.Spec.Params,
+		Scheme:                   src.Spec.Scheme,
+		EnableCompression:        src.Spec.EnableCompression,
+		EnableHTTP2:              src.Spec.EnableHTTP2,
+		BasicAuth:                src.Spec.BasicAuth,
+		Authorization:            src.Spec.Authorization,
+		OAuth2:                   src.Spec.OAuth2,
+		TLSConfig:                src.Spec.TLSConfig,
+		SampleLimit:              src.Spec.SampleLimit,
+		TargetLimit:              src.Spec.TargetLimit,
+		LabelLimit:               src.Spec.LabelLimit,
+		LabelNameLengthLimit:     src.Spec.LabelNameLengthLimit,
+		LabelValueLengthLimit:    src.Spec.LabelValueLengthLimit,
+		NativeHistogramConfig:    src.Spec.NativeHistogramConfig,
+		KeepDroppedTargets:       src.Spec.KeepDroppedTargets,
+		MetricRelabelings:        src.Spec.MetricRelabelConfigs,
+		ProxyConfig:              src.Spec.ProxyConfig,
+		NameValidationScheme:     src.Spec.NameValidationScheme,
+		NameEscapingScheme:       src.Spec.NameEscapingScheme,
+		ScrapeClass:              src.Spec.ScrapeClassName,
+	}
+	dst.Status = src.Status
+
+	return nil
+}
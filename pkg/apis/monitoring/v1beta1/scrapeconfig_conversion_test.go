@@ -0,0 +1,102 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/randfill"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func newFiller() *randfill.Filler {
+	return randfill.NewWithSeed(1).NilChance(0).NumElements(1, 2).Funcs(
+		// The metadata and status are copied as-is.
+		func(*metav1.ObjectMeta, randfill.Continue) {},
+		func(*metav1.Time, randfill.Continue) {},
+	)
+}
+
+func TestScrapeConfigRoundTrip(t *testing.T) {
+	f := newFiller()
+
+	for i := 0; i < 20; i++ {
+		var hub v1alpha1.ScrapeConfig
+		f.Fill(&hub.Spec)
+		hub.Name = "test"
+		hub.Namespace = "default"
+
+		var sc ScrapeConfig
+		if err := sc.ConvertFrom(&hub); err != nil {
+			t.Fatal(err)
+		}
+
+		var got v1alpha1.ScrapeConfig
+		if err := sc.ConvertTo(&got); err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(hub, got) {
+			t.Fatalf("hub -> v1beta1 -> hub: got %+v, want %+v", got, hub)
+		}
+
+		var back ScrapeConfig
+		if err := back.ConvertFrom(&got); err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(sc, back) {
+			t.Fatalf("v1beta1 -> hub -> v1beta1: got %+v, want %+v", back, sc)
+		}
+	}
+}
+
+func TestScrapeConfigRenamedFields(t *testing.T) {
+	sc := &ScrapeConfig{
+		Spec: ScrapeConfigSpec{
+			OpenStackSDConfigs: []v1alpha1.OpenStackSDConfig{{Region: "region"}},
+			LightsailSDConfigs: []v1alpha1.LightSailSDConfig{{Region: ptr.To("region")}},
+			OVHCloudSDConfigs:  []v1alpha1.OVHCloudSDConfig{{ApplicationKey: "key"}},
+		},
+	}
+
+	b, err := json.Marshal(sc.Spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, field := range []string{`"openStackSDConfigs"`, `"lightsailSDConfigs"`, `"ovhCloudSDConfigs"`} {
+		if !strings.Contains(string(b), field) {
+			t.Errorf("expected %s field in %s", field, string(b))
+		}
+	}
+
+	var hub v1alpha1.ScrapeConfig
+	if err := sc.ConvertTo(&hub); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(hub.Spec.OpenStackSDConfigs, sc.Spec.OpenStackSDConfigs) ||
+		!reflect.DeepEqual(hub.Spec.LightSailSDConfigs, sc.Spec.LightsailSDConfigs) ||
+		!reflect.DeepEqual(hub.Spec.OVHCloudSDConfigs, sc.Spec.OVHCloudSDConfigs) {
+		t.Fatalf("unexpected conversion result: %+v", hub.Spec)
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+const (
+	ScrapeConfigsKind   = "ScrapeConfig"
+	ScrapeConfigName    = "scrapeconfigs"
+	ScrapeConfigKindKey = "scrapeconfig"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="scfg"
+// +kubebuilder:subresource:status
+
+// ScrapeConfig defines a namespaced Prometheus scrape_config to be aggregated across
+// multiple namespaces into the Prometheus configuration.
+//
+// The v1beta1 version differs from the v1alpha1 version (storage version) by
+// the names of a few fields: `openStackSDConfigs` (instead of
+// `openstackSDConfigs`), `lightsailSDConfigs` (instead of `lightSailSDConfigs`)
+// and `ovhCloudSDConfigs` (instead of `ovhcloudSDConfigs`). The objects are
+// converted by the conversion webhook.
+type ScrapeConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ScrapeConfigSpec `json:"spec"`
+	// This Status subresource is under active development and is updated only when the
+	// "StatusForConfigurationResources" feature gate is enabled.
+	//
+	// Most recent observed status of the ScrapeConfig. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status v1.ConfigResourceStatus `json:"status,omitempty"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ScrapeConfig) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ScrapeConfigList is a list of ScrapeConfigs.
+// +k8s:openapi-gen=true
+type ScrapeConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ScrapeConfigs
+	Items []ScrapeConfig `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ScrapeConfigList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ScrapeConfigSpec is a specification of the desired configuration for a scrape configuration.
+// +k8s:openapi-gen=true
+type ScrapeConfigSpec struct {
+	// The value of the `job` label assigned to the scraped metrics by default.
+	//
+	// The `job_name` field in the rendered scrape configuration is always controlled by the
+	// operator to prevent duplicate job names, which Prometheus does not allow. Instead the
+	// `job` label is set by means of relabeling configs.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	JobName *string `json:"jobName,omitempty"`
+	// StaticConfigs defines a list of static targets with a common label set.
+	// +optional
+	StaticConfigs []v1alpha1.StaticConfig `json:"staticConfigs,omitempty"`
+	// FileSDConfigs defines a list of file service discovery configurations.
+	// +optional
+	FileSDConfigs []v1alpha1.FileSDConfig `json:"fileSDConfigs,omitempty"`
+	// HTTPSDConfigs defines a list of HTTP service discovery configurations.
+	// +optional
+	HTTPSDConfigs []v1alpha1.HTTPSDConfig `json:"httpSDConfigs,omitempty"`
+	// KubernetesSDConfigs defines a list of Kubernetes service discovery configurations.
+	// +optional
+	KubernetesSDConfigs []v1alpha1.KubernetesSDConfig `json:"kubernetesSDConfigs,omitempty"`
+	// ConsulSDConfigs defines a list of Consul service discovery configurations.
+	// +optional
+	ConsulSDConfigs []v1alpha1.ConsulSDConfig `json:"consulSDConfigs,omitempty"`
+	//DNSSDConfigs defines a list of DNS service discovery configurations.
+	// +optional
+	DNSSDConfigs []v1alpha1.DNSSDConfig `json:"dnsSDConfigs,omitempty"`
+	// EC2SDConfigs defines a list of EC2 service discovery configurations.
+	// +optional
+	EC2SDConfigs []v1alpha1.EC2SDConfig `json:"ec2SDConfigs,omitempty"`
+	// AzureSDConfigs defines a list of Azure service discovery configurations.
+	// +optional
+	AzureSDConfigs []v1alpha1.AzureSDConfig `json:"azureSDConfigs,omitempty"`
+	// GCESDConfigs defines a list of GCE service discovery configurations.
+	// +optional
+	GCESDConfigs []v1alpha1.GCESDConfig `json:"gceSDConfigs,omitempty"`
+	// OpenStackSDConfigs defines a list of OpenStack service discovery configurations.
+	// +optional
+	OpenStackSDConfigs []v1alpha1.OpenStackSDConfig `json:"openStackSDConfigs,omitempty"`
+	// DigitalOceanSDConfigs defines a list of DigitalOcean service discovery configurations.
+	// +optional
+	DigitalOceanSDConfigs []v1alpha1.DigitalOceanSDConfig `json:"digitalOceanSDConfigs,omitempty"`
+	// KumaSDConfigs defines a list of Kuma service discovery configurations.
+	// +optional
+	KumaSDConfigs []v1alpha1.KumaSDConfig `json:"kumaSDConfigs,omitempty"`
+	// EurekaSDConfigs defines a list of Eureka service discovery configurations.
+	// +optional
+	EurekaSDConfigs []v1alpha1.EurekaSDConfig `json:"eurekaSDConfigs,omitempty"`
+	// DockerSDConfigs defines a list of Docker service discovery configurations.
+	// +optional
+	DockerSDConfigs []v1alpha1.DockerSDConfig `json:"dockerSDConfigs,omitempty"`
+	// LinodeSDConfigs defines a list of Linode service discovery configurations.
+	// +optional
+	LinodeSDConfigs []v1alpha1.LinodeSDConfig `json:"linodeSDConfigs,omitempty"`
+	// HetznerSDConfigs defines a list of Hetzner service discovery configurations.
+	// +optional
+	HetznerSDConfigs []v1alpha1.HetznerSDConfig `json:"hetznerSDConfigs,omitempty"`
+	// NomadSDConfigs defines a list of Nomad service discovery configurations.
+	// +optional
+	NomadSDConfigs []v1alpha1.NomadSDConfig `json:"nomadSDConfigs,omitempty"`
+	// DockerSwarmSDConfigs defines a list of Docker Swarm service discovery configurations.
+	// +optional
+	DockerSwarmSDConfigs []v1alpha1.DockerSwarmSDConfig `json:"dockerSwarmSDConfigs,omitempty"`
+	// PuppetDBSDConfigs defines a list of PuppetDB service discovery configurations.
+	// +optional
+	PuppetDBSDConfigs []v1alpha1.PuppetDBSDConfig `json:"puppetDBSDConfigs,omitempty"`
+	// LightsailSDConfigs defines a list of Lightsail service discovery configurations.
+	// +optional
+	LightsailSDConfigs []v1alpha1.LightSailSDConfig `json:"lightsailSDConfigs,omitempty"`
+	// OVHCloudSDConfigs defines a list of OVHcloud service discovery configurations.
+	// +optional
+	OVHCloudSDConfigs []v1alpha1.OVHCloudSDConfig `json:"ovhCloudSDConfigs,omitempty"`
+	// ScalewaySDConfigs defines a list of Scaleway instances and baremetal service discovery configurations.
+	// +optional
+	ScalewaySDConfigs []v1alpha1.ScalewaySDConfig `json:"scalewaySDConfigs,omitempty"`
+	// IonosSDConfigs defines a list of IONOS service discovery configurations.
+	// +optional
+	IonosSDConfigs []v1alpha1.IonosSDConfig `json:"ionosSDConfigs,omitempty"`
+	// CustomResourceSDConfigs defines a list of service discovery
+	// configurations extracting the targets from custom resources (e.g.
+	// objects managed by third-party operators).
+	//
+	// The operator lists the custom resources in the namespace of the
+	// ScrapeConfig and writes the discovered targets to files which are
+	// consumed by Prometheus with the file-based service discovery. The
+	// service account of the operator needs the `list` permission on the
+	// custom resources, the failures are reported by the
+	// `CustomResourceDiscoveryFailed` condition of the Prometheus status.
+	// +optional
+	CustomResourceSDConfigs []v1alpha1.CustomResourceSDConfig `json:"customResourceSDConfigs,omitempty"`
+	// Relabelings defines how to rewrite the target's labels before scraping.
+	// Prometheus Operator automatically adds relabelings for a few standard Kubernetes fields.
+	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
+	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+	// +kubebuilder:validation:MinItems:=1
+	// +optional
+	Relabelings []v1.RelabelConfig `json:"relabelings,omitempty"`
+	// MetricsPath HTTP path to scrape for metrics. If empty, Prometheus uses the default value (e.g. /metrics).
+	// +kubebuilder:validation:MinLength:=1
+	// +optional
+	MetricsPath *string `json:"metricsPath,omitempty"`
+	// ScrapeInterval is the interval between consecutive scrapes.
+	// +optional
+	ScrapeInterval *v1.Duration `json:"scrapeInterval,omitempty"`
+	// ScrapeTimeout is the number of seconds to wait until a scrape request times out.
+	// The value cannot be greater than the scrape interval otherwise the operator will reject the resource.
+	// +optional
+	ScrapeTimeout *v1.Duration `json:"scrapeTimeout,omitempty"`
+	// The protocols to negotiate during a scrape. It tells clients the
+	// protocols supported by Prometheus in order of preference (from most to least preferred).
+	//
+	// If unset, Prometheus uses its default value.
+	//
+	// It requires Prometheus >= v2.49.0.
+	//
+	// +listType=set
+	// +kubebuilder:validation:MinItems:=1
+	// +optional
+	ScrapeProtocols []v1.ScrapeProtocol `json:"scrapeProtocols,omitempty"`
+	// The protocol to use if a scrape returns blank, unparseable, or otherwise invalid Content-Type.
+	//
+	// It requires Prometheus >= v3.0.0.
+	// +optional
+	FallbackScrapeProtocol *v1.ScrapeProtocol `json:"fallbackScrapeProtocol,omitempty"`
+	// HonorTimestamps controls whether Prometheus respects the timestamps present in scraped data.
+	// +optional
+	HonorTimestamps *bool `json:"honorTimestamps,omitempty"`
+	// TrackTimestampsStaleness whether Prometheus tracks staleness of
+	// the metrics that have an explicit timestamp present in scraped data.
+	// Has no effect if `honorTimestamps` is false.
+	// It requires Prometheus >= v2.48.0.
+	//
+	// +optional
+	TrackTimestampsStaleness *bool `json:"trackTimestampsStaleness,omitempty"`
+	// HonorLabels chooses the metric's labels on collisions with target labels.
+	// +optional
+	HonorLabels *bool `json:"honorLabels,omitempty"`
+	// Optional HTTP URL parameters
+	// +mapType:=atomic
+	// +optional
+	Params map[string][]string `json:"params,omitempty"`
+	// Configures the protocol scheme used for requests.
+	// If empty, Prometheus uses HTTP by default.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +optional
+	Scheme *string `json:"scheme,omitempty"`
+	// When false, Prometheus will request uncompressed response from the scraped target.
+	//
+	// It requires Prometheus >= v2.49.0.
+	//
+	// If unset, Prometheus uses true by default.
+	// +optional
+	EnableCompression *bool `json:"enableCompression,omitempty"`
+	// Whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"`
+	// BasicAuth information to use on every scrape request.
+	// +optional
+	BasicAuth *v1.BasicAuth `json:"basicAuth,omitempty"`
+	// Authorization header to use on every scrape request.
+	// +optional
+	Authorization *v1.SafeAuthorization `json:"authorization,omitempty"`
+	// OAuth2 configuration to use on every scrape request.
+	// +optional
+	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
+	// TLS configuration to use on every scrape request
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// SampleLimit defines per-scrape limit on number of scraped samples that will be accepted.
+	// +optional
+	SampleLimit *uint64 `json:"sampleLimit,omitempty"`
+	// TargetLimit defines a limit on the number of scraped targets that will be accepted.
+	// +optional
+	TargetLimit *uint64 `json:"targetLimit,omitempty"`
+	// Per-scrape limit on number of labels that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	// +optional
+	LabelLimit *uint64 `json:"labelLimit,omitempty"`
+	// Per-scrape limit on length of labels name that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	// +optional
+	LabelNameLengthLimit *uint64 `json:"labelNameLengthLimit,omitempty"`
+	// Per-scrape limit on length of labels value that will be accepted for a sample.
+	// Only valid in Prometheus versions 2.27.0 and newer.
+	// +optional
+	LabelValueLengthLimit *uint64 `json:"labelValueLengthLimit,omitempty"`
+
+	v1.NativeHistogramConfig `json:",inline"`
+	// Per-scrape limit on the number of targets dropped by relabeling
+	// that will be kept in memory. 0 means no limit.
+	//
+	// It requires Prometheus >= v2.47.0.
+	//
+	// +optional
+	KeepDroppedTargets *uint64 `json:"keepDroppedTargets,omitempty"`
+	// MetricRelabelings to apply to samples before ingestion.
+	// +kubebuilder:validation:MinItems:=1
+	// +optional
+	MetricRelabelings []v1.RelabelConfig `json:"metricRelabelings,omitempty"`
+	// ProxyConfig allows customizing the proxy behaviour for this scrape config.
+	// +optional
+	v1.ProxyConfig `json:",inline"`
+	// Specifies the validation scheme for metric and label names.
+	//
+	// It requires Prometheus >= v3.0.0.
+	//
+	// +optional
+	NameValidationScheme *v1.NameValidationSchemeOptions `json:"nameValidationScheme,omitempty"`
+	// Metric name escaping mode to request through content negotiation.
+	//
+	// It requires Prometheus >= v3.4.0.
+	//
+	// +optional
+	NameEscapingScheme *v1.NameEscapingSchemeOptions `json:"nameEscapingScheme,omitempty"`
+	// The scrape class to apply.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ScrapeClass *string `json:"scrapeClass,omitempty"`
+}
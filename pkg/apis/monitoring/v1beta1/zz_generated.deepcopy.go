@@ -20,6 +20,7 @@ package v1beta1
 
 import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
@@ -95,6 +96,11 @@ func (in *AlertmanagerConfigSpec) DeepCopyInto(out *AlertmanagerConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResolveTimeout != nil {
+		in, out := &in.ResolveTimeout, &out.ResolveTimeout
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigSpec.
@@ -807,6 +813,389 @@ func (in *SNSConfig) DeepCopy() *SNSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeConfig) DeepCopyInto(out *ScrapeConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeConfig.
+func (in *ScrapeConfig) DeepCopy() *ScrapeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeConfigList) DeepCopyInto(out *ScrapeConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScrapeConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeConfigList.
+func (in *ScrapeConfigList) DeepCopy() *ScrapeConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
+	*out = *in
+	if in.JobName != nil {
+		in, out := &in.JobName, &out.JobName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StaticConfigs != nil {
+		in, out := &in.StaticConfigs, &out.StaticConfigs
+		*out = make([]v1alpha1.StaticConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FileSDConfigs != nil {
+		in, out := &in.FileSDConfigs, &out.FileSDConfigs
+		*out = make([]v1alpha1.FileSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HTTPSDConfigs != nil {
+		in, out := &in.HTTPSDConfigs, &out.HTTPSDConfigs
+		*out = make([]v1alpha1.HTTPSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KubernetesSDConfigs != nil {
+		in, out := &in.KubernetesSDConfigs, &out.KubernetesSDConfigs
+		*out = make([]v1alpha1.KubernetesSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConsulSDConfigs != nil {
+		in, out := &in.ConsulSDConfigs, &out.ConsulSDConfigs
+		*out = make([]v1alpha1.ConsulSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNSSDConfigs != nil {
+		in, out := &in.DNSSDConfigs, &out.DNSSDConfigs
+		*out = make([]v1alpha1.DNSSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EC2SDConfigs != nil {
+		in, out := &in.EC2SDConfigs, &out.EC2SDConfigs
+		*out = make([]v1alpha1.EC2SDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AzureSDConfigs != nil {
+		in, out := &in.AzureSDConfigs, &out.AzureSDConfigs
+		*out = make([]v1alpha1.AzureSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GCESDConfigs != nil {
+		in, out := &in.GCESDConfigs, &out.GCESDConfigs
+		*out = make([]v1alpha1.GCESDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OpenStackSDConfigs != nil {
+		in, out := &in.OpenStackSDConfigs, &out.OpenStackSDConfigs
+		*out = make([]v1alpha1.OpenStackSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DigitalOceanSDConfigs != nil {
+		in, out := &in.DigitalOceanSDConfigs, &out.DigitalOceanSDConfigs
+		*out = make([]v1alpha1.DigitalOceanSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KumaSDConfigs != nil {
+		in, out := &in.KumaSDConfigs, &out.KumaSDConfigs
+		*out = make([]v1alpha1.KumaSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EurekaSDConfigs != nil {
+		in, out := &in.EurekaSDConfigs, &out.EurekaSDConfigs
+		*out = make([]v1alpha1.EurekaSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DockerSDConfigs != nil {
+		in, out := &in.DockerSDConfigs, &out.DockerSDConfigs
+		*out = make([]v1alpha1.DockerSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LinodeSDConfigs != nil {
+		in, out := &in.LinodeSDConfigs, &out.LinodeSDConfigs
+		*out = make([]v1alpha1.LinodeSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HetznerSDConfigs != nil {
+		in, out := &in.HetznerSDConfigs, &out.HetznerSDConfigs
+		*out = make([]v1alpha1.HetznerSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NomadSDConfigs != nil {
+		in, out := &in.NomadSDConfigs, &out.NomadSDConfigs
+		*out = make([]v1alpha1.NomadSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DockerSwarmSDConfigs != nil {
+		in, out := &in.DockerSwarmSDConfigs, &out.DockerSwarmSDConfigs
+		*out = make([]v1alpha1.DockerSwarmSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PuppetDBSDConfigs != nil {
+		in, out := &in.PuppetDBSDConfigs, &out.PuppetDBSDConfigs
+		*out = make([]v1alpha1.PuppetDBSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LightsailSDConfigs != nil {
+		in, out := &in.LightsailSDConfigs, &out.LightsailSDConfigs
+		*out = make([]v1alpha1.LightSailSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OVHCloudSDConfigs != nil {
+		in, out := &in.OVHCloudSDConfigs, &out.OVHCloudSDConfigs
+		*out = make([]v1alpha1.OVHCloudSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScalewaySDConfigs != nil {
+		in, out := &in.ScalewaySDConfigs, &out.ScalewaySDConfigs
+		*out = make([]v1alpha1.ScalewaySDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IonosSDConfigs != nil {
+		in, out := &in.IonosSDConfigs, &out.IonosSDConfigs
+		*out = make([]v1alpha1.IonosSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomResourceSDConfigs != nil {
+		in, out := &in.CustomResourceSDConfigs, &out.CustomResourceSDConfigs
+		*out = make([]v1alpha1.CustomResourceSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Relabelings != nil {
+		in, out := &in.Relabelings, &out.Relabelings
+		*out = make([]monitoringv1.RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetricsPath != nil {
+		in, out := &in.MetricsPath, &out.MetricsPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScrapeInterval != nil {
+		in, out := &in.ScrapeInterval, &out.ScrapeInterval
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.ScrapeTimeout != nil {
+		in, out := &in.ScrapeTimeout, &out.ScrapeTimeout
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.ScrapeProtocols != nil {
+		in, out := &in.ScrapeProtocols, &out.ScrapeProtocols
+		*out = make([]monitoringv1.ScrapeProtocol, len(*in))
+		copy(*out, *in)
+	}
+	if in.FallbackScrapeProtocol != nil {
+		in, out := &in.FallbackScrapeProtocol, &out.FallbackScrapeProtocol
+		*out = new(monitoringv1.ScrapeProtocol)
+		**out = **in
+	}
+	if in.HonorTimestamps != nil {
+		in, out := &in.HonorTimestamps, &out.HonorTimestamps
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrackTimestampsStaleness != nil {
+		in, out := &in.TrackTimestampsStaleness, &out.TrackTimestampsStaleness
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HonorLabels != nil {
+		in, out := &in.HonorLabels, &out.HonorLabels
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableCompression != nil {
+		in, out := &in.EnableCompression, &out.EnableCompression
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(monitoringv1.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(monitoringv1.SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(monitoringv1.OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(monitoringv1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SampleLimit != nil {
+		in, out := &in.SampleLimit, &out.SampleLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TargetLimit != nil {
+		in, out := &in.TargetLimit, &out.TargetLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelLimit != nil {
+		in, out := &in.LabelLimit, &out.LabelLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelNameLengthLimit != nil {
+		in, out := &in.LabelNameLengthLimit, &out.LabelNameLengthLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelValueLengthLimit != nil {
+		in, out := &in.LabelValueLengthLimit, &out.LabelValueLengthLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	in.NativeHistogramConfig.DeepCopyInto(&out.NativeHistogramConfig)
+	if in.KeepDroppedTargets != nil {
+		in, out := &in.KeepDroppedTargets, &out.KeepDroppedTargets
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MetricRelabelings != nil {
+		in, out := &in.MetricRelabelings, &out.MetricRelabelings
+		*out = make([]monitoringv1.RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.NameValidationScheme != nil {
+		in, out := &in.NameValidationScheme, &out.NameValidationScheme
+		*out = new(monitoringv1.NameValidationSchemeOptions)
+		**out = **in
+	}
+	if in.NameEscapingScheme != nil {
+		in, out := &in.NameEscapingScheme, &out.NameEscapingScheme
+		*out = new(monitoringv1.NameEscapingSchemeOptions)
+		**out = **in
+	}
+	if in.ScrapeClass != nil {
+		in, out := &in.ScrapeClass, &out.ScrapeClass
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeConfigSpec.
+func (in *ScrapeConfigSpec) DeepCopy() *ScrapeConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
 	*out = *in
@@ -1176,6 +1565,11 @@ func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 		*out = new(monitoringv1.Duration)
 		**out = **in
 	}
+	if in.SigningSecret != nil {
+		in, out := &in.SigningSecret, &out.SigningSecret
+		*out = new(WebhookSigningSecret)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookConfig.
@@ -1187,3 +1581,18 @@ func (in *WebhookConfig) DeepCopy() *WebhookConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSigningSecret) DeepCopyInto(out *WebhookSigningSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSigningSecret.
+func (in *WebhookSigningSecret) DeepCopy() *WebhookSigningSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSigningSecret)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -16,15 +16,23 @@ package assets
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 )
 
 // StoreBuilder is a store that fetches and caches TLS materials, bearer tokens
@@ -41,6 +49,41 @@ type StoreBuilder struct {
 	objStore cache.Store
 
 	tlsAssetKeys map[tlsAssetKey]struct{}
+	// When not empty, the TLS assets are referenced by their projected
+	// paths and the assets of this namespace are projected directly from
+	// their Secrets and ConfigMaps.
+	tlsAssetsProjectionNamespace string
+
+	// References ("<namespace>/<name>") of the Secrets and ConfigMaps looked
+	// up by the store, including the ones which don't exist.
+	secretRefs    map[string]struct{}
+	configMapRefs map[string]struct{}
+}
+
+// MissingKeyError is returned when the Secret or the ConfigMap referenced by
+// a key selector (or the key itself) doesn't exist.
+type MissingKeyError struct {
+	// Kind is either "Secret" or "ConfigMap".
+	Kind string
+	err  error
+}
+
+func (e *MissingKeyError) Error() string {
+	return e.err.Error()
+}
+
+func (e *MissingKeyError) Unwrap() error {
+	return e.err
+}
+
+// missingKeyError wraps err as a MissingKeyError if the object doesn't
+// exist.
+func missingKeyError(kind string, err error) error {
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return &MissingKeyError{Kind: kind, err: err}
 }
 
 // NewTestStoreBuilder returns a *StoreBuilder already initialized with the
@@ -236,6 +279,7 @@ func (s *StoreBuilder) GetConfigMapKey(ctx context.Context, namespace string, se
 	if namespace == "" {
 		return "", errors.New("namespace cannot be empty")
 	}
+	s.configMapRefs = addReference(s.configMapRefs, namespace, sel.Name)
 
 	obj, exists, err := s.objStore.Get(&v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -250,7 +294,7 @@ func (s *StoreBuilder) GetConfigMapKey(ctx context.Context, namespace string, se
 	if !exists {
 		cm, err := s.cmClient.ConfigMaps(namespace).Get(ctx, sel.Name, metav1.GetOptions{})
 		if err != nil {
-			return "", fmt.Errorf("unable to get configmap %q: %w", sel.Name, err)
+			return "", missingKeyError("ConfigMap", fmt.Errorf("unable to get configmap %q: %w", sel.Name, err))
 		}
 		if err = s.objStore.Add(cm); err != nil {
 			return "", fmt.Errorf("unexpected store error when adding configmap %q: %w", sel.Name, err)
@@ -260,18 +304,34 @@ func (s *StoreBuilder) GetConfigMapKey(ctx context.Context, namespace string, se
 
 	cm := obj.(*v1.ConfigMap)
 	if _, found := cm.Data[sel.Key]; !found {
-		return "", fmt.Errorf("key %q in configmap %q not found", sel.Key, sel.Name)
+		return "", &MissingKeyError{Kind: "ConfigMap", err: k8sutil.NewDependencyMissingError(fmt.Errorf("key %q in configmap %q not found", sel.Key, sel.Name))}
 	}
 
 	return cm.Data[sel.Key], nil
 }
 
+// ValidateSecretKeySelector returns an error if the selector refers to a
+// Secret in another namespace (e.g. "<namespace>/<name>"). The referenced
+// Secrets must live in the same namespace as the referencing resource.
+func ValidateSecretKeySelector(sel v1.SecretKeySelector) error {
+	if ns, name, found := strings.Cut(sel.Name, "/"); found {
+		return k8sutil.NewInvalidSpecError(fmt.Errorf("invalid secret name %q: cross-namespace secret references aren't supported, secret %q should be created in the namespace of the resource instead of %q", sel.Name, name, ns))
+	}
+
+	return nil
+}
+
 // GetSecretKey processes the given SecretKeySelector and returns the referenced data.
 func (s *StoreBuilder) GetSecretKey(ctx context.Context, namespace string, sel v1.SecretKeySelector) (string, error) {
 	if namespace == "" {
 		return "", errors.New("namespace cannot be empty")
 	}
 
+	if err := ValidateSecretKeySelector(sel); err != nil {
+		return "", err
+	}
+	s.secretRefs = addReference(s.secretRefs, namespace, sel.Name)
+
 	obj, exists, err := s.objStore.Get(&v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      sel.Name,
@@ -285,7 +345,7 @@ func (s *StoreBuilder) GetSecretKey(ctx context.Context, namespace string, sel v
 	if !exists {
 		secret, err := s.sClient.Secrets(namespace).Get(ctx, sel.Name, metav1.GetOptions{})
 		if err != nil {
-			return "", fmt.Errorf("unable to get secret %q: %w", sel.Name, err)
+			return "", missingKeyError("Secret", fmt.Errorf("unable to get secret %q: %w", sel.Name, err))
 		}
 		if err = s.objStore.Add(secret); err != nil {
 			return "", fmt.Errorf("unexpected store error when adding secret %q: %w", sel.Name, err)
@@ -295,7 +355,7 @@ func (s *StoreBuilder) GetSecretKey(ctx context.Context, namespace string, sel v
 
 	secret := obj.(*v1.Secret)
 	if _, found := secret.Data[sel.Key]; !found {
-		return "", fmt.Errorf("key %q in secret %q not found", sel.Key, sel.Name)
+		return "", &MissingKeyError{Kind: "Secret", err: k8sutil.NewDependencyMissingError(fmt.Errorf("key %q in secret %q not found", sel.Key, sel.Name))}
 	}
 
 	return string(secret.Data[sel.Key]), nil
@@ -309,14 +369,17 @@ func (s *StoreBuilder) ForNamespace(namespace string) StoreGetter {
 		panic("namespace can't be empty")
 	}
 	return &cacheOnlyStore{
-		ns: namespace,
-		c:  s.objStore,
+		ns:                  namespace,
+		c:                   s.objStore,
+		tlsAssetsProjection: s.tlsAssetsProjectionNamespace != "",
 	}
 }
 
 type cacheOnlyStore struct {
 	ns string
 	c  cache.Store
+
+	tlsAssetsProjection bool
 }
 
 var _ = StoreGetter(&cacheOnlyStore{})
@@ -328,12 +391,12 @@ func (cos *cacheOnlyStore) GetConfigMapKey(sel v1.ConfigMapKeySelector) (string,
 	}
 
 	if !exists {
-		return "", fmt.Errorf("configmap %s/%s not found", cos.ns, sel.Name)
+		return "", k8sutil.NewDependencyMissingError(fmt.Errorf("configmap %s/%s not found", cos.ns, sel.Name))
 	}
 
 	cm := obj.(*v1.ConfigMap)
 	if _, found := cm.Data[sel.Key]; !found {
-		return "", fmt.Errorf("key %q in configmap %s/%s not found", sel.Key, cos.ns, sel.Name)
+		return "", k8sutil.NewDependencyMissingError(fmt.Errorf("key %q in configmap %s/%s not found", sel.Key, cos.ns, sel.Name))
 	}
 
 	return cm.Data[sel.Key], nil
@@ -346,12 +409,12 @@ func (cos *cacheOnlyStore) GetSecretKey(sel v1.SecretKeySelector) ([]byte, error
 	}
 
 	if !exists {
-		return nil, fmt.Errorf("secret %s/%s not found", cos.ns, sel.Name)
+		return nil, k8sutil.NewDependencyMissingError(fmt.Errorf("secret %s/%s not found", cos.ns, sel.Name))
 	}
 
 	s := obj.(*v1.Secret)
 	if _, found := s.Data[sel.Key]; !found {
-		return nil, fmt.Errorf("key %q in secret %s/%s not found", sel.Key, cos.ns, sel.Name)
+		return nil, k8sutil.NewDependencyMissingError(fmt.Errorf("key %q in secret %s/%s not found", sel.Key, cos.ns, sel.Name))
 	}
 
 	return s.Data[sel.Key], nil
@@ -386,6 +449,10 @@ func (cos *cacheOnlyStore) TLSAsset(sel interface{}) string {
 		return ""
 	}
 
+	if cos.tlsAssetsProjection {
+		return k.path()
+	}
+
 	return k.toString()
 }
 
@@ -456,3 +523,91 @@ func (s *StoreBuilder) DeleteObject(obj interface{}) error {
 func (s *StoreBuilder) GetSecretClient() corev1client.SecretsGetter {
 	return s.sClient
 }
+
+// UnwatchedSecrets returns the keys ("<namespace>/<name>") of the Secrets
+// in the store which don't match the given label and field selectors. Changes
+// to these Secrets aren't detected by the operator when it restricts the
+// watched Secrets with the same selectors.
+func (s *StoreBuilder) UnwatchedSecrets(ls labels.Selector, fs fields.Selector) []string {
+	if ls.Empty() && fs.Empty() {
+		return nil
+	}
+
+	var ret []string
+	for _, obj := range s.objStore.List() {
+		secret, ok := obj.(*v1.Secret)
+		if !ok {
+			continue
+		}
+
+		if ls.Matches(labels.Set(secret.Labels)) && fs.Matches(fields.Set{
+			"metadata.name":      secret.Name,
+			"metadata.namespace": secret.Namespace,
+			"type":               string(secret.Type),
+		}) {
+			continue
+		}
+
+		ret = append(ret, secret.Namespace+"/"+secret.Name)
+	}
+	sort.Strings(ret)
+
+	return ret
+}
+
+func addReference(refs map[string]struct{}, namespace, name string) map[string]struct{} {
+	if refs == nil {
+		refs = make(map[string]struct{})
+	}
+	refs[namespace+"/"+name] = struct{}{}
+
+	return refs
+}
+
+// AddSecretReference records a reference to a Secret which is read without
+// the store (e.g. the additional scrape configurations).
+func (s *StoreBuilder) AddSecretReference(namespace, name string) {
+	s.secretRefs = addReference(s.secretRefs, namespace, name)
+}
+
+// References returns the Secrets and ConfigMaps ("<namespace>/<name>") which
+// have been looked up by the store, whether they exist or not.
+func (s *StoreBuilder) References() (secrets []string, configMaps []string) {
+	for k := range s.secretRefs {
+		secrets = append(secrets, k)
+	}
+	sort.Strings(secrets)
+
+	for k := range s.configMapRefs {
+		configMaps = append(configMaps, k)
+	}
+	sort.Strings(configMaps)
+
+	return secrets, configMaps
+}
+
+// Hash returns a hash of the content of the Secrets and ConfigMaps which have
+// been loaded in the store.
+func (s *StoreBuilder) Hash() (string, error) {
+	content := make(map[string]any)
+	for _, obj := range s.objStore.List() {
+		k, err := assetKeyFunc(obj)
+		if err != nil {
+			return "", err
+		}
+
+		switch v := obj.(type) {
+		case *v1.ConfigMap:
+			content[k] = []any{v.Data, v.BinaryData}
+		case *v1.Secret:
+			content[k] = v.Data
+		}
+	}
+
+	b, err := json.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the store content: %w", err)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
@@ -21,7 +21,10 @@ import (
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
@@ -114,6 +117,14 @@ func TestGetSecretKey(t *testing.T) {
 			selectedName: "secret",
 			selectedKey:  "key2",
 
+			err: true,
+		},
+		// Cross-namespace reference.
+		{
+			ns:           "ns2",
+			selectedName: "ns1/secret",
+			selectedKey:  "key1",
+
 			err: true,
 		},
 	} {
@@ -833,6 +844,175 @@ func TestAddTLSConfig(t *testing.T) {
 	}
 }
 
+func TestAddTLSConfigWithCertificateSecret(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tls",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"ca.crt":  []byte(caPEM),
+				"tls.crt": []byte(certPEM),
+				"tls.key": []byte(keyPEM),
+			},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tls-no-ca",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"tls.crt": []byte(certPEM),
+				"tls.key": []byte(keyPEM),
+			},
+		},
+	)
+
+	for _, tc := range []struct {
+		name      string
+		tlsConfig *monitoringv1.TLSConfig
+
+		err        bool
+		expectedCA bool
+	}{
+		{
+			name: "secret with CA",
+			tlsConfig: &monitoringv1.TLSConfig{
+				CertificateSecret: ptr.To("tls"),
+			},
+			expectedCA: true,
+		},
+		{
+			name: "secret with CA and caFile",
+			tlsConfig: &monitoringv1.TLSConfig{
+				CAFile:            "/etc/ca.crt",
+				CertificateSecret: ptr.To("tls"),
+			},
+		},
+		{
+			name: "secret without CA",
+			tlsConfig: &monitoringv1.TLSConfig{
+				CertificateSecret: ptr.To("tls-no-ca"),
+			},
+		},
+		{
+			name: "missing secret",
+			tlsConfig: &monitoringv1.TLSConfig{
+				CertificateSecret: ptr.To("missing"),
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewStoreBuilder(c.CoreV1(), c.CoreV1())
+
+			err := store.AddTLSConfig(context.Background(), "ns1", tc.tlsConfig)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ref := v1.LocalObjectReference{Name: *tc.tlsConfig.CertificateSecret}
+			tlsAssets := store.TLSAssets()
+
+			key := tlsAssetKeyFromSelector("ns1", monitoringv1.SecretOrConfigMap{
+				Secret: &v1.SecretKeySelector{LocalObjectReference: ref, Key: "tls.crt"},
+			}).toString()
+			require.Equal(t, certPEM, string(tlsAssets[key]))
+
+			key = tlsAssetKeyFromSecretSelector("ns1", &v1.SecretKeySelector{LocalObjectReference: ref, Key: "tls.key"}).toString()
+			require.Equal(t, keyPEM, string(tlsAssets[key]))
+
+			key = tlsAssetKeyFromSelector("ns1", monitoringv1.SecretOrConfigMap{
+				Secret: &v1.SecretKeySelector{LocalObjectReference: ref, Key: "ca.crt"},
+			}).toString()
+			_, found := tlsAssets[key]
+			require.Equal(t, tc.expectedCA, found)
+		})
+	}
+}
+
+func TestTLSAssetsProjection(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tls",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"tls.crt": []byte(certPEM),
+				"tls.key": []byte(keyPEM),
+			},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca",
+				Namespace: "ns1",
+			},
+			Data: map[string]string{
+				"ca.crt": caPEM,
+			},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca",
+				Namespace: "ns2",
+			},
+			Data: map[string]string{
+				"ca.crt": caPEM,
+			},
+		},
+	)
+
+	store := NewStoreBuilder(c.CoreV1(), c.CoreV1())
+	store.EnableTLSAssetsProjection("ns1")
+
+	ca := monitoringv1.SecretOrConfigMap{
+		ConfigMap: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "ca"}, Key: "ca.crt"},
+	}
+	tlsConfig := &monitoringv1.SafeTLSConfig{
+		CA: ca,
+		Cert: monitoringv1.SecretOrConfigMap{
+			Secret: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "tls"}, Key: "tls.crt"},
+		},
+		KeySecret: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "tls"}, Key: "tls.key"},
+	}
+	require.NoError(t, store.AddSafeTLSConfig(context.Background(), "ns1", tlsConfig))
+	require.NoError(t, store.AddSafeTLSConfig(context.Background(), "ns2", &monitoringv1.SafeTLSConfig{CA: ca}))
+
+	// The configuration references the assets by their projected paths.
+	require.Equal(t, "ns1/configmap/ca/ca.crt", store.ForNamespace("ns1").TLSAsset(ca))
+	require.Equal(t, "ns1/secret/tls/tls.key", store.ForNamespace("ns1").TLSAsset(tlsConfig.KeySecret))
+	require.Equal(t, "ns2/configmap/ca/ca.crt", store.ForNamespace("ns2").TLSAsset(ca))
+
+	// Only the assets of the other namespaces are copied.
+	key := tlsAssetKeyFromSelector("ns2", ca).toString()
+	require.Equal(t, map[string][]byte{key: []byte(caPEM)}, store.TLSAssets())
+	require.Equal(t, map[string]string{key: "ns2/configmap/ca/ca.crt"}, store.TLSAssetPaths())
+
+	require.Equal(t, []v1.VolumeProjection{
+		{
+			Secret: &v1.SecretProjection{
+				LocalObjectReference: v1.LocalObjectReference{Name: "tls"},
+				Items: []v1.KeyToPath{
+					{Key: "tls.crt", Path: "ns1/secret/tls/tls.crt"},
+					{Key: "tls.key", Path: "ns1/secret/tls/tls.key"},
+				},
+			},
+		},
+		{
+			ConfigMap: &v1.ConfigMapProjection{
+				LocalObjectReference: v1.LocalObjectReference{Name: "ca"},
+				Items: []v1.KeyToPath{
+					{Key: "ca.crt", Path: "ns1/configmap/ca/ca.crt"},
+				},
+			},
+		},
+	}, store.TLSAssetProjections())
+}
+
 func TestAddAuthorization(t *testing.T) {
 	c := fake.NewSimpleClientset(
 		&v1.Secret{
@@ -1472,3 +1652,121 @@ func TestAddObject(t *testing.T) {
 	err = store.AddObject(nil)
 	require.Error(t, err)
 }
+
+func TestUnwatchedSecrets(t *testing.T) {
+	store := NewTestStoreBuilder(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "watched",
+				Namespace: "ns",
+				Labels:    map[string]string{"monitoring": "true"},
+			},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "unlabeled",
+				Namespace: "ns",
+			},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "wrong-type",
+				Namespace: "ns",
+				Labels:    map[string]string{"monitoring": "true"},
+			},
+			Type: v1.SecretTypeDockerConfigJson,
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "unlabeled",
+				Namespace: "ns",
+			},
+		},
+	)
+
+	require.Empty(t, store.UnwatchedSecrets(labels.Everything(), fields.Everything()))
+
+	ls, err := labels.Parse("monitoring=true")
+	require.NoError(t, err)
+	require.Equal(t, []string{"ns/unlabeled"}, store.UnwatchedSecrets(ls, fields.Everything()))
+
+	fs, err := fields.ParseSelector("type!=" + string(v1.SecretTypeDockerConfigJson))
+	require.NoError(t, err)
+	require.Equal(t, []string{"ns/unlabeled", "ns/wrong-type"}, store.UnwatchedSecrets(ls, fs))
+}
+
+func TestReferences(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "ns",
+			},
+			Data: map[string][]byte{"key": []byte("value")},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cm",
+				Namespace: "ns",
+			},
+			Data: map[string]string{"key": "value"},
+		},
+	)
+	store := NewStoreBuilder(c.CoreV1(), c.CoreV1())
+
+	_, err := store.GetSecretKey(context.Background(), "ns", v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+		Key:                  "key",
+	})
+	require.NoError(t, err)
+
+	// Missing objects are referenced too.
+	_, err = store.GetSecretKey(context.Background(), "ns", v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "missing"},
+		Key:                  "key",
+	})
+	require.Error(t, err)
+
+	_, err = store.GetConfigMapKey(context.Background(), "ns", v1.ConfigMapKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "cm"},
+		Key:                  "key",
+	})
+	require.NoError(t, err)
+
+	store.AddSecretReference("ns", "additional")
+
+	secrets, configMaps := store.References()
+	require.Equal(t, []string{"ns/additional", "ns/missing", "ns/secret"}, secrets)
+	require.Equal(t, []string{"ns/cm"}, configMaps)
+}
+
+func TestHash(t *testing.T) {
+	newSecret := func(v string) *v1.Secret {
+		return &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "secret",
+				Namespace:       "ns",
+				ResourceVersion: v,
+			},
+			Data: map[string][]byte{"key": []byte(v)},
+		}
+	}
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cm",
+			Namespace: "ns",
+		},
+		Data: map[string]string{"key": "value"},
+	}
+
+	h1, err := NewTestStoreBuilder(newSecret("a"), cm).Hash()
+	require.NoError(t, err)
+
+	h2, err := NewTestStoreBuilder(cm, newSecret("a")).Hash()
+	require.NoError(t, err)
+	require.Equal(t, h1, h2)
+
+	h3, err := NewTestStoreBuilder(newSecret("b"), cm).Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, h1, h3)
+}
@@ -20,6 +20,9 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"path"
+	"slices"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 
@@ -74,6 +77,17 @@ func (k tlsAssetKey) toString() string {
 	return fmt.Sprintf("%d_%s_%s_%s", k.from, k.ns, k.name, k.key)
 }
 
+// path returns the relative path of the TLS asset when the TLS assets are
+// projected (e.g. "default/secret/etcd-client/tls.crt").
+func (k tlsAssetKey) path() string {
+	kind := "secret"
+	if k.from == fromConfigMap {
+		kind = "configmap"
+	}
+
+	return path.Join(k.ns, kind, k.name, k.key)
+}
+
 // addTLSAssets processes the given SafeTLSConfig and adds the referenced CA, certificate and key to the store.
 func (s *StoreBuilder) addTLSAssets(ctx context.Context, ns string, tlsConfig monitoringv1.SafeTLSConfig) error {
 	var (
@@ -156,15 +170,47 @@ func (s *StoreBuilder) AddTLSConfig(ctx context.Context, ns string, tlsConfig *m
 		return fmt.Errorf("failed to validate TLS configuration: %w", err)
 	}
 
-	return s.addTLSAssets(ctx, ns, tlsConfig.SafeTLSConfig)
+	var hasCA bool
+	if tlsConfig.CertificateSecret != nil {
+		_, err := s.GetSecretKey(ctx, ns, v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: *tlsConfig.CertificateSecret},
+			Key:                  monitoringv1.CertificateSecretCAKey,
+		})
+		hasCA = err == nil
+	}
+
+	return s.addTLSAssets(ctx, ns, tlsConfig.SafeTLSConfigWithCertificateSecret(hasCA))
+}
+
+// EnableTLSAssetsProjection configures the store to reference the TLS assets
+// by paths preserving their namespace, name and key. The assets located in
+// the given namespace (the namespace of the workload) are projected directly
+// from their Secrets and ConfigMaps instead of being copied.
+//
+// It must be called before the store is used.
+func (s *StoreBuilder) EnableTLSAssetsProjection(namespace string) {
+	s.tlsAssetsProjectionNamespace = namespace
+}
+
+// projected returns true if the TLS asset is projected directly from its
+// Secret or ConfigMap.
+func (s *StoreBuilder) projected(k tlsAssetKey) bool {
+	return s.tlsAssetsProjectionNamespace != "" && k.ns == s.tlsAssetsProjectionNamespace
 }
 
 // TLSAssets returns a map of TLS assets (certificates and keys) which have
 // been added to the store by AddTLSConfig() and AddSafeTLSConfig().
+//
+// When the projection is enabled, the assets projected directly from their
+// Secrets and ConfigMaps are omitted.
 func (s *StoreBuilder) TLSAssets() map[string][]byte {
 	m := make(map[string][]byte, len(s.tlsAssetKeys))
 
 	for tak := range s.tlsAssetKeys {
+		if s.projected(tak) {
+			continue
+		}
+
 		obj, found, err := s.objStore.GetByKey(fmt.Sprintf("%d/%s/%s", tak.from, tak.ns, tak.name))
 		if !found || err != nil {
 			continue
@@ -185,3 +231,74 @@ func (s *StoreBuilder) TLSAssets() map[string][]byte {
 
 	return m
 }
+
+// TLSAssetPaths returns the relative paths of the TLS assets returned by
+// TLSAssets() indexed by key. It returns nil if the projection isn't enabled.
+func (s *StoreBuilder) TLSAssetPaths() map[string]string {
+	if s.tlsAssetsProjectionNamespace == "" {
+		return nil
+	}
+
+	m := map[string]string{}
+	for tak := range s.tlsAssetKeys {
+		if !s.projected(tak) {
+			m[tak.toString()] = tak.path()
+		}
+	}
+
+	return m
+}
+
+// TLSAssetProjections returns the volume projections of the TLS assets
+// located in the namespace of the workload, one per Secret or ConfigMap. It
+// returns nil if the projection isn't enabled.
+func (s *StoreBuilder) TLSAssetProjections() []v1.VolumeProjection {
+	if s.tlsAssetsProjectionNamespace == "" {
+		return nil
+	}
+
+	type object struct {
+		from source
+		name string
+	}
+	items := map[object][]v1.KeyToPath{}
+	for tak := range s.tlsAssetKeys {
+		if !s.projected(tak) {
+			continue
+		}
+
+		src := object{from: tak.from, name: tak.name}
+		items[src] = append(items[src], v1.KeyToPath{Key: tak.key, Path: tak.path()})
+	}
+
+	objects := make([]object, 0, len(items))
+	for src := range items {
+		objects = append(objects, src)
+	}
+	slices.SortFunc(objects, func(a, b object) int {
+		if a.from != b.from {
+			return int(a.from) - int(b.from)
+		}
+		return strings.Compare(a.name, b.name)
+	})
+
+	projections := make([]v1.VolumeProjection, 0, len(objects))
+	for _, src := range objects {
+		kp := items[src]
+		slices.SortFunc(kp, func(a, b v1.KeyToPath) int { return strings.Compare(a.Key, b.Key) })
+
+		ref := v1.LocalObjectReference{Name: src.name}
+		if src.from == fromConfigMap {
+			projections = append(projections, v1.VolumeProjection{
+				ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: ref, Items: kp},
+			})
+			continue
+		}
+
+		projections = append(projections, v1.VolumeProjection{
+			Secret: &v1.SecretProjection{LocalObjectReference: ref, Items: kp},
+		})
+	}
+
+	return projections
+}
@@ -26,64 +26,67 @@ import (
 // AlertmanagerSpecApplyConfiguration represents a declarative configuration of the AlertmanagerSpec type for use
 // with apply.
 type AlertmanagerSpecApplyConfiguration struct {
-	PodMetadata                          *EmbeddedObjectMetadataApplyConfiguration               `json:"podMetadata,omitempty"`
-	Image                                *string                                                 `json:"image,omitempty"`
-	ImagePullPolicy                      *corev1.PullPolicy                                      `json:"imagePullPolicy,omitempty"`
-	Version                              *string                                                 `json:"version,omitempty"`
-	Tag                                  *string                                                 `json:"tag,omitempty"`
-	SHA                                  *string                                                 `json:"sha,omitempty"`
-	BaseImage                            *string                                                 `json:"baseImage,omitempty"`
-	ImagePullSecrets                     []corev1.LocalObjectReference                           `json:"imagePullSecrets,omitempty"`
-	Secrets                              []string                                                `json:"secrets,omitempty"`
-	ConfigMaps                           []string                                                `json:"configMaps,omitempty"`
-	ConfigSecret                         *string                                                 `json:"configSecret,omitempty"`
-	LogLevel                             *string                                                 `json:"logLevel,omitempty"`
-	LogFormat                            *string                                                 `json:"logFormat,omitempty"`
-	Replicas                             *int32                                                  `json:"replicas,omitempty"`
-	Retention                            *monitoringv1.GoDuration                                `json:"retention,omitempty"`
-	Storage                              *StorageSpecApplyConfiguration                          `json:"storage,omitempty"`
-	Volumes                              []corev1.Volume                                         `json:"volumes,omitempty"`
-	VolumeMounts                         []corev1.VolumeMount                                    `json:"volumeMounts,omitempty"`
-	PersistentVolumeClaimRetentionPolicy *appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
-	ExternalURL                          *string                                                 `json:"externalUrl,omitempty"`
-	RoutePrefix                          *string                                                 `json:"routePrefix,omitempty"`
-	Paused                               *bool                                                   `json:"paused,omitempty"`
-	NodeSelector                         map[string]string                                       `json:"nodeSelector,omitempty"`
-	Resources                            *corev1.ResourceRequirements                            `json:"resources,omitempty"`
-	Affinity                             *corev1.Affinity                                        `json:"affinity,omitempty"`
-	Tolerations                          []corev1.Toleration                                     `json:"tolerations,omitempty"`
-	TopologySpreadConstraints            []corev1.TopologySpreadConstraint                       `json:"topologySpreadConstraints,omitempty"`
-	SecurityContext                      *corev1.PodSecurityContext                              `json:"securityContext,omitempty"`
-	DNSPolicy                            *monitoringv1.DNSPolicy                                 `json:"dnsPolicy,omitempty"`
-	DNSConfig                            *PodDNSConfigApplyConfiguration                         `json:"dnsConfig,omitempty"`
-	EnableServiceLinks                   *bool                                                   `json:"enableServiceLinks,omitempty"`
-	ServiceName                          *string                                                 `json:"serviceName,omitempty"`
-	ServiceAccountName                   *string                                                 `json:"serviceAccountName,omitempty"`
-	ListenLocal                          *bool                                                   `json:"listenLocal,omitempty"`
-	Containers                           []corev1.Container                                      `json:"containers,omitempty"`
-	InitContainers                       []corev1.Container                                      `json:"initContainers,omitempty"`
-	PriorityClassName                    *string                                                 `json:"priorityClassName,omitempty"`
-	AdditionalPeers                      []string                                                `json:"additionalPeers,omitempty"`
-	ClusterAdvertiseAddress              *string                                                 `json:"clusterAdvertiseAddress,omitempty"`
-	ClusterGossipInterval                *monitoringv1.GoDuration                                `json:"clusterGossipInterval,omitempty"`
-	ClusterLabel                         *string                                                 `json:"clusterLabel,omitempty"`
-	ClusterPushpullInterval              *monitoringv1.GoDuration                                `json:"clusterPushpullInterval,omitempty"`
-	ClusterPeerTimeout                   *monitoringv1.GoDuration                                `json:"clusterPeerTimeout,omitempty"`
-	PortName                             *string                                                 `json:"portName,omitempty"`
-	ForceEnableClusterMode               *bool                                                   `json:"forceEnableClusterMode,omitempty"`
-	AlertmanagerConfigSelector           *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerConfigSelector,omitempty"`
-	AlertmanagerConfigNamespaceSelector  *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerConfigNamespaceSelector,omitempty"`
-	AlertmanagerConfigMatcherStrategy    *AlertmanagerConfigMatcherStrategyApplyConfiguration    `json:"alertmanagerConfigMatcherStrategy,omitempty"`
-	MinReadySeconds                      *uint32                                                 `json:"minReadySeconds,omitempty"`
-	HostAliases                          []HostAliasApplyConfiguration                           `json:"hostAliases,omitempty"`
-	Web                                  *AlertmanagerWebSpecApplyConfiguration                  `json:"web,omitempty"`
-	Limits                               *AlertmanagerLimitsSpecApplyConfiguration               `json:"limits,omitempty"`
-	ClusterTLS                           *ClusterTLSConfigApplyConfiguration                     `json:"clusterTLS,omitempty"`
-	AlertmanagerConfiguration            *AlertmanagerConfigurationApplyConfiguration            `json:"alertmanagerConfiguration,omitempty"`
-	AutomountServiceAccountToken         *bool                                                   `json:"automountServiceAccountToken,omitempty"`
-	EnableFeatures                       []string                                                `json:"enableFeatures,omitempty"`
-	AdditionalArgs                       []ArgumentApplyConfiguration                            `json:"additionalArgs,omitempty"`
-	TerminationGracePeriodSeconds        *int64                                                  `json:"terminationGracePeriodSeconds,omitempty"`
+	PodMetadata                            *EmbeddedObjectMetadataApplyConfiguration               `json:"podMetadata,omitempty"`
+	Image                                  *string                                                 `json:"image,omitempty"`
+	ImagePullPolicy                        *corev1.PullPolicy                                      `json:"imagePullPolicy,omitempty"`
+	Version                                *string                                                 `json:"version,omitempty"`
+	Tag                                    *string                                                 `json:"tag,omitempty"`
+	SHA                                    *string                                                 `json:"sha,omitempty"`
+	BaseImage                              *string                                                 `json:"baseImage,omitempty"`
+	ImagePullSecrets                       []corev1.LocalObjectReference                           `json:"imagePullSecrets,omitempty"`
+	Secrets                                []string                                                `json:"secrets,omitempty"`
+	ConfigMaps                             []string                                                `json:"configMaps,omitempty"`
+	ConfigSecret                           *string                                                 `json:"configSecret,omitempty"`
+	LogLevel                               *string                                                 `json:"logLevel,omitempty"`
+	LogFormat                              *string                                                 `json:"logFormat,omitempty"`
+	Replicas                               *int32                                                  `json:"replicas,omitempty"`
+	Retention                              *monitoringv1.GoDuration                                `json:"retention,omitempty"`
+	Storage                                *StorageSpecApplyConfiguration                          `json:"storage,omitempty"`
+	Volumes                                []corev1.Volume                                         `json:"volumes,omitempty"`
+	VolumeMounts                           []corev1.VolumeMount                                    `json:"volumeMounts,omitempty"`
+	PersistentVolumeClaimRetentionPolicy   *appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
+	ExternalURL                            *string                                                 `json:"externalUrl,omitempty"`
+	RoutePrefix                            *string                                                 `json:"routePrefix,omitempty"`
+	Paused                                 *bool                                                   `json:"paused,omitempty"`
+	NodeSelector                           map[string]string                                       `json:"nodeSelector,omitempty"`
+	Resources                              *corev1.ResourceRequirements                            `json:"resources,omitempty"`
+	ConfigReloader                         *ConfigReloaderSpecApplyConfiguration                   `json:"configReloader,omitempty"`
+	Affinity                               *corev1.Affinity                                        `json:"affinity,omitempty"`
+	Tolerations                            []corev1.Toleration                                     `json:"tolerations,omitempty"`
+	TopologySpreadConstraints              []corev1.TopologySpreadConstraint                       `json:"topologySpreadConstraints,omitempty"`
+	SecurityContext                        *corev1.PodSecurityContext                              `json:"securityContext,omitempty"`
+	DNSPolicy                              *monitoringv1.DNSPolicy                                 `json:"dnsPolicy,omitempty"`
+	DNSConfig                              *PodDNSConfigApplyConfiguration                         `json:"dnsConfig,omitempty"`
+	EnableServiceLinks                     *bool                                                   `json:"enableServiceLinks,omitempty"`
+	ServiceName                            *string                                                 `json:"serviceName,omitempty"`
+	ServiceAccountName                     *string                                                 `json:"serviceAccountName,omitempty"`
+	ListenLocal                            *bool                                                   `json:"listenLocal,omitempty"`
+	Containers                             []corev1.Container                                      `json:"containers,omitempty"`
+	InitContainers                         []corev1.Container                                      `json:"initContainers,omitempty"`
+	PriorityClassName                      *string                                                 `json:"priorityClassName,omitempty"`
+	AdditionalPeers                        []string                                                `json:"additionalPeers,omitempty"`
+	AdditionalPeersHealthCheck             *bool                                                   `json:"additionalPeersHealthCheck,omitempty"`
+	ClusterAdvertiseAddress                *string                                                 `json:"clusterAdvertiseAddress,omitempty"`
+	ClusterGossipInterval                  *monitoringv1.GoDuration                                `json:"clusterGossipInterval,omitempty"`
+	ClusterLabel                           *string                                                 `json:"clusterLabel,omitempty"`
+	ClusterPushpullInterval                *monitoringv1.GoDuration                                `json:"clusterPushpullInterval,omitempty"`
+	ClusterPeerTimeout                     *monitoringv1.GoDuration                                `json:"clusterPeerTimeout,omitempty"`
+	PortName                               *string                                                 `json:"portName,omitempty"`
+	ForceEnableClusterMode                 *bool                                                   `json:"forceEnableClusterMode,omitempty"`
+	AlertmanagerConfigSelector             *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerConfigSelector,omitempty"`
+	AlertmanagerConfigNamespaceSelector    *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerConfigNamespaceSelector,omitempty"`
+	AlertmanagerConfigMatcherStrategy      *AlertmanagerConfigMatcherStrategyApplyConfiguration    `json:"alertmanagerConfigMatcherStrategy,omitempty"`
+	AlertmanagerConfigResolveTimeoutBounds *DurationBoundsApplyConfiguration                       `json:"alertmanagerConfigResolveTimeoutBounds,omitempty"`
+	MinReadySeconds                        *uint32                                                 `json:"minReadySeconds,omitempty"`
+	HostAliases                            []HostAliasApplyConfiguration                           `json:"hostAliases,omitempty"`
+	Web                                    *AlertmanagerWebSpecApplyConfiguration                  `json:"web,omitempty"`
+	Limits                                 *AlertmanagerLimitsSpecApplyConfiguration               `json:"limits,omitempty"`
+	ClusterTLS                             *ClusterTLSConfigApplyConfiguration                     `json:"clusterTLS,omitempty"`
+	AlertmanagerConfiguration              *AlertmanagerConfigurationApplyConfiguration            `json:"alertmanagerConfiguration,omitempty"`
+	AutomountServiceAccountToken           *bool                                                   `json:"automountServiceAccountToken,omitempty"`
+	EnableFeatures                         []string                                                `json:"enableFeatures,omitempty"`
+	AdditionalArgs                         []ArgumentApplyConfiguration                            `json:"additionalArgs,omitempty"`
+	TerminationGracePeriodSeconds          *int64                                                  `json:"terminationGracePeriodSeconds,omitempty"`
 }
 
 // AlertmanagerSpecApplyConfiguration constructs a declarative configuration of the AlertmanagerSpec type for use with
@@ -300,6 +303,14 @@ func (b *AlertmanagerSpecApplyConfiguration) WithResources(value corev1.Resource
 	return b
 }
 
+// WithConfigReloader sets the ConfigReloader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigReloader field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithConfigReloader(value *ConfigReloaderSpecApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.ConfigReloader = value
+	return b
+}
+
 // WithAffinity sets the Affinity field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Affinity field is set to the value of the last call.
@@ -422,6 +433,14 @@ func (b *AlertmanagerSpecApplyConfiguration) WithAdditionalPeers(values ...strin
 	return b
 }
 
+// WithAdditionalPeersHealthCheck sets the AdditionalPeersHealthCheck field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdditionalPeersHealthCheck field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithAdditionalPeersHealthCheck(value bool) *AlertmanagerSpecApplyConfiguration {
+	b.AdditionalPeersHealthCheck = &value
+	return b
+}
+
 // WithClusterAdvertiseAddress sets the ClusterAdvertiseAddress field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ClusterAdvertiseAddress field is set to the value of the last call.
@@ -502,6 +521,14 @@ func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerConfigMatcherStrate
 	return b
 }
 
+// WithAlertmanagerConfigResolveTimeoutBounds sets the AlertmanagerConfigResolveTimeoutBounds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlertmanagerConfigResolveTimeoutBounds field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerConfigResolveTimeoutBounds(value *DurationBoundsApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.AlertmanagerConfigResolveTimeoutBounds = value
+	return b
+}
+
 // WithMinReadySeconds sets the MinReadySeconds field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MinReadySeconds field is set to the value of the last call.
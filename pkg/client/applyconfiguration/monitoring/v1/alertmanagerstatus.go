@@ -19,13 +19,16 @@ package v1
 // AlertmanagerStatusApplyConfiguration represents a declarative configuration of the AlertmanagerStatus type for use
 // with apply.
 type AlertmanagerStatusApplyConfiguration struct {
-	Paused              *bool                         `json:"paused,omitempty"`
-	Replicas            *int32                        `json:"replicas,omitempty"`
-	UpdatedReplicas     *int32                        `json:"updatedReplicas,omitempty"`
-	AvailableReplicas   *int32                        `json:"availableReplicas,omitempty"`
-	UnavailableReplicas *int32                        `json:"unavailableReplicas,omitempty"`
-	Selector            *string                       `json:"selector,omitempty"`
-	Conditions          []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Paused                     *bool                            `json:"paused,omitempty"`
+	Replicas                   *int32                           `json:"replicas,omitempty"`
+	UpdatedReplicas            *int32                           `json:"updatedReplicas,omitempty"`
+	AvailableReplicas          *int32                           `json:"availableReplicas,omitempty"`
+	UnavailableReplicas        *int32                           `json:"unavailableReplicas,omitempty"`
+	Rollout                    *RolloutStatusApplyConfiguration `json:"rollout,omitempty"`
+	Selector                   *string                          `json:"selector,omitempty"`
+	Conditions                 []ConditionApplyConfiguration    `json:"conditions,omitempty"`
+	UnreachableAdditionalPeers []string                         `json:"unreachableAdditionalPeers,omitempty"`
+	OperatorInfo               *OperatorInfoApplyConfiguration  `json:"operatorInfo,omitempty"`
 }
 
 // AlertmanagerStatusApplyConfiguration constructs a declarative configuration of the AlertmanagerStatus type for use with
@@ -74,6 +77,14 @@ func (b *AlertmanagerStatusApplyConfiguration) WithUnavailableReplicas(value int
 	return b
 }
 
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *AlertmanagerStatusApplyConfiguration) WithRollout(value *RolloutStatusApplyConfiguration) *AlertmanagerStatusApplyConfiguration {
+	b.Rollout = value
+	return b
+}
+
 // WithSelector sets the Selector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Selector field is set to the value of the last call.
@@ -94,3 +105,21 @@ func (b *AlertmanagerStatusApplyConfiguration) WithConditions(values ...*Conditi
 	}
 	return b
 }
+
+// WithUnreachableAdditionalPeers adds the given value to the UnreachableAdditionalPeers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnreachableAdditionalPeers field.
+func (b *AlertmanagerStatusApplyConfiguration) WithUnreachableAdditionalPeers(values ...string) *AlertmanagerStatusApplyConfiguration {
+	for i := range values {
+		b.UnreachableAdditionalPeers = append(b.UnreachableAdditionalPeers, values[i])
+	}
+	return b
+}
+
+// WithOperatorInfo sets the OperatorInfo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperatorInfo field is set to the value of the last call.
+func (b *AlertmanagerStatusApplyConfiguration) WithOperatorInfo(value *OperatorInfoApplyConfiguration) *AlertmanagerStatusApplyConfiguration {
+	b.OperatorInfo = value
+	return b
+}
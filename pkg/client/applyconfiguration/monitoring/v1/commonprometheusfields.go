@@ -35,6 +35,7 @@ type CommonPrometheusFieldsApplyConfiguration struct {
 	ProbeNamespaceSelector               *metav1.LabelSelectorApplyConfiguration                 `json:"probeNamespaceSelector,omitempty"`
 	ScrapeConfigSelector                 *metav1.LabelSelectorApplyConfiguration                 `json:"scrapeConfigSelector,omitempty"`
 	ScrapeConfigNamespaceSelector        *metav1.LabelSelectorApplyConfiguration                 `json:"scrapeConfigNamespaceSelector,omitempty"`
+	DuplicateTargetsPolicy               *monitoringv1.DuplicateTargetsPolicy                    `json:"duplicateTargetsPolicy,omitempty"`
 	Version                              *string                                                 `json:"version,omitempty"`
 	Paused                               *bool                                                   `json:"paused,omitempty"`
 	Image                                *string                                                 `json:"image,omitempty"`
@@ -62,6 +63,7 @@ type CommonPrometheusFieldsApplyConfiguration struct {
 	PersistentVolumeClaimRetentionPolicy *appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
 	Web                                  *PrometheusWebSpecApplyConfiguration                    `json:"web,omitempty"`
 	Resources                            *corev1.ResourceRequirements                            `json:"resources,omitempty"`
+	ConfigReloader                       *ConfigReloaderSpecApplyConfiguration                   `json:"configReloader,omitempty"`
 	NodeSelector                         map[string]string                                       `json:"nodeSelector,omitempty"`
 	ServiceAccountName                   *string                                                 `json:"serviceAccountName,omitempty"`
 	AutomountServiceAccountToken         *bool                                                   `json:"automountServiceAccountToken,omitempty"`
@@ -115,7 +117,10 @@ type CommonPrometheusFieldsApplyConfiguration struct {
 	LabelValueLengthLimit                *uint64                                                 `json:"labelValueLengthLimit,omitempty"`
 	KeepDroppedTargets                   *uint64                                                 `json:"keepDroppedTargets,omitempty"`
 	ReloadStrategy                       *monitoringv1.ReloadStrategyType                        `json:"reloadStrategy,omitempty"`
+	ConfigCompression                    *monitoringv1.ConfigCompressionType                     `json:"configCompression,omitempty"`
+	ConfigUpdateDebounce                 *ConfigUpdateDebounceApplyConfiguration                 `json:"configUpdateDebounce,omitempty"`
 	MaximumStartupDurationSeconds        *int32                                                  `json:"maximumStartupDurationSeconds,omitempty"`
+	MaximumStartupDurationMode           *monitoringv1.StartupDurationModeType                   `json:"maximumStartupDurationMode,omitempty"`
 	ScrapeClasses                        []ScrapeClassApplyConfiguration                         `json:"scrapeClasses,omitempty"`
 	ServiceDiscoveryRole                 *monitoringv1.ServiceDiscoveryRole                      `json:"serviceDiscoveryRole,omitempty"`
 	TSDB                                 *TSDBSpecApplyConfiguration                             `json:"tsdb,omitempty"`
@@ -203,6 +208,14 @@ func (b *CommonPrometheusFieldsApplyConfiguration) WithScrapeConfigNamespaceSele
 	return b
 }
 
+// WithDuplicateTargetsPolicy sets the DuplicateTargetsPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DuplicateTargetsPolicy field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithDuplicateTargetsPolicy(value monitoringv1.DuplicateTargetsPolicy) *CommonPrometheusFieldsApplyConfiguration {
+	b.DuplicateTargetsPolicy = &value
+	return b
+}
+
 // WithVersion sets the Version field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Version field is set to the value of the last call.
@@ -437,6 +450,14 @@ func (b *CommonPrometheusFieldsApplyConfiguration) WithResources(value corev1.Re
 	return b
 }
 
+// WithConfigReloader sets the ConfigReloader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigReloader field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithConfigReloader(value *ConfigReloaderSpecApplyConfiguration) *CommonPrometheusFieldsApplyConfiguration {
+	b.ConfigReloader = value
+	return b
+}
+
 // WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the NodeSelector field,
@@ -904,6 +925,22 @@ func (b *CommonPrometheusFieldsApplyConfiguration) WithReloadStrategy(value moni
 	return b
 }
 
+// WithConfigCompression sets the ConfigCompression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigCompression field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithConfigCompression(value monitoringv1.ConfigCompressionType) *CommonPrometheusFieldsApplyConfiguration {
+	b.ConfigCompression = &value
+	return b
+}
+
+// WithConfigUpdateDebounce sets the ConfigUpdateDebounce field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigUpdateDebounce field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithConfigUpdateDebounce(value *ConfigUpdateDebounceApplyConfiguration) *CommonPrometheusFieldsApplyConfiguration {
+	b.ConfigUpdateDebounce = value
+	return b
+}
+
 // WithMaximumStartupDurationSeconds sets the MaximumStartupDurationSeconds field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MaximumStartupDurationSeconds field is set to the value of the last call.
@@ -912,6 +949,14 @@ func (b *CommonPrometheusFieldsApplyConfiguration) WithMaximumStartupDurationSec
 	return b
 }
 
+// WithMaximumStartupDurationMode sets the MaximumStartupDurationMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaximumStartupDurationMode field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithMaximumStartupDurationMode(value monitoringv1.StartupDurationModeType) *CommonPrometheusFieldsApplyConfiguration {
+	b.MaximumStartupDurationMode = &value
+	return b
+}
+
 // WithScrapeClasses adds the given value to the ScrapeClasses field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the ScrapeClasses field.
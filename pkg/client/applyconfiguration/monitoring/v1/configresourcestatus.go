@@ -19,7 +19,9 @@ package v1
 // ConfigResourceStatusApplyConfiguration represents a declarative configuration of the ConfigResourceStatus type for use
 // with apply.
 type ConfigResourceStatusApplyConfiguration struct {
-	Bindings []WorkloadBindingApplyConfiguration `json:"bindings,omitempty"`
+	Bindings         []WorkloadBindingApplyConfiguration `json:"bindings,omitempty"`
+	TotalBindings    *int32                              `json:"totalBindings,omitempty"`
+	AcceptedBindings *int32                              `json:"acceptedBindings,omitempty"`
 }
 
 // ConfigResourceStatusApplyConfiguration constructs a declarative configuration of the ConfigResourceStatus type for use with
@@ -40,3 +42,19 @@ func (b *ConfigResourceStatusApplyConfiguration) WithBindings(values ...*Workloa
 	}
 	return b
 }
+
+// WithTotalBindings sets the TotalBindings field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TotalBindings field is set to the value of the last call.
+func (b *ConfigResourceStatusApplyConfiguration) WithTotalBindings(value int32) *ConfigResourceStatusApplyConfiguration {
+	b.TotalBindings = &value
+	return b
+}
+
+// WithAcceptedBindings sets the AcceptedBindings field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AcceptedBindings field is set to the value of the last call.
+func (b *ConfigResourceStatusApplyConfiguration) WithAcceptedBindings(value int32) *ConfigResourceStatusApplyConfiguration {
+	b.AcceptedBindings = &value
+	return b
+}
@@ -27,7 +27,8 @@ import (
 type ProbeApplyConfiguration struct {
 	metav1.TypeMetaApplyConfiguration    `json:",inline"`
 	*metav1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                                 *ProbeSpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                                 *ProbeSpecApplyConfiguration            `json:"spec,omitempty"`
+	Status                               *ConfigResourceStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // Probe constructs a declarative configuration of the Probe type for use with
@@ -207,6 +208,14 @@ func (b *ProbeApplyConfiguration) WithSpec(value *ProbeSpecApplyConfiguration) *
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *ProbeApplyConfiguration) WithStatus(value *ConfigResourceStatusApplyConfiguration) *ProbeApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetName retrieves the value of the Name field in the declarative configuration.
 func (b *ProbeApplyConfiguration) GetName() *string {
 	b.ensureObjectMetaApplyConfigurationExists()
@@ -27,29 +27,36 @@ import (
 // with apply.
 type PrometheusSpecApplyConfiguration struct {
 	CommonPrometheusFieldsApplyConfiguration `json:",inline"`
-	BaseImage                                *string                                         `json:"baseImage,omitempty"`
-	Tag                                      *string                                         `json:"tag,omitempty"`
-	SHA                                      *string                                         `json:"sha,omitempty"`
-	Retention                                *monitoringv1.Duration                          `json:"retention,omitempty"`
-	RetentionSize                            *monitoringv1.ByteSize                          `json:"retentionSize,omitempty"`
-	ShardRetentionPolicy                     *ShardRetentionPolicyApplyConfiguration         `json:"shardRetentionPolicy,omitempty"`
-	DisableCompaction                        *bool                                           `json:"disableCompaction,omitempty"`
-	Rules                                    *RulesApplyConfiguration                        `json:"rules,omitempty"`
-	PrometheusRulesExcludedFromEnforce       []PrometheusRuleExcludeConfigApplyConfiguration `json:"prometheusRulesExcludedFromEnforce,omitempty"`
-	RuleSelector                             *metav1.LabelSelectorApplyConfiguration         `json:"ruleSelector,omitempty"`
-	RuleNamespaceSelector                    *metav1.LabelSelectorApplyConfiguration         `json:"ruleNamespaceSelector,omitempty"`
-	Query                                    *QuerySpecApplyConfiguration                    `json:"query,omitempty"`
-	Alerting                                 *AlertingSpecApplyConfiguration                 `json:"alerting,omitempty"`
-	AdditionalAlertRelabelConfigs            *corev1.SecretKeySelector                       `json:"additionalAlertRelabelConfigs,omitempty"`
-	AdditionalAlertManagerConfigs            *corev1.SecretKeySelector                       `json:"additionalAlertManagerConfigs,omitempty"`
-	RemoteRead                               []RemoteReadSpecApplyConfiguration              `json:"remoteRead,omitempty"`
-	Thanos                                   *ThanosSpecApplyConfiguration                   `json:"thanos,omitempty"`
-	QueryLogFile                             *string                                         `json:"queryLogFile,omitempty"`
-	AllowOverlappingBlocks                   *bool                                           `json:"allowOverlappingBlocks,omitempty"`
-	Exemplars                                *ExemplarsApplyConfiguration                    `json:"exemplars,omitempty"`
-	EvaluationInterval                       *monitoringv1.Duration                          `json:"evaluationInterval,omitempty"`
-	RuleQueryOffset                          *monitoringv1.Duration                          `json:"ruleQueryOffset,omitempty"`
-	EnableAdminAPI                           *bool                                           `json:"enableAdminAPI,omitempty"`
+	BaseImage                                *string                                           `json:"baseImage,omitempty"`
+	Tag                                      *string                                           `json:"tag,omitempty"`
+	SHA                                      *string                                           `json:"sha,omitempty"`
+	Retention                                *monitoringv1.Duration                            `json:"retention,omitempty"`
+	RetentionSize                            *monitoringv1.ByteSize                            `json:"retentionSize,omitempty"`
+	ShardRetentionPolicy                     *ShardRetentionPolicyApplyConfiguration           `json:"shardRetentionPolicy,omitempty"`
+	ShardScaling                             *ShardScalingSpecApplyConfiguration               `json:"shardScaling,omitempty"`
+	DisableCompaction                        *bool                                             `json:"disableCompaction,omitempty"`
+	MinBlockDuration                         *monitoringv1.Duration                            `json:"minBlockDuration,omitempty"`
+	MaxBlockDuration                         *monitoringv1.Duration                            `json:"maxBlockDuration,omitempty"`
+	Rules                                    *RulesApplyConfiguration                          `json:"rules,omitempty"`
+	PrometheusRulesExcludedFromEnforce       []PrometheusRuleExcludeConfigApplyConfiguration   `json:"prometheusRulesExcludedFromEnforce,omitempty"`
+	RuleSelector                             *metav1.LabelSelectorApplyConfiguration           `json:"ruleSelector,omitempty"`
+	RuleNamespaceSelector                    *metav1.LabelSelectorApplyConfiguration           `json:"ruleNamespaceSelector,omitempty"`
+	Query                                    *QuerySpecApplyConfiguration                      `json:"query,omitempty"`
+	Alerting                                 *AlertingSpecApplyConfiguration                   `json:"alerting,omitempty"`
+	AdditionalAlertRelabelConfigs            *corev1.SecretKeySelector                         `json:"additionalAlertRelabelConfigs,omitempty"`
+	AdditionalAlertManagerConfigs            *corev1.SecretKeySelector                         `json:"additionalAlertManagerConfigs,omitempty"`
+	RemoteRead                               []RemoteReadSpecApplyConfiguration                `json:"remoteRead,omitempty"`
+	Thanos                                   *ThanosSpecApplyConfiguration                     `json:"thanos,omitempty"`
+	QueryLogFile                             *string                                           `json:"queryLogFile,omitempty"`
+	AllowOverlappingBlocks                   *bool                                             `json:"allowOverlappingBlocks,omitempty"`
+	Exemplars                                *ExemplarsApplyConfiguration                      `json:"exemplars,omitempty"`
+	EvaluationInterval                       *monitoringv1.Duration                            `json:"evaluationInterval,omitempty"`
+	RuleQueryOffset                          *monitoringv1.Duration                            `json:"ruleQueryOffset,omitempty"`
+	EnableAdminAPI                           *bool                                             `json:"enableAdminAPI,omitempty"`
+	RemoteWriteReceiverService               *RemoteWriteReceiverServiceSpecApplyConfiguration `json:"remoteWriteReceiverService,omitempty"`
+	EmergencyMode                            *EmergencyModeSpecApplyConfiguration              `json:"emergencyMode,omitempty"`
+	Warmup                                   *WarmupSpecApplyConfiguration                     `json:"warmup,omitempty"`
+	DeletionPolicy                           *monitoringv1.DeletionPolicy                      `json:"deletionPolicy,omitempty"`
 }
 
 // PrometheusSpecApplyConfiguration constructs a declarative configuration of the PrometheusSpec type for use with
@@ -130,6 +137,14 @@ func (b *PrometheusSpecApplyConfiguration) WithScrapeConfigNamespaceSelector(val
 	return b
 }
 
+// WithDuplicateTargetsPolicy sets the DuplicateTargetsPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DuplicateTargetsPolicy field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithDuplicateTargetsPolicy(value monitoringv1.DuplicateTargetsPolicy) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.DuplicateTargetsPolicy = &value
+	return b
+}
+
 // WithVersion sets the Version field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Version field is set to the value of the last call.
@@ -364,6 +379,14 @@ func (b *PrometheusSpecApplyConfiguration) WithResources(value corev1.ResourceRe
 	return b
 }
 
+// WithConfigReloader sets the ConfigReloader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigReloader field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithConfigReloader(value *ConfigReloaderSpecApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ConfigReloader = value
+	return b
+}
+
 // WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the NodeSelector field,
@@ -831,6 +854,22 @@ func (b *PrometheusSpecApplyConfiguration) WithReloadStrategy(value monitoringv1
 	return b
 }
 
+// WithConfigCompression sets the ConfigCompression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigCompression field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithConfigCompression(value monitoringv1.ConfigCompressionType) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ConfigCompression = &value
+	return b
+}
+
+// WithConfigUpdateDebounce sets the ConfigUpdateDebounce field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigUpdateDebounce field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithConfigUpdateDebounce(value *ConfigUpdateDebounceApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ConfigUpdateDebounce = value
+	return b
+}
+
 // WithMaximumStartupDurationSeconds sets the MaximumStartupDurationSeconds field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MaximumStartupDurationSeconds field is set to the value of the last call.
@@ -839,6 +878,14 @@ func (b *PrometheusSpecApplyConfiguration) WithMaximumStartupDurationSeconds(val
 	return b
 }
 
+// WithMaximumStartupDurationMode sets the MaximumStartupDurationMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaximumStartupDurationMode field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithMaximumStartupDurationMode(value monitoringv1.StartupDurationModeType) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.MaximumStartupDurationMode = &value
+	return b
+}
+
 // WithScrapeClasses adds the given value to the ScrapeClasses field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the ScrapeClasses field.
@@ -948,6 +995,14 @@ func (b *PrometheusSpecApplyConfiguration) WithShardRetentionPolicy(value *Shard
 	return b
 }
 
+// WithShardScaling sets the ShardScaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShardScaling field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithShardScaling(value *ShardScalingSpecApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.ShardScaling = value
+	return b
+}
+
 // WithDisableCompaction sets the DisableCompaction field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DisableCompaction field is set to the value of the last call.
@@ -956,6 +1011,22 @@ func (b *PrometheusSpecApplyConfiguration) WithDisableCompaction(value bool) *Pr
 	return b
 }
 
+// WithMinBlockDuration sets the MinBlockDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinBlockDuration field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithMinBlockDuration(value monitoringv1.Duration) *PrometheusSpecApplyConfiguration {
+	b.MinBlockDuration = &value
+	return b
+}
+
+// WithMaxBlockDuration sets the MaxBlockDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxBlockDuration field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithMaxBlockDuration(value monitoringv1.Duration) *PrometheusSpecApplyConfiguration {
+	b.MaxBlockDuration = &value
+	return b
+}
+
 // WithRules sets the Rules field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Rules field is set to the value of the last call.
@@ -1093,3 +1164,35 @@ func (b *PrometheusSpecApplyConfiguration) WithEnableAdminAPI(value bool) *Prome
 	b.EnableAdminAPI = &value
 	return b
 }
+
+// WithRemoteWriteReceiverService sets the RemoteWriteReceiverService field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteWriteReceiverService field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithRemoteWriteReceiverService(value *RemoteWriteReceiverServiceSpecApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.RemoteWriteReceiverService = value
+	return b
+}
+
+// WithEmergencyMode sets the EmergencyMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EmergencyMode field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithEmergencyMode(value *EmergencyModeSpecApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.EmergencyMode = value
+	return b
+}
+
+// WithWarmup sets the Warmup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Warmup field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithWarmup(value *WarmupSpecApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.Warmup = value
+	return b
+}
+
+// WithDeletionPolicy sets the DeletionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionPolicy field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithDeletionPolicy(value monitoringv1.DeletionPolicy) *PrometheusSpecApplyConfiguration {
+	b.DeletionPolicy = &value
+	return b
+}
@@ -16,18 +16,29 @@
 
 package v1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // PrometheusStatusApplyConfiguration represents a declarative configuration of the PrometheusStatus type for use
 // with apply.
 type PrometheusStatusApplyConfiguration struct {
-	Paused              *bool                           `json:"paused,omitempty"`
-	Replicas            *int32                          `json:"replicas,omitempty"`
-	UpdatedReplicas     *int32                          `json:"updatedReplicas,omitempty"`
-	AvailableReplicas   *int32                          `json:"availableReplicas,omitempty"`
-	UnavailableReplicas *int32                          `json:"unavailableReplicas,omitempty"`
-	Conditions          []ConditionApplyConfiguration   `json:"conditions,omitempty"`
-	ShardStatuses       []ShardStatusApplyConfiguration `json:"shardStatuses,omitempty"`
-	Shards              *int32                          `json:"shards,omitempty"`
-	Selector            *string                         `json:"selector,omitempty"`
+	Paused               *bool                                         `json:"paused,omitempty"`
+	Replicas             *int32                                        `json:"replicas,omitempty"`
+	UpdatedReplicas      *int32                                        `json:"updatedReplicas,omitempty"`
+	AvailableReplicas    *int32                                        `json:"availableReplicas,omitempty"`
+	UnavailableReplicas  *int32                                        `json:"unavailableReplicas,omitempty"`
+	Conditions           []ConditionApplyConfiguration                 `json:"conditions,omitempty"`
+	ShardStatuses        []ShardStatusApplyConfiguration               `json:"shardStatuses,omitempty"`
+	Shards               *int32                                        `json:"shards,omitempty"`
+	Selector             *string                                       `json:"selector,omitempty"`
+	ShardScaling         *ShardScalingStatusApplyConfiguration         `json:"shardScaling,omitempty"`
+	RemoteWriteEndpoints []RemoteWriteEndpointStatusApplyConfiguration `json:"remoteWriteEndpoints,omitempty"`
+	RemoteWriteQueues    []RemoteWriteQueueStatusApplyConfiguration    `json:"remoteWriteQueues,omitempty"`
+	OperatorInfo         *OperatorInfoApplyConfiguration               `json:"operatorInfo,omitempty"`
+	SelectedResources    *SelectedResourcesStatusApplyConfiguration    `json:"selectedResources,omitempty"`
+	ScrapeConfigSecrets  []string                                      `json:"scrapeConfigSecrets,omitempty"`
+	LastReconcileTime    *metav1.Time                                  `json:"lastReconcileTime,omitempty"`
 }
 
 // PrometheusStatusApplyConfiguration constructs a declarative configuration of the PrometheusStatus type for use with
@@ -117,3 +128,71 @@ func (b *PrometheusStatusApplyConfiguration) WithSelector(value string) *Prometh
 	b.Selector = &value
 	return b
 }
+
+// WithShardScaling sets the ShardScaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShardScaling field is set to the value of the last call.
+func (b *PrometheusStatusApplyConfiguration) WithShardScaling(value *ShardScalingStatusApplyConfiguration) *PrometheusStatusApplyConfiguration {
+	b.ShardScaling = value
+	return b
+}
+
+// WithRemoteWriteEndpoints adds the given value to the RemoteWriteEndpoints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RemoteWriteEndpoints field.
+func (b *PrometheusStatusApplyConfiguration) WithRemoteWriteEndpoints(values ...*RemoteWriteEndpointStatusApplyConfiguration) *PrometheusStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRemoteWriteEndpoints")
+		}
+		b.RemoteWriteEndpoints = append(b.RemoteWriteEndpoints, *values[i])
+	}
+	return b
+}
+
+// WithRemoteWriteQueues adds the given value to the RemoteWriteQueues field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RemoteWriteQueues field.
+func (b *PrometheusStatusApplyConfiguration) WithRemoteWriteQueues(values ...*RemoteWriteQueueStatusApplyConfiguration) *PrometheusStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRemoteWriteQueues")
+		}
+		b.RemoteWriteQueues = append(b.RemoteWriteQueues, *values[i])
+	}
+	return b
+}
+
+// WithOperatorInfo sets the OperatorInfo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperatorInfo field is set to the value of the last call.
+func (b *PrometheusStatusApplyConfiguration) WithOperatorInfo(value *OperatorInfoApplyConfiguration) *PrometheusStatusApplyConfiguration {
+	b.OperatorInfo = value
+	return b
+}
+
+// WithSelectedResources sets the SelectedResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SelectedResources field is set to the value of the last call.
+func (b *PrometheusStatusApplyConfiguration) WithSelectedResources(value *SelectedResourcesStatusApplyConfiguration) *PrometheusStatusApplyConfiguration {
+	b.SelectedResources = value
+	return b
+}
+
+// WithScrapeConfigSecrets adds the given value to the ScrapeConfigSecrets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ScrapeConfigSecrets field.
+func (b *PrometheusStatusApplyConfiguration) WithScrapeConfigSecrets(values ...string) *PrometheusStatusApplyConfiguration {
+	for i := range values {
+		b.ScrapeConfigSecrets = append(b.ScrapeConfigSecrets, values[i])
+	}
+	return b
+}
+
+// WithLastReconcileTime sets the LastReconcileTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastReconcileTime field is set to the value of the last call.
+func (b *PrometheusStatusApplyConfiguration) WithLastReconcileTime(value metav1.Time) *PrometheusStatusApplyConfiguration {
+	b.LastReconcileTime = &value
+	return b
+}
@@ -25,6 +25,7 @@ import (
 // with apply.
 type RemoteWriteSpecApplyConfiguration struct {
 	URL                           *string                                 `json:"url,omitempty"`
+	FailoverURLs                  []string                                `json:"failoverURLs,omitempty"`
 	Name                          *string                                 `json:"name,omitempty"`
 	MessageVersion                *monitoringv1.RemoteWriteMessageVersion `json:"messageVersion,omitempty"`
 	SendExemplars                 *bool                                   `json:"sendExemplars,omitempty"`
@@ -62,6 +63,16 @@ func (b *RemoteWriteSpecApplyConfiguration) WithURL(value string) *RemoteWriteSp
 	return b
 }
 
+// WithFailoverURLs adds the given value to the FailoverURLs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FailoverURLs field.
+func (b *RemoteWriteSpecApplyConfiguration) WithFailoverURLs(values ...string) *RemoteWriteSpecApplyConfiguration {
+	for i := range values {
+		b.FailoverURLs = append(b.FailoverURLs, values[i])
+	}
+	return b
+}
+
 // WithName sets the Name field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Name field is set to the value of the last call.
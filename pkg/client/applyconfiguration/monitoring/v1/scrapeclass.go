@@ -31,6 +31,7 @@ type ScrapeClassApplyConfiguration struct {
 	Relabelings            []RelabelConfigApplyConfiguration `json:"relabelings,omitempty"`
 	MetricRelabelings      []RelabelConfigApplyConfiguration `json:"metricRelabelings,omitempty"`
 	AttachMetadata         *AttachMetadataApplyConfiguration `json:"attachMetadata,omitempty"`
+	ProxyConfig            *ProxyConfigApplyConfiguration    `json:"proxyConfig,omitempty"`
 }
 
 // ScrapeClassApplyConfiguration constructs a declarative configuration of the ScrapeClass type for use with
@@ -112,3 +113,11 @@ func (b *ScrapeClassApplyConfiguration) WithAttachMetadata(value *AttachMetadata
 	b.AttachMetadata = value
 	return b
 }
+
+// WithProxyConfig sets the ProxyConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyConfig field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithProxyConfig(value *ProxyConfigApplyConfiguration) *ScrapeClassApplyConfiguration {
+	b.ProxyConfig = value
+	return b
+}
@@ -19,11 +19,14 @@ package v1
 // ShardStatusApplyConfiguration represents a declarative configuration of the ShardStatus type for use
 // with apply.
 type ShardStatusApplyConfiguration struct {
-	ShardID             *string `json:"shardID,omitempty"`
-	Replicas            *int32  `json:"replicas,omitempty"`
-	UpdatedReplicas     *int32  `json:"updatedReplicas,omitempty"`
-	AvailableReplicas   *int32  `json:"availableReplicas,omitempty"`
-	UnavailableReplicas *int32  `json:"unavailableReplicas,omitempty"`
+	ShardID              *string                          `json:"shardID,omitempty"`
+	Replicas             *int32                           `json:"replicas,omitempty"`
+	UpdatedReplicas      *int32                           `json:"updatedReplicas,omitempty"`
+	AvailableReplicas    *int32                           `json:"availableReplicas,omitempty"`
+	UnavailableReplicas  *int32                           `json:"unavailableReplicas,omitempty"`
+	Rollout              *RolloutStatusApplyConfiguration `json:"rollout,omitempty"`
+	ObservedGeneration   *int64                           `json:"observedGeneration,omitempty"`
+	RolloutBlockedReason *string                          `json:"rolloutBlockedReason,omitempty"`
 }
 
 // ShardStatusApplyConfiguration constructs a declarative configuration of the ShardStatus type for use with
@@ -71,3 +74,27 @@ func (b *ShardStatusApplyConfiguration) WithUnavailableReplicas(value int32) *Sh
 	b.UnavailableReplicas = &value
 	return b
 }
+
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithRollout(value *RolloutStatusApplyConfiguration) *ShardStatusApplyConfiguration {
+	b.Rollout = value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithObservedGeneration(value int64) *ShardStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithRolloutBlockedReason sets the RolloutBlockedReason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RolloutBlockedReason field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithRolloutBlockedReason(value string) *ShardStatusApplyConfiguration {
+	b.RolloutBlockedReason = &value
+	return b
+}
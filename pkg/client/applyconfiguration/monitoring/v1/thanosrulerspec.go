@@ -34,6 +34,7 @@ type ThanosRulerSpecApplyConfiguration struct {
 	Replicas                           *int32                                          `json:"replicas,omitempty"`
 	NodeSelector                       map[string]string                               `json:"nodeSelector,omitempty"`
 	Resources                          *corev1.ResourceRequirements                    `json:"resources,omitempty"`
+	ConfigReloader                     *ConfigReloaderSpecApplyConfiguration           `json:"configReloader,omitempty"`
 	Affinity                           *corev1.Affinity                                `json:"affinity,omitempty"`
 	Tolerations                        []corev1.Toleration                             `json:"tolerations,omitempty"`
 	TopologySpreadConstraints          []corev1.TopologySpreadConstraint               `json:"topologySpreadConstraints,omitempty"`
@@ -85,6 +86,7 @@ type ThanosRulerSpecApplyConfiguration struct {
 	Web                                *ThanosRulerWebSpecApplyConfiguration           `json:"web,omitempty"`
 	RemoteWrite                        []RemoteWriteSpecApplyConfiguration             `json:"remoteWrite,omitempty"`
 	TerminationGracePeriodSeconds      *int64                                          `json:"terminationGracePeriodSeconds,omitempty"`
+	SelfMonitoring                     *ThanosRulerSelfMonitoringApplyConfiguration    `json:"selfMonitoring,omitempty"`
 }
 
 // ThanosRulerSpecApplyConfiguration constructs a declarative configuration of the ThanosRulerSpec type for use with
@@ -173,6 +175,14 @@ func (b *ThanosRulerSpecApplyConfiguration) WithResources(value corev1.ResourceR
 	return b
 }
 
+// WithConfigReloader sets the ConfigReloader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigReloader field is set to the value of the last call.
+func (b *ThanosRulerSpecApplyConfiguration) WithConfigReloader(value *ConfigReloaderSpecApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	b.ConfigReloader = value
+	return b
+}
+
 // WithAffinity sets the Affinity field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Affinity field is set to the value of the last call.
@@ -629,3 +639,11 @@ func (b *ThanosRulerSpecApplyConfiguration) WithTerminationGracePeriodSeconds(va
 	b.TerminationGracePeriodSeconds = &value
 	return b
 }
+
+// WithSelfMonitoring sets the SelfMonitoring field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SelfMonitoring field is set to the value of the last call.
+func (b *ThanosRulerSpecApplyConfiguration) WithSelfMonitoring(value *ThanosRulerSelfMonitoringApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	b.SelfMonitoring = value
+	return b
+}
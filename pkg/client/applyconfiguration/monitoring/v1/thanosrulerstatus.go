@@ -19,12 +19,13 @@ package v1
 // ThanosRulerStatusApplyConfiguration represents a declarative configuration of the ThanosRulerStatus type for use
 // with apply.
 type ThanosRulerStatusApplyConfiguration struct {
-	Paused              *bool                         `json:"paused,omitempty"`
-	Replicas            *int32                        `json:"replicas,omitempty"`
-	UpdatedReplicas     *int32                        `json:"updatedReplicas,omitempty"`
-	AvailableReplicas   *int32                        `json:"availableReplicas,omitempty"`
-	UnavailableReplicas *int32                        `json:"unavailableReplicas,omitempty"`
-	Conditions          []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Paused              *bool                           `json:"paused,omitempty"`
+	Replicas            *int32                          `json:"replicas,omitempty"`
+	UpdatedReplicas     *int32                          `json:"updatedReplicas,omitempty"`
+	AvailableReplicas   *int32                          `json:"availableReplicas,omitempty"`
+	UnavailableReplicas *int32                          `json:"unavailableReplicas,omitempty"`
+	Conditions          []ConditionApplyConfiguration   `json:"conditions,omitempty"`
+	OperatorInfo        *OperatorInfoApplyConfiguration `json:"operatorInfo,omitempty"`
 }
 
 // ThanosRulerStatusApplyConfiguration constructs a declarative configuration of the ThanosRulerStatus type for use with
@@ -85,3 +86,11 @@ func (b *ThanosRulerStatusApplyConfiguration) WithConditions(values ...*Conditio
 	}
 	return b
 }
+
+// WithOperatorInfo sets the OperatorInfo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperatorInfo field is set to the value of the last call.
+func (b *ThanosRulerStatusApplyConfiguration) WithOperatorInfo(value *OperatorInfoApplyConfiguration) *ThanosRulerStatusApplyConfiguration {
+	b.OperatorInfo = value
+	return b
+}
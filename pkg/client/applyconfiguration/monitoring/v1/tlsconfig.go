@@ -28,6 +28,7 @@ type TLSConfigApplyConfiguration struct {
 	CAFile                          *string `json:"caFile,omitempty"`
 	CertFile                        *string `json:"certFile,omitempty"`
 	KeyFile                         *string `json:"keyFile,omitempty"`
+	CertificateSecret               *string `json:"certificateSecret,omitempty"`
 }
 
 // TLSConfigApplyConfiguration constructs a declarative configuration of the TLSConfig type for use with
@@ -115,3 +116,11 @@ func (b *TLSConfigApplyConfiguration) WithKeyFile(value string) *TLSConfigApplyC
 	b.KeyFile = &value
 	return b
 }
+
+// WithCertificateSecret sets the CertificateSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CertificateSecret field is set to the value of the last call.
+func (b *TLSConfigApplyConfiguration) WithCertificateSecret(value string) *TLSConfigApplyConfiguration {
+	b.CertificateSecret = &value
+	return b
+}
@@ -27,6 +27,7 @@ type WebTLSConfigApplyConfiguration struct {
 	CertFile                 *string                              `json:"certFile,omitempty"`
 	KeySecret                *corev1.SecretKeySelector            `json:"keySecret,omitempty"`
 	KeyFile                  *string                              `json:"keyFile,omitempty"`
+	CertificateSecret        *string                              `json:"certificateSecret,omitempty"`
 	ClientCA                 *SecretOrConfigMapApplyConfiguration `json:"client_ca,omitempty"`
 	ClientCAFile             *string                              `json:"clientCAFile,omitempty"`
 	ClientAuthType           *string                              `json:"clientAuthType,omitempty"`
@@ -75,6 +76,14 @@ func (b *WebTLSConfigApplyConfiguration) WithKeyFile(value string) *WebTLSConfig
 	return b
 }
 
+// WithCertificateSecret sets the CertificateSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CertificateSecret field is set to the value of the last call.
+func (b *WebTLSConfigApplyConfiguration) WithCertificateSecret(value string) *WebTLSConfigApplyConfiguration {
+	b.CertificateSecret = &value
+	return b
+}
+
 // WithClientCA sets the ClientCA field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ClientCA field is set to the value of the last call.
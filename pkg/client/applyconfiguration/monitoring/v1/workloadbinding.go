@@ -19,11 +19,12 @@ package v1
 // WorkloadBindingApplyConfiguration represents a declarative configuration of the WorkloadBinding type for use
 // with apply.
 type WorkloadBindingApplyConfiguration struct {
-	Group      *string                                     `json:"group,omitempty"`
-	Resource   *string                                     `json:"resource,omitempty"`
-	Name       *string                                     `json:"name,omitempty"`
-	Namespace  *string                                     `json:"namespace,omitempty"`
-	Conditions []ConfigResourceConditionApplyConfiguration `json:"conditions,omitempty"`
+	Group            *string                                     `json:"group,omitempty"`
+	Resource         *string                                     `json:"resource,omitempty"`
+	Name             *string                                     `json:"name,omitempty"`
+	Namespace        *string                                     `json:"namespace,omitempty"`
+	Conditions       []ConfigResourceConditionApplyConfiguration `json:"conditions,omitempty"`
+	ScrapeStatistics *ScrapeStatisticsApplyConfiguration         `json:"scrapeStatistics,omitempty"`
 }
 
 // WorkloadBindingApplyConfiguration constructs a declarative configuration of the WorkloadBinding type for use with
@@ -76,3 +77,11 @@ func (b *WorkloadBindingApplyConfiguration) WithConditions(values ...*ConfigReso
 	}
 	return b
 }
+
+// WithScrapeStatistics sets the ScrapeStatistics field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeStatistics field is set to the value of the last call.
+func (b *WorkloadBindingApplyConfiguration) WithScrapeStatistics(value *ScrapeStatisticsApplyConfiguration) *WorkloadBindingApplyConfiguration {
+	b.ScrapeStatistics = value
+	return b
+}
@@ -16,6 +16,10 @@
 
 package v1alpha1
 
+import (
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
 // AlertmanagerConfigSpecApplyConfiguration represents a declarative configuration of the AlertmanagerConfigSpec type for use
 // with apply.
 type AlertmanagerConfigSpecApplyConfiguration struct {
@@ -23,6 +27,7 @@ type AlertmanagerConfigSpecApplyConfiguration struct {
 	Receivers         []ReceiverApplyConfiguration         `json:"receivers,omitempty"`
 	InhibitRules      []InhibitRuleApplyConfiguration      `json:"inhibitRules,omitempty"`
 	MuteTimeIntervals []MuteTimeIntervalApplyConfiguration `json:"muteTimeIntervals,omitempty"`
+	ResolveTimeout    *v1.Duration                         `json:"resolveTimeout,omitempty"`
 }
 
 // AlertmanagerConfigSpecApplyConfiguration constructs a declarative configuration of the AlertmanagerConfigSpec type for use with
@@ -77,3 +82,11 @@ func (b *AlertmanagerConfigSpecApplyConfiguration) WithMuteTimeIntervals(values
 	}
 	return b
 }
+
+// WithResolveTimeout sets the ResolveTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResolveTimeout field is set to the value of the last call.
+func (b *AlertmanagerConfigSpecApplyConfiguration) WithResolveTimeout(value v1.Duration) *AlertmanagerConfigSpecApplyConfiguration {
+	b.ResolveTimeout = &value
+	return b
+}
@@ -29,6 +29,7 @@ import (
 // with apply.
 type PrometheusAgentSpecApplyConfiguration struct {
 	Mode                                        *monitoringv1alpha1.PrometheusAgentMode `json:"mode,omitempty"`
+	ScrapeProxyConfig                           *v1.ProxyConfigApplyConfiguration       `json:"scrapeProxyConfig,omitempty"`
 	v1.CommonPrometheusFieldsApplyConfiguration `json:",inline"`
 }
 
@@ -46,6 +47,14 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithMode(value monitoringv1alpha
 	return b
 }
 
+// WithScrapeProxyConfig sets the ScrapeProxyConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeProxyConfig field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeProxyConfig(value *v1.ProxyConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+	b.ScrapeProxyConfig = value
+	return b
+}
+
 // WithPodMetadata sets the PodMetadata field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PodMetadata field is set to the value of the last call.
@@ -118,6 +127,14 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeConfigNamespaceSelecto
 	return b
 }
 
+// WithDuplicateTargetsPolicy sets the DuplicateTargetsPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DuplicateTargetsPolicy field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithDuplicateTargetsPolicy(value monitoringv1.DuplicateTargetsPolicy) *PrometheusAgentSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.DuplicateTargetsPolicy = &value
+	return b
+}
+
 // WithVersion sets the Version field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Version field is set to the value of the last call.
@@ -352,6 +369,14 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithResources(value corev1.Resou
 	return b
 }
 
+// WithConfigReloader sets the ConfigReloader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigReloader field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithConfigReloader(value *v1.ConfigReloaderSpecApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ConfigReloader = value
+	return b
+}
+
 // WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the NodeSelector field,
@@ -819,6 +844,22 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithReloadStrategy(value monitor
 	return b
 }
 
+// WithConfigCompression sets the ConfigCompression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigCompression field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithConfigCompression(value monitoringv1.ConfigCompressionType) *PrometheusAgentSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ConfigCompression = &value
+	return b
+}
+
+// WithConfigUpdateDebounce sets the ConfigUpdateDebounce field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigUpdateDebounce field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithConfigUpdateDebounce(value *v1.ConfigUpdateDebounceApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ConfigUpdateDebounce = value
+	return b
+}
+
 // WithMaximumStartupDurationSeconds sets the MaximumStartupDurationSeconds field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MaximumStartupDurationSeconds field is set to the value of the last call.
@@ -827,6 +868,14 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithMaximumStartupDurationSecond
 	return b
 }
 
+// WithMaximumStartupDurationMode sets the MaximumStartupDurationMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaximumStartupDurationMode field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithMaximumStartupDurationMode(value monitoringv1.StartupDurationModeType) *PrometheusAgentSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.MaximumStartupDurationMode = &value
+	return b
+}
+
 // WithScrapeClasses adds the given value to the ScrapeClasses field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the ScrapeClasses field.
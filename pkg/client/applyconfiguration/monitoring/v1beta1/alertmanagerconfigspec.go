@@ -16,13 +16,18 @@
 
 package v1beta1
 
+import (
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
 // AlertmanagerConfigSpecApplyConfiguration represents a declarative configuration of the AlertmanagerConfigSpec type for use
 // with apply.
 type AlertmanagerConfigSpecApplyConfiguration struct {
-	Route         *RouteApplyConfiguration         `json:"route,omitempty"`
-	Receivers     []ReceiverApplyConfiguration     `json:"receivers,omitempty"`
-	InhibitRules  []InhibitRuleApplyConfiguration  `json:"inhibitRules,omitempty"`
-	TimeIntervals []TimeIntervalApplyConfiguration `json:"timeIntervals,omitempty"`
+	Route          *RouteApplyConfiguration         `json:"route,omitempty"`
+	Receivers      []ReceiverApplyConfiguration     `json:"receivers,omitempty"`
+	InhibitRules   []InhibitRuleApplyConfiguration  `json:"inhibitRules,omitempty"`
+	TimeIntervals  []TimeIntervalApplyConfiguration `json:"timeIntervals,omitempty"`
+	ResolveTimeout *v1.Duration                     `json:"resolveTimeout,omitempty"`
 }
 
 // AlertmanagerConfigSpecApplyConfiguration constructs a declarative configuration of the AlertmanagerConfigSpec type for use with
@@ -77,3 +82,11 @@ func (b *AlertmanagerConfigSpecApplyConfiguration) WithTimeIntervals(values ...*
 	}
 	return b
 }
+
+// WithResolveTimeout sets the ResolveTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResolveTimeout field is set to the value of the last call.
+func (b *AlertmanagerConfigSpecApplyConfiguration) WithResolveTimeout(value v1.Duration) *AlertmanagerConfigSpecApplyConfiguration {
+	b.ResolveTimeout = &value
+	return b
+}
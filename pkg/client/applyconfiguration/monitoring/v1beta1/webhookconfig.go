@@ -23,12 +23,13 @@ import (
 // WebhookConfigApplyConfiguration represents a declarative configuration of the WebhookConfig type for use
 // with apply.
 type WebhookConfigApplyConfiguration struct {
-	SendResolved *bool                                `json:"sendResolved,omitempty"`
-	URL          *string                              `json:"url,omitempty"`
-	URLSecret    *SecretKeySelectorApplyConfiguration `json:"urlSecret,omitempty"`
-	HTTPConfig   *HTTPConfigApplyConfiguration        `json:"httpConfig,omitempty"`
-	MaxAlerts    *int32                               `json:"maxAlerts,omitempty"`
-	Timeout      *v1.Duration                         `json:"timeout,omitempty"`
+	SendResolved  *bool                                   `json:"sendResolved,omitempty"`
+	URL           *string                                 `json:"url,omitempty"`
+	URLSecret     *SecretKeySelectorApplyConfiguration    `json:"urlSecret,omitempty"`
+	HTTPConfig    *HTTPConfigApplyConfiguration           `json:"httpConfig,omitempty"`
+	MaxAlerts     *int32                                  `json:"maxAlerts,omitempty"`
+	Timeout       *v1.Duration                            `json:"timeout,omitempty"`
+	SigningSecret *WebhookSigningSecretApplyConfiguration `json:"signingSecret,omitempty"`
 }
 
 // WebhookConfigApplyConfiguration constructs a declarative configuration of the WebhookConfig type for use with
@@ -84,3 +85,11 @@ func (b *WebhookConfigApplyConfiguration) WithTimeout(value v1.Duration) *Webhoo
 	b.Timeout = &value
 	return b
 }
+
+// WithSigningSecret sets the SigningSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SigningSecret field is set to the value of the last call.
+func (b *WebhookConfigApplyConfiguration) WithSigningSecret(value *WebhookSigningSecretApplyConfiguration) *WebhookConfigApplyConfiguration {
+	b.SigningSecret = value
+	return b
+}
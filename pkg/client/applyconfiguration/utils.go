@@ -78,16 +78,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.CommonPrometheusFieldsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Condition"):
 		return &monitoringv1.ConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConfigReloaderClientConfig"):
+		return &monitoringv1.ConfigReloaderClientConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConfigReloaderSpec"):
+		return &monitoringv1.ConfigReloaderSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ConfigResourceCondition"):
 		return &monitoringv1.ConfigResourceConditionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ConfigResourceStatus"):
 		return &monitoringv1.ConfigResourceStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConfigUpdateDebounce"):
+		return &monitoringv1.ConfigUpdateDebounceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("CoreV1TopologySpreadConstraint"):
 		return &monitoringv1.CoreV1TopologySpreadConstraintApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DurationBounds"):
+		return &monitoringv1.DurationBoundsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EmbeddedObjectMetadata"):
 		return &monitoringv1.EmbeddedObjectMetadataApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EmbeddedPersistentVolumeClaim"):
 		return &monitoringv1.EmbeddedPersistentVolumeClaimApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EmergencyModeSpec"):
+		return &monitoringv1.EmergencyModeSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Endpoint"):
 		return &monitoringv1.EndpointApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Exemplars"):
@@ -124,6 +134,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.OAuth2ApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ObjectReference"):
 		return &monitoringv1.ObjectReferenceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("OperatorInfo"):
+		return &monitoringv1.OperatorInfoApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("OTLPConfig"):
 		return &monitoringv1.OTLPConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PodDNSConfig"):
@@ -174,10 +186,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.RelabelConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RemoteReadSpec"):
 		return &monitoringv1.RemoteReadSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RemoteWriteEndpointStatus"):
+		return &monitoringv1.RemoteWriteEndpointStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RemoteWriteQueueStatus"):
+		return &monitoringv1.RemoteWriteQueueStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RemoteWriteReceiverServiceSpec"):
+		return &monitoringv1.RemoteWriteReceiverServiceSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RemoteWriteSpec"):
 		return &monitoringv1.RemoteWriteSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RetainConfig"):
 		return &monitoringv1.RetainConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RolloutStatus"):
+		return &monitoringv1.RolloutStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Rule"):
 		return &monitoringv1.RuleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RuleGroup"):
@@ -194,14 +214,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.SafeTLSConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ScrapeClass"):
 		return &monitoringv1.ScrapeClassApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ScrapeStatistics"):
+		return &monitoringv1.ScrapeStatisticsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SecretOrConfigMap"):
 		return &monitoringv1.SecretOrConfigMapApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SelectedResourcesStatus"):
+		return &monitoringv1.SelectedResourcesStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SelectionSummary"):
+		return &monitoringv1.SelectionSummaryApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServiceMonitor"):
 		return &monitoringv1.ServiceMonitorApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServiceMonitorSpec"):
 		return &monitoringv1.ServiceMonitorSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardRetentionPolicy"):
 		return &monitoringv1.ShardRetentionPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ShardScalingSpec"):
+		return &monitoringv1.ShardScalingSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ShardScalingStatus"):
+		return &monitoringv1.ShardScalingStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardStatus"):
 		return &monitoringv1.ShardStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Sigv4"):
@@ -210,6 +240,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.StorageSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ThanosRuler"):
 		return &monitoringv1.ThanosRulerApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ThanosRulerSelfMonitoring"):
+		return &monitoringv1.ThanosRulerSelfMonitoringApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ThanosRulerSpec"):
 		return &monitoringv1.ThanosRulerSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ThanosRulerStatus"):
@@ -224,6 +256,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.TopologySpreadConstraintApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("TSDBSpec"):
 		return &monitoringv1.TSDBSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WarmupSpec"):
+		return &monitoringv1.WarmupSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("WebConfigFileFields"):
 		return &monitoringv1.WebConfigFileFieldsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("WebHTTPConfig"):
@@ -244,8 +278,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.AttachMetadataApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AzureSDConfig"):
 		return &monitoringv1alpha1.AzureSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ComponentLogging"):
+		return &monitoringv1alpha1.ComponentLoggingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ConfigReloaderSettings"):
+		return &monitoringv1alpha1.ConfigReloaderSettingsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ConsulSDConfig"):
 		return &monitoringv1alpha1.ConsulSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CustomResourceSDConfig"):
+		return &monitoringv1alpha1.CustomResourceSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DayOfMonthRange"):
 		return &monitoringv1alpha1.DayOfMonthRangeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DigitalOceanSDConfig"):
@@ -306,6 +346,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.NomadSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackSDConfig"):
 		return &monitoringv1alpha1.OpenStackSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OperatorConfiguration"):
+		return &monitoringv1alpha1.OperatorConfigurationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OperatorConfigurationSpec"):
+		return &monitoringv1alpha1.OperatorConfigurationSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OperatorImages"):
+		return &monitoringv1alpha1.OperatorImagesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OperatorLogging"):
+		return &monitoringv1alpha1.OperatorLoggingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OperatorNamespaces"):
+		return &monitoringv1alpha1.OperatorNamespacesApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpsGenieConfig"):
 		return &monitoringv1alpha1.OpsGenieConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpsGenieConfigResponder"):
@@ -360,6 +410,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.WebexConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("WebhookConfig"):
 		return &monitoringv1alpha1.WebhookConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("WebhookSigningSecret"):
+		return &monitoringv1alpha1.WebhookSigningSecretApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("WeChatConfig"):
 		return &monitoringv1alpha1.WeChatConfigApplyConfiguration{}
 
@@ -402,6 +454,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1beta1.ReceiverApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Route"):
 		return &monitoringv1beta1.RouteApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ScrapeConfig"):
+		return &monitoringv1beta1.ScrapeConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ScrapeConfigSpec"):
+		return &monitoringv1beta1.ScrapeConfigSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("SecretKeySelector"):
 		return &monitoringv1beta1.SecretKeySelectorApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("SlackAction"):
@@ -428,6 +484,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1beta1.WebexConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("WebhookConfig"):
 		return &monitoringv1beta1.WebhookConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WebhookSigningSecret"):
+		return &monitoringv1beta1.WebhookSigningSecretApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("WeChatConfig"):
 		return &monitoringv1beta1.WeChatConfigApplyConfiguration{}
 
@@ -24,6 +24,8 @@ import (
 type Interface interface {
 	// AlertmanagerConfigs returns a AlertmanagerConfigInformer.
 	AlertmanagerConfigs() AlertmanagerConfigInformer
+	// OperatorConfigurations returns a OperatorConfigurationInformer.
+	OperatorConfigurations() OperatorConfigurationInformer
 	// PrometheusAgents returns a PrometheusAgentInformer.
 	PrometheusAgents() PrometheusAgentInformer
 	// ScrapeConfigs returns a ScrapeConfigInformer.
@@ -46,6 +48,11 @@ func (v *version) AlertmanagerConfigs() AlertmanagerConfigInformer {
 	return &alertmanagerConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// OperatorConfigurations returns a OperatorConfigurationInformer.
+func (v *version) OperatorConfigurations() OperatorConfigurationInformer {
+	return &operatorConfigurationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // PrometheusAgents returns a PrometheusAgentInformer.
 func (v *version) PrometheusAgents() PrometheusAgentInformer {
 	return &prometheusAgentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -24,6 +24,10 @@ type AlertmanagerConfigListerExpansion interface{}
 // AlertmanagerConfigNamespaceLister.
 type AlertmanagerConfigNamespaceListerExpansion interface{}
 
+// OperatorConfigurationListerExpansion allows custom methods to be added to
+// OperatorConfigurationLister.
+type OperatorConfigurationListerExpansion interface{}
+
 // PrometheusAgentListerExpansion allows custom methods to be added to
 // PrometheusAgentLister.
 type PrometheusAgentListerExpansion interface{}
@@ -23,3 +23,11 @@ type AlertmanagerConfigListerExpansion interface{}
 // AlertmanagerConfigNamespaceListerExpansion allows custom methods to be added to
 // AlertmanagerConfigNamespaceLister.
 type AlertmanagerConfigNamespaceListerExpansion interface{}
+
+// ScrapeConfigListerExpansion allows custom methods to be added to
+// ScrapeConfigLister.
+type ScrapeConfigListerExpansion interface{}
+
+// ScrapeConfigNamespaceListerExpansion allows custom methods to be added to
+// ScrapeConfigNamespaceLister.
+type ScrapeConfigNamespaceListerExpansion interface{}
@@ -30,6 +30,10 @@ func (c *FakeMonitoringV1alpha1) AlertmanagerConfigs(namespace string) v1alpha1.
 	return newFakeAlertmanagerConfigs(c, namespace)
 }
 
+func (c *FakeMonitoringV1alpha1) OperatorConfigurations() v1alpha1.OperatorConfigurationInterface {
+	return newFakeOperatorConfigurations(c)
+}
+
 func (c *FakeMonitoringV1alpha1) PrometheusAgents(namespace string) v1alpha1.PrometheusAgentInterface {
 	return newFakePrometheusAgents(c, namespace)
 }
@@ -18,6 +18,8 @@ package v1alpha1
 
 type AlertmanagerConfigExpansion interface{}
 
+type OperatorConfigurationExpansion interface{}
+
 type PrometheusAgentExpansion interface{}
 
 type ScrapeConfigExpansion interface{}
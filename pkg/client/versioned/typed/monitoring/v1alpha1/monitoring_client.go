@@ -27,6 +27,7 @@ import (
 type MonitoringV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AlertmanagerConfigsGetter
+	OperatorConfigurationsGetter
 	PrometheusAgentsGetter
 	ScrapeConfigsGetter
 }
@@ -40,6 +41,10 @@ func (c *MonitoringV1alpha1Client) AlertmanagerConfigs(namespace string) Alertma
 	return newAlertmanagerConfigs(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) OperatorConfigurations() OperatorConfigurationInterface {
+	return newOperatorConfigurations(c)
+}
+
 func (c *MonitoringV1alpha1Client) PrometheusAgents(namespace string) PrometheusAgentInterface {
 	return newPrometheusAgents(c, namespace)
 }
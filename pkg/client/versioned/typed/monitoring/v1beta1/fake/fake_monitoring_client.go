@@ -30,6 +30,10 @@ func (c *FakeMonitoringV1beta1) AlertmanagerConfigs(namespace string) v1beta1.Al
 	return newFakeAlertmanagerConfigs(c, namespace)
 }
 
+func (c *FakeMonitoringV1beta1) ScrapeConfigs(namespace string) v1beta1.ScrapeConfigInterface {
+	return newFakeScrapeConfigs(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMonitoringV1beta1) RESTClient() rest.Interface {
@@ -27,6 +27,7 @@ import (
 type MonitoringV1beta1Interface interface {
 	RESTClient() rest.Interface
 	AlertmanagerConfigsGetter
+	ScrapeConfigsGetter
 }
 
 // MonitoringV1beta1Client is used to interact with features provided by the monitoring.coreos.com group.
@@ -38,6 +39,10 @@ func (c *MonitoringV1beta1Client) AlertmanagerConfigs(namespace string) Alertman
 	return newAlertmanagerConfigs(c, namespace)
 }
 
+func (c *MonitoringV1beta1Client) ScrapeConfigs(namespace string) ScrapeConfigInterface {
+	return newScrapeConfigs(c, namespace)
+}
+
 // NewForConfig creates a new MonitoringV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
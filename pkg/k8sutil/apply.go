@@ -0,0 +1,116 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApplyClient is the subset of the typed clients used by Apply.
+type ApplyClient[T client.Object] interface {
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (T, error)
+}
+
+// Apply creates or updates the object with server-side apply. The conflicts
+// with other field managers are forced.
+//
+// The fields set by the previous read-modify-write updates of the operator
+// are owned by the "Update" entry of the same manager (the name is derived
+// from the user agent). They are transferred once to the server-side apply
+// entry and the object is applied again so that the fields which the
+// operator doesn't set anymore are removed.
+func Apply[T client.Object](ctx context.Context, c ApplyClient[T], obj T, fieldManager string) (T, error) {
+	ret, err := apply(ctx, c, obj, fieldManager)
+	if err != nil {
+		return ret, err
+	}
+
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(ret, sets.New(fieldManager), fieldManager)
+	if err != nil {
+		return ret, fmt.Errorf("failed to upgrade the managed fields: %w", err)
+	}
+
+	if patch == nil {
+		return ret, nil
+	}
+
+	if _, err := c.Patch(ctx, obj.GetName(), types.JSONPatchType, patch, metav1.PatchOptions{}); err != nil {
+		return ret, fmt.Errorf("failed to upgrade the managed fields: %w", err)
+	}
+
+	return apply(ctx, c, obj, fieldManager)
+}
+
+func apply[T client.Object](ctx context.Context, c ApplyClient[T], obj T, fieldManager string) (T, error) {
+	data, err := applyPatch(obj)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("failed to build the apply patch: %w", err)
+	}
+
+	return c.Patch(
+		ctx,
+		obj.GetName(),
+		types.ApplyPatchType,
+		data,
+		metav1.PatchOptions{
+			FieldManager: fieldManager,
+			Force:        ptr.To(true),
+		},
+	)
+}
+
+// applyPatch returns the JSON representation of the object without the
+// status and the metadata fields managed by the API server.
+func applyPatch(obj client.Object) ([]byte, error) {
+	obj = obj.DeepCopyObject().(client.Object)
+	if err := AddTypeInformationToObject(obj); err != nil {
+		return nil, err
+	}
+
+	u, err := toUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(u)
+}
+
+// toUnstructured returns the unstructured representation of the object
+// without the status and the metadata fields managed by the API server.
+func toUnstructured(obj client.Object) (map[string]any, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(u, "status")
+	for _, f := range []string{"creationTimestamp", "resourceVersion", "uid", "generation", "managedFields"} {
+		unstructured.RemoveNestedField(u, "metadata", f)
+	}
+
+	return u, nil
+}
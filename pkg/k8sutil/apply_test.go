@@ -0,0 +1,139 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestApplyPatch(t *testing.T) {
+	sset := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "prometheus-k8s",
+			Namespace:       "monitoring",
+			ResourceVersion: "10",
+			UID:             "1234",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(int32(2)),
+		},
+		Status: appsv1.StatefulSetStatus{
+			Replicas: 2,
+		},
+	}
+
+	b, err := applyPatch(sset)
+	require.NoError(t, err)
+
+	var patch map[string]any
+	require.NoError(t, json.Unmarshal(b, &patch))
+	require.Equal(t, "apps/v1", patch["apiVersion"])
+	require.Equal(t, "StatefulSet", patch["kind"])
+	require.NotContains(t, patch, "status")
+	require.Equal(t, map[string]any{"name": "prometheus-k8s", "namespace": "monitoring"}, patch["metadata"])
+
+	// The object isn't modified.
+	require.Empty(t, sset.Kind)
+	require.Equal(t, "10", sset.ResourceVersion)
+}
+
+func TestServerSideApply(t *testing.T) {
+	ctx := context.Background()
+	svcClient := fake.NewClientset().CoreV1().Services("default")
+
+	svc := func() *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "prometheus-operated",
+				Namespace: "default",
+				Labels:    map[string]string{"operated-prometheus": "true"},
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Name: "web", Port: 9090}},
+			},
+		}
+	}
+
+	_, err := CreateOrUpdateService(ctx, svcClient, svc(), "PrometheusOperator")
+	require.NoError(t, err)
+
+	// Another manager adds an annotation and the cluster IP is allocated.
+	_, err = svcClient.Patch(
+		ctx,
+		"prometheus-operated",
+		types.ApplyPatchType,
+		[]byte(`{"apiVersion":"v1","kind":"Service","metadata":{"name":"prometheus-operated","annotations":{"example.com/owner":"team-a"}},"spec":{"clusterIP":"10.0.0.1"}}`),
+		metav1.PatchOptions{FieldManager: "other"},
+	)
+	require.NoError(t, err)
+
+	// The fields of the other manager are preserved.
+	desired := svc()
+	desired.Spec.Ports = append(desired.Spec.Ports, corev1.ServicePort{Name: "grpc", Port: 10901})
+	got, err := CreateOrUpdateService(ctx, svcClient, desired, "PrometheusOperator")
+	require.NoError(t, err)
+
+	require.Equal(t, "10.0.0.1", got.Spec.ClusterIP)
+	require.Equal(t, "team-a", got.Annotations["example.com/owner"])
+	require.Equal(t, "true", got.Labels["operated-prometheus"])
+	require.Len(t, got.Spec.Ports, 2)
+}
+
+func TestServerSideApplyUpgradesManagedFields(t *testing.T) {
+	ctx := context.Background()
+	secretClient := fake.NewClientset().CoreV1().Secrets("default")
+
+	// The Secret was previously managed with read-modify-write updates.
+	_, err := secretClient.Create(
+		ctx,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "prometheus-k8s",
+				Namespace: "default",
+				Labels:    map[string]string{"stale": "true"},
+			},
+			Data: map[string][]byte{"key": []byte("value")},
+		},
+		metav1.CreateOptions{FieldManager: "PrometheusOperator"},
+	)
+	require.NoError(t, err)
+
+	desired := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus-k8s",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{"key": []byte("value")},
+	}
+	got, err := Apply(ctx, secretClient, desired, "PrometheusOperator")
+	require.NoError(t, err)
+
+	// The fields which aren't set anymore are removed.
+	require.NotContains(t, got.Labels, "stale")
+	require.Equal(t, []byte("value"), got.Data["key"])
+	for _, mf := range got.ManagedFields {
+		require.Equal(t, metav1.ManagedFieldsOperationApply, mf.Operation)
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/transport"
+)
+
+// auditedResources are the resources for which the audit transport reports
+// the differences between the live and the desired objects.
+var auditedResources = map[string]struct{}{
+	"configmaps":   {},
+	"secrets":      {},
+	"statefulsets": {},
+}
+
+// NewAuditTransport returns a wrapper for the transport of the Kubernetes
+// clients (see rest.Config.Wrap) which turns all the write requests into
+// dry-run requests: the API server validates and admits the objects but
+// doesn't persist them.
+//
+// For the StatefulSets, Secrets and ConfigMaps, the differences between the
+// live objects and the objects returned by the dry-run requests are logged
+// and counted. The values of the Secrets are replaced by their hash.
+func NewAuditTransport(logger *slog.Logger, r prometheus.Registerer) transport.WrapperFunc {
+	changes := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "prometheus_operator_audit_changes_total",
+			Help: "Total number of changes which would have been applied to the Kubernetes objects in audit mode by resource and operation.",
+		},
+		[]string{"resource", "operation"},
+	)
+	r.MustRegister(changes)
+
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &auditTransport{
+			logger:  logger,
+			rt:      rt,
+			changes: changes,
+		}
+	}
+}
+
+type auditTransport struct {
+	logger  *slog.Logger
+	rt      http.RoundTripper
+	changes *prometheus.CounterVec
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.rt.RoundTrip(req)
+	}
+
+	// The access and token reviews don't write anything.
+	if isReviewPath(req.URL.Path) {
+		return t.rt.RoundTrip(req)
+	}
+
+	resource, namespace, name, subresource := parseResourcePath(req.URL.Path)
+	_, audited := auditedResources[resource]
+	audited = audited && subresource == ""
+
+	var live map[string]any
+	if audited && name != "" && (req.Method == http.MethodPut || req.Method == http.MethodPatch) {
+		var err error
+		live, err = t.get(req)
+		if err != nil {
+			t.logger.Warn("failed to get the live object", "resource", resource, "namespace", namespace, "name", name, "err", err)
+		}
+	}
+
+	dryRunReq := req.Clone(req.Context())
+	q := dryRunReq.URL.Query()
+	q.Set("dryRun", metav1.DryRunAll)
+	dryRunReq.URL.RawQuery = q.Encode()
+	if audited {
+		// The client may request protobuf-encoded objects.
+		dryRunReq.Header.Set("Accept", "application/json")
+	}
+
+	resp, err := t.rt.RoundTrip(dryRunReq)
+	if err != nil || !audited || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp, err
+	}
+
+	if req.Method == http.MethodDelete {
+		t.changes.WithLabelValues(resource, "delete").Inc()
+		t.logger.Info("object would be deleted", "resource", resource, "namespace", namespace, "name", name)
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if !isJSON(resp.Header.Get("Content-Type")) {
+		return resp, nil
+	}
+
+	var desired map[string]any
+	if err := json.Unmarshal(body, &desired); err != nil {
+		t.logger.Warn("failed to decode the dry-run object", "resource", resource, "namespace", namespace, "name", name, "err", err)
+		return resp, nil
+	}
+
+	if req.Method == http.MethodPost {
+		t.changes.WithLabelValues(resource, "create").Inc()
+		t.logger.Info("object would be created", "resource", resource, "namespace", namespace, "name", objectName(desired))
+		return resp, nil
+	}
+
+	if live == nil {
+		return resp, nil
+	}
+
+	diff := cmp.Diff(sanitizeObject(resource, live), sanitizeObject(resource, desired))
+	if diff == "" {
+		return resp, nil
+	}
+
+	t.changes.WithLabelValues(resource, "update").Inc()
+	t.logger.Info("object would be updated", "resource", resource, "namespace", namespace, "name", name, "diff", diff)
+
+	return resp, nil
+}
+
+// get returns the live object targeted by the request.
+func (t *auditTransport) get(req *http.Request) (map[string]any, error) {
+	u := *req.URL
+	u.RawQuery = ""
+
+	getReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	getReq.Header = req.Header.Clone()
+	getReq.Header.Del("Content-Type")
+	getReq.Header.Set("Accept", "application/json")
+
+	resp, err := t.rt.RoundTrip(getReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	var obj map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// parseResourcePath returns the resource, namespace, name and subresource
+// from the path of a Kubernetes API request (e.g.
+// "/apis/apps/v1/namespaces/default/statefulsets/prometheus-k8s/status").
+func parseResourcePath(p string) (string, string, string, string) {
+	parts := strings.Split(strings.Trim(p, "/"), "/")
+	switch {
+	case len(parts) > 2 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) > 3 && parts[0] == "apis":
+		parts = parts[3:]
+	default:
+		return "", "", "", ""
+	}
+
+	var namespace string
+	if len(parts) > 2 && parts[0] == "namespaces" {
+		namespace = parts[1]
+		parts = parts[2:]
+	}
+
+	parts = append(parts, "", "")
+	return parts[0], namespace, parts[1], parts[2]
+}
+
+func isReviewPath(p string) bool {
+	return strings.HasPrefix(p, "/apis/authorization.k8s.io/") ||
+		strings.HasPrefix(p, "/apis/authentication.k8s.io/")
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
+
+func objectName(obj map[string]any) string {
+	md, _ := obj["metadata"].(map[string]any)
+	name, _ := md["name"].(string)
+	return name
+}
+
+// sanitizeObject removes the fields which aren't relevant for the comparison
+// and hashes the values of the Secrets.
+func sanitizeObject(resource string, obj map[string]any) map[string]any {
+	delete(obj, "status")
+
+	if md, ok := obj["metadata"].(map[string]any); ok {
+		for _, k := range []string{"creationTimestamp", "generation", "managedFields", "resourceVersion", "uid"} {
+			delete(md, k)
+		}
+	}
+
+	if resource != "secrets" {
+		return obj
+	}
+
+	for _, k := range []string{"data", "stringData"} {
+		data, ok := obj[k].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for key, v := range data {
+			data[key] = fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(fmt.Sprint(v))))
+		}
+	}
+
+	return obj
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestParseResourcePath(t *testing.T) {
+	for _, tc := range []struct {
+		path     string
+		expected [4]string
+	}{
+		{
+			path:     "/apis/apps/v1/namespaces/default/statefulsets/prometheus-k8s/status",
+			expected: [4]string{"statefulsets", "default", "prometheus-k8s", "status"},
+		},
+		{
+			path:     "/api/v1/namespaces/default/secrets",
+			expected: [4]string{"secrets", "default", "", ""},
+		},
+		{
+			path:     "/api/v1/namespaces/default",
+			expected: [4]string{"namespaces", "", "default", ""},
+		},
+		{
+			path: "/version",
+		},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			resource, namespace, name, subresource := parseResourcePath(tc.path)
+			require.Equal(t, tc.expected, [4]string{resource, namespace, name, subresource})
+		})
+	}
+}
+
+func TestAuditTransport(t *testing.T) {
+	var (
+		mtx      sync.Mutex
+		requests []string
+	)
+
+	live := &v1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "test",
+			Namespace:       "default",
+			ResourceVersion: "1",
+		},
+		Data: map[string][]byte{"password": []byte("old-password")},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mtx.Lock()
+		requests = append(requests, req.Method+" "+req.URL.RawQuery)
+		mtx.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if req.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(live)
+			return
+		}
+
+		// Echo the object like a dry-run request would do.
+		b, _ := io.ReadAll(req.Body)
+		if req.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = w.Write(b)
+	}))
+	defer srv.Close()
+
+	var logs bytes.Buffer
+	reg := prometheus.NewRegistry()
+
+	cfg := &rest.Config{
+		Host: srv.URL,
+		ContentConfig: rest.ContentConfig{
+			ContentType: "application/json",
+		},
+	}
+	cfg.Wrap(NewAuditTransport(slog.New(slog.NewTextHandler(&logs, nil)), reg))
+	kclient, err := kubernetes.NewForConfig(cfg)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = kclient.CoreV1().Secrets("default").Get(ctx, "test", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	desired := live.DeepCopy()
+	desired.Data["password"] = []byte("new-password")
+	_, err = kclient.CoreV1().Secrets("default").Update(ctx, desired, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	desired.Name = "other"
+	desired.ResourceVersion = ""
+	_, err = kclient.CoreV1().Secrets("default").Create(ctx, desired, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"GET ",
+		"GET ",
+		"PUT dryRun=All",
+		"POST dryRun=All",
+	}, requests)
+
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP prometheus_operator_audit_changes_total Total number of changes which would have been applied to the Kubernetes objects in audit mode by resource and operation.
+# TYPE prometheus_operator_audit_changes_total counter
+prometheus_operator_audit_changes_total{operation="create",resource="secrets"} 1
+prometheus_operator_audit_changes_total{operation="update",resource="secrets"} 1
+`), "prometheus_operator_audit_changes_total"))
+
+	require.Contains(t, logs.String(), "object would be updated")
+	require.Contains(t, logs.String(), "object would be created")
+	// The values of the Secrets are hashed.
+	require.NotContains(t, logs.String(), base64.StdEncoding.EncodeToString([]byte("new-password")))
+}
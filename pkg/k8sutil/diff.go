@@ -0,0 +1,241 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+)
+
+const (
+	// maxDiffValueLength is the maximum length of the values reported in
+	// the logs.
+	maxDiffValueLength = 128
+
+	// unknownUpdateCause is the cause of the updates for which no
+	// difference was found (e.g. a field removed from the desired object).
+	unknownUpdateCause = "unknown"
+)
+
+// updateDiffs logs and counts the differences of the updated objects.
+// It is disabled when nil.
+var updateDiffs *updateDiffObserver
+
+type updateDiffObserver struct {
+	logger *slog.Logger
+	causes *prometheus.CounterVec
+}
+
+// EnableUpdateDiffs makes UpdateStatefulSet, UpdateDaemonSet and the
+// CreateOrUpdate helpers log (at the debug level) the fields which differ
+// between the existing object and the desired object before updating it. The
+// updates are also counted by kind and cause in the
+// prometheus_operator_object_updates_total metric, the cause being the first
+// 2 levels of the changed fields (e.g. "spec.template" or "metadata.labels")
+// or "data" for the Secrets and ConfigMaps.
+//
+// The fields which are only present in the existing object are ignored since
+// they are most likely defaulted by the API server. The values of the Secrets
+// aren't logged.
+//
+// It must be called before starting the controllers.
+func EnableUpdateDiffs(logger *slog.Logger, registerer prometheus.Registerer) {
+	causes := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "prometheus_operator_object_updates_total",
+			Help: "Total number of objects updated by the operator by kind and cause.",
+		},
+		[]string{"kind", "cause"},
+	)
+	registerer.MustRegister(causes)
+
+	updateDiffs = &updateDiffObserver{
+		logger: logger,
+		causes: causes,
+	}
+}
+
+// observeUpdate logs the differences between the existing and the desired
+// objects and counts the update causes.
+func observeUpdate(existing, desired client.Object) {
+	if updateDiffs == nil {
+		return
+	}
+
+	kind := objectKind(desired)
+	diffs, err := objectDiff(existing, desired, kind == "Secret")
+	if err != nil {
+		updateDiffs.logger.Debug("failed to compute the object's diff", "kind", kind, "namespace", desired.GetNamespace(), "name", desired.GetName(), "err", err)
+		updateDiffs.causes.WithLabelValues(kind, unknownUpdateCause).Inc()
+		return
+	}
+
+	causes := map[string]struct{}{}
+	changes := make([]string, 0, len(diffs))
+	for _, d := range diffs {
+		causes[d.cause] = struct{}{}
+		changes = append(changes, d.String())
+	}
+
+	if len(causes) == 0 {
+		causes[unknownUpdateCause] = struct{}{}
+	}
+
+	for cause := range causes {
+		updateDiffs.causes.WithLabelValues(kind, cause).Inc()
+	}
+
+	if updateDiffs.logger.Enabled(context.Background(), slog.LevelDebug) {
+		updateDiffs.logger.Debug(
+			"updating object",
+			"kind", kind,
+			"namespace", desired.GetNamespace(),
+			"name", desired.GetName(),
+			"causes", strings.Join(sortutil.SortedKeys(causes), ","),
+			"diff", changes,
+		)
+	}
+}
+
+// fieldDiff is a field which differs between 2 objects.
+type fieldDiff struct {
+	path     string
+	cause    string
+	old, new any
+	redacted bool
+}
+
+func (d fieldDiff) String() string {
+	if d.redacted {
+		return d.path + ": changed"
+	}
+
+	return fmt.Sprintf("%s: %s -> %s", d.path, formatDiffValue(d.old), formatDiffValue(d.new))
+}
+
+func formatDiffValue(v any) string {
+	if v == nil {
+		return "<none>"
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	if len(b) > maxDiffValueLength {
+		return string(b[:maxDiffValueLength]) + "..."
+	}
+
+	return string(b)
+}
+
+// objectDiff returns the fields of the desired object which differ from the
+// existing object. The status and the metadata fields managed by the API
+// server are ignored.
+func objectDiff(existing, desired client.Object, redact bool) ([]fieldDiff, error) {
+	o, err := toUnstructured(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := toUnstructured(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []fieldDiff
+	diffValues("", nil, o, n, redact, &diffs)
+
+	return diffs, nil
+}
+
+// diffValues appends to diffs the differences between the old and new
+// values. keys holds the map keys leading to the values which are used to
+// compute the cause.
+func diffValues(path string, keys []string, old, new any, redact bool, diffs *[]fieldDiff) {
+	switch n := new.(type) {
+	case map[string]any:
+		o, ok := old.(map[string]any)
+		if !ok {
+			break
+		}
+
+		for _, k := range sortutil.SortedKeys(n) {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+
+			diffValues(p, append(keys[:len(keys):len(keys)], k), o[k], n[k], redact, diffs)
+		}
+
+		return
+
+	case []any:
+		o, ok := old.([]any)
+		if !ok || len(o) != len(n) {
+			break
+		}
+
+		for i := range n {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), keys, o[i], n[i], redact, diffs)
+		}
+
+		return
+	}
+
+	if reflect.DeepEqual(old, new) {
+		return
+	}
+
+	// The keys of the Secrets and ConfigMaps (e.g. rule files) aren't part
+	// of the cause to keep the cardinality of the metric bounded.
+	cause := keys
+	switch {
+	case len(cause) > 0 && (cause[0] == "data" || cause[0] == "binaryData" || cause[0] == "stringData"):
+		cause = cause[:1]
+	case len(cause) > 2:
+		cause = cause[:2]
+	}
+
+	*diffs = append(*diffs, fieldDiff{
+		path:     path,
+		cause:    strings.Join(cause, "."),
+		old:      old,
+		new:      new,
+		redacted: redact,
+	})
+}
+
+// objectKind returns the kind of the object from the scheme.
+func objectKind(obj runtime.Object) string {
+	gvks, _, err := scheme.ObjectKinds(obj)
+	if err != nil || len(gvks) == 0 {
+		return reflect.TypeOf(obj).Elem().Name()
+	}
+
+	return gvks[0].Kind
+}
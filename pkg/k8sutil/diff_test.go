@@ -0,0 +1,107 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestObjectDiff(t *testing.T) {
+	sset := func(image string, replicas int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "prometheus-k8s",
+				Namespace: "monitoring",
+				Labels:    map[string]string{"app.kubernetes.io/name": "prometheus"},
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To(replicas),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "prometheus", Image: image}},
+					},
+				},
+			},
+		}
+	}
+
+	existing := sset("quay.io/prometheus/prometheus:v3.0.0", 1)
+	existing.ResourceVersion = "10"
+	existing.Status.Replicas = 1
+	// Defaulted by the API server.
+	existing.Spec.Template.Spec.Containers[0].TerminationMessagePath = "/dev/termination-log"
+
+	diffs, err := objectDiff(existing, sset("quay.io/prometheus/prometheus:v3.0.0", 1), false)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	diffs, err = objectDiff(existing, sset("quay.io/prometheus/prometheus:v3.1.0", 2), false)
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+
+	require.Equal(t, "spec.replicas: 1 -> 2", diffs[0].String())
+	require.Equal(t, "spec.replicas", diffs[0].cause)
+	require.Equal(t, `spec.template.spec.containers[0].image: "quay.io/prometheus/prometheus:v3.0.0" -> "quay.io/prometheus/prometheus:v3.1.0"`, diffs[1].String())
+	require.Equal(t, "spec.template", diffs[1].cause)
+
+	// The labels with dots are reported with the right cause.
+	desired := sset("quay.io/prometheus/prometheus:v3.0.0", 1)
+	desired.Labels["app.kubernetes.io/version"] = "3.0.0"
+	diffs, err = objectDiff(existing, desired, false)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.Equal(t, "metadata.labels", diffs[0].cause)
+}
+
+func TestObserveUpdate(t *testing.T) {
+	var buf bytes.Buffer
+	reg := prometheus.NewRegistry()
+	EnableUpdateDiffs(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})), reg)
+	t.Cleanup(func() { updateDiffs = nil })
+
+	ctx := context.Background()
+	secret := func(password string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "alertmanager-main-generated",
+				Namespace: "monitoring",
+			},
+			Data: map[string][]byte{"password": []byte(password)},
+		}
+	}
+
+	secretClient := fake.NewSimpleClientset(secret("foo")).CoreV1().Secrets("monitoring")
+	require.NoError(t, CreateOrUpdateSecret(ctx, secretClient, secret("bar"), ""))
+
+	require.Equal(t, 1.0, testutil.ToFloat64(updateDiffs.causes.WithLabelValues("Secret", "data")))
+	require.Contains(t, buf.String(), "data.password: changed")
+	require.NotContains(t, buf.String(), "YmFy")
+
+	// No update when nothing changed.
+	require.NoError(t, CreateOrUpdateSecret(ctx, secretClient, secret("bar"), ""))
+	require.Equal(t, 1, testutil.CollectAndCount(updateDiffs.causes))
+}
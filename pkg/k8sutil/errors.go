@@ -0,0 +1,169 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ErrorCategory classifies the reconciliation errors. The category
+// determines the reason of the Reconciled condition, whether the
+// reconciliation is retried and the label of the error metrics.
+type ErrorCategory string
+
+const (
+	// TransientError is an error which may go away by retrying (e.g. network
+	// failure, API conflict). It is the category of uncategorized errors.
+	TransientError ErrorCategory = "transient"
+	// InvalidSpecError is an error caused by the resource's spec which
+	// requires a change of the resource (or of the resources it selects) to
+	// be fixed.
+	InvalidSpecError ErrorCategory = "invalid_spec"
+	// DependencyMissingError is an error caused by a missing object
+	// referenced by the resource (e.g. Secret, ConfigMap, StorageClass).
+	DependencyMissingError ErrorCategory = "dependency_missing"
+	// ForbiddenError is an error returned when the operator lacks the
+	// permissions to perform an operation.
+	ForbiddenError ErrorCategory = "forbidden"
+)
+
+// ErrorCategories lists all the error categories.
+var ErrorCategories = []ErrorCategory{TransientError, InvalidSpecError, DependencyMissingError, ForbiddenError}
+
+// Reason returns the reason of the Reconciled condition for the category.
+func (c ErrorCategory) Reason() string {
+	switch c {
+	case InvalidSpecError:
+		return "InvalidSpec"
+	case DependencyMissingError:
+		return "DependencyMissing"
+	case ForbiddenError:
+		return "Forbidden"
+	default:
+		return "ReconciliationFailed"
+	}
+}
+
+// Retryable returns whether the reconciliation should be retried (with
+// backoff) after an error of this category. The resources failing with a
+// non-retryable error are reconciled again when they (or the objects they
+// select) change.
+func (c ErrorCategory) Retryable() bool {
+	return c != InvalidSpecError
+}
+
+// ConfigMapTooLargeError is returned when the data of a ConfigMap exceeds
+// the maximum size accepted by the API server.
+type ConfigMapTooLargeError struct {
+	Name  string
+	Size  int
+	Limit int
+}
+
+func (e *ConfigMapTooLargeError) Error() string {
+	return fmt.Sprintf("the data of ConfigMap %q is %d bytes which is above the maximum limit of %d bytes", e.Name, e.Size, e.Limit)
+}
+
+type categorizedError struct {
+	category ErrorCategory
+	err      error
+}
+
+func (e *categorizedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *categorizedError) Unwrap() error {
+	return e.err
+}
+
+// NewTransientError wraps err as a TransientError.
+func NewTransientError(err error) error {
+	return newCategorizedError(TransientError, err)
+}
+
+// NewInvalidSpecError wraps err as an InvalidSpecError.
+func NewInvalidSpecError(err error) error {
+	return newCategorizedError(InvalidSpecError, err)
+}
+
+// NewDependencyMissingError wraps err as a DependencyMissingError.
+func NewDependencyMissingError(err error) error {
+	return newCategorizedError(DependencyMissingError, err)
+}
+
+// NewForbiddenError wraps err as a ForbiddenError.
+func NewForbiddenError(err error) error {
+	return newCategorizedError(ForbiddenError, err)
+}
+
+// newCategorizedError returns err unchanged if it's nil or already
+// categorized: the innermost category is the most accurate one (e.g. a
+// missing Secret detected while generating the configuration).
+func newCategorizedError(category ErrorCategory, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, found := categoryOf(err); found {
+		return err
+	}
+
+	return &categorizedError{category: category, err: err}
+}
+
+// ErrorCategoryOf returns the category of err. The Kubernetes API errors
+// found in the chain are categorized from their status reason and the
+// uncategorized errors are considered transient.
+func ErrorCategoryOf(err error) ErrorCategory {
+	if c, found := categoryOf(err); found {
+		return c
+	}
+
+	return TransientError
+}
+
+func categoryOf(err error) (ErrorCategory, bool) {
+	var ce *categorizedError
+	if errors.As(err, &ce) {
+		return ce.category, true
+	}
+
+	var cmErr *ConfigMapTooLargeError
+	if errors.As(err, &cmErr) {
+		return InvalidSpecError, true
+	}
+
+	switch {
+	case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return ForbiddenError, true
+	case apierrors.IsNotFound(err):
+		return DependencyMissingError, true
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return InvalidSpecError, true
+	case apierrors.IsConflict(err),
+		apierrors.IsServerTimeout(err),
+		apierrors.IsTimeout(err),
+		apierrors.IsTooManyRequests(err),
+		apierrors.IsServiceUnavailable(err),
+		apierrors.IsInternalError(err):
+		return TransientError, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestErrorCategoryOf(t *testing.T) {
+	gr := schema.GroupResource{Resource: "secrets"}
+
+	for _, tc := range []struct {
+		name     string
+		err      error
+		expected ErrorCategory
+	}{
+		{
+			name:     "uncategorized error",
+			err:      errors.New("connection refused"),
+			expected: TransientError,
+		},
+		{
+			name:     "invalid spec",
+			err:      NewInvalidSpecError(errors.New("invalid")),
+			expected: InvalidSpecError,
+		},
+		{
+			name:     "wrapped category",
+			err:      fmt.Errorf("sync failed: %w", NewDependencyMissingError(errors.New("missing key"))),
+			expected: DependencyMissingError,
+		},
+		{
+			name:     "innermost category wins",
+			err:      NewInvalidSpecError(fmt.Errorf("generating config: %w", NewDependencyMissingError(errors.New("missing key")))),
+			expected: DependencyMissingError,
+		},
+		{
+			name:     "API not found error",
+			err:      NewInvalidSpecError(fmt.Errorf("loading secret: %w", apierrors.NewNotFound(gr, "foo"))),
+			expected: DependencyMissingError,
+		},
+		{
+			name:     "API forbidden error",
+			err:      fmt.Errorf("updating secret: %w", apierrors.NewForbidden(gr, "foo", errors.New("denied"))),
+			expected: ForbiddenError,
+		},
+		{
+			name:     "ConfigMap too large",
+			err:      fmt.Errorf("rules: %w", &ConfigMapTooLargeError{Name: "foo", Size: 2, Limit: 1}),
+			expected: InvalidSpecError,
+		},
+		{
+			name:     "API conflict error",
+			err:      NewInvalidSpecError(apierrors.NewConflict(gr, "foo", errors.New("conflict"))),
+			expected: TransientError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ErrorCategoryOf(tc.err))
+		})
+	}
+}
+
+func TestNewCategorizedError(t *testing.T) {
+	require.NoError(t, NewInvalidSpecError(nil))
+
+	inner := errors.New("invalid")
+	err := NewInvalidSpecError(inner)
+	require.Equal(t, "invalid", err.Error())
+	require.ErrorIs(t, err, inner)
+	require.False(t, InvalidSpecError.Retryable())
+	require.Equal(t, "InvalidSpec", ErrorCategoryOf(err).Reason())
+	require.Equal(t, "ReconciliationFailed", TransientError.Reason())
+}
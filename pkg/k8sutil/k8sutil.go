@@ -16,6 +16,8 @@ package k8sutil
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -35,12 +37,16 @@ import (
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/discovery"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	clientappsv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
 	clientauthv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	clientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -48,10 +54,12 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	monitoringv1client "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/typed/monitoring/v1"
 )
 
 // KubeConfigEnv (optionally) specify the location of kubeconfig file.
@@ -59,11 +67,20 @@ const KubeConfigEnv = "KUBECONFIG"
 
 const StatusCleanupFinalizerName = "monitoring.coreos.com/status-cleanup"
 
+// DeletionPolicyFinalizerName is the finalizer used to apply the deletion
+// policy of a workload resource before it is removed.
+const DeletionPolicyFinalizerName = "monitoring.coreos.com/deletion-policy"
+
+// ArtifactStoreFinalizerName is the finalizer used to remove the artifacts
+// published to the external store before a workload resource is removed.
+const ArtifactStoreFinalizerName = "monitoring.coreos.com/artifact-store"
+
 var invalidDNS1123Characters = regexp.MustCompile("[^-a-z0-9]+")
 
 var scheme = runtime.NewScheme()
 
 func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(monitoringv1.SchemeBuilder.AddToScheme(scheme))
 	utilruntime.Must(monitoringv1alpha1.SchemeBuilder.AddToScheme(scheme))
 	utilruntime.Must(monitoringv1beta1.SchemeBuilder.AddToScheme(scheme))
@@ -236,105 +253,280 @@ func IsResourceNotFoundError(err error) bool {
 	return false
 }
 
-func CreateOrUpdateService(ctx context.Context, sclient clientv1.ServiceInterface, svc *v1.Service) (*v1.Service, error) {
-	var ret *v1.Service
+// ObjectClient is the subset of the typed clients used by CreateOrUpdate.
+type ObjectClient[T client.Object] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	Create(ctx context.Context, obj T, opts metav1.CreateOptions) (T, error)
+	Update(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+}
+
+// MutateFunc modifies the desired object based on the existing object before
+// the update (e.g. to preserve immutable fields).
+type MutateFunc[T client.Object] func(existing, desired T)
+
+// CreateOrUpdate creates the desired object if it doesn't exist. Otherwise it
+// merges the labels and annotations of the existing object into the desired
+// object (the desired values win), applies the mutate functions and updates
+// the object unless it is semantically equal to the existing one. The
+// operation is retried on conflict.
+func CreateOrUpdate[T client.Object](ctx context.Context, c ObjectClient[T], desired T, mutateFns ...MutateFunc[T]) (T, error) {
+	var ret T
 
 	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		service, err := sclient.Get(ctx, svc.Name, metav1.GetOptions{})
+		existing, err := c.Get(ctx, desired.GetName(), metav1.GetOptions{})
 		if err != nil {
 			if !apierrors.IsNotFound(err) {
 				return err
 			}
 
-			ret, err = sclient.Create(ctx, svc, metav1.CreateOptions{})
+			ret, err = c.Create(ctx, desired, metav1.CreateOptions{})
 			return err
 		}
 
-		// Apply immutable fields from the existing service.
-		svc.Spec.IPFamilies = service.Spec.IPFamilies
-		svc.Spec.IPFamilyPolicy = service.Spec.IPFamilyPolicy
-		svc.Spec.ClusterIP = service.Spec.ClusterIP
-		svc.Spec.ClusterIPs = service.Spec.ClusterIPs
+		for _, fn := range mutateFns {
+			fn(existing, desired)
+		}
 
-		svc.SetOwnerReferences(mergeOwnerReferences(service.GetOwnerReferences(), svc.GetOwnerReferences()))
-		mergeMetadata(&svc.ObjectMeta, service.ObjectMeta)
+		// The merge modifies the maps of the existing object.
+		mergeMetadata(desired, existing.DeepCopyObject().(T))
+		if apiequality.Semantic.DeepEqual(existing, desired) {
+			ret = existing
+			return nil
+		}
 
-		ret, err = sclient.Update(ctx, svc, metav1.UpdateOptions{})
+		observeUpdate(existing, desired)
+		ret, err = c.Update(ctx, desired, metav1.UpdateOptions{})
 		return err
 	})
 
 	return ret, err
 }
 
+// CreateOrUpdateService creates or updates the Service.
+// It uses server-side apply instead when fieldManager isn't empty.
+func CreateOrUpdateService(ctx context.Context, sclient clientv1.ServiceInterface, svc *v1.Service, fieldManager string) (*v1.Service, error) {
+	if fieldManager != "" {
+		return Apply(ctx, sclient, svc, fieldManager)
+	}
+
+	return CreateOrUpdate(ctx, sclient, svc, func(existing, desired *v1.Service) {
+		// Apply immutable fields from the existing service.
+		desired.Spec.IPFamilies = existing.Spec.IPFamilies
+		desired.Spec.IPFamilyPolicy = existing.Spec.IPFamilyPolicy
+		desired.Spec.ClusterIP = existing.Spec.ClusterIP
+		desired.Spec.ClusterIPs = existing.Spec.ClusterIPs
+
+		desired.SetOwnerReferences(mergeOwnerReferences(existing.GetOwnerReferences(), desired.GetOwnerReferences()))
+	})
+}
+
 // CreateOrUpdateEndpoints creates or updates an endpoint resource.
 //
 //nolint:staticcheck // Ignore SA1019 Endpoints is marked as deprecated.
 func CreateOrUpdateEndpoints(ctx context.Context, eclient clientv1.EndpointsInterface, eps *v1.Endpoints) error {
-	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
-	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		endpoints, err := eclient.Get(ctx, eps.Name, metav1.GetOptions{})
-		if err != nil {
-			if !apierrors.IsNotFound(err) {
-				return err
-			}
+	_, err := CreateOrUpdate(ctx, eclient, eps)
+	return err
+}
 
-			_, err = eclient.Create(ctx, eps, metav1.CreateOptions{})
-			return err
-		}
+// ListEndpointSlices returns the EndpointSlices associated to the given
+// Service.
+func ListEndpointSlices(ctx context.Context, c clientdiscoveryv1.EndpointSliceInterface, serviceName string) ([]discoveryv1.EndpointSlice, error) {
+	l, err := c.List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{discoveryv1.LabelServiceName: serviceName}.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		mergeMetadata(&eps.ObjectMeta, endpoints.ObjectMeta)
+	return l.Items, nil
+}
 
-		_, err = eclient.Update(ctx, eps, metav1.UpdateOptions{})
+// CreateOrUpdateEndpointSlice creates or updates an EndpointSlice resource.
+// The object is created when its name is empty (e.g. when it relies on
+// generateName).
+func CreateOrUpdateEndpointSlice(ctx context.Context, c clientdiscoveryv1.EndpointSliceInterface, eps *discoveryv1.EndpointSlice) error {
+	if eps.Name == "" {
+		_, err := c.Create(ctx, eps, metav1.CreateOptions{})
 		return err
-	})
+	}
+
+	_, err := CreateOrUpdate(ctx, c, eps)
+	return err
 }
 
-func CreateOrUpdateEndpointSlice(ctx context.Context, c clientdiscoveryv1.EndpointSliceInterface, eps *discoveryv1.EndpointSlice) error {
+// LastAppliedAnnotationName is the annotation storing the StatefulSet last
+// applied by the operator. It is the base of the three-way merge patch which
+// updates the StatefulSet.
+const LastAppliedAnnotationName = "operator.prometheus.io/last-applied-configuration"
+
+// maxLastAppliedSize is the maximum size of the last applied configuration.
+// The API server limits the total size of the annotations to 256KiB: larger
+// StatefulSets are updated without the annotation (and the three-way merge).
+const maxLastAppliedSize = 128 * 1024
+
+// UpdateStatefulSet merges metadata of existing StatefulSet with new one and updates it.
+//
+// When the existing StatefulSet carries the last applied configuration, only
+// the fields which changed since the last update are patched: the fields set
+// by the API server (e.g. default values) or by other parties (e.g. injected
+// containers) are preserved. Otherwise (or when the last applied
+// configuration exceeds 128KiB) the full object is updated.
+//
+// It uses server-side apply instead when fieldManager isn't empty.
+func UpdateStatefulSet(ctx context.Context, sstClient clientappsv1.StatefulSetInterface, sset *appsv1.StatefulSet, fieldManager string) error {
+	if fieldManager != "" {
+		_, err := Apply(ctx, sstClient, sset, fieldManager)
+		return err
+	}
+
+	lastApplied, err := lastAppliedConfiguration(sset)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the StatefulSet: %w", err)
+	}
+
+	if len(lastApplied) > maxLastAppliedSize {
+		lastApplied = ""
+	}
+
 	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		if eps.Name == "" {
-			_, err := c.Create(ctx, eps, metav1.CreateOptions{})
+		existingSset, err := sstClient.Get(ctx, sset.Name, metav1.GetOptions{})
+		if err != nil {
 			return err
 		}
 
-		endpoints, err := c.Get(ctx, eps.Name, metav1.GetOptions{})
-		if err != nil {
-			if !apierrors.IsNotFound(err) {
-				return err
-			}
+		desired := sset.DeepCopy()
+		original, found := existingSset.Annotations[LastAppliedAnnotationName]
 
-			_, err = c.Create(ctx, eps, metav1.CreateOptions{})
+		// The merge modifies the maps of the existing object.
+		mergeMetadata(desired, existingSset.DeepCopy())
+		// Propagate annotations set by kubectl on spec.template.annotations. e.g performing a rolling restart.
+		// The existing object is needed afterwards to compute the patch so it shouldn't be modified.
+		mergeKubectlAnnotations(existingSset.Spec.Template.ObjectMeta.DeepCopy(), desired.Spec.Template.ObjectMeta)
+		if lastApplied == "" {
+			delete(desired.Annotations, LastAppliedAnnotationName)
+		} else {
+			desired.Annotations[LastAppliedAnnotationName] = lastApplied
+		}
+
+		if !found || lastApplied == "" {
+			observeUpdate(existingSset, desired)
+
+			_, err = sstClient.Update(ctx, desired, metav1.UpdateOptions{})
 			return err
 		}
 
-		mergeMetadata(&eps.ObjectMeta, endpoints.ObjectMeta)
+		patch, err := threeWayMergePatch([]byte(original), existingSset, desired, appsv1.StatefulSet{})
+		if err != nil {
+			return fmt.Errorf("failed to compute the StatefulSet patch: %w", err)
+		}
+
+		if patch == nil {
+			return nil
+		}
+
+		observeUpdate(existingSset, desired)
 
-		_, err = c.Update(ctx, eps, metav1.UpdateOptions{})
+		_, err = sstClient.Patch(ctx, sset.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
 		return err
 	})
 }
 
-// UpdateStatefulSet merges metadata of existing StatefulSet with new one and updates it.
-func UpdateStatefulSet(ctx context.Context, sstClient clientappsv1.StatefulSetInterface, sset *appsv1.StatefulSet) error {
-	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
-	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		existingSset, err := sstClient.Get(ctx, sset.Name, metav1.GetOptions{})
+// lastAppliedConfiguration returns the JSON representation of the object
+// which is stored in the last applied configuration annotation.
+func lastAppliedConfiguration(obj client.Object) (string, error) {
+	u, err := toUnstructured(obj)
+	if err != nil {
+		return "", err
+	}
+	unstructured.RemoveNestedField(u, "metadata", "annotations", LastAppliedAnnotationName)
+
+	b, err := json.Marshal(u)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// threeWayMergePatch returns the strategic merge patch transforming the
+// existing object into the desired object. The fields which are absent from
+// the original (last applied) object aren't removed. The status and the
+// metadata fields managed by the API server are ignored but the patch
+// carries the resource version of the existing object to detect conflicting
+// updates. It returns nil if there's nothing to patch.
+func threeWayMergePatch(original []byte, existing, desired client.Object, dataStruct any) ([]byte, error) {
+	var docs [2][]byte
+	for i, obj := range []client.Object{existing, desired} {
+		u, err := toUnstructured(obj)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		mergeMetadata(&sset.ObjectMeta, existingSset.ObjectMeta)
-		// Propagate annotations set by kubectl on spec.template.annotations. e.g performing a rolling restart.
-		mergeKubectlAnnotations(&existingSset.Spec.Template.ObjectMeta, sset.Spec.Template.ObjectMeta)
+		if docs[i], err = json.Marshal(u); err != nil {
+			return nil, err
+		}
+	}
 
-		_, err = sstClient.Update(ctx, sset, metav1.UpdateOptions{})
-		return err
-	})
+	lookupPatchMeta, err := strategicpatch.NewPatchMetaFromStruct(dataStruct)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := strategicpatch.CreateThreeWayMergePatch(original, docs[1], docs[0], lookupPatchMeta, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// The patch may contain only directives (e.g. the order of the list
+	// elements) which don't modify the existing object.
+	patched, err := strategicpatch.StrategicMergePatchUsingLookupPatchMeta(docs[0], patch, lookupPatchMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	if equal, err := jsonEqual(docs[0], patched); err != nil || equal {
+		return nil, err
+	}
+
+	if existing.GetResourceVersion() == "" {
+		return patch, nil
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(patch, &m); err != nil {
+		return nil, err
+	}
+
+	if err := unstructured.SetNestedField(m, existing.GetResourceVersion(), "metadata", "resourceVersion"); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(m)
+}
+
+func jsonEqual(a, b []byte) (bool, error) {
+	var va, vb any
+	if err := json.Unmarshal(a, &va); err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal(b, &vb); err != nil {
+		return false, err
+	}
+
+	return apiequality.Semantic.DeepEqual(va, vb), nil
 }
 
 // UpdateDaemonSet merges metadata of existing DaemonSet with new one and updates it.
-func UpdateDaemonSet(ctx context.Context, dmsClient clientappsv1.DaemonSetInterface, dset *appsv1.DaemonSet) error {
+// It uses server-side apply instead when fieldManager isn't empty.
+func UpdateDaemonSet(ctx context.Context, dmsClient clientappsv1.DaemonSetInterface, dset *appsv1.DaemonSet, fieldManager string) error {
+	if fieldManager != "" {
+		_, err := Apply(ctx, dmsClient, dset, fieldManager)
+		return err
+	}
+
 	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		existingDset, err := dmsClient.Get(ctx, dset.Name, metav1.GetOptions{})
@@ -342,37 +534,95 @@ func UpdateDaemonSet(ctx context.Context, dmsClient clientappsv1.DaemonSetInterf
 			return err
 		}
 
-		mergeMetadata(&dset.ObjectMeta, existingDset.ObjectMeta)
+		mergeMetadata(dset, existingDset)
 		// Propagate annotations set by kubectl on spec.template.annotations. e.g performing a rolling restart.
 		mergeKubectlAnnotations(&existingDset.Spec.Template.ObjectMeta, dset.Spec.Template.ObjectMeta)
+		observeUpdate(existingDset, dset)
 
 		_, err = dmsClient.Update(ctx, dset, metav1.UpdateOptions{})
 		return err
 	})
 }
 
-// CreateOrUpdateSecret merges metadata of existing Secret with new one and updates it.
-func CreateOrUpdateSecret(ctx context.Context, secretClient clientv1.SecretInterface, desired *v1.Secret) error {
-	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
-	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		existingSecret, err := secretClient.Get(ctx, desired.Name, metav1.GetOptions{})
-		if err != nil {
-			if !apierrors.IsNotFound(err) {
-				return err
-			}
+// CreateOrUpdateDeployment merges metadata of existing Deployment with new one and updates it.
+// It uses server-side apply instead when fieldManager isn't empty.
+func CreateOrUpdateDeployment(ctx context.Context, dplClient clientappsv1.DeploymentInterface, dpl *appsv1.Deployment, fieldManager string) error {
+	if fieldManager != "" {
+		_, err := Apply(ctx, dplClient, dpl, fieldManager)
+		return err
+	}
 
-			_, err = secretClient.Create(ctx, desired, metav1.CreateOptions{})
-			return err
-		}
+	_, err := CreateOrUpdate(ctx, dplClient, dpl, func(existing, desired *appsv1.Deployment) {
+		// Propagate annotations set by kubectl on spec.template.annotations. e.g performing a rolling restart.
+		// The existing object is compared with the desired one afterwards so it shouldn't be modified.
+		mergeKubectlAnnotations(existing.Spec.Template.ObjectMeta.DeepCopy(), desired.Spec.Template.ObjectMeta)
+	})
+	return err
+}
 
-		mutated := existingSecret.DeepCopyObject().(*v1.Secret)
-		mergeMetadata(&desired.ObjectMeta, mutated.ObjectMeta)
-		if apiequality.Semantic.DeepEqual(existingSecret, desired) {
-			return nil
-		}
-		_, err = secretClient.Update(ctx, desired, metav1.UpdateOptions{})
+// CreateOrUpdateSecret merges metadata of existing Secret with new one and updates it.
+// It uses server-side apply instead when fieldManager isn't empty.
+func CreateOrUpdateSecret(ctx context.Context, secretClient clientv1.SecretInterface, desired *v1.Secret, fieldManager string) error {
+	if fieldManager != "" {
+		_, err := Apply(ctx, secretClient, desired, fieldManager)
 		return err
+	}
+
+	_, err := CreateOrUpdate(ctx, secretClient, desired)
+	return err
+}
+
+// MaxConfigMapSize is the maximum size of the ConfigMap data accepted by the
+// API server.
+const MaxConfigMapSize = v1.MaxSecretSize
+
+// ConfigMapDataSize returns the size in bytes of the data and binary data of
+// the ConfigMap.
+func ConfigMapDataSize(cm *v1.ConfigMap) int {
+	var size int
+	for k, v := range cm.Data {
+		size += len(k) + len(v)
+	}
+
+	for k, v := range cm.BinaryData {
+		size += len(k) + len(v)
+	}
+
+	return size
+}
+
+// CreateOrUpdateConfigMap merges metadata of existing ConfigMap with new one and updates it.
+// The labels, annotations and owner references added by other parties to the
+// existing ConfigMap are preserved.
+// It returns a *ConfigMapTooLargeError without calling the API if the data
+// exceeds MaxConfigMapSize.
+func CreateOrUpdateConfigMap(ctx context.Context, cmClient clientv1.ConfigMapInterface, desired *v1.ConfigMap) error {
+	if size := ConfigMapDataSize(desired); size > MaxConfigMapSize {
+		return &ConfigMapTooLargeError{Name: desired.Name, Size: size, Limit: MaxConfigMapSize}
+	}
+
+	_, err := CreateOrUpdate(ctx, cmClient, desired, func(existing, desired *v1.ConfigMap) {
+		desired.SetOwnerReferences(mergeOwnerReferences(existing.GetOwnerReferences(), desired.GetOwnerReferences()))
 	})
+	return err
+}
+
+// CreateOrUpdateServiceMonitor merges metadata of existing ServiceMonitor with new one and updates it.
+func CreateOrUpdateServiceMonitor(ctx context.Context, smClient monitoringv1client.ServiceMonitorInterface, desired *monitoringv1.ServiceMonitor) error {
+	_, err := CreateOrUpdate(ctx, smClient, desired)
+	return err
+}
+
+// CreateOrUpdatePodMonitor merges metadata of existing PodMonitor with new one and updates it.
+func CreateOrUpdatePodMonitor(ctx context.Context, pmClient monitoringv1client.PodMonitorInterface, desired *monitoringv1.PodMonitor) error {
+	_, err := CreateOrUpdate(ctx, pmClient, desired)
+	return err
+}
+
+// CreateOrUpdatePrometheusRule merges metadata of existing PrometheusRule with new one and updates it.
+func CreateOrUpdatePrometheusRule(ctx context.Context, ruleClient monitoringv1client.PrometheusRuleInterface, desired *monitoringv1.PrometheusRule) error {
+	_, err := CreateOrUpdate(ctx, ruleClient, desired)
+	return err
 }
 
 // IsAPIGroupVersionResourceSupported checks if given groupVersion and resource is supported by the cluster.
@@ -395,15 +645,79 @@ func IsAPIGroupVersionResourceSupported(discoveryCli discovery.DiscoveryInterfac
 	return false, nil
 }
 
+// HashAlgorithm is the hash function used by ResourceNamer to generate the
+// unique suffix of the names.
+type HashAlgorithm string
+
+const (
+	// XXHashAlgorithm is the 64-bit xxHash function. It is the default.
+	XXHashAlgorithm HashAlgorithm = "xxhash"
+	// SHA256HashAlgorithm is the SHA-256 function. It makes collisions less
+	// likely for pathological inputs (e.g. crafted to collide with xxHash)
+	// and allows longer suffixes.
+	SHA256HashAlgorithm HashAlgorithm = "sha256"
+)
+
+// defaultHashLength is the default number of hexadecimal characters of the
+// hash suffix.
+const defaultHashLength = 8
+
 // ResourceNamer knows how to generate valid names for various Kubernetes resources.
 type ResourceNamer struct {
-	prefix string
+	prefix     string
+	hashLength int
+	algorithm  HashAlgorithm
+	dns1035    bool
+}
+
+// ResourceNamerOption customizes a ResourceNamer.
+type ResourceNamerOption func(*ResourceNamer)
+
+// WithHashLength sets the number of hexadecimal characters of the hash
+// suffix (default: 8). It can't exceed 16 characters with xxHash and 32
+// characters with SHA-256.
+func WithHashLength(n int) ResourceNamerOption {
+	return func(rn *ResourceNamer) {
+		rn.hashLength = n
+	}
+}
+
+// WithHashAlgorithm sets the hash function used to compute the suffix
+// (default: xxHash).
+func WithHashAlgorithm(a HashAlgorithm) ResourceNamerOption {
+	return func(rn *ResourceNamer) {
+		rn.algorithm = a
+	}
+}
+
+// WithDNS1035Labels makes the ResourceNamer return DNS-1035 labels (starting
+// with an alphabetic character) which can be used as identifiers for
+// containers or ports.
+func WithDNS1035Labels() ResourceNamerOption {
+	return func(rn *ResourceNamer) {
+		rn.dns1035 = true
+	}
+}
+
+// NewResourceNamer returns a ResourceNamer customized with the given options.
+// Without options, the returned names are identical to the ones of the zero
+// value ResourceNamer.
+func NewResourceNamer(opts ...ResourceNamerOption) ResourceNamer {
+	var rn ResourceNamer
+	for _, opt := range opts {
+		opt(&rn)
+	}
+
+	return rn
 }
 
 // NewResourceNamerWithPrefix returns a ResourceNamer that adds a prefix
 // followed by an hyphen character to all resource names.
-func NewResourceNamerWithPrefix(p string) ResourceNamer {
-	return ResourceNamer{prefix: p}
+func NewResourceNamerWithPrefix(p string, opts ...ResourceNamerOption) ResourceNamer {
+	rn := NewResourceNamer(opts...)
+	rn.prefix = p
+
+	return rn
 }
 
 func (rn ResourceNamer) sanitizedLabel(name string) string {
@@ -426,9 +740,44 @@ func isValidDNS1123Label(name string) error {
 	return nil
 }
 
+// hash returns the hexadecimal hash suffix of the name.
+func (rn ResourceNamer) hash(name string) (string, error) {
+	length := rn.hashLength
+	if length == 0 {
+		length = defaultHashLength
+	}
+
+	var h string
+	switch rn.algorithm {
+	case "", XXHashAlgorithm:
+		xxh := xxhash.New()
+		if _, err := xxh.Write([]byte(name)); err != nil {
+			return "", err
+		}
+
+		h = fmt.Sprintf("%x", xxh.Sum64())
+		if len(h) < 16 {
+			h = strings.Repeat("0", 16-len(h)) + h
+		}
+	case SHA256HashAlgorithm:
+		sum := sha256.Sum256([]byte(name))
+		h = hex.EncodeToString(sum[:16])
+	default:
+		return "", fmt.Errorf("unsupported hash algorithm %q", rn.algorithm)
+	}
+
+	if length < 1 || length > len(h) {
+		return "", fmt.Errorf("invalid hash length %d for the %q algorithm: expected a value between 1 and %d", length, rn.algorithm, len(h))
+	}
+
+	return h[:length], nil
+}
+
 // UniqueDNS1123Label returns a name that is a valid DNS-1123 label.
 // The returned name has a hash-based suffix to ensure uniqueness in case the
 // input name exceeds the 63-chars limit.
+// When the DNS-1035 mode is enabled, the returned name is also a valid
+// DNS-1035 label.
 func (rn ResourceNamer) UniqueDNS1123Label(name string) (string, error) {
 	// Hash the name and append the 8 first characters of the hash
 	// value to the resulting name to ensure that 2 names longer than
@@ -440,23 +789,27 @@ func (rn ResourceNamer) UniqueDNS1123Label(name string) (string, error) {
 	// the first 8 chars and added to the end:
 	// * long-63-chars-abc -> first-54-chars-deadbeef
 	// * long-63-chars-XYZ -> first-54-chars-d3adb33f
-	xxh := xxhash.New()
-	if _, err := xxh.Write([]byte(name)); err != nil {
+	h, err := rn.hash(name)
+	if err != nil {
 		return "", err
 	}
-
-	h := fmt.Sprintf("-%x", xxh.Sum64())
-	h = h[:9]
+	h = "-" + h
 
 	name = rn.sanitizedLabel(name)
 
-	if len(name) > validation.DNS1123LabelMaxLength-9 {
-		name = name[:validation.DNS1123LabelMaxLength-9]
+	if rn.dns1035 && name != "" && (name[0] < 'a' || name[0] > 'z') {
+		name = "x" + name
+	}
+
+	if len(name) > validation.DNS1123LabelMaxLength-len(h) {
+		name = strings.TrimRight(name[:validation.DNS1123LabelMaxLength-len(h)], "-")
 	}
 
 	name = name + h
-	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
-		return "", errors.New(strings.Join(errs, ","))
+	if rn.dns1035 {
+		if errs := validation.IsDNS1035Label(name); len(errs) > 0 {
+			return "", errors.New(strings.Join(errs, ","))
+		}
 	}
 
 	return name, isValidDNS1123Label(name)
@@ -514,11 +867,11 @@ func mergeOwnerReferences(oldObj []metav1.OwnerReference, newObj []metav1.OwnerR
 // them into the new resource. If a key is present in both resources, the new
 // resource wins. It also copies the ResourceVersion from the old resource to
 // the new resource to prevent update conflicts.
-func mergeMetadata(newObj *metav1.ObjectMeta, oldObj metav1.ObjectMeta) {
-	newObj.ResourceVersion = oldObj.ResourceVersion
+func mergeMetadata(newObj metav1.Object, oldObj metav1.Object) {
+	newObj.SetResourceVersion(oldObj.GetResourceVersion())
 
-	newObj.SetLabels(mergeMaps(newObj.Labels, oldObj.Labels))
-	newObj.SetAnnotations(mergeMaps(newObj.Annotations, oldObj.Annotations))
+	newObj.SetLabels(mergeMaps(newObj.GetLabels(), oldObj.GetLabels()))
+	newObj.SetAnnotations(mergeMaps(newObj.GetAnnotations(), oldObj.GetAnnotations()))
 }
 
 func mergeMaps(newObj map[string]string, oldObj map[string]string) map[string]string {
@@ -650,3 +1003,13 @@ func FinalizerDeletePatch(finalizers []string, finalizerName string) ([]byte, er
 func HasStatusCleanupFinalizer(obj metav1.Object) bool {
 	return slices.Contains(obj.GetFinalizers(), StatusCleanupFinalizerName)
 }
+
+// HasOperatorFinalizer returns true if the object has any of the finalizers
+// managed by the operator.
+func HasOperatorFinalizer(obj metav1.Object) bool {
+	return slices.ContainsFunc(obj.GetFinalizers(), func(f string) bool {
+		return f == StatusCleanupFinalizerName ||
+			f == DeletionPolicyFinalizerName ||
+			f == ArtifactStoreFinalizerName
+	})
+}
@@ -17,8 +17,11 @@ package k8sutil
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -26,9 +29,14 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -128,6 +136,87 @@ func TestUniqueVolumeNameCollision(t *testing.T) {
 	require.NotEqual(t, fooSanitized, barSanitized, "expected sanitized volume name of %q and %q to be different but got %q", foo, bar, fooSanitized)
 }
 
+func TestResourceNamerOptions(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		opts     []ResourceNamerOption
+		input    string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "defaults",
+			input:    "NAME",
+			expected: "name-4cfd3574",
+		},
+		{
+			name:     "explicit defaults",
+			opts:     []ResourceNamerOption{WithHashLength(8), WithHashAlgorithm(XXHashAlgorithm)},
+			input:    "NAME",
+			expected: "name-4cfd3574",
+		},
+		{
+			name:     "shorter hash",
+			opts:     []ResourceNamerOption{WithHashLength(4)},
+			input:    "NAME",
+			expected: "name-4cfd",
+		},
+		{
+			name:  "longer hash",
+			opts:  []ResourceNamerOption{WithHashLength(16)},
+			input: strings.Repeat("a", validation.DNS1123LabelMaxLength*2),
+			expected: strings.Repeat("a", validation.DNS1123LabelMaxLength-17) +
+				"-4ed69ce2",
+		},
+		{
+			name:  "invalid hash length",
+			opts:  []ResourceNamerOption{WithHashLength(17)},
+			input: "name",
+			err:   true,
+		},
+		{
+			name:  "invalid hash algorithm",
+			opts:  []ResourceNamerOption{WithHashAlgorithm("md5")},
+			input: "name",
+			err:   true,
+		},
+		{
+			name:     "dns1035 label",
+			opts:     []ResourceNamerOption{WithDNS1035Labels()},
+			input:    "NAME",
+			expected: "name-4cfd3574",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := NewResourceNamer(tc.opts...).UniqueDNS1123Label(tc.input)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(out), validation.DNS1123LabelMaxLength)
+			if tc.expected != "" {
+				require.True(t, strings.HasPrefix(out, tc.expected), "expected %q to start with %q", out, tc.expected)
+			}
+		})
+	}
+
+	// The DNS-1035 mode adds a leading alphabetic character.
+	out, err := NewResourceNamer(WithDNS1035Labels()).UniqueDNS1123Label("0-name")
+	require.NoError(t, err)
+	require.Empty(t, validation.IsDNS1035Label(out))
+	require.True(t, strings.HasPrefix(out, "x0-name-"), out)
+
+	// The SHA-256 hash differs from the xxHash one.
+	xxh, err := NewResourceNamer().UniqueDNS1123Label("name")
+	require.NoError(t, err)
+	sha, err := NewResourceNamer(WithHashAlgorithm(SHA256HashAlgorithm), WithHashLength(32)).UniqueDNS1123Label("name")
+	require.NoError(t, err)
+	require.NotEqual(t, xxh, sha)
+	require.Len(t, sha, len("name-")+32)
+}
+
 func TestPropagateKubectlTemplateAnnotations(t *testing.T) {
 	ctx := context.Background()
 
@@ -229,7 +318,7 @@ func TestPropagateKubectlTemplateAnnotations(t *testing.T) {
 			modifiedSset := sset.DeepCopy()
 			modifiedSset.Spec.Template.Annotations = tc.new
 
-			err := UpdateStatefulSet(ctx, ssetClient, modifiedSset)
+			err := UpdateStatefulSet(ctx, ssetClient, modifiedSset, "")
 			require.NoError(t, err)
 
 			updatedSset, err := ssetClient.Get(ctx, "prometheus", metav1.GetOptions{})
@@ -240,6 +329,229 @@ func TestPropagateKubectlTemplateAnnotations(t *testing.T) {
 			}
 		})
 	}
+
+	for _, tc := range testCases {
+		t.Run("deployment/"+tc.name, func(t *testing.T) {
+			dpl := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "prometheus",
+					Namespace: namespace,
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: tc.existing,
+						},
+					},
+				},
+			}
+
+			dplClient := fake.NewSimpleClientset(dpl).AppsV1().Deployments(namespace)
+
+			modifiedDpl := dpl.DeepCopy()
+			modifiedDpl.Spec.Template.Annotations = tc.new
+
+			err := CreateOrUpdateDeployment(ctx, dplClient, modifiedDpl, "")
+			require.NoError(t, err)
+
+			updatedDpl, err := dplClient.Get(ctx, "prometheus", metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, updatedDpl.Spec.Template.Annotations)
+		})
+	}
+}
+
+func TestUpdateStatefulSetPatch(t *testing.T) {
+	ctx := context.Background()
+
+	sset := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "prometheus"},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(int32(1)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "prometheus", Image: "quay.io/prometheus/prometheus:v3.0.0"}},
+				},
+			},
+		},
+	}
+
+	clientset := fake.NewSimpleClientset(sset)
+	ssetClient := clientset.AppsV1().StatefulSets("default")
+
+	var patches []map[string]any
+	clientset.PrependReactor("patch", "statefulsets", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		var patch map[string]any
+		require.NoError(t, json.Unmarshal(action.(clienttesting.PatchAction).GetPatch(), &patch))
+		patches = append(patches, patch)
+		return false, nil, nil
+	})
+
+	// The first update stores the last applied configuration.
+	require.NoError(t, UpdateStatefulSet(ctx, ssetClient, sset.DeepCopy(), ""))
+	require.Empty(t, patches)
+
+	live, err := ssetClient.Get(ctx, "prometheus", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Contains(t, live.Annotations, LastAppliedAnnotationName)
+
+	// Simulate the fields defaulted by the API server and the modifications
+	// of other parties (e.g. a mutating webhook injecting a container).
+	live.ResourceVersion = "10"
+	live.Annotations["injected"] = "true"
+	live.Spec.PodManagementPolicy = appsv1.OrderedReadyPodManagement
+	live.Spec.RevisionHistoryLimit = ptr.To(int32(10))
+	live.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyAlways
+	live.Spec.Template.Spec.Containers[0].ImagePullPolicy = corev1.PullIfNotPresent
+	live.Spec.Template.Spec.Containers = append(live.Spec.Template.Spec.Containers, corev1.Container{Name: "sidecar", Image: "sidecar"})
+	_, err = ssetClient.Update(ctx, live, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// Nothing is patched when the StatefulSet is up-to-date.
+	require.NoError(t, UpdateStatefulSet(ctx, ssetClient, sset.DeepCopy(), ""))
+	require.Empty(t, patches)
+
+	desired := sset.DeepCopy()
+	desired.Spec.Replicas = ptr.To(int32(2))
+	require.NoError(t, UpdateStatefulSet(ctx, ssetClient, desired, ""))
+
+	// The patch only modifies the replicas and the last applied
+	// configuration: the defaulted fields aren't reset and the injected
+	// container isn't deleted. It carries the resource version of the live
+	// object.
+	require.Len(t, patches, 1)
+	require.Equal(t, float64(2), patches[0]["spec"].(map[string]any)["replicas"])
+	spec, err := json.Marshal(patches[0]["spec"])
+	require.NoError(t, err)
+	require.NotContains(t, string(spec), "null")
+	require.NotContains(t, string(spec), "$patch")
+	metadata := patches[0]["metadata"].(map[string]any)
+	require.Equal(t, "10", metadata["resourceVersion"])
+	require.Equal(t, []string{LastAppliedAnnotationName}, slices.Collect(maps.Keys(metadata["annotations"].(map[string]any))))
+
+	updated, err := ssetClient.Get(ctx, "prometheus", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), *updated.Spec.Replicas)
+	require.Equal(t, "true", updated.Annotations["injected"])
+	require.Equal(t, appsv1.OrderedReadyPodManagement, updated.Spec.PodManagementPolicy)
+	require.Equal(t, corev1.PullIfNotPresent, updated.Spec.Template.Spec.Containers[0].ImagePullPolicy)
+	require.Len(t, updated.Spec.Template.Spec.Containers, 2)
+
+	// The fields removed from the desired object are removed from the live
+	// object.
+	desired.Spec.Replicas = nil
+	patches = nil
+	require.NoError(t, UpdateStatefulSet(ctx, ssetClient, desired, ""))
+	require.Len(t, patches, 1)
+	require.Contains(t, patches[0]["spec"], "replicas")
+	require.Nil(t, patches[0]["spec"].(map[string]any)["replicas"])
+
+	// The patch is recomputed when the live object changed concurrently.
+	var conflicts int
+	clientset.PrependReactor("patch", "statefulsets", func(clienttesting.Action) (bool, runtime.Object, error) {
+		if conflicts > 0 {
+			return false, nil, nil
+		}
+		conflicts++
+		return true, nil, apierrors.NewConflict(appsv1.Resource("statefulsets"), "prometheus", errors.New("conflict"))
+	})
+	desired.Spec.Replicas = ptr.To(int32(3))
+	require.NoError(t, UpdateStatefulSet(ctx, ssetClient, desired, ""))
+	require.Equal(t, 1, conflicts)
+
+	updated, err = ssetClient.Get(ctx, "prometheus", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), *updated.Spec.Replicas)
+}
+
+func TestUpdateStatefulSetLargeSpec(t *testing.T) {
+	ctx := context.Background()
+	sset := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus",
+			Namespace: "default",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(int32(1)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "prometheus", Image: "quay.io/prometheus/prometheus:v3.0.0"}},
+				},
+			},
+		},
+	}
+
+	clientset := fake.NewSimpleClientset(sset)
+	ssetClient := clientset.AppsV1().StatefulSets("default")
+
+	var patches int
+	clientset.PrependReactor("patch", "statefulsets", func(clienttesting.Action) (bool, runtime.Object, error) {
+		patches++
+		return false, nil, nil
+	})
+
+	require.NoError(t, UpdateStatefulSet(ctx, ssetClient, sset.DeepCopy(), ""))
+	live, err := ssetClient.Get(ctx, "prometheus", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Contains(t, live.Annotations, LastAppliedAnnotationName)
+
+	// The last applied configuration of a large StatefulSet would exceed
+	// the size limit of the annotations.
+	desired := sset.DeepCopy()
+	desired.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "LARGE", Value: strings.Repeat("x", 200*1024)}}
+	require.NoError(t, UpdateStatefulSet(ctx, ssetClient, desired, ""))
+	require.Zero(t, patches)
+
+	live, err = ssetClient.Get(ctx, "prometheus", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotContains(t, live.Annotations, LastAppliedAnnotationName)
+	require.Len(t, live.Spec.Template.Spec.Containers[0].Env, 1)
+
+	// The StatefulSet is updated without the annotation until it shrinks.
+	desired.Spec.Replicas = ptr.To(int32(2))
+	require.NoError(t, UpdateStatefulSet(ctx, ssetClient, desired, ""))
+	require.Zero(t, patches)
+
+	live, err = ssetClient.Get(ctx, "prometheus", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), *live.Spec.Replicas)
+	require.NotContains(t, live.Annotations, LastAppliedAnnotationName)
+}
+
+func TestCreateOrUpdateDeployment(t *testing.T) {
+	ctx := context.Background()
+	dplClient := fake.NewSimpleClientset().AppsV1().Deployments("default")
+
+	dpl := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus-agent",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "prometheus-agent"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(1)),
+		},
+	}
+
+	require.NoError(t, CreateOrUpdateDeployment(ctx, dplClient, dpl.DeepCopy(), ""))
+
+	existing, err := dplClient.Get(ctx, "prometheus-agent", metav1.GetOptions{})
+	require.NoError(t, err)
+	existing.Labels["external"] = "true"
+	_, err = dplClient.Update(ctx, existing, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	dpl.Spec.Replicas = ptr.To(int32(3))
+	require.NoError(t, CreateOrUpdateDeployment(ctx, dplClient, dpl.DeepCopy(), ""))
+
+	updated, err := dplClient.Get(ctx, "prometheus-agent", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), *updated.Spec.Replicas)
+	require.Equal(t, map[string]string{"app": "prometheus-agent", "external": "true"}, updated.Labels)
 }
 
 func TestMergeMetadata(t *testing.T) {
@@ -321,7 +633,7 @@ func TestMergeMetadata(t *testing.T) {
 				_, err := svcClient.Update(context.Background(), modifiedSvc, metav1.UpdateOptions{})
 				require.NoError(t, err)
 
-				_, err = CreateOrUpdateService(context.Background(), svcClient, service)
+				_, err = CreateOrUpdateService(context.Background(), svcClient, service, "")
 				require.NoError(t, err)
 
 				updatedSvc, err := svcClient.Get(context.Background(), "prometheus-operated", metav1.GetOptions{})
@@ -401,12 +713,15 @@ func TestMergeMetadata(t *testing.T) {
 				_, err := ssetClient.Update(context.Background(), modifiedSset, metav1.UpdateOptions{})
 				require.NoError(t, err)
 
-				err = UpdateStatefulSet(context.Background(), ssetClient, sset)
+				err = UpdateStatefulSet(context.Background(), ssetClient, sset, "")
 				require.NoError(t, err)
 
 				updatedSset, err := ssetClient.Get(context.Background(), "prometheus", metav1.GetOptions{})
 				require.NoError(t, err)
 
+				require.Contains(t, updatedSset.Annotations, LastAppliedAnnotationName)
+				delete(updatedSset.Annotations, LastAppliedAnnotationName)
+
 				if !reflect.DeepEqual(tc.expectedAnnotations, updatedSset.Annotations) {
 					t.Errorf("expected annotations %q, got %q", tc.expectedAnnotations, updatedSset.Annotations)
 				}
@@ -441,7 +756,7 @@ func TestMergeMetadata(t *testing.T) {
 				_, err := sClient.Update(context.Background(), modifiedSecret, metav1.UpdateOptions{})
 				require.NoError(t, err)
 
-				err = CreateOrUpdateSecret(context.Background(), sClient, secret)
+				err = CreateOrUpdateSecret(context.Background(), sClient, secret, "")
 				require.NoError(t, err)
 
 				updatedSecret, err := sClient.Get(context.Background(), "prometheus-tls-assets", metav1.GetOptions{})
@@ -510,7 +825,7 @@ func TestCreateOrUpdateImmutableFields(t *testing.T) {
 			Status: corev1.ServiceStatus{},
 		}
 
-		_, err := CreateOrUpdateService(context.TODO(), svcClient, modifiedSvc)
+		_, err := CreateOrUpdateService(context.TODO(), svcClient, modifiedSvc, "")
 		require.NoError(t, err)
 
 		require.Equal(t, service.Spec.IPFamilies, modifiedSvc.Spec.IPFamilies, "services Spec.IPFamilies are not equal, expected %q, got %q",
@@ -527,6 +842,158 @@ func TestCreateOrUpdateImmutableFields(t *testing.T) {
 	})
 }
 
+func TestCreateOrUpdate(t *testing.T) {
+	ctx := context.Background()
+	clientset := fake.NewSimpleClientset()
+	cmClient := clientset.CoreV1().ConfigMaps("default")
+
+	desired := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "test"},
+			},
+			Data: map[string]string{"key": "value"},
+		}
+	}
+
+	countUpdates := func() int {
+		var n int
+		for _, a := range clientset.Actions() {
+			if a.GetVerb() == "update" {
+				n++
+			}
+		}
+		return n
+	}
+
+	// The object is created.
+	cm, err := CreateOrUpdate(ctx, cmClient, desired())
+	require.NoError(t, err)
+	require.Equal(t, "value", cm.Data["key"])
+
+	// The labels added by a third party are preserved.
+	cm.Labels["external"] = "true"
+	_, err = cmClient.Update(ctx, cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	clientset.ClearActions()
+
+	// No update when the object doesn't change.
+	_, err = CreateOrUpdate(ctx, cmClient, desired())
+	require.NoError(t, err)
+	require.Equal(t, 0, countUpdates())
+
+	// The update is retried on conflict.
+	conflicts := 1
+	clientset.PrependReactor("update", "configmaps", func(clienttesting.Action) (bool, runtime.Object, error) {
+		if conflicts == 0 {
+			return false, nil, nil
+		}
+		conflicts--
+		return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "test", fmt.Errorf("conflict"))
+	})
+
+	d := desired()
+	d.Data["key"] = "updated"
+	cm, err = CreateOrUpdate(ctx, cmClient, d, func(existing, desired *corev1.ConfigMap) {
+		desired.Data["previous"] = existing.Data["key"]
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, countUpdates())
+	require.Equal(t, map[string]string{"key": "updated", "previous": "value"}, cm.Data)
+	require.Equal(t, map[string]string{"app": "test", "external": "true"}, cm.Labels)
+}
+
+func TestCreateOrUpdateConfigMap(t *testing.T) {
+	ctx := context.Background()
+
+	ownerRef := metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: "foo", UID: "1"}
+	externalOwnerRef := metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: "bar", UID: "2"}
+
+	cmClient := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "rules",
+			Namespace:       "default",
+			Labels:          map[string]string{"external": "true"},
+			OwnerReferences: []metav1.OwnerReference{externalOwnerRef},
+		},
+		Data: map[string]string{"old.yaml": "groups: []"},
+	}).CoreV1().ConfigMaps("default")
+
+	err := CreateOrUpdateConfigMap(ctx, cmClient, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "rules",
+			Namespace:       "default",
+			Labels:          map[string]string{"managed": "true"},
+			OwnerReferences: []metav1.OwnerReference{ownerRef},
+		},
+		Data: map[string]string{"new.yaml": "groups: []"},
+	})
+	require.NoError(t, err)
+
+	cm, err := cmClient.Get(ctx, "rules", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"new.yaml": "groups: []"}, cm.Data)
+	require.Equal(t, map[string]string{"external": "true", "managed": "true"}, cm.Labels)
+	require.Equal(t, []metav1.OwnerReference{externalOwnerRef, ownerRef}, cm.OwnerReferences)
+
+	// The ConfigMap isn't sent to the API server when it's too large.
+	err = CreateOrUpdateConfigMap(ctx, cmClient, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rules",
+			Namespace: "default",
+		},
+		Data: map[string]string{"large.yaml": strings.Repeat("a", MaxConfigMapSize)},
+	})
+
+	var cmErr *ConfigMapTooLargeError
+	require.ErrorAs(t, err, &cmErr)
+	require.Equal(t, "rules", cmErr.Name)
+	require.Equal(t, MaxConfigMapSize+len("large.yaml"), cmErr.Size)
+
+	cm, err = cmClient.Get(ctx, "rules", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Contains(t, cm.Data, "new.yaml")
+}
+
+func TestListEndpointSlices(t *testing.T) {
+	ctx := context.Background()
+
+	endpointSlice := func(name, svc string) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "kube-system",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: svc},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+		}
+	}
+
+	c := fake.NewSimpleClientset(
+		endpointSlice("kubelet-abc", "kubelet"),
+		endpointSlice("kubelet-def", "kubelet"),
+		endpointSlice("coredns-abc", "coredns"),
+	).DiscoveryV1().EndpointSlices("kube-system")
+
+	epsl, err := ListEndpointSlices(ctx, c, "kubelet")
+	require.NoError(t, err)
+	require.Len(t, epsl, 2)
+
+	epsl, err = ListEndpointSlices(ctx, c, "unknown")
+	require.NoError(t, err)
+	require.Empty(t, epsl)
+
+	failing := fake.NewSimpleClientset()
+	failing.PrependReactor("list", "endpointslices", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(discoveryv1.Resource("endpointslices"), "", fmt.Errorf("forbidden"))
+	})
+
+	_, err = ListEndpointSlices(ctx, failing.DiscoveryV1().EndpointSlices("kube-system"), "kubelet")
+	require.True(t, apierrors.IsForbidden(err))
+}
+
 func TestConvertToK8sDNSConfig(t *testing.T) {
 	monitoringDNSConfig := &monitoringv1.PodDNSConfig{
 		Nameservers: []string{"8.8.8.8", "8.8.4.4"},
@@ -61,6 +61,7 @@ func MergePatchContainers(base, patches []v1.Container) ([]v1.Container, error)
 		if err := json.Unmarshal(jsonResult, &patchResult); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal merged container %s: %w", container.Name, err)
 		}
+		replaceUnionFields(&patchResult, &patchContainer)
 
 		// Add the patch result and remove the corresponding key from the to do list.
 		out = append(out, patchResult)
@@ -77,3 +78,46 @@ func MergePatchContainers(base, patches []v1.Container) ([]v1.Container, error)
 
 	return out, nil
 }
+
+// replaceUnionFields ensures that the union fields (e.g. the probe handlers
+// or the sources of the environment variables) defined by the patch replace
+// the ones of the base container. Because the patch is built from a typed
+// struct, it can't carry the null values which would delete the other
+// members of the union and the strategic merge patch keeps them all.
+func replaceUnionFields(merged, patch *v1.Container) {
+	for _, p := range []struct {
+		merged, patch *v1.Probe
+	}{
+		{merged.LivenessProbe, patch.LivenessProbe},
+		{merged.ReadinessProbe, patch.ReadinessProbe},
+		{merged.StartupProbe, patch.StartupProbe},
+	} {
+		if p.patch != nil && p.patch.ProbeHandler != (v1.ProbeHandler{}) {
+			p.merged.ProbeHandler = p.patch.ProbeHandler
+		}
+	}
+
+	if patch.Lifecycle != nil {
+		for _, h := range []struct {
+			merged, patch *v1.LifecycleHandler
+		}{
+			{merged.Lifecycle.PostStart, patch.Lifecycle.PostStart},
+			{merged.Lifecycle.PreStop, patch.Lifecycle.PreStop},
+		} {
+			if h.patch != nil {
+				*h.merged = *h.patch
+			}
+		}
+	}
+
+	envByName := make(map[string]v1.EnvVar, len(patch.Env))
+	for _, e := range patch.Env {
+		envByName[e.Name] = e
+	}
+	for i, e := range merged.Env {
+		if pe, found := envByName[e.Name]; found {
+			merged.Env[i].Value = pe.Value
+			merged.Env[i].ValueFrom = pe.ValueFrom
+		}
+	}
+}
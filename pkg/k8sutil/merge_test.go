@@ -20,6 +20,7 @@ import (
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestPodLabelsAnnotations(t *testing.T) {
@@ -156,3 +157,155 @@ func TestMergePatchContainersOrderPreserved(t *testing.T) {
 		require.Equal(t, "", diff, "patch result did not match. diff:\n%s", diff)
 	}
 }
+
+func TestMergePatchContainersPartialPatch(t *testing.T) {
+	base := []v1.Container{
+		{
+			Name:  "prometheus",
+			Image: "prometheus:v3",
+			Args:  []string{"--config.file=/etc/prometheus/config.yaml"},
+			Env: []v1.EnvVar{
+				{Name: "POD_NAME", ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+				{Name: "GOMEMLIMIT", Value: "1GiB"},
+			},
+			VolumeMounts: []v1.VolumeMount{
+				{Name: "config", MountPath: "/etc/prometheus", ReadOnly: true},
+				{Name: "data", MountPath: "/prometheus"},
+			},
+			ReadinessProbe: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					HTTPGet: &v1.HTTPGetAction{Path: "/-/ready", Port: intstr.FromString("web")},
+				},
+				PeriodSeconds:    5,
+				FailureThreshold: 3,
+			},
+			StartupProbe: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					HTTPGet: &v1.HTTPGetAction{Path: "/-/ready", Port: intstr.FromString("web")},
+				},
+				PeriodSeconds:    15,
+				FailureThreshold: 60,
+			},
+			Lifecycle: &v1.Lifecycle{
+				PreStop: &v1.LifecycleHandler{Sleep: &v1.SleepAction{Seconds: 5}},
+			},
+		},
+		{
+			Name:  "config-reloader",
+			Image: "config-reloader:v1",
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		patch    v1.Container
+		expected func(c *v1.Container)
+	}{
+		{
+			name: "add env var",
+			patch: v1.Container{
+				Name: "prometheus",
+				Env:  []v1.EnvVar{{Name: "GODEBUG", Value: "x509ignoreCN=0"}},
+			},
+			expected: func(c *v1.Container) {
+				c.Env = append([]v1.EnvVar{{Name: "GODEBUG", Value: "x509ignoreCN=0"}}, c.Env...)
+			},
+		},
+		{
+			name: "replace env var value with source",
+			patch: v1.Container{
+				Name: "prometheus",
+				Env: []v1.EnvVar{{
+					Name:      "GOMEMLIMIT",
+					ValueFrom: &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{Resource: "limits.memory"}},
+				}},
+			},
+			expected: func(c *v1.Container) {
+				c.Env[1] = v1.EnvVar{
+					Name:      "GOMEMLIMIT",
+					ValueFrom: &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{Resource: "limits.memory"}},
+				}
+			},
+		},
+		{
+			name: "replace env var source with value",
+			patch: v1.Container{
+				Name: "prometheus",
+				Env:  []v1.EnvVar{{Name: "POD_NAME", Value: "prometheus"}},
+			},
+			expected: func(c *v1.Container) {
+				c.Env[0] = v1.EnvVar{Name: "POD_NAME", Value: "prometheus"}
+			},
+		},
+		{
+			name: "add volume mount",
+			patch: v1.Container{
+				Name:         "prometheus",
+				VolumeMounts: []v1.VolumeMount{{Name: "extra", MountPath: "/extra"}},
+			},
+			expected: func(c *v1.Container) {
+				c.VolumeMounts = append([]v1.VolumeMount{{Name: "extra", MountPath: "/extra"}}, c.VolumeMounts...)
+			},
+		},
+		{
+			name: "replace volume mount",
+			patch: v1.Container{
+				Name:         "prometheus",
+				VolumeMounts: []v1.VolumeMount{{Name: "other-data", MountPath: "/prometheus", SubPath: "prometheus-db"}},
+			},
+			expected: func(c *v1.Container) {
+				c.VolumeMounts[1] = v1.VolumeMount{Name: "other-data", MountPath: "/prometheus", SubPath: "prometheus-db"}
+			},
+		},
+		{
+			name: "patch probe threshold",
+			patch: v1.Container{
+				Name:         "prometheus",
+				StartupProbe: &v1.Probe{FailureThreshold: 500},
+			},
+			expected: func(c *v1.Container) {
+				c.StartupProbe.FailureThreshold = 500
+			},
+		},
+		{
+			name: "replace probe handler",
+			patch: v1.Container{
+				Name: "prometheus",
+				ReadinessProbe: &v1.Probe{
+					ProbeHandler: v1.ProbeHandler{
+						Exec: &v1.ExecAction{Command: []string{"/bin/check"}},
+					},
+				},
+			},
+			expected: func(c *v1.Container) {
+				c.ReadinessProbe.ProbeHandler = v1.ProbeHandler{
+					Exec: &v1.ExecAction{Command: []string{"/bin/check"}},
+				}
+			},
+		},
+		{
+			name: "replace lifecycle handler",
+			patch: v1.Container{
+				Name: "prometheus",
+				Lifecycle: &v1.Lifecycle{
+					PreStop: &v1.LifecycleHandler{Exec: &v1.ExecAction{Command: []string{"/bin/drain"}}},
+				},
+			},
+			expected: func(c *v1.Container) {
+				c.Lifecycle.PreStop = &v1.LifecycleHandler{Exec: &v1.ExecAction{Command: []string{"/bin/drain"}}}
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			expected := make([]v1.Container, len(base))
+			for i := range base {
+				base[i].DeepCopyInto(&expected[i])
+			}
+			tc.expected(&expected[0])
+
+			result, err := MergePatchContainers(base, []v1.Container{tc.patch})
+			require.NoError(t, err)
+			require.Equal(t, expected, result)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/client-go/tools/metrics"
+	"k8s.io/client-go/util/workqueue"
 )
 
 type clientGoHTTPMetricAdapter struct {
@@ -37,7 +38,20 @@ type clientGoRateLimiterMetricAdapter struct {
 
 var _ = metrics.LatencyMetric(&clientGoRateLimiterMetricAdapter{})
 
-// MustRegisterClientGoMetrics registers the k8s.io/client-go metrics.
+type workqueueMetricsProvider struct {
+	depth                   *prometheus.GaugeVec
+	adds                    *prometheus.CounterVec
+	latency                 *prometheus.HistogramVec
+	workDuration            *prometheus.HistogramVec
+	unfinishedWork          *prometheus.GaugeVec
+	longestRunningProcessor *prometheus.GaugeVec
+	retries                 *prometheus.CounterVec
+}
+
+var _ = workqueue.MetricsProvider(&workqueueMetricsProvider{})
+
+// MustRegisterClientGoMetrics registers the k8s.io/client-go metrics
+// (including the work queue metrics).
 // It panics if it encounters an error (e.g. metrics already registered).
 func MustRegisterClientGoMetrics(registerer prometheus.Registerer) {
 	httpMetrics := &clientGoHTTPMetricAdapter{
@@ -89,6 +103,105 @@ func MustRegisterClientGoMetrics(registerer prometheus.Registerer) {
 	metrics.RateLimiterLatency = rateLimiterMetrics
 
 	registerer.MustRegister(httpMetrics.count, httpMetrics.duration, rateLimiterMetrics.duration)
+
+	queueMetrics := newWorkqueueMetricsProvider()
+	workqueue.SetProvider(queueMetrics)
+
+	registerer.MustRegister(
+		queueMetrics.depth,
+		queueMetrics.adds,
+		queueMetrics.latency,
+		queueMetrics.workDuration,
+		queueMetrics.unfinishedWork,
+		queueMetrics.longestRunningProcessor,
+		queueMetrics.retries,
+	)
+}
+
+func newWorkqueueMetricsProvider() *workqueueMetricsProvider {
+	buckets := prometheus.ExponentialBuckets(0.001, 4, 10)
+
+	return &workqueueMetricsProvider{
+		depth: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "prometheus_operator_workqueue_depth",
+				Help: "Current number of items in the work queue.",
+			},
+			[]string{"name"},
+		),
+		adds: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "prometheus_operator_workqueue_adds_total",
+				Help: "Total number of items added to the work queue.",
+			},
+			[]string{"name"},
+		),
+		latency: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "prometheus_operator_workqueue_queue_duration_seconds",
+				Help:    "Histogram of the time spent by the items in the work queue before being processed.",
+				Buckets: buckets,
+			},
+			[]string{"name"},
+		),
+		workDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "prometheus_operator_workqueue_work_duration_seconds",
+				Help:    "Histogram of the time spent processing the items of the work queue.",
+				Buckets: buckets,
+			},
+			[]string{"name"},
+		),
+		unfinishedWork: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "prometheus_operator_workqueue_unfinished_work_seconds",
+				Help: "Time spent by the items of the work queue which are still being processed.",
+			},
+			[]string{"name"},
+		),
+		longestRunningProcessor: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "prometheus_operator_workqueue_longest_running_processor_seconds",
+				Help: "Time spent by the longest running item of the work queue.",
+			},
+			[]string{"name"},
+		),
+		retries: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "prometheus_operator_workqueue_retries_total",
+				Help: "Total number of retries handled by the work queue.",
+			},
+			[]string{"name"},
+		),
+	}
+}
+
+func (p *workqueueMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return p.depth.WithLabelValues(name)
+}
+
+func (p *workqueueMetricsProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	return p.adds.WithLabelValues(name)
+}
+
+func (p *workqueueMetricsProvider) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	return p.latency.WithLabelValues(name)
+}
+
+func (p *workqueueMetricsProvider) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	return p.workDuration.WithLabelValues(name)
+}
+
+func (p *workqueueMetricsProvider) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return p.unfinishedWork.WithLabelValues(name)
+}
+
+func (p *workqueueMetricsProvider) NewLongestRunningProcessorSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return p.longestRunningProcessor.WithLabelValues(name)
+}
+
+func (p *workqueueMetricsProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return p.retries.WithLabelValues(name)
 }
 
 func (a *clientGoHTTPMetricAdapter) Increment(_ context.Context, code string, _ string, _ string) {
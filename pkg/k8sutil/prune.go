@@ -0,0 +1,217 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/metadata"
+)
+
+// ExpectedChildren holds the names of the objects which a resource is
+// expected to own after a reconciliation, indexed by resource type.
+type ExpectedChildren map[schema.GroupVersionResource]map[string]struct{}
+
+// Add records the given names as expected for the resource type. Calling it
+// without names means that no object of this type is expected.
+func (ec ExpectedChildren) Add(gvr schema.GroupVersionResource, names ...string) {
+	if _, found := ec[gvr]; !found {
+		ec[gvr] = make(map[string]struct{}, len(names))
+	}
+
+	for _, n := range names {
+		ec[gvr][n] = struct{}{}
+	}
+}
+
+// PruneChildren deletes the objects in the owner's namespace which match the
+// label selector and are controlled by the owner but aren't expected. Only
+// the resource types present in expected are considered.
+//
+// It returns the references ("<resource>/<name>") of the deleted objects.
+func PruneChildren(ctx context.Context, client metadata.Interface, owner metav1.Object, selector labels.Selector, expected ExpectedChildren) ([]string, error) {
+	var (
+		deleted []string
+		errs    []error
+	)
+
+	gvrs := make([]schema.GroupVersionResource, 0, len(expected))
+	for gvr := range expected {
+		gvrs = append(gvrs, gvr)
+	}
+	sort.Slice(gvrs, func(i, j int) bool { return gvrs[i].String() < gvrs[j].String() })
+
+	for _, gvr := range gvrs {
+		rClient := client.Resource(gvr).Namespace(owner.GetNamespace())
+
+		objs, err := rClient.List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+		if apierrors.IsNotFound(err) {
+			// The resource type isn't served by the API server (e.g. the CRD
+			// isn't installed) hence there's nothing to prune.
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list %s: %w", gvr.Resource, err))
+			continue
+		}
+
+		for _, obj := range objs.Items {
+			if !metav1.IsControlledBy(&obj, owner) {
+				continue
+			}
+
+			if _, found := expected[gvr][obj.Name]; found {
+				continue
+			}
+
+			if obj.DeletionTimestamp != nil {
+				continue
+			}
+
+			err := rClient.Delete(ctx, obj.Name, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to delete %s %q: %w", gvr.Resource, obj.Name, err))
+				continue
+			}
+
+			deleted = append(deleted, gvr.Resource+"/"+obj.Name)
+		}
+	}
+
+	return deleted, errors.Join(errs...)
+}
+
+// OrphanChildren removes the owner's references from the objects in the
+// owner's namespace which match the label selector and are controlled by the
+// owner. The objects aren't garbage-collected when the owner is deleted.
+//
+// It returns the references ("<resource>/<name>") of the orphaned objects.
+func OrphanChildren(ctx context.Context, client metadata.Interface, owner metav1.Object, selector labels.Selector, gvrs ...schema.GroupVersionResource) ([]string, error) {
+	var (
+		orphaned []string
+		errs     []error
+	)
+
+	for _, gvr := range gvrs {
+		rClient := client.Resource(gvr).Namespace(owner.GetNamespace())
+
+		objs, err := rClient.List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list %s: %w", gvr.Resource, err))
+			continue
+		}
+
+		for _, obj := range objs.Items {
+			if !metav1.IsControlledBy(&obj, owner) {
+				continue
+			}
+
+			refs := make([]metav1.OwnerReference, 0, len(obj.OwnerReferences))
+			for _, ref := range obj.OwnerReferences {
+				if ref.UID != owner.GetUID() {
+					refs = append(refs, ref)
+				}
+			}
+
+			patch, err := json.Marshal(map[string]any{
+				"metadata": map[string]any{
+					"ownerReferences": refs,
+					"resourceVersion": obj.ResourceVersion,
+				},
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to marshal patch: %w", err))
+				continue
+			}
+
+			_, err = rClient.Patch(ctx, obj.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to orphan %s %q: %w", gvr.Resource, obj.Name, err))
+				continue
+			}
+
+			orphaned = append(orphaned, gvr.Resource+"/"+obj.Name)
+		}
+	}
+
+	return orphaned, errors.Join(errs...)
+}
+
+// DeleteStatefulSetClaims deletes the PersistentVolumeClaims created from the
+// volume claim templates of the StatefulSet.
+//
+// It returns the names of the deleted claims.
+func DeleteStatefulSetClaims(ctx context.Context, pvcClient clientv1.PersistentVolumeClaimInterface, sset *appsv1.StatefulSet) ([]string, error) {
+	if len(sset.Spec.VolumeClaimTemplates) == 0 || sset.Spec.Selector == nil {
+		return nil, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(sset.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+
+	pvcs, err := pvcClient.List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistent volume claims: %w", err)
+	}
+
+	var (
+		deleted []string
+		errs    []error
+	)
+	for _, pvc := range pvcs.Items {
+		// The claims are named "<template>-<statefulset>-<ordinal>".
+		if !slices.ContainsFunc(sset.Spec.VolumeClaimTemplates, func(t v1.PersistentVolumeClaim) bool {
+			ordinal, found := strings.CutPrefix(pvc.Name, t.Name+"-"+sset.Name+"-")
+			if !found {
+				return false
+			}
+
+			_, err := strconv.Atoi(ordinal)
+			return err == nil
+		}) {
+			continue
+		}
+
+		if pvc.DeletionTimestamp != nil {
+			continue
+		}
+
+		if err := pvcClient.Delete(ctx, pvc.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete persistent volume claim %q: %w", pvc.Name, err))
+			continue
+		}
+
+		deleted = append(deleted, pvc.Name)
+	}
+
+	return deleted, errors.Join(errs...)
+}
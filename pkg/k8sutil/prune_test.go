@@ -0,0 +1,165 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/metadata/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestPruneChildren(t *testing.T) {
+	owner := &metav1.ObjectMeta{Name: "owner", Namespace: "ns", UID: types.UID("1")}
+
+	newSecret := func(name, ns string, lbls map[string]string, ownerUID types.UID) *metav1.PartialObjectMetadata {
+		o := &metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+				Labels:    lbls,
+			},
+		}
+		if ownerUID != "" {
+			o.OwnerReferences = []metav1.OwnerReference{{Name: "owner", UID: ownerUID, Controller: ptr.To(true)}}
+		}
+
+		return o
+	}
+
+	managed := map[string]string{"managed-by": "prometheus-operator"}
+	scheme := runtime.NewScheme()
+	require.NoError(t, metav1.AddMetaToScheme(scheme))
+
+	client := fake.NewSimpleMetadataClient(
+		scheme,
+		newSecret("expected", "ns", managed, "1"),
+		newSecret("tls-assets-1", "ns", managed, "1"),
+		newSecret("other-owner", "ns", managed, "2"),
+		newSecret("not-owned", "ns", managed, ""),
+		newSecret("unlabeled", "ns", nil, "1"),
+		newSecret("other-namespace", "ns2", managed, "1"),
+	)
+
+	secrets := v1.SchemeGroupVersion.WithResource("secrets")
+	expected := ExpectedChildren{}
+	expected.Add(secrets, "expected")
+
+	deleted, err := PruneChildren(context.Background(), client, owner, labels.SelectorFromSet(managed), expected)
+	require.NoError(t, err)
+	require.Equal(t, []string{"secrets/tls-assets-1"}, deleted)
+
+	list, err := client.Resource(secrets).Namespace("ns").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+
+	var names []string
+	for _, o := range list.Items {
+		names = append(names, o.Name)
+	}
+	require.ElementsMatch(t, []string{"expected", "other-owner", "not-owned", "unlabeled"}, names)
+
+	// Resource types missing from the expected children aren't pruned.
+	deleted, err = PruneChildren(context.Background(), client, owner, labels.SelectorFromSet(managed), ExpectedChildren{})
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+}
+
+func TestOrphanChildren(t *testing.T) {
+	owner := &metav1.ObjectMeta{Name: "owner", Namespace: "ns", UID: types.UID("1")}
+	managed := map[string]string{"managed-by": "prometheus-operator"}
+
+	newSecret := func(name string, refs ...metav1.OwnerReference) *metav1.PartialObjectMetadata {
+		return &metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "ns",
+				Labels:          managed,
+				OwnerReferences: refs,
+			},
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, metav1.AddMetaToScheme(scheme))
+
+	client := fake.NewSimpleMetadataClient(
+		scheme,
+		newSecret("owned",
+			metav1.OwnerReference{Name: "owner", UID: "1", Controller: ptr.To(true)},
+			metav1.OwnerReference{Name: "other", UID: "3"},
+		),
+		newSecret("other-owner", metav1.OwnerReference{Name: "other", UID: "2", Controller: ptr.To(true)}),
+	)
+
+	secrets := v1.SchemeGroupVersion.WithResource("secrets")
+	orphaned, err := OrphanChildren(context.Background(), client, owner, labels.SelectorFromSet(managed), secrets)
+	require.NoError(t, err)
+	require.Equal(t, []string{"secrets/owned"}, orphaned)
+
+	o, err := client.Resource(secrets).Namespace("ns").Get(context.Background(), "owned", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []metav1.OwnerReference{{Name: "other", UID: "3"}}, o.OwnerReferences)
+
+	o, err = client.Resource(secrets).Namespace("ns").Get(context.Background(), "other-owner", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, o.OwnerReferences, 1)
+}
+
+func TestDeleteStatefulSetClaims(t *testing.T) {
+	newPVC := func(name string, lbls map[string]string) *v1.PersistentVolumeClaim {
+		return &v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: lbls},
+		}
+	}
+
+	selector := map[string]string{"app": "prometheus", "prometheus": "k8s"}
+	kclient := k8sfake.NewClientset(
+		newPVC("prometheus-k8s-db-prometheus-k8s-0", selector),
+		newPVC("prometheus-k8s-db-prometheus-k8s-1", selector),
+		newPVC("prometheus-k8s-db-prometheus-k8s-shard-1-0", selector),
+		newPVC("other", selector),
+		newPVC("prometheus-k8s-db-prometheus-k8s-2", map[string]string{"app": "other"}),
+	)
+
+	sset := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "prometheus-k8s", Namespace: "ns"},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{
+				{ObjectMeta: metav1.ObjectMeta{Name: "prometheus-k8s-db"}},
+			},
+		},
+	}
+
+	pvcClient := kclient.CoreV1().PersistentVolumeClaims("ns")
+	deleted, err := DeleteStatefulSetClaims(context.Background(), pvcClient, sset)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"prometheus-k8s-db-prometheus-k8s-0", "prometheus-k8s-db-prometheus-k8s-1"}, deleted)
+
+	list, err := pvcClient.List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 3)
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+// RevisionProgress is the number of pods running a StatefulSet revision.
+type RevisionProgress struct {
+	Revision          string
+	Replicas          int32
+	AvailableReplicas int32
+}
+
+// RolloutProgress describes the progress of a StatefulSet rollout.
+type RolloutProgress struct {
+	// Desired number of replicas.
+	Replicas int32
+	// Pods which don't run the update revision yet.
+	Current RevisionProgress
+	// Pods running the update revision.
+	Update RevisionProgress
+}
+
+// Complete returns true when all the desired replicas run the update revision
+// and are available.
+func (p RolloutProgress) Complete() bool {
+	return p.Current.Replicas == 0 &&
+		p.Update.Replicas == p.Replicas &&
+		p.Update.AvailableReplicas == p.Replicas
+}
+
+// NewRolloutProgress returns the rollout progress of the StatefulSet from its
+// status and its pods. The pods which are being deleted are ignored.
+func NewRolloutProgress(sset *appsv1.StatefulSet, pods []*v1.Pod, now time.Time) RolloutProgress {
+	p := RolloutProgress{
+		Replicas: 1,
+		Current: RevisionProgress{
+			Revision: sset.Status.CurrentRevision,
+		},
+		Update: RevisionProgress{
+			Revision: sset.Status.UpdateRevision,
+		},
+	}
+
+	if sset.Spec.Replicas != nil {
+		p.Replicas = *sset.Spec.Replicas
+	}
+
+	minReady := time.Duration(sset.Spec.MinReadySeconds) * time.Second
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		rp := &p.Current
+		if pod.Labels[appsv1.ControllerRevisionHashLabelKey] == p.Update.Revision {
+			rp = &p.Update
+		}
+
+		rp.Replicas++
+		if podAvailable(pod, minReady, now) {
+			rp.AvailableReplicas++
+		}
+	}
+
+	return p
+}
+
+// podAvailable returns true if the pod has been ready for at least minReady.
+func podAvailable(pod *v1.Pod, minReady time.Duration, now time.Time) bool {
+	if pod.Status.Phase != v1.PodRunning {
+		return false
+	}
+
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type != v1.PodReady {
+			continue
+		}
+
+		if cond.Status != v1.ConditionTrue {
+			return false
+		}
+
+		return minReady == 0 || !cond.LastTransitionTime.Add(minReady).After(now)
+	}
+
+	return false
+}
+
+// WatchStatefulSetRollout watches the pods of the StatefulSet and calls fn
+// with the rollout progress every time it changes. It returns when fn returns
+// true or when the context is done.
+func WatchStatefulSetRollout(ctx context.Context, kclient kubernetes.Interface, namespace, name string, fn func(RolloutProgress) bool) error {
+	ssetClient := kclient.AppsV1().StatefulSets(namespace)
+	podClient := kclient.CoreV1().Pods(namespace)
+
+	sset, err := ssetClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get StatefulSet %s/%s: %w", namespace, name, err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(sset.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("invalid selector for StatefulSet %s/%s: %w", namespace, name, err)
+	}
+
+	var (
+		pods = map[string]*v1.Pod{}
+		last *RolloutProgress
+	)
+
+	// report calls fn if the progress changed and returns true when fn
+	// returns true.
+	report := func() (bool, error) {
+		sset, err := ssetClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("failed to get StatefulSet %s/%s: %w", namespace, name, err)
+		}
+
+		owned := make([]*v1.Pod, 0, len(pods))
+		for _, p := range pods {
+			if metav1.IsControlledBy(p, sset) {
+				owned = append(owned, p)
+			}
+		}
+
+		p := NewRolloutProgress(sset, owned, time.Now())
+		if last != nil && *last == p {
+			return false, nil
+		}
+		last = &p
+
+		return fn(p), nil
+	}
+
+	if done, err := report(); done || err != nil {
+		return err
+	}
+
+	lw := &cache.ListWatch{
+		ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			options.LabelSelector = selector.String()
+			return podClient.List(ctx, options)
+		},
+		WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+			options.LabelSelector = selector.String()
+			return podClient.Watch(ctx, options)
+		},
+	}
+
+	_, err = watchtools.UntilWithSync(ctx, lw, &v1.Pod{}, nil, func(event watch.Event) (bool, error) {
+		pod, ok := event.Object.(*v1.Pod)
+		if !ok {
+			return false, nil
+		}
+
+		switch event.Type {
+		case watch.Added, watch.Modified:
+			pods[pod.Name] = pod
+		case watch.Deleted:
+			delete(pods, pod.Name)
+		default:
+			return false, nil
+		}
+
+		return report()
+	})
+
+	return err
+}
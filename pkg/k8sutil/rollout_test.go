@@ -0,0 +1,145 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func newRolloutStatefulSet(replicas int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "alertmanager-main",
+			Namespace: "default",
+			UID:       "1234",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(replicas),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "alertmanager"},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{
+			CurrentRevision: "rev-1",
+			UpdateRevision:  "rev-2",
+		},
+	}
+}
+
+func newRolloutPod(sset *appsv1.StatefulSet, i int, revision string, ready bool, readySince time.Time) *v1.Pod {
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", sset.Name, i),
+			Namespace: sset.Namespace,
+			Labels: map[string]string{
+				"app":                                 "alertmanager",
+				appsv1.ControllerRevisionHashLabelKey: revision,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sset, appsv1.SchemeGroupVersion.WithKind("StatefulSet")),
+			},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+			Conditions: []v1.PodCondition{{
+				Type:               v1.PodReady,
+				Status:             status,
+				LastTransitionTime: metav1.NewTime(readySince),
+			}},
+		},
+	}
+}
+
+func TestNewRolloutProgress(t *testing.T) {
+	now := time.Now()
+	sset := newRolloutStatefulSet(3)
+	sset.Spec.MinReadySeconds = 60
+
+	terminating := newRolloutPod(sset, 3, "rev-1", true, now.Add(-time.Hour))
+	terminating.DeletionTimestamp = ptr.To(metav1.NewTime(now))
+
+	p := NewRolloutProgress(sset, []*v1.Pod{
+		newRolloutPod(sset, 0, "rev-1", true, now.Add(-time.Hour)),
+		newRolloutPod(sset, 1, "rev-2", true, now.Add(-time.Hour)),
+		// Not ready for minReadySeconds yet.
+		newRolloutPod(sset, 2, "rev-2", true, now.Add(-time.Second)),
+		terminating,
+	}, now)
+
+	require.Equal(t, RolloutProgress{
+		Replicas: 3,
+		Current:  RevisionProgress{Revision: "rev-1", Replicas: 1, AvailableReplicas: 1},
+		Update:   RevisionProgress{Revision: "rev-2", Replicas: 2, AvailableReplicas: 1},
+	}, p)
+	require.False(t, p.Complete())
+
+	p = NewRolloutProgress(sset, []*v1.Pod{
+		newRolloutPod(sset, 0, "rev-2", true, now.Add(-time.Hour)),
+		newRolloutPod(sset, 1, "rev-2", true, now.Add(-time.Hour)),
+		newRolloutPod(sset, 2, "rev-2", true, now.Add(-time.Hour)),
+	}, now)
+	require.True(t, p.Complete())
+}
+
+func TestWatchStatefulSetRollout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	sset := newRolloutStatefulSet(2)
+	kclient := fake.NewClientset(
+		sset,
+		newRolloutPod(sset, 0, "rev-1", true, now),
+		newRolloutPod(sset, 1, "rev-2", false, now),
+	)
+
+	var progress []RolloutProgress
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- WatchStatefulSetRollout(ctx, kclient, "default", "alertmanager-main", func(p RolloutProgress) bool {
+			progress = append(progress, p)
+			return p.Complete()
+		})
+	}()
+
+	// Roll out the pods.
+	_, err := kclient.CoreV1().Pods("default").Update(ctx, newRolloutPod(sset, 1, "rev-2", true, now), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	_, err = kclient.CoreV1().Pods("default").Update(ctx, newRolloutPod(sset, 0, "rev-2", true, now), metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, <-errCh)
+	require.NotEmpty(t, progress)
+	require.Equal(t, RevisionProgress{Revision: "rev-2", Replicas: 2, AvailableReplicas: 2}, progress[len(progress)-1].Update)
+
+	for i := 1; i < len(progress); i++ {
+		require.NotEqual(t, progress[i-1], progress[i], "progress should only be reported when it changes")
+	}
+}
@@ -54,7 +54,7 @@ func LoadSecretRef(ctx context.Context, logger *slog.Logger, client clientv1.Sec
 			return nil, nil
 		}
 
-		return nil, fmt.Errorf("key %v could not be found in secret %v", sks.Key, sks.Name)
+		return nil, NewDependencyMissingError(fmt.Errorf("key %v could not be found in secret %v", sks.Key, sks.Name))
 	}
 
 	return b, nil
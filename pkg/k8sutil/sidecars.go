@@ -0,0 +1,48 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+// MoveToNativeSidecars moves the named containers to the end of the init
+// containers with the "Always" restart policy, turning them into native
+// sidecar containers. Kubernetes starts the native sidecars before the
+// regular containers and stops them after, which guarantees that the
+// sidecars outlive the main container during the pod termination.
+//
+// It returns the updated containers and init containers.
+func MoveToNativeSidecars(containers, initContainers []v1.Container, names ...string) ([]v1.Container, []v1.Container) {
+	var (
+		outContainers     = make([]v1.Container, 0, len(containers))
+		outInitContainers = slices.Clone(initContainers)
+	)
+
+	for _, c := range containers {
+		if !slices.Contains(names, c.Name) {
+			outContainers = append(outContainers, c)
+			continue
+		}
+
+		c.RestartPolicy = ptr.To(v1.ContainerRestartPolicyAlways)
+		outInitContainers = append(outInitContainers, c)
+	}
+
+	return outContainers, outInitContainers
+}
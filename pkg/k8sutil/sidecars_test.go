@@ -0,0 +1,41 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestMoveToNativeSidecars(t *testing.T) {
+	containers := []v1.Container{{Name: "main"}, {Name: "reloader"}, {Name: "other"}}
+	initContainers := []v1.Container{{Name: "init"}}
+
+	gotContainers, gotInitContainers := MoveToNativeSidecars(containers, initContainers, "reloader", "missing")
+
+	require.Equal(t, []v1.Container{{Name: "main"}, {Name: "other"}}, gotContainers)
+	require.Equal(t, []v1.Container{
+		{Name: "init"},
+		{Name: "reloader", RestartPolicy: ptr.To(v1.ContainerRestartPolicyAlways)},
+	}, gotInitContainers)
+
+	// The input slices aren't modified.
+	require.Len(t, containers, 3)
+	require.Nil(t, containers[1].RestartPolicy)
+	require.Len(t, initContainers, 1)
+}
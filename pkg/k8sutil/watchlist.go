@@ -0,0 +1,47 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	clientfeatures "k8s.io/client-go/features"
+)
+
+// watchListFeatureGates enables the WatchListClient feature on top of the
+// k8s.io/client-go feature gates.
+type watchListFeatureGates struct {
+	clientfeatures.Gates
+}
+
+func (g *watchListFeatureGates) Enabled(key clientfeatures.Feature) bool {
+	if key == clientfeatures.WatchListClient {
+		return true
+	}
+
+	return g.Gates.Enabled(key)
+}
+
+// EnableWatchListClient enables the WatchList (streaming list) feature of the
+// k8s.io/client-go reflectors. The informers populate their caches from a
+// watch stream instead of issuing paginated LIST requests which reduces the
+// load on the API server and the memory usage of the operator at start-up.
+// The reflectors fall back to LIST requests if the API server doesn't support
+// the feature.
+//
+// It must be called before creating the informers.
+func EnableWatchListClient() {
+	clientfeatures.ReplaceFeatureGates(&watchListFeatureGates{
+		Gates: clientfeatures.FeatureGates(),
+	})
+}
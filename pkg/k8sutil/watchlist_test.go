@@ -0,0 +1,43 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	clientfeatures "k8s.io/client-go/features"
+)
+
+func TestEnableWatchListClient(t *testing.T) {
+	defaultGates := clientfeatures.FeatureGates()
+	t.Cleanup(func() {
+		clientfeatures.ReplaceFeatureGates(defaultGates)
+	})
+
+	// The feature is disabled by default.
+	require.False(t, clientfeatures.FeatureGates().Enabled(clientfeatures.WatchListClient))
+
+	EnableWatchListClient()
+
+	require.True(t, clientfeatures.FeatureGates().Enabled(clientfeatures.WatchListClient))
+	// The other features keep their value.
+	for _, f := range []clientfeatures.Feature{
+		clientfeatures.ClientsAllowCBOR,
+		clientfeatures.InformerResourceVersion,
+	} {
+		require.Equal(t, defaultGates.Enabled(f), clientfeatures.FeatureGates().Enabled(f), f)
+	}
+}
@@ -21,15 +21,16 @@ import (
 	"net"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	v1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	clientauthv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
@@ -65,6 +66,7 @@ type Controller struct {
 	kubeletObjectNamespace string
 	kubeletSelector        string
 
+	mtx         sync.RWMutex
 	annotations operator.Map
 	labels      operator.Map
 
@@ -73,6 +75,9 @@ type Controller struct {
 
 	manageEndpointSlice bool
 	manageEndpoints     bool
+
+	// Field manager of the server-side apply requests (empty when disabled).
+	fieldManager string
 }
 
 type ControllerOption func(*Controller)
@@ -95,12 +100,76 @@ func WithEndpoints() ControllerOption {
 	}
 }
 
+// WithFieldManager enables the server-side apply of the kubelet Service with
+// the given field manager.
+func WithFieldManager(fieldManager string) ControllerOption {
+	return func(c *Controller) {
+		c.fieldManager = fieldManager
+	}
+}
+
 func WithNodeAddressPriority(s string) ControllerOption {
 	return func(c *Controller) {
 		c.nodeAddressPriority = s
 	}
 }
 
+// EndpointsOptions returns the options selecting the objects (Endpoints
+// and/or EndpointSlices) which are managed for the kubelet targets.
+//
+// When EndpointSlices are requested but can't be managed because the
+// Kubernetes API doesn't support them or the operator lacks the permissions,
+// it falls back to Endpoints to ensure that the kubelet targets are always
+// published.
+func EndpointsOptions(
+	ctx context.Context,
+	logger *slog.Logger,
+	ssarClient clientauthv1.SelfSubjectAccessReviewInterface,
+	namespace string,
+	endpointSliceSupported bool,
+	endpointSlice bool,
+	endpoints bool,
+) ([]ControllerOption, error) {
+	allowed := endpointSlice && endpointSliceSupported
+	if allowed {
+		var (
+			errs []error
+			err  error
+		)
+		allowed, errs, err = k8sutil.IsAllowed(
+			ctx,
+			ssarClient,
+			[]string{namespace},
+			k8sutil.ResourceAttribute{
+				Group:    discoveryv1.SchemeGroupVersion.Group,
+				Version:  discoveryv1.SchemeGroupVersion.Version,
+				Resource: "endpointslices",
+				Verbs:    []string{"get", "list", "create", "update", "delete"},
+			})
+		if err != nil {
+			return nil, fmt.Errorf("failed to check permissions on resource 'endpointslices' (group %q): %w", discoveryv1.SchemeGroupVersion.Group, err)
+		}
+
+		for _, reason := range errs {
+			logger.Warn(fmt.Sprintf("missing permission on resource 'endpointslices' (group: %q)", discoveryv1.SchemeGroupVersion.Group), "reason", reason)
+		}
+	}
+
+	var opts []ControllerOption
+	if allowed {
+		opts = append(opts, WithEndpointSlice())
+	} else if endpointSlice && !endpoints {
+		logger.Warn("falling back to Endpoints for the kubelet targets")
+		endpoints = true
+	}
+
+	if endpoints {
+		opts = append(opts, WithEndpoints())
+	}
+
+	return opts, nil
+}
+
 func New(
 	logger *slog.Logger,
 	kclient kubernetes.Interface,
@@ -410,13 +479,14 @@ func (c *Controller) sync(ctx context.Context) {
 
 func (c *Controller) syncEndpoints(ctx context.Context, addresses []nodeAddress) error {
 	c.logger.Debug("Sync endpoints")
+	annotations, labels := c.metadata()
 
 	//nolint:staticcheck // Ignore SA1019 Endpoints is marked as deprecated.
 	eps := &v1.Endpoints{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        c.kubeletObjectName,
-			Annotations: c.annotations,
-			Labels: c.labels.Merge(map[string]string{
+			Annotations: annotations,
+			Labels: labels.Merge(map[string]string{
 				"k8s-app":                      "kubelet",
 				"app.kubernetes.io/name":       "kubelet",
 				"app.kubernetes.io/managed-by": "prometheus-operator",
@@ -465,12 +535,13 @@ func (c *Controller) syncEndpoints(ctx context.Context, addresses []nodeAddress)
 
 func (c *Controller) syncService(ctx context.Context) (*v1.Service, error) {
 	c.logger.Debug("Sync service")
+	annotations, labels := c.metadata()
 
 	svc := &v1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        c.kubeletObjectName,
-			Annotations: c.annotations,
-			Labels: c.labels.Merge(map[string]string{
+			Annotations: annotations,
+			Labels: labels.Merge(map[string]string{
 				"k8s-app":                      "kubelet",
 				"app.kubernetes.io/name":       "kubelet",
 				"app.kubernetes.io/managed-by": "prometheus-operator",
@@ -497,26 +568,20 @@ func (c *Controller) syncService(ctx context.Context) (*v1.Service, error) {
 	}
 
 	c.logger.Debug("Updating Kubernetes service", "service", c.kubeletObjectName)
-	return k8sutil.CreateOrUpdateService(ctx, c.kclient.CoreV1().Services(c.kubeletObjectNamespace), svc)
+	return k8sutil.CreateOrUpdateService(ctx, c.kclient.CoreV1().Services(c.kubeletObjectNamespace), svc, c.fieldManager)
 }
 
 func (c *Controller) syncEndpointSlice(ctx context.Context, svc *v1.Service, addresses []nodeAddress) error {
 	c.logger.Debug("Sync endpointslice")
+	annotations, commonLabels := c.metadata()
 
 	// Get the list of endpointslice objects associated to the service.
 	client := c.kclient.DiscoveryV1().EndpointSlices(c.kubeletObjectNamespace)
-	l, err := client.List(ctx, metav1.ListOptions{
-		LabelSelector: labels.Set{discoveryv1.LabelServiceName: c.kubeletObjectName}.String(),
-	})
+	epsl, err := k8sutil.ListEndpointSlices(ctx, client, c.kubeletObjectName)
 	if err != nil {
 		return fmt.Errorf("failed to list endpointslice: %w", err)
 	}
 
-	epsl := []discoveryv1.EndpointSlice{}
-	if len(l.Items) > 0 {
-		epsl = l.Items
-	}
-
 	nodeAddressIdx := make(map[string]nodeAddress, len(addresses))
 	for _, a := range addresses {
 		nodeAddressIdx[a.ipAddress] = a
@@ -601,8 +666,8 @@ func (c *Controller) syncEndpointSlice(ctx context.Context, svc *v1.Service, add
 			eps = &discoveryv1.EndpointSlice{
 				ObjectMeta: metav1.ObjectMeta{
 					GenerateName: c.kubeletObjectName + "-",
-					Annotations:  c.annotations,
-					Labels: c.labels.Merge(map[string]string{
+					Annotations:  annotations,
+					Labels: commonLabels.Merge(map[string]string{
 						discoveryv1.LabelServiceName:   c.kubeletObjectName,
 						discoveryv1.LabelManagedBy:     "prometheus-operator",
 						"k8s-app":                      "kubelet",
@@ -658,7 +723,6 @@ func (c *Controller) syncEndpointSlice(ctx context.Context, svc *v1.Service, add
 
 	for _, eps := range epsl {
 		if len(eps.Endpoints) == 0 {
-			fmt.Println("delete")
 			c.logger.Debug("Deleting endpointslice object", "name", eps.Name)
 			err := client.Delete(ctx, eps.Name, metav1.DeleteOptions{})
 			if err != nil {
@@ -681,3 +745,20 @@ func (c *Controller) syncEndpointSlice(ctx context.Context, svc *v1.Service, add
 func (c *Controller) fullCapacity(eps []discoveryv1.Endpoint) bool {
 	return len(eps) >= c.maxEndpointsPerSlice
 }
+
+func (c *Controller) metadata() (operator.Map, operator.Map) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return c.annotations, c.labels
+}
+
+// UpdateConfig applies the annotations and labels of the operator's
+// configuration. They are used from the next synchronization.
+func (c *Controller) UpdateConfig(cfg operator.Config) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.annotations = cfg.Annotations
+	c.labels = cfg.Labels
+}
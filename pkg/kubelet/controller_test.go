@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -584,3 +585,104 @@ func newLogger() *slog.Logger {
 
 	return l
 }
+
+func TestEndpointsOptions(t *testing.T) {
+	for _, tc := range []struct {
+		name                   string
+		endpointSliceSupported bool
+		endpointSlice          bool
+		endpoints              bool
+		allowed                bool
+		ssarErr                error
+
+		expectedEndpointSlice bool
+		expectedEndpoints     bool
+		expectedErr           bool
+	}{
+		{
+			name:                   "endpointslice disabled",
+			endpointSliceSupported: true,
+			endpoints:              true,
+			allowed:                true,
+			expectedEndpoints:      true,
+		},
+		{
+			name:                   "endpointslice and endpoints disabled",
+			endpointSliceSupported: true,
+			allowed:                true,
+		},
+		{
+			name:                   "endpointslice enabled",
+			endpointSliceSupported: true,
+			endpointSlice:          true,
+			allowed:                true,
+			expectedEndpointSlice:  true,
+		},
+		{
+			name:                   "endpointslice and endpoints enabled",
+			endpointSliceSupported: true,
+			endpointSlice:          true,
+			endpoints:              true,
+			allowed:                true,
+			expectedEndpointSlice:  true,
+			expectedEndpoints:      true,
+		},
+		{
+			name:              "endpointslice unsupported",
+			endpointSlice:     true,
+			allowed:           true,
+			expectedEndpoints: true,
+		},
+		{
+			name:                   "endpointslice forbidden",
+			endpointSliceSupported: true,
+			endpointSlice:          true,
+			expectedEndpoints:      true,
+		},
+		{
+			name:                   "permission check failure",
+			endpointSliceSupported: true,
+			endpointSlice:          true,
+			ssarErr:                fmt.Errorf("connection refused"),
+			expectedErr:            true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewSimpleClientset()
+			fakeClient.PrependReactor(
+				"create", "selfsubjectaccessreviews",
+				func(action ktesting.Action) (bool, runtime.Object, error) {
+					if tc.ssarErr != nil {
+						return true, nil, tc.ssarErr
+					}
+
+					ssar := action.(ktesting.CreateAction).GetObject().(*authv1.SelfSubjectAccessReview)
+					ssar.Status.Allowed = tc.allowed
+					return true, ssar, nil
+				},
+			)
+
+			opts, err := EndpointsOptions(
+				context.Background(),
+				newLogger(),
+				fakeClient.AuthorizationV1().SelfSubjectAccessReviews(),
+				"kube-system",
+				tc.endpointSliceSupported,
+				tc.endpointSlice,
+				tc.endpoints,
+			)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			c := &Controller{}
+			for _, opt := range opts {
+				opt(c)
+			}
+			require.Equal(t, tc.expectedEndpointSlice, c.manageEndpointSlice)
+			require.Equal(t, tc.expectedEndpoints, c.manageEndpoints)
+		})
+	}
+}
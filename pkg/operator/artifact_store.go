@@ -0,0 +1,152 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+// SecretsStoreCSIDriver is the name of the Secrets Store CSI driver.
+const SecretsStoreCSIDriver = "secrets-store.csi.k8s.io"
+
+// ArtifactKey identifies an artifact generated by the operator. The name is
+// the name of the Secret which holds the artifact when no store is
+// configured.
+type ArtifactKey struct {
+	Namespace string
+	Name      string
+}
+
+func (k ArtifactKey) String() string {
+	return k.Namespace + "/" + k.Name
+}
+
+// ArtifactStore publishes the artifacts generated by the operator (e.g. the
+// Prometheus configuration which contains the scrape credentials) to an
+// external secret manager such as Vault or AWS Secrets Manager instead of
+// Kubernetes Secrets.
+type ArtifactStore interface {
+	// Publish creates or updates the artifact.
+	Publish(ctx context.Context, key ArtifactKey, data map[string][]byte) error
+	// Delete removes the artifact. It shouldn't return an error if the
+	// artifact doesn't exist.
+	Delete(ctx context.Context, key ArtifactKey) error
+	// VolumeSource returns the volume source which mounts the artifact in
+	// the pods. Each key of the published data must be exposed as a file
+	// with the same name.
+	VolumeSource(key ArtifactKey) v1.VolumeSource
+}
+
+// SecretsStoreCSIVolumeSource returns a read-only volume source backed by the
+// Secrets Store CSI driver and the given SecretProviderClass.
+func SecretsStoreCSIVolumeSource(secretProviderClass string) v1.VolumeSource {
+	return v1.VolumeSource{
+		CSI: &v1.CSIVolumeSource{
+			Driver:   SecretsStoreCSIDriver,
+			ReadOnly: ptr.To(true),
+			VolumeAttributes: map[string]string{
+				"secretProviderClass": secretProviderClass,
+			},
+		},
+	}
+}
+
+// ArtifactStoreRequest is the payload sent by the webhook artifact store.
+type ArtifactStoreRequest struct {
+	// Namespace of the artifact.
+	Namespace string `json:"namespace"`
+	// Name of the artifact.
+	Name string `json:"name"`
+	// Content of the artifact (only for the PUT method). The values are
+	// base64-encoded.
+	Data map[string][]byte `json:"data,omitempty"`
+}
+
+type webhookArtifactStore struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookArtifactStore returns an ArtifactStore which delegates the
+// storage to an external HTTP endpoint bridging the operator with the secret
+// manager. The endpoint receives an ArtifactStoreRequest with the PUT method
+// to publish an artifact and with the DELETE method to remove it. It must
+// reply with a 2xx status code.
+//
+// The pods mount the artifacts with the Secrets Store CSI driver: the
+// SecretProviderClass must be in the namespace of the artifact and have the
+// same name.
+func NewWebhookArtifactStore(url string, client *http.Client) ArtifactStore {
+	return &webhookArtifactStore{
+		url:    url,
+		client: client,
+	}
+}
+
+// Publish implements the ArtifactStore interface.
+func (w *webhookArtifactStore) Publish(ctx context.Context, key ArtifactKey, data map[string][]byte) error {
+	return w.do(ctx, http.MethodPut, ArtifactStoreRequest{
+		Namespace: key.Namespace,
+		Name:      key.Name,
+		Data:      data,
+	})
+}
+
+// Delete implements the ArtifactStore interface.
+func (w *webhookArtifactStore) Delete(ctx context.Context, key ArtifactKey) error {
+	return w.do(ctx, http.MethodDelete, ArtifactStoreRequest{
+		Namespace: key.Namespace,
+		Name:      key.Name,
+	})
+}
+
+// VolumeSource implements the ArtifactStore interface.
+func (w *webhookArtifactStore) VolumeSource(key ArtifactKey) v1.VolumeSource {
+	return SecretsStoreCSIVolumeSource(key.Name)
+}
+
+func (w *webhookArtifactStore) do(ctx context.Context, method string, r ArtifactStoreRequest) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, w.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %q: %s", resp.Status, string(msg))
+	}
+
+	return nil
+}
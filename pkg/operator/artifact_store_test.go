@@ -0,0 +1,67 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookArtifactStore(t *testing.T) {
+	artifacts := map[string]map[string][]byte{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ArtifactStoreRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if req.Name == "broken" {
+			http.Error(w, "access denied", http.StatusForbidden)
+			return
+		}
+
+		key := ArtifactKey{Namespace: req.Namespace, Name: req.Name}.String()
+		switch r.Method {
+		case http.MethodPut:
+			artifacts[key] = req.Data
+		case http.MethodDelete:
+			delete(artifacts, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer srv.Close()
+
+	store := NewWebhookArtifactStore(srv.URL, srv.Client())
+	key := ArtifactKey{Namespace: "default", Name: "prometheus-main"}
+
+	require.NoError(t, store.Publish(context.Background(), key, map[string][]byte{"prometheus.yaml.gz": []byte("config")}))
+	require.Equal(t, map[string][]byte{"prometheus.yaml.gz": []byte("config")}, artifacts["default/prometheus-main"])
+
+	require.NoError(t, store.Delete(context.Background(), key))
+	require.Empty(t, artifacts)
+
+	err := store.Publish(context.Background(), ArtifactKey{Namespace: "default", Name: "broken"}, nil)
+	require.ErrorContains(t, err, "access denied")
+
+	vs := store.VolumeSource(key)
+	require.NotNil(t, vs.CSI)
+	require.Equal(t, SecretsStoreCSIDriver, vs.CSI.Driver)
+	require.Equal(t, "prometheus-main", vs.CSI.VolumeAttributes["secretProviderClass"])
+}
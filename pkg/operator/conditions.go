@@ -15,6 +15,10 @@
 package operator
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
 
@@ -50,3 +54,23 @@ func UpdateConditions(conditions []monitoringv1.Condition, newConditions ...moni
 
 	return ret
 }
+
+// PausedCondition returns the Paused condition of a workload resource.
+func PausedCondition(paused bool, generation int64) monitoringv1.Condition {
+	c := monitoringv1.Condition{
+		Type:   monitoringv1.Paused,
+		Status: monitoringv1.ConditionFalse,
+		LastTransitionTime: metav1.Time{
+			Time: time.Now().UTC(),
+		},
+		ObservedGeneration: generation,
+	}
+
+	if paused {
+		c.Status = monitoringv1.ConditionTrue
+		c.Reason = "ReconciliationPaused"
+		c.Message = "The operator doesn't update the underlying resources."
+	}
+
+	return c
+}
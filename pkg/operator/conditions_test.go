@@ -0,0 +1,73 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestIsPaused(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		annotations map[string]string
+		paused      bool
+		expected    bool
+	}{
+		{
+			name: "not paused",
+		},
+		{
+			name:     "paused by the spec",
+			paused:   true,
+			expected: true,
+		},
+		{
+			name:        "paused by the annotation",
+			annotations: map[string]string{PausedAnnotation: "true"},
+			expected:    true,
+		},
+		{
+			name:        "annotation with invalid value",
+			annotations: map[string]string{PausedAnnotation: "yes"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+
+			require.Equal(t, tc.expected, IsPaused(p, tc.paused))
+		})
+	}
+}
+
+func TestPausedCondition(t *testing.T) {
+	c := PausedCondition(false, 2)
+	require.Equal(t, monitoringv1.Paused, c.Type)
+	require.Equal(t, monitoringv1.ConditionFalse, c.Status)
+	require.Equal(t, int64(2), c.ObservedGeneration)
+
+	c = PausedCondition(true, 3)
+	require.Equal(t, monitoringv1.ConditionTrue, c.Status)
+	require.Equal(t, "ReconciliationPaused", c.Reason)
+	require.Equal(t, int64(3), c.ObservedGeneration)
+}
@@ -20,6 +20,7 @@ import (
 	"maps"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	v1 "k8s.io/api/core/v1"
@@ -27,8 +28,12 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	k8sflag "k8s.io/component-base/cli/flag"
+
+	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
 )
 
+const defaultResyncPeriod = 5 * time.Minute
+
 // Config defines configuration parameters for the Operator.
 type Config struct {
 	// Version reported by the Kubernetes API.
@@ -65,11 +70,76 @@ type Config struct {
 	// Controller id for pod ownership.
 	ControllerID string
 
+	// Number of concurrent reconciliation workers per controller.
+	Workers Workers
+
+	// Resync period of the informers per controller.
+	ResyncPeriods ResyncPeriods
+
+	// Settings of the writer which updates the status of the configuration
+	// resources.
+	StatusWriter StatusWriterConfig
+
+	// Maximum number of workloads rolled out concurrently (0 means no limit).
+	MaxConcurrentWorkloadRollouts int
+
+	// Go runtime settings of the Prometheus, Alertmanager and Thanos
+	// containers.
+	GoRuntime GoRuntimeConfig
+
+	// Whether the TLS assets are projected with paths preserving their
+	// namespace, name and key instead of being copied with mangled names.
+	TLSAssetsProjection bool
+
+	// How the StatefulSets are deleted when they need to be recreated.
+	StatefulSetRecreationPolicy StatefulSetRecreationPolicy
+
+	// Settings of the leader election.
+	LeaderElection LeaderElectionConfig
+
+	// Settings of the horizontal sharding.
+	Sharding ShardingConfig
+
+	// Discovery of the remote clusters.
+	RemoteClusters RemoteClustersConfig
+
+	// Monitoring resources created for the operator itself.
+	SelfMonitoring SelfMonitoringConfig
+
+	// Mode of operation.
+	Mode Mode
+
 	// Event recorder factory.
 	EventRecorderFactory EventRecorderFactory
 
 	// Feature gates.
 	Gates *FeatureGates
+
+	// Logging settings defined by the OperatorConfiguration resource.
+	LogSettings logging.Settings
+}
+
+// Workers defines the number of objects which each controller can reconcile
+// concurrently. A given object is never reconciled by more than one worker at
+// the same time.
+type Workers struct {
+	Prometheus      int
+	PrometheusAgent int
+	Alertmanager    int
+	ThanosRuler     int
+}
+
+// ResyncPeriods defines the interval at which the informers of each
+// controller replay the cached objects, triggering a periodic reconciliation
+// of the resources. A zero value disables the periodic resync.
+type ResyncPeriods struct {
+	// Used by the Prometheus and PrometheusAgent controllers.
+	Prometheus   time.Duration
+	Alertmanager time.Duration
+	ThanosRuler  time.Duration
+	// Used for the ScrapeConfig informers of the Prometheus and
+	// PrometheusAgent controllers.
+	ScrapeConfig time.Duration
 }
 
 // DefaultConfig returns a default operator configuration.
@@ -81,6 +151,39 @@ func DefaultConfig(cpu, memory string) Config {
 			MemoryRequests: Quantity{q: resource.MustParse(memory)},
 			MemoryLimits:   Quantity{q: resource.MustParse(memory)},
 		},
+		Workers: Workers{
+			Prometheus:      1,
+			PrometheusAgent: 1,
+			Alertmanager:    1,
+			ThanosRuler:     1,
+		},
+		ResyncPeriods: ResyncPeriods{
+			Prometheus:   defaultResyncPeriod,
+			Alertmanager: defaultResyncPeriod,
+			ThanosRuler:  defaultResyncPeriod,
+			ScrapeConfig: defaultResyncPeriod,
+		},
+		LeaderElection: LeaderElectionConfig{
+			LeaseName:     "prometheus-operator",
+			LeaseDuration: 15 * time.Second,
+			RenewDeadline: 10 * time.Second,
+			RetryPeriod:   2 * time.Second,
+		},
+		Sharding: ShardingConfig{
+			GroupName:     "prometheus-operator",
+			LeaseDuration: 30 * time.Second,
+			RenewInterval: 10 * time.Second,
+		},
+		SelfMonitoring: SelfMonitoringConfig{
+			ServiceSelector: "app.kubernetes.io/name=prometheus-operator",
+			PortName:        "http",
+			Labels:          Map{},
+		},
+		StatusWriter: StatusWriterConfig{
+			Workers: 2,
+			QPS:     10,
+			Burst:   20,
+		},
 		Namespaces: Namespaces{
 			AllowList:                   StringSet{},
 			DenyList:                    StringSet{},
@@ -106,10 +209,43 @@ func DefaultConfig(cpu, memory string) Config {
 				description: "Updates the status subresource for configuration resources",
 				enabled:     false,
 			},
+			ServerSideApplyFeature: FeatureGate{
+				description: "Uses server-side apply to manage the StatefulSets, DaemonSets, Services and Secrets",
+				enabled:     false,
+			},
+			NativeSidecarContainersFeature: FeatureGate{
+				description: "Runs the config-reloader and Thanos sidecar containers as native sidecars (requires Kubernetes >= 1.29)",
+				enabled:     false,
+			},
 		},
 	}
 }
 
+// NativeSidecarsMinimumKubernetesVersion is the first Kubernetes version
+// enabling the native sidecar containers by default.
+var NativeSidecarsMinimumKubernetesVersion = semver.MustParse("1.29.0")
+
+// ApplyFieldManager returns the field manager of the server-side apply
+// requests creating and updating the workload resources. It returns an empty
+// string when the ServerSideApply feature gate is disabled.
+func (c *Config) ApplyFieldManager() string {
+	if c.Gates == nil || !c.Gates.Enabled(ServerSideApplyFeature) {
+		return ""
+	}
+
+	return PrometheusOperatorFieldManager
+}
+
+// NativeSidecarsEnabled returns true when the sidecar containers should run
+// as native sidecars (init containers with the "Always" restart policy).
+func (c *Config) NativeSidecarsEnabled() bool {
+	if c.Gates == nil || !c.Gates.Enabled(NativeSidecarContainersFeature) {
+		return false
+	}
+
+	return c.KubernetesVersion.GTE(NativeSidecarsMinimumKubernetesVersion)
+}
+
 func (c *Config) RegisterFeatureGatesFlags(fs *flag.FlagSet, flags *k8sflag.MapStringBool) {
 	fs.Var(
 		flags,
@@ -120,6 +256,23 @@ func (c *Config) RegisterFeatureGatesFlags(fs *flag.FlagSet, flags *k8sflag.MapS
 	)
 }
 
+// SecretWatchSelectors returns the label and field selectors which restrict
+// the Secrets watched by the operator.
+func (c *Config) SecretWatchSelectors() (labels.Selector, fields.Selector) {
+	// The selectors have been validated when parsing the flags.
+	ls, err := labels.Parse(c.SecretListWatchLabelSelector.String())
+	if err != nil {
+		ls = labels.Everything()
+	}
+
+	fs, err := fields.ParseSelector(c.SecretListWatchFieldSelector.String())
+	if err != nil {
+		fs = fields.Everything()
+	}
+
+	return ls, fs
+}
+
 // ContainerConfig holds some configuration for the ConfigReloader sidecar
 // that can be set through prometheus-operator command line arguments.
 type ContainerConfig struct {
@@ -313,6 +466,35 @@ func (ls *LabelSelector) Set(value string) error {
 	return nil
 }
 
+const (
+	// ReconcileMode is the default mode in which the operator applies the
+	// changes to the Kubernetes objects.
+	ReconcileMode Mode = "reconcile"
+	// AuditMode is the mode in which the operator computes the desired
+	// objects and reports the differences with the live objects without
+	// persisting any change.
+	AuditMode Mode = "audit"
+)
+
+type Mode string
+
+// String implements the flag.Value interface.
+func (m *Mode) String() string {
+	if m == nil || *m == "" {
+		return string(ReconcileMode)
+	}
+	return string(*m)
+}
+
+// Set implements the flag.Value interface.
+func (m *Mode) Set(value string) error {
+	if value != string(ReconcileMode) && value != string(AuditMode) {
+		return fmt.Errorf("invalid mode, expected %q or %q but got: %q", ReconcileMode, AuditMode, value)
+	}
+	*m = Mode(value)
+	return nil
+}
+
 type NodeAddressPriority string
 
 // String implements the flag.Value interface.
@@ -0,0 +1,96 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ConfigHashCache remembers the hash of the inputs which were used to
+// generate the configuration of each object (e.g. Prometheus). It allows the
+// controllers to skip the generation and the update of the configuration
+// when none of the inputs changed since the last reconciliation.
+type ConfigHashCache struct {
+	mtx    sync.Mutex
+	hashes map[string]string
+
+	requests *prometheus.CounterVec
+}
+
+// NewConfigHashCache returns an empty cache and registers the
+// prometheus_operator_config_hash_cache_requests_total metric with the
+// registerer.
+func NewConfigHashCache(r prometheus.Registerer) *ConfigHashCache {
+	c := &ConfigHashCache{
+		hashes: map[string]string{},
+		requests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "prometheus_operator_config_hash_cache_requests_total",
+				Help: "Number of lookups in the configuration hash cache. A hit means that the configuration generation was skipped because its inputs didn't change.",
+			},
+			[]string{"result"},
+		),
+	}
+
+	c.requests.WithLabelValues("hit")
+	c.requests.WithLabelValues("miss")
+	r.MustRegister(c.requests)
+
+	return c
+}
+
+// Unchanged returns true if the hash matches the one recorded for the
+// object identified by key.
+func (c *ConfigHashCache) Unchanged(key, hash string) bool {
+	c.mtx.Lock()
+	h, found := c.hashes[key]
+	c.mtx.Unlock()
+
+	if found && h == hash {
+		c.requests.WithLabelValues("hit").Inc()
+		return true
+	}
+
+	c.requests.WithLabelValues("miss").Inc()
+	return false
+}
+
+// Set records the hash for the object identified by key. It should be called
+// once the configuration has been successfully written.
+func (c *ConfigHashCache) Set(key, hash string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.hashes[key] = hash
+}
+
+// Has returns true if a hash is recorded for the object identified by key.
+func (c *ConfigHashCache) Has(key string) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	_, found := c.hashes[key]
+	return found
+}
+
+// Forget removes the object identified by key from the cache.
+func (c *ConfigHashCache) Forget(key string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	delete(c.hashes, key)
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigHashCache(t *testing.T) {
+	c := NewConfigHashCache(prometheus.NewRegistry())
+
+	require.False(t, c.Unchanged("ns/a", "1"))
+
+	c.Set("ns/a", "1")
+	require.True(t, c.Unchanged("ns/a", "1"))
+	require.False(t, c.Unchanged("ns/a", "2"))
+	require.False(t, c.Unchanged("ns/b", "1"))
+
+	c.Forget("ns/a")
+	require.False(t, c.Unchanged("ns/a", "1"))
+
+	require.Equal(t, 1.0, testutil.ToFloat64(c.requests.WithLabelValues("hit")))
+	require.Equal(t, 4.0, testutil.ToFloat64(c.requests.WithLabelValues("miss")))
+}
@@ -0,0 +1,537 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	// ConfigReloadStatusPath is the HTTP path on which the config-reloader
+	// exposes the status of the last configuration reload.
+	ConfigReloadStatusPath = "/api/v1/status/reload"
+
+	// ConfigReloaderReadinessPath is the HTTP path of the config-reloader's
+	// readiness endpoint which fails while the last reload failed.
+	ConfigReloaderReadinessPath = "/readyz"
+
+	// ConfigReloadPollInterval is the interval at which the controllers
+	// collect the status of the configuration reloads.
+	ConfigReloadPollInterval = time.Minute
+
+	// ConfigReloadFailureThreshold is the duration after which a failing
+	// configuration reload is considered persistent.
+	ConfigReloadFailureThreshold = 10 * time.Minute
+
+	// ConfigReloadFailedEvent is the reason of the event emitted when the
+	// configuration reload fails persistently.
+	ConfigReloadFailedEvent = "ConfigReloadFailed"
+
+	configReloadPollTimeout = 10 * time.Second
+	// configReloadPollDeadline bounds the collection of the reload status
+	// for all the objects during one poll interval.
+	configReloadPollDeadline = 30 * time.Second
+	// configReloadPollConcurrency is the maximum number of objects (and
+	// pods per object) being polled concurrently.
+	configReloadPollConcurrency = 10
+	configReloaderPortName      = "reloader-web"
+)
+
+// errNoReloadStatus is returned when the config-reloader hasn't reloaded the
+// configuration yet or doesn't expose the reload status (older versions).
+var errNoReloadStatus = errors.New("no reload status")
+
+// ConfigReloadStatus is the status of the last configuration reload
+// triggered by the config-reloader.
+type ConfigReloadStatus struct {
+	Success bool      `json:"success"`
+	Time    time.Time `json:"time"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// fetchConfigReloadStatus returns the status of the last configuration
+// reload from the config-reloader served at the given host.
+func fetchConfigReloadStatus(ctx context.Context, client *http.Client, host string) (*ConfigReloadStatus, error) {
+	u := url.URL{
+		Scheme: "http",
+		Host:   host,
+		Path:   ConfigReloadStatusPath,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errNoReloadStatus
+	default:
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var s ConfigReloadStatus
+	if err := json.NewDecoder(resp.Body).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to decode the reload status: %w", err)
+	}
+
+	return &s, nil
+}
+
+// fetchConfigReloadStatusFromMetrics returns the status of the last
+// configuration reload from the metrics of the config-reloader served at the
+// given host. It is used for the config-reloader versions which don't expose
+// the reload status endpoint.
+func fetchConfigReloadStatusFromMetrics(ctx context.Context, client *http.Client, host string) (*ConfigReloadStatus, error) {
+	u := url.URL{
+		Scheme: "http",
+		Host:   host,
+		Path:   "/metrics",
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeTextPlain)))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errNoReloadStatus
+	default:
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var parser expfmt.TextParser
+	mfs, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the metrics: %w", err)
+	}
+
+	successful := mfs["reloader_last_reload_successful"].GetMetric()
+	if len(successful) == 0 {
+		return nil, errNoReloadStatus
+	}
+
+	s := ConfigReloadStatus{Success: successful[0].GetGauge().GetValue() == 1}
+	if ts := mfs["reloader_last_reload_success_timestamp_seconds"].GetMetric(); len(ts) > 0 && ts[0].GetGauge().GetValue() > 0 {
+		s.Time = time.Unix(int64(ts[0].GetGauge().GetValue()), 0).UTC()
+	}
+	if !s.Success {
+		s.Error = "the reloader_last_reload_successful metric reports a failed reload"
+	}
+
+	return &s, nil
+}
+
+// reloaderContainer returns the name of the config-reloader container and the
+// port of its web server. It returns false if the port isn't exposed (e.g.
+// listenLocal is true).
+func reloaderContainer(pod *v1.Pod) (string, int32, bool) {
+	containers := pod.Spec.Containers
+	for _, c := range pod.Spec.InitContainers {
+		// The config-reloader may run as a native sidecar container.
+		if ptr.Deref(c.RestartPolicy, "") == v1.ContainerRestartPolicyAlways {
+			containers = append(slices.Clone(containers), c)
+		}
+	}
+
+	for _, c := range containers {
+		for _, p := range c.Ports {
+			if p.Name == configReloaderPortName {
+				return c.Name, p.ContainerPort, true
+			}
+		}
+	}
+
+	return "", 0, false
+}
+
+// containerRunning returns true if the container of the pod is running.
+func containerRunning(pod *v1.Pod, name string) bool {
+	for _, s := range slices.Concat(pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses) {
+		if s.Name == name {
+			return s.State.Running != nil
+		}
+	}
+
+	return false
+}
+
+type configReloadEntry struct {
+	// The status of the last reload indexed by pod name.
+	statuses map[string]ConfigReloadStatus
+	// The time since which the reloads fail, indexed by pod name.
+	failingSince map[string]time.Time
+	// The pods whose persistent failure has been reported already.
+	reported map[string]struct{}
+	// The error of the last refresh, if any.
+	err error
+	// The condition computed by the last refresh.
+	cond configReloadCondition
+}
+
+// configReloadCondition holds the fields of the ConfigOutOfSync condition
+// which depend on the reload status.
+type configReloadCondition struct {
+	status  monitoringv1.ConditionStatus
+	reason  string
+	message string
+}
+
+// ConfigReloadTracker holds the status of the configuration reloads of the
+// workload resources.
+type ConfigReloadTracker struct {
+	mtx     sync.Mutex
+	entries map[string]*configReloadEntry
+
+	now func() time.Time
+}
+
+// NewConfigReloadTracker returns an empty ConfigReloadTracker.
+func NewConfigReloadTracker() *ConfigReloadTracker {
+	return &ConfigReloadTracker{
+		entries: map[string]*configReloadEntry{},
+		now:     time.Now,
+	}
+}
+
+// fetchConfigReloadStatuses collects concurrently the status of the last
+// configuration reload from the config-reloader containers of the ready pods
+// (or whose config-reloader container is running). The errors are indexed by
+// pod name.
+func fetchConfigReloadStatuses(ctx context.Context, pods []v1.Pod) (map[string]ConfigReloadStatus, map[string]error) {
+	var (
+		client   = &http.Client{Timeout: configReloadPollTimeout}
+		mtx      sync.Mutex
+		statuses = map[string]ConfigReloadStatus{}
+		errs     = map[string]error{}
+		g        errgroup.Group
+	)
+	g.SetLimit(configReloadPollConcurrency)
+
+	for _, pod := range pods {
+		if pod.Status.PodIP == "" {
+			continue
+		}
+
+		name, port, found := reloaderContainer(&pod)
+		if !found {
+			continue
+		}
+
+		// The pods may not be ready because the last reload failed (see
+		// the failReadinessOnReloadError field).
+		if pp := Pod(pod); !pp.Ready() && !containerRunning(&pod, name) {
+			continue
+		}
+
+		host := net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(port)))
+		g.Go(func() error {
+			s, err := fetchConfigReloadStatus(ctx, client, host)
+			if errors.Is(err, errNoReloadStatus) {
+				// Older config-reloader versions only expose metrics.
+				s, err = fetchConfigReloadStatusFromMetrics(ctx, client, host)
+			}
+
+			mtx.Lock()
+			defer mtx.Unlock()
+
+			if err != nil {
+				if !errors.Is(err, errNoReloadStatus) {
+					errs[pod.Name] = err
+				}
+				return nil
+			}
+
+			statuses[pod.Name] = *s
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return statuses, errs
+}
+
+// Refresh collects the status of the last configuration reload from the
+// config-reloader containers of the ready pods (or whose config-reloader
+// container is running). It returns true if the ConfigOutOfSync condition
+// of the object changed. In case of failure, the error is recorded and
+// returned.
+func (t *ConfigReloadTracker) Refresh(ctx context.Context, key string, pods []v1.Pod) (bool, error) {
+	statuses, errs := fetchConfigReloadStatuses(ctx, pods)
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	e, found := t.entries[key]
+	if !found {
+		e = &configReloadEntry{}
+		t.entries[key] = e
+	}
+
+	if len(statuses) == 0 {
+		e.err = errors.New("no reload status found for the ready pods")
+		if len(errs) > 0 {
+			var podErrs []error
+			for _, pod := range sortutil.SortedKeys(errs) {
+				podErrs = append(podErrs, fmt.Errorf("pod %s: %w", pod, errs[pod]))
+			}
+			e.err = errors.Join(podErrs...)
+		}
+	} else {
+		now := t.now()
+		failingSince := map[string]time.Time{}
+		for pod, s := range statuses {
+			if s.Success {
+				delete(e.reported, pod)
+				continue
+			}
+
+			since, found := e.failingSince[pod]
+			if !found {
+				since = now
+			}
+			failingSince[pod] = since
+		}
+
+		e.statuses = statuses
+		e.failingSince = failingSince
+		e.err = nil
+	}
+
+	cond := t.condition(e)
+	changed := !found || cond != e.cond
+	e.cond = cond
+
+	return changed, e.err
+}
+
+// RefreshAll refreshes concurrently the reload status of the objects whose
+// pods are given (indexed by object key). The refresh of all the objects is
+// bounded by a global deadline. It returns the keys of the objects whose
+// ConfigOutOfSync condition changed.
+func (t *ConfigReloadTracker) RefreshAll(ctx context.Context, logger *slog.Logger, pods map[string][]v1.Pod) map[string]struct{} {
+	ctx, cancel := context.WithTimeout(ctx, configReloadPollDeadline)
+	defer cancel()
+
+	var (
+		mtx     sync.Mutex
+		changed = map[string]struct{}{}
+		g       errgroup.Group
+	)
+	g.SetLimit(configReloadPollConcurrency)
+
+	for key, pp := range pods {
+		g.Go(func() error {
+			ok, err := t.Refresh(ctx, key, pp)
+			if err != nil {
+				logger.Debug("failed to refresh the config reload status", "key", key, "err", err)
+			}
+
+			if ok {
+				mtx.Lock()
+				changed[key] = struct{}{}
+				mtx.Unlock()
+			}
+
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return changed
+}
+
+// persistentFailures returns the pods whose reloads have been failing for
+// more than ConfigReloadFailureThreshold. The caller must hold the lock.
+func (t *ConfigReloadTracker) persistentFailures(e *configReloadEntry) map[string]time.Duration {
+	var (
+		now  = t.now()
+		pods = map[string]time.Duration{}
+	)
+	for pod, since := range e.failingSince {
+		if d := now.Sub(since); d >= ConfigReloadFailureThreshold {
+			pods[pod] = d
+		}
+	}
+
+	return pods
+}
+
+// ReportPersistentFailures emits a warning event for the object when the
+// reloads of some pods start failing persistently. The event is emitted once
+// until the reloads of the pods succeed again.
+func (t *ConfigReloadTracker) ReportPersistentFailures(recorder record.EventRecorder, obj runtime.Object, key string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	e, found := t.entries[key]
+	if !found {
+		return
+	}
+
+	var pods []string
+	for _, pod := range sortutil.SortedKeys(t.persistentFailures(e)) {
+		if _, found := e.reported[pod]; found {
+			continue
+		}
+
+		if e.reported == nil {
+			e.reported = map[string]struct{}{}
+		}
+		e.reported[pod] = struct{}{}
+		pods = append(pods, pod)
+	}
+
+	if len(pods) == 0 {
+		return
+	}
+
+	recorder.Eventf(
+		obj,
+		v1.EventTypeWarning,
+		ConfigReloadFailedEvent,
+		"The configuration reload has been failing for more than %s, the pods run an outdated configuration: %s",
+		ConfigReloadFailureThreshold,
+		strings.Join(pods, ", "),
+	)
+}
+
+// Forget removes the state of the object. It returns true if the state
+// existed.
+func (t *ConfigReloadTracker) Forget(key string) bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	_, found := t.entries[key]
+	delete(t.entries, key)
+
+	return found
+}
+
+// Retain removes the state of the objects which aren't in keys.
+func (t *ConfigReloadTracker) Retain(keys map[string]struct{}) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	for key := range t.entries {
+		if _, found := keys[key]; !found {
+			delete(t.entries, key)
+		}
+	}
+}
+
+// condition computes the ConfigOutOfSync condition of the entry. The caller
+// must hold the lock.
+func (t *ConfigReloadTracker) condition(e *configReloadEntry) configReloadCondition {
+	if e.err != nil {
+		return configReloadCondition{
+			status:  monitoringv1.ConditionUnknown,
+			reason:  "ReloadStatusUnavailable",
+			message: e.err.Error(),
+		}
+	}
+
+	var (
+		messages   []string
+		persistent = t.persistentFailures(e)
+	)
+	for _, pod := range sortutil.SortedKeys(e.statuses) {
+		s := e.statuses[pod]
+		if s.Success {
+			continue
+		}
+
+		msg := fmt.Sprintf("pod %s: %s", pod, s.Error)
+		if d, found := persistent[pod]; found {
+			msg += fmt.Sprintf(" (failing for %s)", d.Truncate(time.Minute))
+		}
+		messages = append(messages, msg)
+	}
+
+	if len(messages) == 0 {
+		return configReloadCondition{status: monitoringv1.ConditionFalse}
+	}
+
+	c := configReloadCondition{
+		status:  monitoringv1.ConditionTrue,
+		reason:  "ConfigReloadFailed",
+		message: strings.Join(messages, "\n"),
+	}
+	if len(persistent) > 0 {
+		c.reason = "ConfigReloadFailedPersistently"
+	}
+
+	return c
+}
+
+// Conditions returns the ConfigOutOfSync condition of the object computed by
+// the last refresh. It returns nil if the reload status hasn't been collected.
+func (t *ConfigReloadTracker) Conditions(key string, generation int64) []monitoringv1.Condition {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	e, found := t.entries[key]
+	if !found {
+		return nil
+	}
+
+	return []monitoringv1.Condition{{
+		Type:               monitoringv1.ConfigOutOfSync,
+		Status:             e.cond.status,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             e.cond.reason,
+		Message:            e.cond.message,
+		ObservedGeneration: generation,
+	}}
+}
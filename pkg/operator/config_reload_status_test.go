@@ -0,0 +1,304 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func newConfigReloadServer(t *testing.T, status *ConfigReloadStatus) v1.Pod {
+	t.Helper()
+
+	return newConfigReloaderPod(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != ConfigReloadStatusPath || status == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(status)
+	}))
+}
+
+// newConfigReloadMetricsServer returns a pod whose config-reloader only
+// exposes metrics.
+func newConfigReloadMetricsServer(t *testing.T, metrics string) v1.Pod {
+	t.Helper()
+
+	return newConfigReloaderPod(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics" {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = w.Write([]byte(metrics))
+	}))
+}
+
+func newConfigReloaderPod(t *testing.T, h http.Handler) v1.Pod {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	host, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pod-" + port,
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name:  "config-reloader",
+				Ports: []v1.ContainerPort{{Name: configReloaderPortName, ContainerPort: int32(p)}},
+			}},
+		},
+		Status: v1.PodStatus{
+			PodIP: host,
+			Phase: v1.PodRunning,
+			Conditions: []v1.PodCondition{{
+				Type:   v1.PodReady,
+				Status: v1.ConditionTrue,
+			}},
+		},
+	}
+}
+
+func TestConfigReloadTracker(t *testing.T) {
+	var (
+		ok     = newConfigReloadServer(t, &ConfigReloadStatus{Success: true, Time: time.Now()})
+		failed = newConfigReloadServer(t, &ConfigReloadStatus{Time: time.Now(), Error: "received 500 Internal Server Error response: invalid config"})
+		noInfo = newConfigReloadServer(t, nil)
+	)
+
+	tracker := NewConfigReloadTracker()
+	require.Nil(t, tracker.Conditions("ns/test", 1))
+
+	// All the reloads succeeded.
+	changed, err := tracker.Refresh(context.Background(), "ns/test", []v1.Pod{ok, noInfo})
+	require.NoError(t, err)
+	require.True(t, changed)
+	conds := tracker.Conditions("ns/test", 1)
+	require.Len(t, conds, 1)
+	require.Equal(t, monitoringv1.ConfigOutOfSync, conds[0].Type)
+	require.Equal(t, monitoringv1.ConditionFalse, conds[0].Status)
+	require.Equal(t, int64(1), conds[0].ObservedGeneration)
+
+	// The condition doesn't change.
+	changed, err = tracker.Refresh(context.Background(), "ns/test", []v1.Pod{ok, noInfo})
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	// One reload failed.
+	changed, err = tracker.Refresh(context.Background(), "ns/test", []v1.Pod{ok, failed})
+	require.NoError(t, err)
+	require.True(t, changed)
+	conds = tracker.Conditions("ns/test", 2)
+	require.Equal(t, monitoringv1.ConditionTrue, conds[0].Status)
+	require.Equal(t, "ConfigReloadFailed", conds[0].Reason)
+	require.Equal(t, "pod "+failed.Name+": received 500 Internal Server Error response: invalid config", conds[0].Message)
+
+	// The pod isn't ready because the last reload failed.
+	failedNotReady := failed
+	failedNotReady.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}}
+	failedNotReady.Status.ContainerStatuses = []v1.ContainerStatus{{
+		Name:  "config-reloader",
+		State: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+	}}
+	changed, err = tracker.Refresh(context.Background(), "ns/test", []v1.Pod{failedNotReady})
+	require.NoError(t, err)
+	require.False(t, changed)
+	conds = tracker.Conditions("ns/test", 2)
+	require.Equal(t, monitoringv1.ConditionTrue, conds[0].Status)
+	require.Equal(t, "ConfigReloadFailed", conds[0].Reason)
+
+	// The config-reloader runs as a native sidecar container.
+	sidecar := ok
+	sidecar.Spec = v1.PodSpec{
+		InitContainers: []v1.Container{
+			{
+				Name:  "init-config-reloader",
+				Ports: []v1.ContainerPort{{Name: configReloaderPortName, ContainerPort: 1}},
+			},
+			{
+				Name:          "config-reloader",
+				RestartPolicy: ptr.To(v1.ContainerRestartPolicyAlways),
+				Ports:         ok.Spec.Containers[0].Ports,
+			},
+		},
+	}
+	changed, err = tracker.Refresh(context.Background(), "ns/test", []v1.Pod{sidecar})
+	require.NoError(t, err)
+	require.True(t, changed)
+	conds = tracker.Conditions("ns/test", 2)
+	require.Equal(t, monitoringv1.ConditionFalse, conds[0].Status)
+
+	// No status available.
+	notReady := ok
+	notReady.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}}
+	changed, err = tracker.Refresh(context.Background(), "ns/test", []v1.Pod{noInfo, notReady})
+	require.Error(t, err)
+	require.True(t, changed)
+	conds = tracker.Conditions("ns/test", 2)
+	require.Equal(t, monitoringv1.ConditionUnknown, conds[0].Status)
+	require.Equal(t, "ReloadStatusUnavailable", conds[0].Reason)
+
+	tracker.Retain(map[string]struct{}{"ns/test": {}})
+	require.NotNil(t, tracker.Conditions("ns/test", 2))
+
+	tracker.Retain(map[string]struct{}{})
+	require.Nil(t, tracker.Conditions("ns/test", 2))
+	require.False(t, tracker.Forget("ns/test"))
+}
+
+func TestConfigReloadStatusFromMetrics(t *testing.T) {
+	var (
+		ok = newConfigReloadMetricsServer(t, `# TYPE reloader_last_reload_successful gauge
+reloader_last_reload_successful 1
+# TYPE reloader_last_reload_success_timestamp_seconds gauge
+reloader_last_reload_success_timestamp_seconds 1.7e+09
+`)
+		failed = newConfigReloadMetricsServer(t, `# TYPE reloader_last_reload_successful gauge
+reloader_last_reload_successful 0
+`)
+		noMetric = newConfigReloadMetricsServer(t, `# TYPE go_goroutines gauge
+go_goroutines 10
+`)
+		tracker = NewConfigReloadTracker()
+	)
+
+	_, err := tracker.Refresh(context.Background(), "ns/test", []v1.Pod{ok, failed, noMetric})
+	require.NoError(t, err)
+
+	pods := tracker.entries["ns/test"].statuses
+	require.Len(t, pods, 2)
+	require.True(t, pods[ok.Name].Success)
+	require.Equal(t, time.Unix(1700000000, 0).UTC(), pods[ok.Name].Time)
+	require.False(t, pods[failed.Name].Success)
+	require.NotEmpty(t, pods[failed.Name].Error)
+}
+
+func TestConfigReloadPersistentFailures(t *testing.T) {
+	var (
+		now      = time.Now()
+		failed   = newConfigReloadServer(t, &ConfigReloadStatus{Time: now, Error: "invalid config"})
+		tracker  = NewConfigReloadTracker()
+		recorder = record.NewFakeRecorder(10)
+		obj      = &monitoringv1.Prometheus{}
+	)
+	tracker.now = func() time.Time { return now }
+
+	_, err := tracker.Refresh(context.Background(), "ns/test", []v1.Pod{failed})
+	require.NoError(t, err)
+	conds := tracker.Conditions("ns/test", 1)
+	require.Equal(t, "ConfigReloadFailed", conds[0].Reason)
+	tracker.ReportPersistentFailures(recorder, obj, "ns/test")
+	require.Empty(t, recorder.Events)
+
+	// The reload fails for longer than the threshold.
+	now = now.Add(ConfigReloadFailureThreshold)
+	changed, err := tracker.Refresh(context.Background(), "ns/test", []v1.Pod{failed})
+	require.NoError(t, err)
+	require.True(t, changed)
+	conds = tracker.Conditions("ns/test", 1)
+	require.Equal(t, monitoringv1.ConditionTrue, conds[0].Status)
+	require.Equal(t, "ConfigReloadFailedPersistently", conds[0].Reason)
+	require.Contains(t, conds[0].Message, "failing for 10m0s")
+
+	tracker.ReportPersistentFailures(recorder, obj, "ns/test")
+	require.Len(t, recorder.Events, 1)
+	require.Contains(t, <-recorder.Events, ConfigReloadFailedEvent)
+
+	// The event is emitted only once per failure.
+	tracker.ReportPersistentFailures(recorder, obj, "ns/test")
+	require.Empty(t, recorder.Events)
+}
+
+func TestConfigReloadRefreshAll(t *testing.T) {
+	var (
+		ok      = newConfigReloadServer(t, &ConfigReloadStatus{Success: true, Time: time.Now()})
+		failed  = newConfigReloadServer(t, &ConfigReloadStatus{Time: time.Now(), Error: "invalid config"})
+		tracker = NewConfigReloadTracker()
+		logger  = promslog.NewNopLogger()
+	)
+
+	changed := tracker.RefreshAll(context.Background(), logger, map[string][]v1.Pod{
+		"ns/a": {ok},
+		"ns/b": {failed},
+	})
+	require.Equal(t, map[string]struct{}{"ns/a": {}, "ns/b": {}}, changed)
+
+	// The conditions don't change.
+	changed = tracker.RefreshAll(context.Background(), logger, map[string][]v1.Pod{
+		"ns/a": {ok},
+		"ns/b": {failed},
+	})
+	require.Empty(t, changed)
+
+	changed = tracker.RefreshAll(context.Background(), logger, map[string][]v1.Pod{
+		"ns/a": {ok},
+		"ns/b": {ok},
+	})
+	require.Equal(t, map[string]struct{}{"ns/b": {}}, changed)
+}
+
+func TestConfigReloadRefreshConcurrently(t *testing.T) {
+	var (
+		wg      sync.WaitGroup
+		tracker = NewConfigReloadTracker()
+		pods    []v1.Pod
+	)
+
+	// The servers reply only once all the pods have been queried.
+	wg.Add(3)
+	for range 3 {
+		pods = append(pods, newConfigReloaderPod(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != ConfigReloadStatusPath {
+				http.NotFound(w, r)
+				return
+			}
+
+			wg.Done()
+			wg.Wait()
+			_ = json.NewEncoder(w).Encode(&ConfigReloadStatus{Success: true, Time: time.Now()})
+		})))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := tracker.Refresh(ctx, "ns/test", pods)
+	require.NoError(t, err)
+	require.Len(t, tracker.entries["ns/test"].statuses, 3)
+}
@@ -18,11 +18,17 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"slices"
 	"strconv"
 
+	"github.com/blang/semver/v4"
+	ref "github.com/distribution/reference"
+	"github.com/prometheus/common/version"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
 
 const (
@@ -42,6 +48,37 @@ const (
 	NodeNameEnvVar = "NODE_NAME"
 )
 
+// zstdConfigReloaderVersion is the first version of the config-reloader
+// which decompresses the configuration files compressed with zstd.
+var zstdConfigReloaderVersion = semver.MustParse("0.85.0")
+
+// ConfigReloaderSupportsZstd returns true if the config-reloader image
+// decompresses the configuration files compressed with zstd. The version is
+// read from the image tag: images which aren't tagged with a version (e.g.
+// referenced by digest) are assumed to not support it.
+func ConfigReloaderSupportsZstd(image string) bool {
+	named, err := ref.ParseNormalizedNamed(image)
+	if err != nil {
+		return false
+	}
+
+	tagged, ok := named.(ref.Tagged)
+	if !ok {
+		return false
+	}
+
+	if tagged.Tag() == "v"+version.Version {
+		return true
+	}
+
+	v, err := semver.ParseTolerant(tagged.Tag())
+	if err != nil {
+		return false
+	}
+
+	return v.GTE(zstdConfigReloaderVersion)
+}
+
 // ConfigReloader contains the options to configure
 // a config-reloader container.
 type ConfigReloader struct {
@@ -50,6 +87,8 @@ type ConfigReloader struct {
 	webConfigFile      string
 	configFile         string
 	configEnvsubstFile string
+	configDir          string
+	configEnvsubstDir  string
 	imagePullPolicy    v1.PullPolicy
 	listenLocal        bool
 	localHost          string
@@ -63,6 +102,37 @@ type ConfigReloader struct {
 	watchedDirectories []string
 	useSignal          bool
 	withNodeNameEnv    bool
+	overrides          *monitoringv1.ConfigReloaderSpec
+	reloadClient       ReloadClientFiles
+}
+
+// ReloadClientFiles are the files and settings configuring the TLS and basic
+// authentication of the HTTP client which calls the reload URL.
+type ReloadClientFiles struct {
+	CAFile       string
+	CertFile     string
+	KeyFile      string
+	ServerName   string
+	UsernameFile string
+	PasswordFile string
+}
+
+func (f ReloadClientFiles) args() []string {
+	var args []string
+	for _, a := range []struct{ flag, value string }{
+		{"reload-tls-ca-file", f.CAFile},
+		{"reload-tls-cert-file", f.CertFile},
+		{"reload-tls-key-file", f.KeyFile},
+		{"reload-tls-server-name", f.ServerName},
+		{"reload-basic-auth-username-file", f.UsernameFile},
+		{"reload-basic-auth-password-file", f.PasswordFile},
+	} {
+		if a.value != "" {
+			args = append(args, fmt.Sprintf("--%s=%s", a.flag, a.value))
+		}
+	}
+
+	return args
 }
 
 type ReloaderOption = func(*ConfigReloader)
@@ -109,6 +179,16 @@ func ConfigEnvsubstFile(configEnvsubstFile string) ReloaderOption {
 	}
 }
 
+// ConfigDir sets the directory of configuration files which are
+// decompressed and expanded into the output directory by the config-reloader
+// container.
+func ConfigDir(dir, outputDir string) ReloaderOption {
+	return func(c *ConfigReloader) {
+		c.configDir = dir
+		c.configEnvsubstDir = outputDir
+	}
+}
+
 // ReloaderConfig sets the config option for the config-reloader container.
 func ReloaderConfig(rc ContainerConfig) ReloaderOption {
 	return func(c *ConfigReloader) {
@@ -116,6 +196,16 @@ func ReloaderConfig(rc ContainerConfig) ReloaderOption {
 	}
 }
 
+// ReloaderOverrides sets the overrides defined by the custom resource. The
+// image and resources take precedence over the ReloaderConfig option, the
+// watched volume mounts are added to the VolumeMounts and WatchedDirectories
+// options and the readiness probe can reflect the status of the last reload.
+func ReloaderOverrides(spec *monitoringv1.ConfigReloaderSpec) ReloaderOption {
+	return func(c *ConfigReloader) {
+		c.overrides = spec
+	}
+}
+
 // ReloaderURL sets the reloaderURL option for the config-reloader container.
 func ReloaderURL(u url.URL) ReloaderOption {
 	return func(c *ConfigReloader) {
@@ -123,6 +213,14 @@ func ReloaderURL(u url.URL) ReloaderOption {
 	}
 }
 
+// ReloadClient sets the TLS and basic authentication settings of the HTTP
+// client which calls the reload URL.
+func ReloadClient(files ReloadClientFiles) ReloaderOption {
+	return func(c *ConfigReloader) {
+		c.reloadClient = files
+	}
+}
+
 // RuntimeInfoURL sets the runtimeInfoURL option for the config-reloader container.
 func RuntimeInfoURL(u url.URL) ReloaderOption {
 	return func(c *ConfigReloader) {
@@ -238,7 +336,7 @@ func CreateConfigReloader(name string, options ...ReloaderOption) v1.Container {
 		ports = append(
 			ports,
 			v1.ContainerPort{
-				Name:          "reloader-web",
+				Name:          configReloaderPortName,
 				ContainerPort: int32(port),
 				Protocol:      v1.ProtocolTCP,
 			},
@@ -249,16 +347,27 @@ func CreateConfigReloader(name string, options ...ReloaderOption) v1.Container {
 		args = append(args, fmt.Sprintf("--web-config-file=%s", configReloader.webConfigFile))
 	}
 
+	reloadURL, runtimeInfoURL := configReloader.reloadURL, configReloader.runtimeInfoURL
+	if o := configReloader.overrides; o != nil && o.ReloadClient != nil && ptr.Deref(o.ReloadClient.Scheme, "") != "" {
+		if reloadURL.Host != "" {
+			reloadURL.Scheme = *o.ReloadClient.Scheme
+		}
+		if runtimeInfoURL.Host != "" {
+			runtimeInfoURL.Scheme = *o.ReloadClient.Scheme
+		}
+	}
+
 	if configReloader.useSignal {
 		args = append(args, "--reload-method=signal")
-		if len(configReloader.runtimeInfoURL.String()) > 0 {
-			args = append(args, fmt.Sprintf("--runtimeinfo-url=%s", configReloader.runtimeInfoURL.String()))
+		if len(runtimeInfoURL.String()) > 0 {
+			args = append(args, fmt.Sprintf("--runtimeinfo-url=%s", runtimeInfoURL.String()))
 		}
 	} else {
 		// Don't set the --reload-method argument in case the operator is
 		// configured with an older version of the config reloader.
-		if len(configReloader.reloadURL.String()) > 0 {
-			args = append(args, fmt.Sprintf("--reload-url=%s", configReloader.reloadURL.String()))
+		if len(reloadURL.String()) > 0 {
+			args = append(args, fmt.Sprintf("--reload-url=%s", reloadURL.String()))
+			args = append(args, configReloader.reloadClient.args()...)
 		}
 	}
 
@@ -270,12 +379,24 @@ func CreateConfigReloader(name string, options ...ReloaderOption) v1.Container {
 		args = append(args, fmt.Sprintf("--config-envsubst-file=%s", configReloader.configEnvsubstFile))
 	}
 
-	if len(configReloader.watchedDirectories) > 0 {
-		for _, directory := range configReloader.watchedDirectories {
-			args = append(args, fmt.Sprintf("--watched-dir=%s", directory))
+	if len(configReloader.configDir) > 0 {
+		args = append(args, fmt.Sprintf("--config-dir=%s", configReloader.configDir))
+		args = append(args, fmt.Sprintf("--config-envsubst-dir=%s", configReloader.configEnvsubstDir))
+	}
+
+	volumeMounts, watchedDirectories := configReloader.volumeMounts, configReloader.watchedDirectories
+	if o := configReloader.overrides; o != nil && len(o.WatchedVolumeMounts) > 0 {
+		volumeMounts = slices.Concat(volumeMounts, o.WatchedVolumeMounts)
+		watchedDirectories = slices.Clone(watchedDirectories)
+		for _, m := range o.WatchedVolumeMounts {
+			watchedDirectories = append(watchedDirectories, m.MountPath)
 		}
 	}
 
+	for _, directory := range watchedDirectories {
+		args = append(args, fmt.Sprintf("--watched-dir=%s", directory))
+	}
+
 	if configReloader.logLevel != "" && configReloader.logLevel != "info" {
 		args = append(args, fmt.Sprintf("--log-level=%s", configReloader.logLevel))
 	}
@@ -291,17 +412,27 @@ func CreateConfigReloader(name string, options ...ReloaderOption) v1.Container {
 		})
 	}
 
+	image, resources := configReloader.config.Image, configReloader.config.ResourceRequirements()
+	if o := configReloader.overrides; o != nil {
+		if ptr.Deref(o.Image, "") != "" {
+			image = *o.Image
+		}
+		if o.Resources != nil {
+			resources = *o.Resources
+		}
+	}
+
 	c := v1.Container{
 		Name:                     name,
-		Image:                    configReloader.config.Image,
+		Image:                    image,
 		ImagePullPolicy:          configReloader.imagePullPolicy,
 		TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
 		Env:                      envVars,
 		Command:                  []string{"/bin/prometheus-config-reloader"},
 		Args:                     args,
 		Ports:                    ports,
-		VolumeMounts:             configReloader.volumeMounts,
-		Resources:                configReloader.config.ResourceRequirements(),
+		VolumeMounts:             volumeMounts,
+		Resources:                resources,
 		SecurityContext: &v1.SecurityContext{
 			AllowPrivilegeEscalation: ptr.To(false),
 			ReadOnlyRootFilesystem:   ptr.To(true),
@@ -315,11 +446,25 @@ func CreateConfigReloader(name string, options ...ReloaderOption) v1.Container {
 		c = configReloader.addProbes(c)
 	}
 
+	if !configReloader.initContainer && configReloader.overrides != nil && ptr.Deref(configReloader.overrides.FailReadinessOnReloadError, false) {
+		c.ReadinessProbe = &v1.Probe{ProbeHandler: configReloader.probeHandler(ConfigReloaderReadinessPath)}
+	}
+
 	return c
 }
 
 func (cr *ConfigReloader) addProbes(c v1.Container) v1.Container {
-	probePath := path.Clean("/healthz")
+	handler := cr.probeHandler("/healthz")
+
+	c.LivenessProbe = &v1.Probe{ProbeHandler: handler}
+	c.ReadinessProbe = &v1.Probe{ProbeHandler: handler}
+	c.StartupProbe = &v1.Probe{ProbeHandler: handler}
+
+	return c
+}
+
+func (cr *ConfigReloader) probeHandler(probePath string) v1.ProbeHandler {
+	probePath = path.Clean(probePath)
 	handler := v1.ProbeHandler{}
 	if cr.listenLocal {
 		probeURL := url.URL{
@@ -335,9 +480,5 @@ func (cr *ConfigReloader) addProbes(c v1.Container) v1.Container {
 		}
 	}
 
-	c.LivenessProbe = &v1.Probe{ProbeHandler: handler}
-	c.ReadinessProbe = &v1.Probe{ProbeHandler: handler}
-	c.StartupProbe = &v1.Probe{ProbeHandler: handler}
-
-	return c
+	return handler
 }
@@ -21,8 +21,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
 
 var reloaderConfig = ContainerConfig{
@@ -172,6 +177,7 @@ func TestCreateConfigReloader(t *testing.T) {
 		LogLevel(logLevel),
 		ConfigFile(configFile),
 		ConfigEnvsubstFile(configEnvsubstFile),
+		ConfigDir("configDir", "configEnvsubstDir"),
 		WatchedDirectories(watchedDirectories),
 		WebConfigFile(webConfigFile),
 		Shard(shard),
@@ -199,6 +205,12 @@ func TestCreateConfigReloader(t *testing.T) {
 	if !contains(container.Args, "--config-envsubst-file=configEnvsubstFile") {
 		t.Errorf("Expected '--config-envsubst-file=%s' not found in %s", configEnvsubstFile, container.Args)
 	}
+	if !contains(container.Args, "--config-dir=configDir") {
+		t.Errorf("Expected '--config-dir=configDir' not found in %s", container.Args)
+	}
+	if !contains(container.Args, "--config-envsubst-dir=configEnvsubstDir") {
+		t.Errorf("Expected '--config-envsubst-dir=configEnvsubstDir' not found in %s", container.Args)
+	}
 	if !contains(container.Args, "--web-config-file=webConfigFile") {
 		t.Errorf("Expected '--web-config-file=%s' not found in %s", webConfigFile, container.Args)
 	}
@@ -254,6 +266,200 @@ func TestCreateConfigReloaderForDaemonSet(t *testing.T) {
 	})
 }
 
+func TestCreateConfigReloaderOverrides(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		overrides         *monitoringv1.ConfigReloaderSpec
+		expectedImage     string
+		expectedResources v1.ResourceRequirements
+	}{
+		{
+			name:              "no overrides",
+			expectedImage:     reloaderConfig.Image,
+			expectedResources: reloaderConfig.ResourceRequirements(),
+		},
+		{
+			name:              "empty overrides",
+			overrides:         &monitoringv1.ConfigReloaderSpec{Image: ptr.To("")},
+			expectedImage:     reloaderConfig.Image,
+			expectedResources: reloaderConfig.ResourceRequirements(),
+		},
+		{
+			name: "image and resources",
+			overrides: &monitoringv1.ConfigReloaderSpec{
+				Image: ptr.To("example.com/config-reloader:v1"),
+				Resources: &v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("200Mi")},
+				},
+			},
+			expectedImage: "example.com/config-reloader:v1",
+			expectedResources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("200Mi")},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, opts := range [][]ReloaderOption{
+				{ReloaderConfig(reloaderConfig), ReloaderOverrides(tc.overrides)},
+				// The overrides don't depend on the order of the options.
+				{ReloaderOverrides(tc.overrides), ReloaderConfig(reloaderConfig)},
+			} {
+				container := CreateConfigReloader("config-reloader", opts...)
+
+				assert.Equal(t, tc.expectedImage, container.Image)
+				assert.Equal(t, tc.expectedResources, container.Resources)
+			}
+		})
+	}
+}
+
+func TestCreateConfigReloaderWatchedVolumeMounts(t *testing.T) {
+	mounts := []v1.VolumeMount{{Name: "config", MountPath: "/etc/config"}}
+	overrides := &monitoringv1.ConfigReloaderSpec{
+		WatchedVolumeMounts: []v1.VolumeMount{{Name: "rules", MountPath: "/etc/rules", ReadOnly: true}},
+	}
+
+	container := CreateConfigReloader(
+		"config-reloader",
+		VolumeMounts(mounts),
+		WatchedDirectories([]string{"/etc/config"}),
+		ReloaderOverrides(overrides),
+	)
+
+	assert.Equal(t, []v1.VolumeMount{
+		{Name: "config", MountPath: "/etc/config"},
+		{Name: "rules", MountPath: "/etc/rules", ReadOnly: true},
+	}, container.VolumeMounts)
+	assert.Contains(t, container.Args, "--watched-dir=/etc/config")
+	assert.Contains(t, container.Args, "--watched-dir=/etc/rules")
+
+	// The options aren't modified.
+	assert.Len(t, mounts, 1)
+}
+
+func TestCreateConfigReloaderReloadClient(t *testing.T) {
+	reloadURL := url.URL{Scheme: "http", Host: "localhost:9090", Path: "/-/reload"}
+	files := ReloadClientFiles{
+		CAFile:       "/etc/ca/ca.crt",
+		CertFile:     "/etc/tls/tls.crt",
+		KeyFile:      "/etc/tls/tls.key",
+		UsernameFile: "/etc/auth/username",
+		PasswordFile: "/etc/auth/password",
+	}
+
+	for _, tc := range []struct {
+		name         string
+		opts         []ReloaderOption
+		expectedArgs []string
+		excludedArgs []string
+	}{
+		{
+			name: "default",
+			opts: []ReloaderOption{ReloaderURL(reloadURL)},
+			expectedArgs: []string{
+				"--reload-url=http://localhost:9090/-/reload",
+			},
+			excludedArgs: []string{
+				"--reload-tls-server-name=",
+			},
+		},
+		{
+			name: "scheme and files",
+			opts: []ReloaderOption{
+				ReloaderURL(reloadURL),
+				ReloadClient(files),
+				ReloaderOverrides(&monitoringv1.ConfigReloaderSpec{
+					ReloadClient: &monitoringv1.ConfigReloaderClientConfig{Scheme: ptr.To("https")},
+				}),
+			},
+			expectedArgs: []string{
+				"--reload-url=https://localhost:9090/-/reload",
+				"--reload-tls-ca-file=/etc/ca/ca.crt",
+				"--reload-tls-cert-file=/etc/tls/tls.crt",
+				"--reload-tls-key-file=/etc/tls/tls.key",
+				"--reload-basic-auth-username-file=/etc/auth/username",
+				"--reload-basic-auth-password-file=/etc/auth/password",
+			},
+			excludedArgs: []string{
+				"--reload-tls-server-name=",
+			},
+		},
+		{
+			name: "init container",
+			opts: []ReloaderOption{
+				InitContainer(),
+				ReloadClient(files),
+			},
+			excludedArgs: []string{
+				"--reload-tls-ca-file=/etc/ca/ca.crt",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			container := CreateConfigReloader("config-reloader", tc.opts...)
+
+			for _, arg := range tc.expectedArgs {
+				assert.Contains(t, container.Args, arg)
+			}
+			for _, arg := range tc.excludedArgs {
+				assert.NotContains(t, container.Args, arg)
+			}
+		})
+	}
+}
+
+func TestCreateConfigReloaderFailReadinessOnReloadError(t *testing.T) {
+	reloaderConfigCopy := reloaderConfig
+	reloaderConfigCopy.EnableProbes = true
+
+	for _, tc := range []struct {
+		name        string
+		listenLocal bool
+		expected    v1.ProbeHandler
+	}{
+		{
+			name: "http",
+			expected: v1.ProbeHandler{
+				HTTPGet: &v1.HTTPGetAction{
+					Path: ConfigReloaderReadinessPath,
+					Port: intstr.FromInt(configReloaderPort),
+				},
+			},
+		},
+		{
+			name:        "listenLocal",
+			listenLocal: true,
+			expected: v1.ProbeHandler{
+				Exec: ExecAction(fmt.Sprintf("http://localhost:%d%s", configReloaderPort, ConfigReloaderReadinessPath)),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			overrides := ReloaderOverrides(&monitoringv1.ConfigReloaderSpec{FailReadinessOnReloadError: ptr.To(true)})
+
+			container := CreateConfigReloader(
+				"config-reloader",
+				ReloaderConfig(reloaderConfigCopy),
+				ListenLocal(tc.listenLocal),
+				overrides,
+			)
+			assert.Equal(t, tc.expected, container.ReadinessProbe.ProbeHandler)
+			// The liveness probe still uses the health endpoint.
+			assert.NotEqual(t, tc.expected, container.LivenessProbe.ProbeHandler)
+
+			// The init container has no probes.
+			container = CreateConfigReloader(
+				"init-config-reloader",
+				ReloaderConfig(reloaderConfigCopy),
+				ListenLocal(tc.listenLocal),
+				InitContainer(),
+				overrides,
+			)
+			assert.Nil(t, container.ReadinessProbe)
+		})
+	}
+}
+
 func contains(s []string, str string) bool {
 	for _, v := range s {
 		if v == str {
@@ -262,3 +468,22 @@ func contains(s []string, str string) bool {
 	}
 	return false
 }
+
+func TestConfigReloaderSupportsZstd(t *testing.T) {
+	for _, tc := range []struct {
+		image    string
+		expected bool
+	}{
+		{image: DefaultPrometheusConfigReloaderImage, expected: true},
+		{image: "quay.io/prometheus-operator/prometheus-config-reloader:v0.85.0", expected: true},
+		{image: "registry.example.com:5000/prometheus-config-reloader:v1.0.0", expected: true},
+		{image: "quay.io/prometheus-operator/prometheus-config-reloader:v0.84.0", expected: false},
+		{image: "quay.io/prometheus-operator/prometheus-config-reloader:latest", expected: false},
+		{image: "quay.io/prometheus-operator/prometheus-config-reloader", expected: false},
+		{image: "quay.io/prometheus-operator/prometheus-config-reloader@sha256:0000000000000000000000000000000000000000000000000000000000000000", expected: false},
+	} {
+		t.Run(tc.image, func(t *testing.T) {
+			require.Equal(t, tc.expected, ConfigReloaderSupportsZstd(tc.image))
+		})
+	}
+}
@@ -0,0 +1,462 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+)
+
+// ConfigResourceStatusKey returns the StatusWriter key identifying the
+// binding between a configuration resource and a workload resource.
+func ConfigResourceStatusKey(kind, namespace, name string, binding monitoringv1.WorkloadBinding) string {
+	return fmt.Sprintf("%s/%s/%s:%s/%s/%s", kind, namespace, name, binding.Resource, binding.Namespace, binding.Name)
+}
+
+// NewWorkloadBinding returns the binding of a configuration resource to the
+// workload resource. The Accepted condition is true if err is nil, otherwise
+// its reason is the rejection reason.
+func NewWorkloadBinding(workload metav1.Object, resource string, generation int64, reason RejectionReason, err error) monitoringv1.WorkloadBinding {
+	cond := monitoringv1.ConfigResourceCondition{
+		Type:               monitoringv1.Accepted,
+		Status:             monitoringv1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: generation,
+	}
+
+	if err != nil {
+		cond.Status = monitoringv1.ConditionFalse
+		cond.Reason = string(reason)
+		cond.Message = err.Error()
+	}
+
+	return monitoringv1.WorkloadBinding{
+		Group:      monitoringv1.SchemeGroupVersion.Group,
+		Resource:   resource,
+		Name:       workload.GetName(),
+		Namespace:  workload.GetNamespace(),
+		Conditions: []monitoringv1.ConfigResourceCondition{cond},
+	}
+}
+
+// ServiceMonitorBindingUpdate returns a StatusWriteFunc which adds (or
+// replaces) the binding in the status of the ServiceMonitor.
+//
+// The function reads the latest version of the object and applies the status
+// with the resource version as a precondition: concurrent updates result in a
+// conflict error which is retried by the StatusWriter.
+func ServiceMonitorBindingUpdate(mclient monitoringclient.Interface, namespace, name string, binding monitoringv1.WorkloadBinding) StatusWriteFunc {
+	return bindingUpdate(mclient, monitoringv1.ServiceMonitorsKind, namespace, name, binding)
+}
+
+// PodMonitorBindingUpdate returns a StatusWriteFunc which adds (or replaces)
+// the binding in the status of the PodMonitor.
+func PodMonitorBindingUpdate(mclient monitoringclient.Interface, namespace, name string, binding monitoringv1.WorkloadBinding) StatusWriteFunc {
+	return bindingUpdate(mclient, monitoringv1.PodMonitorsKind, namespace, name, binding)
+}
+
+// ProbeBindingUpdate returns a StatusWriteFunc which adds (or replaces) the
+// binding in the status of the Probe.
+func ProbeBindingUpdate(mclient monitoringclient.Interface, namespace, name string, binding monitoringv1.WorkloadBinding) StatusWriteFunc {
+	return bindingUpdate(mclient, monitoringv1.ProbesKind, namespace, name, binding)
+}
+
+// ScrapeConfigBindingUpdate returns a StatusWriteFunc which adds (or
+// replaces) the binding in the status of the ScrapeConfig.
+func ScrapeConfigBindingUpdate(mclient monitoringclient.Interface, namespace, name string, binding monitoringv1.WorkloadBinding) StatusWriteFunc {
+	return bindingUpdate(mclient, monitoringv1alpha1.ScrapeConfigsKind, namespace, name, binding)
+}
+
+func bindingUpdate(mclient monitoringclient.Interface, kind, namespace, name string, binding monitoringv1.WorkloadBinding) StatusWriteFunc {
+	return func(ctx context.Context) error {
+		return updateBindings(ctx, mclient, kind, namespace, name, func(bindings []monitoringv1.WorkloadBinding) ([]monitoringv1.WorkloadBinding, bool) {
+			if WorkloadBindingUpToDate(bindings, binding) {
+				return nil, false
+			}
+
+			return setWorkloadBinding(bindings, binding), true
+		})
+	}
+}
+
+// BindingRemoval returns a StatusWriteFunc which removes the binding to the
+// workload from the status of the configuration resource identified by kind,
+// namespace and name.
+func BindingRemoval(mclient monitoringclient.Interface, kind, namespace, name string, workload monitoringv1.WorkloadBinding) StatusWriteFunc {
+	return func(ctx context.Context) error {
+		return updateBindings(ctx, mclient, kind, namespace, name, func(bindings []monitoringv1.WorkloadBinding) ([]monitoringv1.WorkloadBinding, bool) {
+			if !slices.ContainsFunc(bindings, func(b monitoringv1.WorkloadBinding) bool { return sameWorkload(b, workload) }) {
+				return nil, false
+			}
+
+			return slices.DeleteFunc(slices.Clone(bindings), func(b monitoringv1.WorkloadBinding) bool { return sameWorkload(b, workload) }), true
+		})
+	}
+}
+
+// ConfigResourceRef identifies a configuration resource.
+type ConfigResourceRef struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// BoundConfigResources tracks the configuration resources bound to each
+// workload resource so that the bindings can be removed from the status of
+// the resources which aren't selected anymore (e.g. after a label change).
+//
+// The tracking is kept in memory: the resources deselected while the
+// operator isn't running keep their binding.
+type BoundConfigResources struct {
+	mtx   sync.Mutex
+	bound map[string]map[ConfigResourceRef]struct{}
+}
+
+// NewBoundConfigResources returns an empty BoundConfigResources.
+func NewBoundConfigResources() *BoundConfigResources {
+	return &BoundConfigResources{
+		bound: map[string]map[ConfigResourceRef]struct{}{},
+	}
+}
+
+// Update records the configuration resources bound to the workload
+// identified by its "<namespace>/<name>" key. It returns the resources which
+// were bound to the workload before but aren't anymore.
+func (b *BoundConfigResources) Update(workload string, refs []ConfigResourceRef) []ConfigResourceRef {
+	current := make(map[ConfigResourceRef]struct{}, len(refs))
+	for _, ref := range refs {
+		current[ref] = struct{}{}
+	}
+
+	b.mtx.Lock()
+	previous := b.bound[workload]
+	b.bound[workload] = current
+	b.mtx.Unlock()
+
+	var stale []ConfigResourceRef
+	for ref := range previous {
+		if _, found := current[ref]; !found {
+			stale = append(stale, ref)
+		}
+	}
+
+	sortConfigResourceRefs(stale)
+	return stale
+}
+
+// Forget stops tracking the workload and returns the resources which were
+// bound to it.
+func (b *BoundConfigResources) Forget(workload string) []ConfigResourceRef {
+	b.mtx.Lock()
+	previous := b.bound[workload]
+	delete(b.bound, workload)
+	b.mtx.Unlock()
+
+	refs := make([]ConfigResourceRef, 0, len(previous))
+	for ref := range previous {
+		refs = append(refs, ref)
+	}
+
+	sortConfigResourceRefs(refs)
+	return refs
+}
+
+func sortConfigResourceRefs(refs []ConfigResourceRef) {
+	slices.SortFunc(refs, func(a, b ConfigResourceRef) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+}
+
+// RemoveBindings schedules the removal of the binding to the workload
+// identified by its resource name (e.g. "prometheuses") and its
+// "<namespace>/<name>" key from the status of the configuration resources.
+func RemoveBindings(w *StatusWriter, mclient monitoringclient.Interface, resource, workload string, refs []ConfigResourceRef) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(workload)
+	if err != nil {
+		return
+	}
+
+	binding := monitoringv1.WorkloadBinding{
+		Group:     monitoringv1.SchemeGroupVersion.Group,
+		Resource:  resource,
+		Namespace: namespace,
+		Name:      name,
+	}
+
+	for _, ref := range refs {
+		// The key is shared with the binding updates so that a pending
+		// update is replaced by the removal.
+		w.Enqueue(
+			ConfigResourceStatusKey(ref.Kind, ref.Namespace, ref.Name, binding),
+			BindingRemoval(mclient, ref.Kind, ref.Namespace, ref.Name, binding),
+		)
+	}
+}
+
+// ScrapeStatisticsUpdate returns a StatusWriteFunc which sets the scrape
+// statistics of the binding to the workload in the status of the
+// configuration resource identified by kind, namespace and name.
+//
+// Nothing is written if the configuration resource isn't bound yet to the
+// workload: the binding is added by the reconciliation of the workload.
+func ScrapeStatisticsUpdate(mclient monitoringclient.Interface, kind, namespace, name string, workload monitoringv1.WorkloadBinding, stats *monitoringv1.ScrapeStatistics) StatusWriteFunc {
+	return func(ctx context.Context) error {
+		return updateBindings(ctx, mclient, kind, namespace, name, func(bindings []monitoringv1.WorkloadBinding) ([]monitoringv1.WorkloadBinding, bool) {
+			i := slices.IndexFunc(bindings, func(b monitoringv1.WorkloadBinding) bool { return sameWorkload(b, workload) })
+			if i < 0 {
+				return nil, false
+			}
+
+			bindings = slices.Clone(bindings)
+			bindings[i].ScrapeStatistics = stats
+
+			return bindings, true
+		})
+	}
+}
+
+// updateBindings reads the bindings of the configuration resource and
+// applies the bindings returned by the update function unless it returns
+// false.
+func updateBindings(
+	ctx context.Context,
+	mclient monitoringclient.Interface,
+	kind, namespace, name string,
+	update func([]monitoringv1.WorkloadBinding) ([]monitoringv1.WorkloadBinding, bool),
+) error {
+	var (
+		bindings        []monitoringv1.WorkloadBinding
+		resourceVersion string
+		err             error
+	)
+
+	switch kind {
+	case monitoringv1.ServiceMonitorsKind:
+		var smon *monitoringv1.ServiceMonitor
+		smon, err = mclient.MonitoringV1().ServiceMonitors(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			bindings, resourceVersion = smon.Status.Bindings, smon.ResourceVersion
+		}
+	case monitoringv1.PodMonitorsKind:
+		var pmon *monitoringv1.PodMonitor
+		pmon, err = mclient.MonitoringV1().PodMonitors(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			bindings, resourceVersion = pmon.Status.Bindings, pmon.ResourceVersion
+		}
+	case monitoringv1.ProbesKind:
+		var probe *monitoringv1.Probe
+		probe, err = mclient.MonitoringV1().Probes(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			bindings, resourceVersion = probe.Status.Bindings, probe.ResourceVersion
+		}
+	case monitoringv1alpha1.ScrapeConfigsKind:
+		var sc *monitoringv1alpha1.ScrapeConfig
+		sc, err = mclient.MonitoringV1alpha1().ScrapeConfigs(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			bindings, resourceVersion = sc.Status.Bindings, sc.ResourceVersion
+		}
+	default:
+		return fmt.Errorf("unsupported kind %q", kind)
+	}
+
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	bindings, ok := update(bindings)
+	if !ok {
+		return nil
+	}
+
+	opts := metav1.ApplyOptions{FieldManager: PrometheusOperatorFieldManager, Force: true}
+	switch kind {
+	case monitoringv1.ServiceMonitorsKind:
+		_, err = mclient.MonitoringV1().ServiceMonitors(namespace).ApplyStatus(
+			ctx,
+			monitoringv1ac.ServiceMonitor(name, namespace).
+				WithResourceVersion(resourceVersion).
+				WithStatus(applyConfigurationFromBindings(bindings)),
+			opts,
+		)
+	case monitoringv1.PodMonitorsKind:
+		_, err = mclient.MonitoringV1().PodMonitors(namespace).ApplyStatus(
+			ctx,
+			monitoringv1ac.PodMonitor(name, namespace).
+				WithResourceVersion(resourceVersion).
+				WithStatus(applyConfigurationFromBindings(bindings)),
+			opts,
+		)
+	case monitoringv1.ProbesKind:
+		_, err = mclient.MonitoringV1().Probes(namespace).ApplyStatus(
+			ctx,
+			monitoringv1ac.Probe(name, namespace).
+				WithResourceVersion(resourceVersion).
+				WithStatus(applyConfigurationFromBindings(bindings)),
+			opts,
+		)
+	case monitoringv1alpha1.ScrapeConfigsKind:
+		_, err = mclient.MonitoringV1alpha1().ScrapeConfigs(namespace).ApplyStatus(
+			ctx,
+			monitoringv1alpha1ac.ScrapeConfig(name, namespace).
+				WithResourceVersion(resourceVersion).
+				WithStatus(applyConfigurationFromBindings(bindings)),
+			opts,
+		)
+	}
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// WorkloadBindingUpToDate returns true if the bindings already contain b. The
+// last transition time of the conditions isn't compared so that callers can
+// skip the status update when nothing has changed.
+func WorkloadBindingUpToDate(bindings []monitoringv1.WorkloadBinding, b monitoringv1.WorkloadBinding) bool {
+	for _, current := range bindings {
+		if !sameWorkload(current, b) {
+			continue
+		}
+
+		if len(current.Conditions) != len(b.Conditions) {
+			return false
+		}
+
+		for _, cond := range b.Conditions {
+			if !slices.ContainsFunc(current.Conditions, func(c monitoringv1.ConfigResourceCondition) bool {
+				return c.Type == cond.Type &&
+					c.Status == cond.Status &&
+					c.Reason == cond.Reason &&
+					c.Message == cond.Message &&
+					c.ObservedGeneration == cond.ObservedGeneration
+			}) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// setWorkloadBinding returns the bindings with b added or replacing the
+// existing binding to the same workload. The last transition time of the
+// conditions is preserved when their status doesn't change.
+func setWorkloadBinding(bindings []monitoringv1.WorkloadBinding, b monitoringv1.WorkloadBinding) []monitoringv1.WorkloadBinding {
+	ret := make([]monitoringv1.WorkloadBinding, 0, len(bindings)+1)
+	var found bool
+	for _, current := range bindings {
+		if !sameWorkload(current, b) {
+			ret = append(ret, current)
+			continue
+		}
+
+		found = true
+		if b.ScrapeStatistics == nil {
+			b.ScrapeStatistics = current.ScrapeStatistics
+		}
+		for i, cond := range b.Conditions {
+			for _, currentCond := range current.Conditions {
+				if currentCond.Type == cond.Type && currentCond.Status == cond.Status {
+					b.Conditions[i].LastTransitionTime = currentCond.LastTransitionTime
+				}
+			}
+		}
+		ret = append(ret, b)
+	}
+
+	if !found {
+		ret = append(ret, b)
+	}
+
+	return ret
+}
+
+func sameWorkload(a, b monitoringv1.WorkloadBinding) bool {
+	return a.Group == b.Group && a.Resource == b.Resource && a.Namespace == b.Namespace && a.Name == b.Name
+}
+
+// applyConfigurationFromBindings returns the status apply configuration of
+// the configuration resource with the bindings and their counts.
+func applyConfigurationFromBindings(bindings []monitoringv1.WorkloadBinding) *monitoringv1ac.ConfigResourceStatusApplyConfiguration {
+	var accepted int32
+	for _, b := range bindings {
+		if slices.ContainsFunc(b.Conditions, func(c monitoringv1.ConfigResourceCondition) bool {
+			return c.Type == monitoringv1.Accepted && c.Status == monitoringv1.ConditionTrue
+		}) {
+			accepted++
+		}
+	}
+
+	status := monitoringv1ac.ConfigResourceStatus().
+		WithTotalBindings(int32(len(bindings))).
+		WithAcceptedBindings(accepted)
+	for _, b := range bindings {
+		bac := monitoringv1ac.WorkloadBinding().
+			WithGroup(b.Group).
+			WithResource(b.Resource).
+			WithName(b.Name).
+			WithNamespace(b.Namespace)
+
+		for _, c := range b.Conditions {
+			bac.WithConditions(
+				monitoringv1ac.ConfigResourceCondition().
+					WithType(c.Type).
+					WithStatus(c.Status).
+					WithLastTransitionTime(c.LastTransitionTime).
+					WithReason(c.Reason).
+					WithMessage(c.Message).
+					WithObservedGeneration(c.ObservedGeneration),
+			)
+		}
+
+		if st := b.ScrapeStatistics; st != nil {
+			bac.WithScrapeStatistics(
+				monitoringv1ac.ScrapeStatistics().
+					WithTargets(st.Targets).
+					WithStaleTargets(st.StaleTargets).
+					WithLastScrapeDurationP50(st.LastScrapeDurationP50).
+					WithLastScrapeDurationP90(st.LastScrapeDurationP90).
+					WithLastScrapeDurationP99(st.LastScrapeDurationP99).
+					WithIntervalDriftPercentP50(st.IntervalDriftPercentP50).
+					WithIntervalDriftPercentP90(st.IntervalDriftPercentP90).
+					WithIntervalDriftPercentP99(st.IntervalDriftPercentP99).
+					WithLastUpdateTime(st.LastUpdateTime),
+			)
+		}
+
+		status.WithBindings(bac)
+	}
+
+	return status
+}
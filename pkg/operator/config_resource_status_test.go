@@ -0,0 +1,263 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+)
+
+func TestSetWorkloadBinding(t *testing.T) {
+	workload := &metav1.ObjectMeta{Name: "k8s", Namespace: "monitoring"}
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	other := NewWorkloadBinding(&metav1.ObjectMeta{Name: "other", Namespace: "monitoring"}, monitoringv1.PrometheusName, 1, "", nil)
+	current := NewWorkloadBinding(workload, monitoringv1.PrometheusName, 1, "", nil)
+	current.Conditions[0].LastTransitionTime = past
+
+	// Same status: the last transition time is preserved.
+	bindings := setWorkloadBinding([]monitoringv1.WorkloadBinding{other, current}, NewWorkloadBinding(workload, monitoringv1.PrometheusName, 2, "", nil))
+	require.Len(t, bindings, 2)
+	require.Equal(t, other, bindings[0])
+	require.Equal(t, past, bindings[1].Conditions[0].LastTransitionTime)
+	require.Equal(t, int64(2), bindings[1].Conditions[0].ObservedGeneration)
+
+	// Different status: the last transition time is updated.
+	bindings = setWorkloadBinding([]monitoringv1.WorkloadBinding{other, current}, NewWorkloadBinding(workload, monitoringv1.PrometheusName, 2, "InvalidConfiguration", errors.New("invalid")))
+	require.Len(t, bindings, 2)
+	require.Equal(t, monitoringv1.ConditionFalse, bindings[1].Conditions[0].Status)
+	require.Equal(t, "InvalidConfiguration", bindings[1].Conditions[0].Reason)
+	require.Equal(t, "invalid", bindings[1].Conditions[0].Message)
+	require.NotEqual(t, past, bindings[1].Conditions[0].LastTransitionTime)
+
+	// Same workload name but different resource.
+	bindings = setWorkloadBinding([]monitoringv1.WorkloadBinding{current}, NewWorkloadBinding(workload, "prometheusagents", 1, "", nil))
+	require.Len(t, bindings, 2)
+
+	// The scrape statistics are preserved.
+	current.ScrapeStatistics = &monitoringv1.ScrapeStatistics{Targets: 3}
+	bindings = setWorkloadBinding([]monitoringv1.WorkloadBinding{current}, NewWorkloadBinding(workload, monitoringv1.PrometheusName, 2, "", nil))
+	require.Len(t, bindings, 1)
+	require.Equal(t, current.ScrapeStatistics, bindings[0].ScrapeStatistics)
+}
+
+func TestWorkloadBindingUpToDate(t *testing.T) {
+	workload := &metav1.ObjectMeta{Name: "k8s", Namespace: "monitoring"}
+
+	current := NewWorkloadBinding(workload, monitoringv1.PrometheusName, 1, "", nil)
+	current.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+	bindings := []monitoringv1.WorkloadBinding{current}
+
+	// The last transition time is ignored.
+	require.True(t, WorkloadBindingUpToDate(bindings, NewWorkloadBinding(workload, monitoringv1.PrometheusName, 1, "", nil)))
+
+	// Different generation.
+	require.False(t, WorkloadBindingUpToDate(bindings, NewWorkloadBinding(workload, monitoringv1.PrometheusName, 2, "", nil)))
+
+	// Different status.
+	require.False(t, WorkloadBindingUpToDate(bindings, NewWorkloadBinding(workload, monitoringv1.PrometheusName, 1, "InvalidConfiguration", errors.New("invalid"))))
+
+	// Unknown workload.
+	require.False(t, WorkloadBindingUpToDate(bindings, NewWorkloadBinding(&metav1.ObjectMeta{Name: "other", Namespace: "monitoring"}, monitoringv1.PrometheusName, 1, "", nil)))
+	require.False(t, WorkloadBindingUpToDate(nil, current))
+}
+
+func TestServiceMonitorBindingUpdate(t *testing.T) {
+	mclient := monitoringfake.NewSimpleClientset(&monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "smon", Namespace: "default"},
+	})
+
+	binding := NewWorkloadBinding(&metav1.ObjectMeta{Name: "k8s", Namespace: "monitoring"}, monitoringv1.PrometheusName, 1, "", nil)
+	require.NoError(t, ServiceMonitorBindingUpdate(mclient, "default", "smon", binding)(context.Background()))
+
+	var applied bool
+	for _, a := range mclient.Actions() {
+		if a.GetVerb() == "patch" && a.GetSubresource() == "status" {
+			applied = true
+		}
+	}
+	require.True(t, applied)
+
+	// The status isn't applied again when it's unchanged.
+	mclient = monitoringfake.NewSimpleClientset(&monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "smon", Namespace: "default"},
+		Status: monitoringv1.ConfigResourceStatus{
+			Bindings: []monitoringv1.WorkloadBinding{binding},
+		},
+	})
+	require.NoError(t, ServiceMonitorBindingUpdate(mclient, "default", "smon", binding)(context.Background()))
+	for _, a := range mclient.Actions() {
+		require.NotEqual(t, "patch", a.GetVerb())
+	}
+
+	// Missing objects are ignored.
+	require.NoError(t, ServiceMonitorBindingUpdate(mclient, "default", "missing", binding)(context.Background()))
+}
+
+func TestPodMonitorBindingUpdate(t *testing.T) {
+	mclient := monitoringfake.NewSimpleClientset(&monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "pmon", Namespace: "default"},
+	})
+
+	binding := NewWorkloadBinding(&metav1.ObjectMeta{Name: "k8s", Namespace: "monitoring"}, monitoringv1.PrometheusName, 1, "InvalidConfiguration", errors.New("invalid"))
+	require.NoError(t, PodMonitorBindingUpdate(mclient, "default", "pmon", binding)(context.Background()))
+
+	var applied bool
+	for _, a := range mclient.Actions() {
+		if a.GetVerb() == "patch" && a.GetSubresource() == "status" && a.GetResource().Resource == monitoringv1.PodMonitorName {
+			applied = true
+		}
+	}
+	require.True(t, applied)
+
+	pmon, err := mclient.MonitoringV1().PodMonitors("default").Get(context.Background(), "pmon", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), pmon.Status.TotalBindings)
+	require.Equal(t, int32(0), pmon.Status.AcceptedBindings)
+
+	// Missing objects are ignored.
+	require.NoError(t, PodMonitorBindingUpdate(mclient, "default", "missing", binding)(context.Background()))
+}
+
+func TestProbeBindingUpdate(t *testing.T) {
+	mclient := monitoringfake.NewSimpleClientset(&monitoringv1.Probe{
+		ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "default"},
+	})
+
+	binding := NewWorkloadBinding(&metav1.ObjectMeta{Name: "k8s", Namespace: "monitoring"}, monitoringv1.PrometheusName, 1, "", nil)
+	require.NoError(t, ProbeBindingUpdate(mclient, "default", "probe", binding)(context.Background()))
+
+	probe, err := mclient.MonitoringV1().Probes("default").Get(context.Background(), "probe", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.True(t, WorkloadBindingUpToDate(probe.Status.Bindings, binding))
+	require.Equal(t, int32(1), probe.Status.TotalBindings)
+	require.Equal(t, int32(1), probe.Status.AcceptedBindings)
+}
+
+func TestScrapeConfigBindingUpdate(t *testing.T) {
+	mclient := monitoringfake.NewSimpleClientset(&monitoringv1alpha1.ScrapeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "scfg", Namespace: "default"},
+	})
+
+	// The validation error is reported in the binding's condition.
+	err := errors.New("HTTP SD configuration is only supported for Prometheus version >= 2.28.0")
+	binding := NewWorkloadBinding(&metav1.ObjectMeta{Name: "k8s", Namespace: "monitoring"}, monitoringv1.PrometheusName, 1, UnsupportedVersionFieldReason, err)
+	require.NoError(t, ScrapeConfigBindingUpdate(mclient, "default", "scfg", binding)(context.Background()))
+
+	scfg, err := mclient.MonitoringV1alpha1().ScrapeConfigs("default").Get(context.Background(), "scfg", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.True(t, WorkloadBindingUpToDate(scfg.Status.Bindings, binding))
+	require.Equal(t, int32(1), scfg.Status.TotalBindings)
+	require.Equal(t, int32(0), scfg.Status.AcceptedBindings)
+
+	require.Len(t, scfg.Status.Bindings, 1)
+	require.Len(t, scfg.Status.Bindings[0].Conditions, 1)
+	cond := scfg.Status.Bindings[0].Conditions[0]
+	require.Equal(t, monitoringv1.ConditionFalse, cond.Status)
+	require.Equal(t, string(UnsupportedVersionFieldReason), cond.Reason)
+	require.Equal(t, "HTTP SD configuration is only supported for Prometheus version >= 2.28.0", cond.Message)
+}
+
+func TestBindingRemoval(t *testing.T) {
+	k8s := NewWorkloadBinding(&metav1.ObjectMeta{Name: "k8s", Namespace: "monitoring"}, monitoringv1.PrometheusName, 1, "", nil)
+	other := NewWorkloadBinding(&metav1.ObjectMeta{Name: "other", Namespace: "monitoring"}, monitoringv1.PrometheusName, 1, "", nil)
+
+	mclient := monitoringfake.NewSimpleClientset(&monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "smon", Namespace: "default"},
+		Status: monitoringv1.ConfigResourceStatus{
+			Bindings: []monitoringv1.WorkloadBinding{k8s, other},
+		},
+	})
+
+	require.NoError(t, BindingRemoval(mclient, monitoringv1.ServiceMonitorsKind, "default", "smon", k8s)(context.Background()))
+
+	smon, err := mclient.MonitoringV1().ServiceMonitors("default").Get(context.Background(), "smon", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, smon.Status.Bindings, 1)
+	require.True(t, WorkloadBindingUpToDate(smon.Status.Bindings, other))
+
+	// Removing a missing binding or from a missing resource is a no-op.
+	require.NoError(t, BindingRemoval(mclient, monitoringv1.ServiceMonitorsKind, "default", "smon", k8s)(context.Background()))
+	require.NoError(t, BindingRemoval(mclient, monitoringv1.ServiceMonitorsKind, "default", "missing", k8s)(context.Background()))
+}
+
+func TestBoundConfigResources(t *testing.T) {
+	var (
+		b     = NewBoundConfigResources()
+		smon  = ConfigResourceRef{Kind: monitoringv1.ServiceMonitorsKind, Namespace: "default", Name: "smon"}
+		pmon  = ConfigResourceRef{Kind: monitoringv1.PodMonitorsKind, Namespace: "default", Name: "pmon"}
+		probe = ConfigResourceRef{Kind: monitoringv1.ProbesKind, Namespace: "default", Name: "probe"}
+	)
+
+	require.Empty(t, b.Update("monitoring/k8s", []ConfigResourceRef{smon, pmon}))
+	require.Empty(t, b.Update("monitoring/other", []ConfigResourceRef{smon}))
+
+	require.Equal(t, []ConfigResourceRef{pmon}, b.Update("monitoring/k8s", []ConfigResourceRef{smon, probe}))
+	require.Empty(t, b.Update("monitoring/k8s", []ConfigResourceRef{smon, probe}))
+
+	require.Equal(t, []ConfigResourceRef{probe, smon}, b.Update("monitoring/k8s", nil))
+	require.Equal(t, []ConfigResourceRef{smon}, b.Forget("monitoring/other"))
+	require.Empty(t, b.Forget("monitoring/other"))
+}
+
+func TestScrapeStatisticsUpdate(t *testing.T) {
+	workload := &metav1.ObjectMeta{Name: "k8s", Namespace: "monitoring"}
+	binding := NewWorkloadBinding(workload, monitoringv1.PrometheusName, 1, "", nil)
+	stats := &monitoringv1.ScrapeStatistics{Targets: 2, StaleTargets: 1}
+
+	mclient := monitoringfake.NewSimpleClientset(
+		&monitoringv1alpha1.ScrapeConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "bound", Namespace: "default"},
+			Status: monitoringv1.ConfigResourceStatus{
+				Bindings: []monitoringv1.WorkloadBinding{binding},
+			},
+		},
+		&monitoringv1alpha1.ScrapeConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "unbound", Namespace: "default"},
+		},
+	)
+
+	countPatches := func() int {
+		var n int
+		for _, a := range mclient.Actions() {
+			if a.GetVerb() == "patch" && a.GetSubresource() == "status" {
+				n++
+			}
+		}
+		return n
+	}
+
+	require.NoError(t, ScrapeStatisticsUpdate(mclient, monitoringv1alpha1.ScrapeConfigsKind, "default", "bound", binding, stats)(context.Background()))
+	require.Equal(t, 1, countPatches())
+
+	// The statistics aren't written when the workload isn't bound.
+	require.NoError(t, ScrapeStatisticsUpdate(mclient, monitoringv1alpha1.ScrapeConfigsKind, "default", "unbound", binding, stats)(context.Background()))
+	require.Equal(t, 1, countPatches())
+
+	// Missing objects are ignored.
+	require.NoError(t, ScrapeStatisticsUpdate(mclient, monitoringv1alpha1.ScrapeConfigsKind, "default", "missing", binding, stats)(context.Background()))
+
+	// Unsupported kinds return an error.
+	require.Error(t, ScrapeStatisticsUpdate(mclient, monitoringv1.PrometheusRuleKind, "default", "bound", binding, stats)(context.Background()))
+}
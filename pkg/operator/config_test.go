@@ -17,6 +17,7 @@ package operator
 import (
 	"testing"
 
+	"github.com/blang/semver/v4"
 	"github.com/stretchr/testify/require"
 )
 
@@ -123,3 +124,36 @@ func TestStringSet(t *testing.T) {
 		require.True(t, found)
 	}
 }
+
+func TestNativeSidecarsEnabled(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		enabled           bool
+		kubernetesVersion string
+		expected          bool
+	}{
+		{
+			name:              "feature gate disabled",
+			kubernetesVersion: "1.30.0",
+		},
+		{
+			name:              "Kubernetes < 1.29",
+			enabled:           true,
+			kubernetesVersion: "1.28.5",
+		},
+		{
+			name:              "Kubernetes >= 1.29",
+			enabled:           true,
+			kubernetesVersion: "1.29.0",
+			expected:          true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := DefaultConfig("100m", "50Mi")
+			require.NoError(t, c.Gates.UpdateFeatureGates(map[string]bool{string(NativeSidecarContainersFeature): tc.enabled}))
+			c.KubernetesVersion = semver.MustParse(tc.kubernetesVersion)
+
+			require.Equal(t, tc.expected, c.NativeSidecarsEnabled())
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"sync"
+	"time"
+)
+
+type pendingUpdate struct {
+	hash        string
+	firstChange time.Time
+	lastChange  time.Time
+}
+
+// Debouncer coalesces the updates of generated objects (e.g. the
+// configuration Secret) when their inputs change frequently. An update is
+// postponed until the inputs haven't changed for the duration of the window
+// but no longer than the maximum delay after the first pending change.
+type Debouncer struct {
+	mtx     sync.Mutex
+	pending map[string]*pendingUpdate
+
+	now func() time.Time
+}
+
+// NewDebouncer returns a Debouncer without pending updates.
+func NewDebouncer() *Debouncer {
+	return &Debouncer{
+		pending: map[string]*pendingUpdate{},
+		now:     time.Now,
+	}
+}
+
+// Delay returns how long the update of the object identified by key should
+// be postponed given the hash of its current inputs. A zero value means that
+// the update can proceed.
+func (d *Debouncer) Delay(key, hash string, window, maxDelay time.Duration) time.Duration {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	now := d.now()
+	pu, found := d.pending[key]
+	if !found {
+		pu = &pendingUpdate{firstChange: now}
+		d.pending[key] = pu
+	}
+
+	if pu.hash != hash {
+		pu.hash = hash
+		pu.lastChange = now
+	}
+
+	deadline := pu.lastChange.Add(window)
+	if maxDeadline := pu.firstChange.Add(maxDelay); maxDeadline.Before(deadline) {
+		deadline = maxDeadline
+	}
+
+	if !now.Before(deadline) {
+		delete(d.pending, key)
+		return 0
+	}
+
+	return deadline.Sub(now)
+}
+
+// Forget discards the pending update of the object identified by key.
+func (d *Debouncer) Forget(key string) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	delete(d.pending, key)
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebouncer(t *testing.T) {
+	now := time.Now()
+	d := NewDebouncer()
+	d.now = func() time.Time { return now }
+
+	window, maxDelay := 10*time.Second, 25*time.Second
+
+	// The first change is postponed by the window.
+	require.Equal(t, window, d.Delay("ns/a", "1", window, maxDelay))
+
+	// A new change resets the window.
+	now = now.Add(8 * time.Second)
+	require.Equal(t, window, d.Delay("ns/a", "2", window, maxDelay))
+
+	// No change: the remaining time of the window.
+	now = now.Add(4 * time.Second)
+	require.Equal(t, 6*time.Second, d.Delay("ns/a", "2", window, maxDelay))
+
+	// The delay is bounded by the max delay after the first change.
+	now = now.Add(4 * time.Second)
+	require.Equal(t, 9*time.Second, d.Delay("ns/a", "3", window, maxDelay))
+
+	now = now.Add(9 * time.Second)
+	require.Equal(t, time.Duration(0), d.Delay("ns/a", "4", window, maxDelay))
+
+	// Once the update proceeded, a new change starts a new window.
+	require.Equal(t, window, d.Delay("ns/a", "5", window, maxDelay))
+
+	d.Forget("ns/a")
+	require.Equal(t, window, d.Delay("ns/a", "5", window, maxDelay))
+}
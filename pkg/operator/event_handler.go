@@ -28,7 +28,7 @@ type EventHandler struct {
 	metrics  *Metrics
 
 	objName     string
-	enqueueFunc func(string)
+	enqueueFunc func(namespace, name string)
 }
 
 func NewEventHandler(
@@ -37,6 +37,20 @@ func NewEventHandler(
 	metrics *Metrics,
 	objName string,
 	enqueueFunc func(ns string),
+) *EventHandler {
+	return NewObjectEventHandler(logger, accessor, metrics, objName, func(ns, _ string) {
+		enqueueFunc(ns)
+	})
+}
+
+// NewObjectEventHandler returns an event handler which passes both the
+// namespace and the name of the updated object to the enqueue function.
+func NewObjectEventHandler(
+	logger *slog.Logger,
+	accessor *Accessor,
+	metrics *Metrics,
+	objName string,
+	enqueueFunc func(namespace, name string),
 ) *EventHandler {
 	return &EventHandler{
 		logger:      logger,
@@ -52,7 +66,7 @@ func (e *EventHandler) OnAdd(obj interface{}, _ bool) {
 	if ok {
 		e.logger.Debug(fmt.Sprintf("%s added", e.objName))
 		e.metrics.TriggerByCounter(e.objName, AddEvent).Inc()
-		e.enqueueFunc(o.GetNamespace())
+		e.enqueueFunc(o.GetNamespace(), o.GetName())
 	}
 }
 
@@ -64,7 +78,7 @@ func (e *EventHandler) OnUpdate(old, cur interface{}) {
 	if o, ok := e.accessor.ObjectMetadata(cur); ok {
 		e.logger.Debug(fmt.Sprintf("%s updated", e.objName))
 		e.metrics.TriggerByCounter(e.objName, UpdateEvent)
-		e.enqueueFunc(o.GetNamespace())
+		e.enqueueFunc(o.GetNamespace(), o.GetName())
 	}
 }
 
@@ -72,6 +86,6 @@ func (e *EventHandler) OnDelete(obj interface{}) {
 	if o, ok := e.accessor.ObjectMetadata(obj); ok {
 		e.logger.Debug(fmt.Sprintf("%s deleted", e.objName))
 		e.metrics.TriggerByCounter(e.objName, DeleteEvent).Inc()
-		e.enqueueFunc(o.GetNamespace())
+		e.enqueueFunc(o.GetNamespace(), o.GetName())
 	}
 }
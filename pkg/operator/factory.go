@@ -15,9 +15,11 @@
 package operator
 
 import (
+	"strconv"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 )
@@ -125,6 +127,23 @@ func WithInputHashAnnotation(h string) ObjectOption {
 	}
 }
 
+// GenerationAnnotationName is the name of the annotation used to store the
+// generation of the governing object from which the object was generated.
+const GenerationAnnotationName = "operator.prometheus.io/generation"
+
+// WithGenerationAnnotation records the generation of the governing object in
+// the object's annotations.
+func WithGenerationAnnotation(generation int64) ObjectOption {
+	return func(o metav1.Object) {
+		a := o.GetAnnotations()
+		if a == nil {
+			a = map[string]string{}
+		}
+		a[GenerationAnnotationName] = strconv.FormatInt(generation, 10)
+		o.SetAnnotations(a)
+	}
+}
+
 // WithoutKubectlAnnotations removes kubectl annotations inherited from the
 // governing object. Otherwise the managed object might be deleted when
 // "kubectl apply --prune" is run against the governing object.
@@ -141,6 +160,12 @@ func WithoutKubectlAnnotations() ObjectOption {
 	}
 }
 
+// ManagedByOperatorSelector returns the label selector matching the objects
+// created with UpdateObject().
+func ManagedByOperatorSelector() labels.Selector {
+	return labels.SelectorFromSet(labels.Set{managedByOperatorLabel: managedByOperatorLabelValue})
+}
+
 // UpdateObject updates the object with the provided options.
 func UpdateObject(o metav1.Object, opts ...ObjectOption) {
 	WithLabels(map[string]string{managedByOperatorLabel: managedByOperatorLabelValue})(o)
@@ -15,11 +15,16 @@
 package operator
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"slices"
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/version"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
 
 const (
@@ -34,6 +39,12 @@ const (
 
 	// StatusForConfigurationResourcesFeature enables the status subresource for Prometheus-Operator Config Objects.
 	StatusForConfigurationResourcesFeature FeatureGateName = "StatusForConfigurationResources"
+
+	// ServerSideApplyFeature enables the server-side apply of the StatefulSets, DaemonSets, Services and Secrets.
+	ServerSideApplyFeature FeatureGateName = "ServerSideApply"
+
+	// NativeSidecarContainersFeature runs the config-reloader and Thanos sidecar containers as native sidecars.
+	NativeSidecarContainersFeature FeatureGateName = "NativeSidecarContainers"
 )
 
 type FeatureGateName string
@@ -85,6 +96,31 @@ func (fg *FeatureGates) keyValuePairs() ([]FeatureGateName, []FeatureGate) {
 	return names, gates
 }
 
+// EnabledNames returns the sorted names of the enabled feature gates.
+func (fg *FeatureGates) EnabledNames() []string {
+	var (
+		names, gates = fg.keyValuePairs()
+		enabled      []string
+	)
+
+	for i := range names {
+		if gates[i].enabled {
+			enabled = append(enabled, string(names[i]))
+		}
+	}
+
+	return enabled
+}
+
+// OperatorInfo returns the information about the operator which is reported
+// in the status of the reconciled resources.
+func (fg *FeatureGates) OperatorInfo() *monitoringv1.OperatorInfo {
+	return &monitoringv1.OperatorInfo{
+		Version:             version.Version,
+		EnabledFeatureGates: fg.EnabledNames(),
+	}
+}
+
 func (fg *FeatureGates) Descriptions() []string {
 	var (
 		names, gates = fg.keyValuePairs()
@@ -138,3 +174,47 @@ func (fg *FeatureGates) Collect(ch chan<- prometheus.Metric) {
 		)
 	}
 }
+
+// FeatureGatesPattern is the HTTP route pattern of the FeatureGates handler.
+const FeatureGatesPattern = "GET /api/v1/feature-gates"
+
+// FeatureGateStatus describes a feature gate in the response of the
+// FeatureGates handler.
+type FeatureGateStatus struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// FeatureGatesStatus is the response of the FeatureGates handler.
+type FeatureGatesStatus struct {
+	// Version of the operator.
+	Version string `json:"version"`
+	// FeatureGates lists all the feature gates sorted by name.
+	FeatureGates []FeatureGateStatus `json:"featureGates"`
+}
+
+// Status returns the state of all the feature gates.
+func (fg *FeatureGates) Status() FeatureGatesStatus {
+	names, gates := fg.keyValuePairs()
+
+	status := FeatureGatesStatus{
+		Version:      version.Version,
+		FeatureGates: make([]FeatureGateStatus, 0, len(names)),
+	}
+	for i := range names {
+		status.FeatureGates = append(status.FeatureGates, FeatureGateStatus{
+			Name:        string(names[i]),
+			Description: gates[i].description,
+			Enabled:     gates[i].enabled,
+		})
+	}
+
+	return status
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (fg *FeatureGates) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(fg.Status())
+}
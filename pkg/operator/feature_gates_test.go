@@ -15,8 +15,13 @@
 package operator
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,3 +70,49 @@ func TestUpdateFeatureGates(t *testing.T) {
 		})
 	}
 }
+
+func TestEnabledNames(t *testing.T) {
+	fg := &FeatureGates{
+		FeatureGateName("Foo"): {enabled: true},
+		FeatureGateName("Bar"): {enabled: false},
+		FeatureGateName("Baz"): {enabled: true},
+	}
+	require.Equal(t, []string{"Baz", "Foo"}, fg.EnabledNames())
+
+	require.NoError(t, fg.UpdateFeatureGates(map[string]bool{"Baz": false, "Foo": false}))
+	require.Empty(t, fg.EnabledNames())
+	require.Empty(t, fg.OperatorInfo().EnabledFeatureGates)
+}
+
+func TestFeatureGatesMetric(t *testing.T) {
+	fg := &FeatureGates{
+		FeatureGateName("Foo"): {enabled: true},
+		FeatureGateName("Bar"): {enabled: false},
+	}
+
+	require.NoError(t, testutil.CollectAndCompare(fg, strings.NewReader(`
+# HELP prometheus_operator_feature_gate Reports about the Prometheus operator feature gates. A value of 1 means that the feature gate is enabled. Otherwise the value is 0.
+# TYPE prometheus_operator_feature_gate gauge
+prometheus_operator_feature_gate{name="Bar"} 0
+prometheus_operator_feature_gate{name="Foo"} 1
+`)))
+}
+
+func TestFeatureGatesHandler(t *testing.T) {
+	fg := &FeatureGates{
+		FeatureGateName("Foo"): {description: "foo", enabled: true},
+		FeatureGateName("Bar"): {description: "bar", enabled: false},
+	}
+
+	w := httptest.NewRecorder()
+	fg.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/feature-gates", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var status FeatureGatesStatus
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&status))
+	require.Equal(t, []FeatureGateStatus{
+		{Name: "Bar", Description: "bar", Enabled: false},
+		{Name: "Foo", Description: "foo", Enabled: true},
+	}, status.FeatureGates)
+}
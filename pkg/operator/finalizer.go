@@ -57,12 +57,20 @@ func (s *FinalizerSyncer) Sync(ctx context.Context, p metav1.Object, logger *slo
 		return false, nil
 	}
 
+	// The resource isn't being deleted, add the finalizer if missing.
+	// Otherwise remove the finalizer.
+	return s.SyncFinalizer(ctx, p, logger, k8sutil.StatusCleanupFinalizerName, !deletionInProgress)
+}
+
+// SyncFinalizer adds the finalizer to the given workload resource if present
+// is true, otherwise it removes the finalizer.
+//
+// Returns true if the finalizer list was modified, otherwise false.
+func (s *FinalizerSyncer) SyncFinalizer(ctx context.Context, p metav1.Object, logger *slog.Logger, finalizer string, present bool) (bool, error) {
 	finalizers := p.GetFinalizers()
 
-	// The resource isn't being deleted, add the finalizer if missing.
-	if !deletionInProgress {
-		// Add finalizer to the workload resource if it doesn't have one.
-		patchBytes, err := k8sutil.FinalizerAddPatch(finalizers, k8sutil.StatusCleanupFinalizerName)
+	if present {
+		patchBytes, err := k8sutil.FinalizerAddPatch(finalizers, finalizer)
 		if err != nil {
 			return false, fmt.Errorf("failed to marshal patch: %w", err)
 		}
@@ -71,14 +79,13 @@ func (s *FinalizerSyncer) Sync(ctx context.Context, p metav1.Object, logger *slo
 			return false, nil
 		}
 		if err = s.updateObject(ctx, p, patchBytes); err != nil {
-			return false, fmt.Errorf("failed to add %q finalizer: %w", k8sutil.StatusCleanupFinalizerName, err)
+			return false, fmt.Errorf("failed to add %q finalizer: %w", finalizer, err)
 		}
-		logger.Debug("added finalizer to object")
+		logger.Debug("added finalizer to object", "finalizer", finalizer)
 		return true, nil
 	}
 
-	// If the workload instance is marked for deletion, we remove the finalizer.
-	patchBytes, err := k8sutil.FinalizerDeletePatch(finalizers, k8sutil.StatusCleanupFinalizerName)
+	patchBytes, err := k8sutil.FinalizerDeletePatch(finalizers, finalizer)
 	if err != nil {
 		return false, fmt.Errorf("failed to marshal patch: %w", err)
 	}
@@ -87,9 +94,9 @@ func (s *FinalizerSyncer) Sync(ctx context.Context, p metav1.Object, logger *slo
 	}
 
 	if err = s.updateObject(ctx, p, patchBytes); err != nil {
-		return false, fmt.Errorf("failed to remove %q finalizer: %w", k8sutil.StatusCleanupFinalizerName, err)
+		return false, fmt.Errorf("failed to remove %q finalizer: %w", finalizer, err)
 	}
-	logger.Debug("removed finalizer from object")
+	logger.Debug("removed finalizer from object", "finalizer", finalizer)
 
 	return true, nil
 }
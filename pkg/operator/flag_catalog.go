@@ -0,0 +1,351 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// flagVersions is the range of versions supporting a flag. An empty value
+// means that the bound is unknown or that there's no bound.
+type flagVersions struct {
+	// since is the first version supporting the flag.
+	since string
+	// until is the first version which doesn't support the flag anymore.
+	until string
+}
+
+// FlagCatalog lists the command-line flags supported by a component.
+type FlagCatalog struct {
+	component string
+	// latest is the most recent version covered by the catalog. The unknown
+	// flags are accepted for more recent versions since they may have been
+	// added in the meantime.
+	latest semver.Version
+	flags  map[string]flagVersions
+}
+
+func newFlagCatalog(component, latest string, flagSets ...map[string]flagVersions) *FlagCatalog {
+	fc := &FlagCatalog{
+		component: component,
+		latest:    semver.MustParse(latest),
+		flags:     map[string]flagVersions{},
+	}
+
+	for _, fs := range flagSets {
+		maps.Copy(fc.flags, fs)
+	}
+
+	return fc
+}
+
+var (
+	prometheusCommonFlags = map[string]flagVersions{
+		"config.file":                       {},
+		"config.auto-reload-interval":       {},
+		"web.listen-address":                {},
+		"web.config.file":                   {since: "2.24.0"},
+		"web.read-timeout":                  {},
+		"web.max-connections":               {},
+		"web.max-notifications-subscribers": {},
+		"web.external-url":                  {},
+		"web.route-prefix":                  {},
+		"web.user-assets":                   {},
+		"web.enable-lifecycle":              {},
+		"web.enable-admin-api":              {},
+		"web.enable-remote-write-receiver":  {since: "2.33.0"},
+		"web.remote-write-receiver.accepted-protobuf-messages": {since: "2.54.0"},
+		"web.enable-otlp-receiver":                             {since: "3.0.0"},
+		"web.console.templates":                                {},
+		"web.console.libraries":                                {},
+		"web.page-title":                                       {since: "2.6.0"},
+		"web.cors.origin":                                      {},
+		"auto-gomaxprocs":                                      {},
+		"auto-gomemlimit":                                      {},
+		"auto-gomemlimit.ratio":                                {},
+		"storage.remote.flush-deadline":                        {},
+		"scrape.adjust-timestamps":                             {},
+		"scrape.timestamp-tolerance":                           {},
+		"scrape.discovery-reload-interval":                     {},
+		"enable-feature":                                       {since: "2.25.0"},
+		"log.level":                                            {},
+		"log.format":                                           {},
+	}
+
+	prometheusServerFlags = map[string]flagVersions{
+		"storage.tsdb.path":                                 {},
+		"storage.tsdb.min-block-duration":                   {},
+		"storage.tsdb.max-block-duration":                   {},
+		"storage.tsdb.max-block-chunk-segment-size":         {},
+		"storage.tsdb.wal-segment-size":                     {},
+		"storage.tsdb.retention":                            {until: "3.0.0"},
+		"storage.tsdb.retention.time":                       {},
+		"storage.tsdb.retention.size":                       {since: "2.7.0"},
+		"storage.tsdb.no-lockfile":                          {},
+		"storage.tsdb.allow-overlapping-blocks":             {until: "3.0.0"},
+		"storage.tsdb.allow-overlapping-compaction":         {},
+		"storage.tsdb.wal-compression":                      {},
+		"storage.tsdb.wal-compression-type":                 {},
+		"storage.tsdb.head-chunks-write-queue-size":         {},
+		"storage.tsdb.samples-per-chunk":                    {},
+		"storage.tsdb.delayed-compaction.max-percent":       {},
+		"storage.remote.read-sample-limit":                  {},
+		"storage.remote.read-concurrent-limit":              {},
+		"storage.remote.read-max-bytes-in-frame":            {},
+		"rules.alert.for-outage-tolerance":                  {since: "2.4.0"},
+		"rules.alert.for-grace-period":                      {since: "2.4.0"},
+		"rules.alert.resend-delay":                          {since: "2.4.0"},
+		"rules.max-concurrent-evals":                        {},
+		"alertmanager.timeout":                              {until: "3.0.0"},
+		"alertmanager.notification-queue-capacity":          {},
+		"alertmanager.notification-batch-size":              {},
+		"alertmanager.drain-notification-queue-on-shutdown": {},
+		"query.lookback-delta":                              {},
+		"query.timeout":                                     {},
+		"query.max-concurrency":                             {},
+		"query.max-samples":                                 {since: "2.5.0"},
+	}
+
+	prometheusAgentFlags = map[string]flagVersions{
+		"agent":                                {since: "3.0.0"},
+		"storage.agent.path":                   {},
+		"storage.agent.wal-segment-size":       {},
+		"storage.agent.wal-compression":        {},
+		"storage.agent.wal-compression-type":   {},
+		"storage.agent.wal-truncate-frequency": {},
+		"storage.agent.retention.min-time":     {},
+		"storage.agent.retention.max-time":     {},
+		"storage.agent.no-lockfile":            {},
+	}
+
+	alertmanagerFlags = map[string]flagVersions{
+		"config.file":                     {},
+		"storage.path":                    {},
+		"data.retention":                  {},
+		"data.maintenance-interval":       {},
+		"silences.max-silences":           {since: "0.28.0"},
+		"silences.max-silence-size-bytes": {since: "0.28.0"},
+		"alerts.gc-interval":              {},
+		"web.listen-address":              {},
+		"web.systemd-socket":              {},
+		"web.config.file":                 {since: "0.22.0"},
+		"web.external-url":                {},
+		"web.route-prefix":                {},
+		"web.get-concurrency":             {since: "0.17.0"},
+		"web.timeout":                     {since: "0.17.0"},
+		"auto-gomemlimit.ratio":           {},
+		"cluster.listen-address":          {},
+		"cluster.advertise-address":       {},
+		"cluster.peer":                    {},
+		"cluster.peer-timeout":            {},
+		"cluster.gossip-interval":         {},
+		"cluster.pushpull-interval":       {},
+		"cluster.tcp-timeout":             {},
+		"cluster.probe-timeout":           {},
+		"cluster.probe-interval":          {},
+		"cluster.settle-timeout":          {},
+		"cluster.reconnect-interval":      {},
+		"cluster.reconnect-timeout":       {},
+		"cluster.tls-config":              {since: "0.24.0"},
+		"cluster.allow-insecure-public-advertise-address-discovery": {},
+		"cluster.label":  {since: "0.26.0"},
+		"enable-feature": {since: "0.27.0"},
+		"log.level":      {},
+		"log.format":     {since: "0.16.0"},
+	}
+
+	thanosCommonFlags = map[string]flagVersions{
+		"log.level":              {},
+		"log.format":             {},
+		"tracing.config":         {},
+		"tracing.config-file":    {},
+		"enable-auto-gomemlimit": {},
+		"auto-gomemlimit.ratio":  {},
+
+		"http-address":      {},
+		"http-grace-period": {},
+		"http.config":       {},
+
+		"grpc-address":                   {},
+		"grpc-server-tls-cert":           {},
+		"grpc-server-tls-key":            {},
+		"grpc-server-tls-client-ca":      {},
+		"grpc-server-tls-min-version":    {},
+		"grpc-server-max-connection-age": {},
+		"grpc-grace-period":              {},
+
+		"shipper.upload-compacted":           {},
+		"shipper.ignore-unequal-block-size":  {},
+		"shipper.allow-out-of-order-uploads": {},
+		"shipper.skip-corrupted-blocks":      {},
+		"shipper.meta-file-name":             {},
+		"hash-func":                          {},
+
+		"objstore.config":              {},
+		"objstore.config-file":         {},
+		"request.logging-config":       {},
+		"request.logging-config-file":  {},
+		"store.limits.request-series":  {},
+		"store.limits.request-samples": {},
+	}
+
+	thanosSidecarFlags = map[string]flagVersions{
+		"prometheus.url":                 {},
+		"prometheus.ready_timeout":       {},
+		"prometheus.get_config_interval": {since: "0.29.0"},
+		"prometheus.get_config_timeout":  {since: "0.29.0"},
+		"prometheus.http-client":         {},
+		"prometheus.http-client-file":    {},
+		"tsdb.path":                      {},
+		"reloader.config-file":           {},
+		"reloader.config-envsubst-file":  {},
+		"reloader.rule-dir":              {},
+		"reloader.watch-interval":        {},
+		"reloader.retry-interval":        {},
+		"reloader.method":                {},
+		"reloader.process-name":          {},
+		"min-time":                       {},
+	}
+
+	thanosRulerFlags = map[string]flagVersions{
+		"web.route-prefix":    {},
+		"web.external-prefix": {},
+		"web.prefix-header":   {},
+		"web.disable-cors":    {},
+
+		"query":                        {},
+		"query.config":                 {},
+		"query.config-file":            {},
+		"query.sd-files":               {},
+		"query.sd-interval":            {},
+		"query.sd-dns-interval":        {},
+		"query.http-method":            {},
+		"query.sd-dns-resolver":        {},
+		"query.default-step":           {},
+		"query.only-prometheus-params": {},
+		"query.enable-x-functions":     {},
+
+		"alertmanagers.config":          {},
+		"alertmanagers.config-file":     {},
+		"alertmanagers.url":             {},
+		"alertmanagers.send-timeout":    {},
+		"alertmanagers.sd-dns-interval": {},
+		"alert.query-url":               {},
+		"alert.label-drop":              {},
+		"alert.relabel-config":          {},
+		"alert.relabel-config-file":     {},
+		"alert.query-template":          {},
+
+		"label":                      {},
+		"tsdb.block-duration":        {},
+		"tsdb.retention":             {},
+		"tsdb.no-lockfile":           {},
+		"tsdb.wal-compression":       {},
+		"data-dir":                   {},
+		"rule-file":                  {},
+		"resend-delay":               {},
+		"eval-interval":              {},
+		"rule-query-offset":          {since: "0.38.0"},
+		"for-outage-tolerance":       {},
+		"for-grace-period":           {},
+		"restore-ignored-label":      {},
+		"rule-concurrent-evaluation": {since: "0.37.0"},
+		"grpc-query-endpoint":        {},
+		"enable-feature":             {},
+		"remote-write.config":        {},
+		"remote-write.config-file":   {},
+	}
+)
+
+var (
+	// PrometheusServerFlags is the catalog of the Prometheus flags in server
+	// mode.
+	PrometheusServerFlags = newFlagCatalog("Prometheus", "3.4.2", prometheusCommonFlags, prometheusServerFlags)
+	// PrometheusAgentFlags is the catalog of the Prometheus flags in agent
+	// mode.
+	PrometheusAgentFlags = newFlagCatalog("Prometheus", "3.4.2", prometheusCommonFlags, prometheusAgentFlags)
+	// AlertmanagerFlags is the catalog of the Alertmanager flags.
+	AlertmanagerFlags = newFlagCatalog("Alertmanager", "0.28.1", alertmanagerFlags)
+	// ThanosSidecarFlags is the catalog of the Thanos sidecar flags.
+	ThanosSidecarFlags = newFlagCatalog("Thanos sidecar", "0.39.2", thanosCommonFlags, thanosSidecarFlags)
+	// ThanosRulerFlags is the catalog of the Thanos ruler flags.
+	ThanosRulerFlags = newFlagCatalog("Thanos ruler", "0.39.2", thanosCommonFlags, thanosRulerFlags)
+)
+
+// lookup returns the name of the flag in the catalog matching the argument
+// name. Boolean flags can be negated with the "no-" prefix.
+func (fc *FlagCatalog) lookup(name string) (string, flagVersions, bool) {
+	if fv, found := fc.flags[name]; found {
+		return name, fv, true
+	}
+
+	if n, found := strings.CutPrefix(name, "no-"); found {
+		if fv, found := fc.flags[n]; found {
+			return n, fv, true
+		}
+	}
+
+	return "", flagVersions{}, false
+}
+
+// ValidateArgs verifies that the additional arguments are supported by the
+// given version of the component and that no flag is set more than once.
+// The path is used to locate the invalid arguments in the error messages
+// (e.g. "spec.additionalArgs").
+//
+// The conflicts with the arguments managed by the operator are detected by
+// BuildArgs.
+func (fc *FlagCatalog) ValidateArgs(path string, version semver.Version, args []monitoringv1.Argument) error {
+	var (
+		errs []error
+		seen = make(map[string]int, len(args))
+	)
+
+	for i, arg := range args {
+		name, fv, found := fc.lookup(arg.Name)
+		if !found {
+			// The flag may have been added after the latest version of the
+			// catalog.
+			if version.LTE(fc.latest) {
+				errs = append(errs, fmt.Errorf("%s[%d]: unknown flag %q for %s %s", path, i, arg.Name, fc.component, version))
+			}
+
+			name = arg.Name
+		}
+
+		if j, found := seen[name]; found {
+			errs = append(errs, fmt.Errorf("%s[%d]: flag %q already set by %s[%d]", path, i, arg.Name, path, j))
+			continue
+		}
+		seen[name] = i
+
+		if fv.since != "" && version.LT(semver.MustParse(fv.since)) {
+			errs = append(errs, fmt.Errorf("%s[%d]: flag %q requires %s >= %s (got %s)", path, i, arg.Name, fc.component, fv.since, version))
+		}
+
+		if fv.until != "" && version.GTE(semver.MustParse(fv.until)) {
+			errs = append(errs, fmt.Errorf("%s[%d]: flag %q isn't supported by %s >= %s (got %s)", path, i, arg.Name, fc.component, fv.until, version))
+		}
+	}
+
+	return errors.Join(errs...)
+}
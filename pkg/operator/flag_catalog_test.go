@@ -0,0 +1,107 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/require"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestFlagCatalogValidateArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		catalog *FlagCatalog
+		version string
+		args    []monitoringv1.Argument
+		errs    []string
+	}{
+		{
+			name:    "known flags",
+			catalog: PrometheusServerFlags,
+			version: "3.0.0",
+			args: []monitoringv1.Argument{
+				{Name: "storage.tsdb.no-lockfile"},
+				{Name: "no-storage.tsdb.wal-compression"},
+				{Name: "query.max-samples", Value: "1000"},
+			},
+		},
+		{
+			name:    "unknown flag",
+			catalog: PrometheusServerFlags,
+			version: "3.0.0",
+			args:    []monitoringv1.Argument{{Name: "storage.tsdb.retention-time", Value: "1d"}},
+			errs:    []string{`spec.additionalArgs[0]: unknown flag "storage.tsdb.retention-time" for Prometheus 3.0.0`},
+		},
+		{
+			name:    "unknown flag for a version more recent than the catalog",
+			catalog: PrometheusServerFlags,
+			version: "99.0.0",
+			args:    []monitoringv1.Argument{{Name: "new-flag"}},
+		},
+		{
+			name:    "server flag in agent mode",
+			catalog: PrometheusAgentFlags,
+			version: "3.0.0",
+			args:    []monitoringv1.Argument{{Name: "query.timeout", Value: "1m"}},
+			errs:    []string{`spec.additionalArgs[0]: unknown flag "query.timeout" for Prometheus 3.0.0`},
+		},
+		{
+			name:    "flag not supported yet",
+			catalog: AlertmanagerFlags,
+			version: "0.25.0",
+			args:    []monitoringv1.Argument{{Name: "cluster.label", Value: "foo"}},
+			errs:    []string{`spec.additionalArgs[0]: flag "cluster.label" requires Alertmanager >= 0.26.0 (got 0.25.0)`},
+		},
+		{
+			name:    "flag not supported anymore",
+			catalog: PrometheusServerFlags,
+			version: "3.1.0",
+			args:    []monitoringv1.Argument{{Name: "storage.tsdb.allow-overlapping-blocks"}},
+			errs:    []string{`spec.additionalArgs[0]: flag "storage.tsdb.allow-overlapping-blocks" isn't supported by Prometheus >= 3.0.0 (got 3.1.0)`},
+		},
+		{
+			name:    "duplicate flags",
+			catalog: ThanosRulerFlags,
+			version: "0.39.0",
+			args: []monitoringv1.Argument{
+				{Name: "tsdb.wal-compression"},
+				{Name: "label", Value: "a=\"b\""},
+				{Name: "no-tsdb.wal-compression"},
+				{Name: "unknown"},
+			},
+			errs: []string{
+				`spec.additionalArgs[2]: flag "no-tsdb.wal-compression" already set by spec.additionalArgs[0]`,
+				`spec.additionalArgs[3]: unknown flag "unknown" for Thanos ruler 0.39.0`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.catalog.ValidateArgs("spec.additionalArgs", semver.MustParse(tc.version), tc.args)
+			if len(tc.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, e := range tc.errs {
+				require.Contains(t, err.Error(), e)
+			}
+		})
+	}
+}
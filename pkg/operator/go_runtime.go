@@ -0,0 +1,73 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	goMemLimitEnvVar = "GOMEMLIMIT"
+	goMaxProcsEnvVar = "GOMAXPROCS"
+)
+
+// GoRuntimeConfig defines how the operator configures the Go runtime of the
+// Prometheus, Alertmanager and Thanos containers from their resource limits.
+type GoRuntimeConfig struct {
+	// Ratio of the memory limit used for the GOMEMLIMIT environment
+	// variable. A value of 0 disables it.
+	MemLimitRatio float64
+	// Whether the GOMAXPROCS environment variable is set from the CPU
+	// limit.
+	MaxProcs bool
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c GoRuntimeConfig) Validate() error {
+	if c.MemLimitRatio < 0 || c.MemLimitRatio >= 1 {
+		return fmt.Errorf("the GOMEMLIMIT ratio should be greater than or equal to 0.0 and less than 1.0, got %v", c.MemLimitRatio)
+	}
+
+	return nil
+}
+
+// EnvVars returns the GOMEMLIMIT and GOMAXPROCS environment variables for a
+// container with the given resource requirements. The variables are omitted
+// when the respective limit isn't defined.
+func (c GoRuntimeConfig) EnvVars(res v1.ResourceRequirements) []v1.EnvVar {
+	var envVars []v1.EnvVar
+
+	if mem, found := res.Limits[v1.ResourceMemory]; found && c.MemLimitRatio > 0 && !mem.IsZero() {
+		envVars = append(envVars, v1.EnvVar{
+			Name:  goMemLimitEnvVar,
+			Value: strconv.FormatInt(int64(float64(mem.Value())*c.MemLimitRatio), 10),
+		})
+	}
+
+	if cpu, found := res.Limits[v1.ResourceCPU]; found && c.MaxProcs && !cpu.IsZero() {
+		// Round up to the next integer (e.g. 1500m gives 2) since the
+		// container can use its full CPU quota.
+		procs := max((cpu.MilliValue()+999)/1000, 1)
+		envVars = append(envVars, v1.EnvVar{
+			Name:  goMaxProcsEnvVar,
+			Value: strconv.FormatInt(procs, 10),
+		})
+	}
+
+	return envVars
+}
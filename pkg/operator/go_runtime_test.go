@@ -0,0 +1,88 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestGoRuntimeEnvVars(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		config   GoRuntimeConfig
+		limits   v1.ResourceList
+		expected []v1.EnvVar
+	}{
+		{
+			name: "disabled",
+			limits: v1.ResourceList{
+				v1.ResourceMemory: resource.MustParse("1Gi"),
+				v1.ResourceCPU:    resource.MustParse("2"),
+			},
+		},
+		{
+			name:   "no limits",
+			config: GoRuntimeConfig{MemLimitRatio: 0.9, MaxProcs: true},
+		},
+		{
+			name:   "memory and cpu limits",
+			config: GoRuntimeConfig{MemLimitRatio: 0.9, MaxProcs: true},
+			limits: v1.ResourceList{
+				v1.ResourceMemory: resource.MustParse("1Gi"),
+				v1.ResourceCPU:    resource.MustParse("2"),
+			},
+			expected: []v1.EnvVar{
+				{Name: "GOMEMLIMIT", Value: "966367641"},
+				{Name: "GOMAXPROCS", Value: "2"},
+			},
+		},
+		{
+			name:   "fractional cpu limit",
+			config: GoRuntimeConfig{MaxProcs: true},
+			limits: v1.ResourceList{
+				v1.ResourceMemory: resource.MustParse("1Gi"),
+				v1.ResourceCPU:    resource.MustParse("1500m"),
+			},
+			expected: []v1.EnvVar{
+				{Name: "GOMAXPROCS", Value: "2"},
+			},
+		},
+		{
+			name:   "cpu limit lower than 1",
+			config: GoRuntimeConfig{MaxProcs: true},
+			limits: v1.ResourceList{
+				v1.ResourceCPU: resource.MustParse("100m"),
+			},
+			expected: []v1.EnvVar{
+				{Name: "GOMAXPROCS", Value: "1"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.config.EnvVars(v1.ResourceRequirements{Limits: tc.limits}))
+		})
+	}
+}
+
+func TestGoRuntimeConfigValidate(t *testing.T) {
+	require.NoError(t, GoRuntimeConfig{}.Validate())
+	require.NoError(t, GoRuntimeConfig{MemLimitRatio: 0.85}.Validate())
+	require.Error(t, GoRuntimeConfig{MemLimitRatio: 1}.Validate())
+	require.Error(t, GoRuntimeConfig{MemLimitRatio: -0.1}.Validate())
+}
@@ -19,8 +19,13 @@ import (
 	"compress/gzip"
 	"io"
 	"strings"
+
+	"github.com/klauspost/compress/zstd"
 )
 
+// zstdMagic are the first bytes of a zstd frame.
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
 func GzipConfig(w io.Writer, conf []byte) error {
 	if len(conf) == 0 {
 		return nil
@@ -47,3 +52,43 @@ func GunzipConfig(b []byte) (string, error) {
 	}
 	return uncompressed.String(), nil
 }
+
+// ZstdConfig writes the configuration compressed with zstd to w.
+func ZstdConfig(w io.Writer, conf []byte) error {
+	if len(conf) == 0 {
+		return nil
+	}
+
+	enc, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+	if err != nil {
+		return err
+	}
+
+	if _, err := enc.Write(conf); err != nil {
+		enc.Close()
+		return err
+	}
+
+	return enc.Close()
+}
+
+// DecompressConfig returns the decompressed configuration. The compression
+// (gzip or zstd) is detected from the content.
+func DecompressConfig(b []byte) (string, error) {
+	if !bytes.HasPrefix(b, zstdMagic) {
+		return GunzipConfig(b)
+	}
+
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return "", err
+	}
+	defer dec.Close()
+
+	uncompressed, err := dec.DecodeAll(b, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(uncompressed), nil
+}
@@ -33,3 +33,26 @@ func TestGzipConfig(t *testing.T) {
 		t.Errorf("gzip data is incorrect - %s", "foo")
 	}
 }
+
+func TestDecompressConfig(t *testing.T) {
+	for name, compress := range map[string]func(*bytes.Buffer, []byte) error{
+		"gzip": func(buf *bytes.Buffer, b []byte) error { return GzipConfig(buf, b) },
+		"zstd": func(buf *bytes.Buffer, b []byte) error { return ZstdConfig(buf, b) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := compress(&buf, []byte("aaa")); err != nil {
+				t.Fatalf("failed to compress config: %v", err)
+			}
+
+			uncompressed, err := DecompressConfig(buf.Bytes())
+			if err != nil {
+				t.Fatalf("failed to decompress config: %v", err)
+			}
+
+			if uncompressed != "aaa" {
+				t.Errorf("expected %q, got %q", "aaa", uncompressed)
+			}
+		})
+	}
+}
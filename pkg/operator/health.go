@@ -0,0 +1,174 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+)
+
+// HealthCheckFunc returns an error when the component isn't healthy.
+type HealthCheckFunc func(context.Context) error
+
+// HealthStatus is the response of the HealthChecker handler.
+type HealthStatus struct {
+	// Status is "ok" when all the checks succeeded, "failed" otherwise.
+	Status string `json:"status"`
+	// Checks lists the result of each check.
+	Checks []HealthCheckResult `json:"checks"`
+}
+
+// HealthCheckResult is the result of a health check.
+type HealthCheckResult struct {
+	// Name of the component.
+	Name string `json:"name"`
+	// Status is "ok" when the check succeeded, "failed" otherwise.
+	Status string `json:"status"`
+	// Error returned by the check (if any).
+	Error string `json:"error,omitempty"`
+}
+
+const (
+	healthStatusOK     = "ok"
+	healthStatusFailed = "failed"
+)
+
+type namedHealthCheck struct {
+	name  string
+	check HealthCheckFunc
+}
+
+// HealthChecker runs the health checks of the operator's components. It
+// implements the http.Handler interface and replies with a 503 status code
+// when at least one check fails. The response body details the result of
+// each check.
+type HealthChecker struct {
+	timeout time.Duration
+
+	mtx    sync.RWMutex
+	checks []namedHealthCheck
+}
+
+// NewHealthChecker returns a HealthChecker which cancels the checks after the
+// given timeout.
+func NewHealthChecker(timeout time.Duration) *HealthChecker {
+	return &HealthChecker{timeout: timeout}
+}
+
+// Register adds a named check.
+func (hc *HealthChecker) Register(name string, check HealthCheckFunc) {
+	hc.mtx.Lock()
+	defer hc.mtx.Unlock()
+
+	hc.checks = append(hc.checks, namedHealthCheck{name: name, check: check})
+}
+
+// Check runs all the checks concurrently.
+func (hc *HealthChecker) Check(ctx context.Context) HealthStatus {
+	hc.mtx.RLock()
+	checks := hc.checks
+	hc.mtx.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, hc.timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	results := make([]HealthCheckResult, len(checks))
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			results[i] = HealthCheckResult{Name: c.name, Status: healthStatusOK}
+			if err := c.check(ctx); err != nil {
+				results[i].Status = healthStatusFailed
+				results[i].Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	status := HealthStatus{Status: healthStatusOK, Checks: results}
+	for _, r := range results {
+		if r.Status != healthStatusOK {
+			status.Status = healthStatusFailed
+			break
+		}
+	}
+
+	return status
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (hc *HealthChecker) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	status := hc.Check(req.Context())
+
+	w.Header().Set("Content-Type", "application/json")
+	if status.Status != healthStatusOK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+// NamedInformer associates an informer with the name of the resource that
+// it watches.
+type NamedInformer struct {
+	Name     string
+	Informer cache.SharedIndexInformer
+}
+
+// NamedInformers is a list of informers.
+type NamedInformers []NamedInformer
+
+// AppendForResource appends the informers of the resource. It is a no-op if
+// infs is nil (e.g. when the CRD isn't installed).
+func (ni NamedInformers) AppendForResource(name string, infs *informers.ForResource) NamedInformers {
+	if infs == nil {
+		return ni
+	}
+
+	for _, inf := range infs.GetInformers() {
+		ni = append(ni, NamedInformer{Name: name, Informer: inf.Informer()})
+	}
+
+	return ni
+}
+
+// CheckSynced returns an error listing the resources for which at least one
+// informer hasn't synced yet.
+func (ni NamedInformers) CheckSynced() error {
+	var unsynced []string
+	for _, n := range ni {
+		if !n.Informer.HasSynced() && !slices.Contains(unsynced, n.Name) {
+			unsynced = append(unsynced, n.Name)
+		}
+	}
+
+	if len(unsynced) > 0 {
+		return fmt.Errorf("informers not synced: %s", strings.Join(unsynced, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthChecker(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		checks map[string]HealthCheckFunc
+
+		code     int
+		expected HealthStatus
+	}{
+		{
+			name: "no checks",
+			code: http.StatusOK,
+			expected: HealthStatus{
+				Status: healthStatusOK,
+				Checks: []HealthCheckResult{},
+			},
+		},
+		{
+			name: "all checks succeed",
+			checks: map[string]HealthCheckFunc{
+				"kubernetes-api": func(context.Context) error { return nil },
+			},
+			code: http.StatusOK,
+			expected: HealthStatus{
+				Status: healthStatusOK,
+				Checks: []HealthCheckResult{
+					{Name: "kubernetes-api", Status: healthStatusOK},
+				},
+			},
+		},
+		{
+			name: "failed check",
+			checks: map[string]HealthCheckFunc{
+				"prometheus": func(context.Context) error { return errors.New("informers not synced: Secret") },
+			},
+			code: http.StatusServiceUnavailable,
+			expected: HealthStatus{
+				Status: healthStatusFailed,
+				Checks: []HealthCheckResult{
+					{Name: "prometheus", Status: healthStatusFailed, Error: "informers not synced: Secret"},
+				},
+			},
+		},
+		{
+			name: "check timeout",
+			checks: map[string]HealthCheckFunc{
+				"kubernetes-api": func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			},
+			code: http.StatusServiceUnavailable,
+			expected: HealthStatus{
+				Status: healthStatusFailed,
+				Checks: []HealthCheckResult{
+					{Name: "kubernetes-api", Status: healthStatusFailed, Error: context.DeadlineExceeded.Error()},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			hc := NewHealthChecker(100 * time.Millisecond)
+			for name, check := range tc.checks {
+				hc.Register(name, check)
+			}
+
+			w := httptest.NewRecorder()
+			hc.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+			var status HealthStatus
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&status))
+			require.Equal(t, tc.expected, status)
+		})
+	}
+}
+
+func TestNamedInformersCheckSynced(t *testing.T) {
+	ni := NamedInformers{
+		{Name: "Prometheus", Informer: &fakeInformer{synced: true}},
+		{Name: "Secret", Informer: &fakeInformer{}},
+		{Name: "Secret", Informer: &fakeInformer{}},
+		{Name: "ConfigMap", Informer: &fakeInformer{}},
+	}
+	require.EqualError(t, ni.CheckSynced(), "informers not synced: Secret, ConfigMap")
+
+	require.Equal(t, ni, ni.AppendForResource("ScrapeConfig", nil))
+}
@@ -0,0 +1,167 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// LeaderElectionConfig defines the settings of the leader election.
+type LeaderElectionConfig struct {
+	// Whether the leader election is enabled.
+	Enabled bool
+	// Name and namespace of the Lease object.
+	LeaseName      string
+	LeaseNamespace string
+	// Duration that non-leader candidates will wait before trying to acquire
+	// the leadership.
+	LeaseDuration time.Duration
+	// Duration that the leader will retry refreshing the leadership before
+	// giving it up.
+	RenewDeadline time.Duration
+	// Duration between the attempts to acquire or renew the leadership.
+	RetryPeriod time.Duration
+}
+
+// ErrLeaderElectionLost is returned by RunWithLeaderElection when the process
+// loses the leadership.
+var ErrLeaderElectionLost = errors.New("leader election lost")
+
+// RunWithLeaderElection blocks until the process acquires the leadership and
+// then executes run. It returns ErrLeaderElectionLost if the leadership is
+// lost before the context is canceled.
+//
+// The prometheus_operator_leader gauge reports whether the process is the
+// leader.
+func RunWithLeaderElection(ctx context.Context, logger *slog.Logger, kclient kubernetes.Interface, r prometheus.Registerer, config LeaderElectionConfig, run func(context.Context) error) error {
+	namespace := config.LeaseNamespace
+	if namespace == "" {
+		namespace = inClusterNamespace()
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("failed to get the hostname: %w", err)
+	}
+	identity := hostname + "_" + string(uuid.NewUUID())
+
+	lock, err := resourcelock.New(
+		resourcelock.LeasesResourceLock,
+		namespace,
+		config.LeaseName,
+		kclient.CoreV1(),
+		kclient.CoordinationV1(),
+		resourcelock.ResourceLockConfig{Identity: identity},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create the resource lock: %w", err)
+	}
+
+	leader := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "prometheus_operator_leader",
+		Help: "1 if the operator is the leader, 0 otherwise.",
+	})
+	r.MustRegister(leader)
+
+	var (
+		started = make(chan struct{})
+		runErr  = make(chan error, 1)
+	)
+
+	// The leader election stops when run returns.
+	leCtx, leCancel := context.WithCancel(ctx)
+	defer leCancel()
+
+	logger = logger.With("lease", namespace+"/"+config.LeaseName, "identity", identity)
+	logger.Info("waiting for the leadership")
+
+	leaderelection.RunOrDie(leCtx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   config.LeaseDuration,
+		RenewDeadline:   config.RenewDeadline,
+		RetryPeriod:     config.RetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            config.LeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				logger.Info("acquired the leadership")
+				leader.Set(1)
+				close(started)
+
+				runErr <- run(ctx)
+				leCancel()
+			},
+			OnStoppedLeading: func() {
+				leader.Set(0)
+			},
+			OnNewLeader: func(id string) {
+				if id != identity {
+					logger.Info("new leader elected", "leader", id)
+				}
+			},
+		},
+	})
+
+	select {
+	case <-started:
+	default:
+		// The context has been canceled before acquiring the leadership.
+		return nil
+	}
+
+	select {
+	case err := <-runErr:
+		// run returned before the leadership was lost.
+		return err
+	default:
+	}
+
+	if ctx.Err() != nil {
+		// Wait for the controllers to shut down.
+		return <-runErr
+	}
+
+	logger.Error("lost the leadership")
+	return ErrLeaderElectionLost
+}
+
+// inClusterNamespace returns the namespace of the service account or
+// "default" if it can't be determined.
+func inClusterNamespace() string {
+	b, err := os.ReadFile(serviceAccountNamespaceFile)
+	if err != nil {
+		return "default"
+	}
+
+	if ns := strings.TrimSpace(string(b)); ns != "" {
+		return ns
+	}
+
+	return "default"
+}
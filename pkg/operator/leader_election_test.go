@@ -0,0 +1,77 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRunWithLeaderElection(t *testing.T) {
+	kclient := fake.NewClientset()
+	config := LeaderElectionConfig{
+		Enabled:        true,
+		LeaseName:      "prometheus-operator",
+		LeaseNamespace: "monitoring",
+		LeaseDuration:  15 * time.Second,
+		RenewDeadline:  10 * time.Second,
+		RetryPeriod:    2 * time.Second,
+	}
+
+	var (
+		r      = prometheus.NewRegistry()
+		runErr = errors.New("run error")
+	)
+	err := RunWithLeaderElection(context.Background(), promslog.NewNopLogger(), kclient, r, config, func(ctx context.Context) error {
+		lease, err := kclient.CoordinationV1().Leases("monitoring").Get(ctx, "prometheus-operator", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, lease.Spec.HolderIdentity)
+
+		mfs, err := r.Gather()
+		require.NoError(t, err)
+		require.Len(t, mfs, 1)
+		require.Equal(t, 1.0, mfs[0].GetMetric()[0].GetGauge().GetValue())
+
+		return runErr
+	})
+	require.ErrorIs(t, err, runErr)
+}
+
+func TestRunWithLeaderElectionCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var called bool
+	err := RunWithLeaderElection(ctx, promslog.NewNopLogger(), fake.NewClientset(), prometheus.NewRegistry(), LeaderElectionConfig{
+		LeaseName:      "prometheus-operator",
+		LeaseNamespace: "monitoring",
+		LeaseDuration:  15 * time.Second,
+		RenewDeadline:  10 * time.Second,
+		RetryPeriod:    2 * time.Second,
+	}, func(context.Context) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, err)
+	require.False(t, called)
+}
@@ -18,6 +18,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,12 +37,20 @@ import (
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/scheme"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 )
 
 const (
 	PrometheusOperatorFieldManager = "PrometheusOperator"
 
-	InvalidConfigurationEvent = "InvalidConfiguration"
+	InvalidConfigurationEvent   = "InvalidConfiguration"
+	DuplicateTargetsEvent       = "DuplicateTargets"
+	UnwatchedSecretsEvent       = "UnwatchedSecrets"
+	StatefulSetRecreatedEvent   = "StatefulSetRecreated"
+	ConfigGenerationFailedEvent = "ConfigGenerationFailed"
+	SecretTooLargeEvent         = "SecretTooLarge"
+	RejectedRulesEvent          = "RejectedPrometheusRules"
+	UnsupportedRuleFieldsEvent  = "UnsupportedRuleFields"
 )
 
 var (
@@ -55,10 +66,35 @@ var (
 		[]string{"resource", "state"},
 		nil,
 	)
+	generatedConfigSizeDesc = prometheus.NewDesc(
+		"prometheus_operator_generated_config_size_bytes",
+		"Size of the configuration generated per object before (none) and after (gzip) compression. The resource_hash label is the hash of the object's \"<namespace>/<name>\" key (see ResourceKeyHash)",
+		[]string{"resource_hash", "compression"},
+		nil,
+	)
+	generatedRuleConfigMapsDesc = prometheus.NewDesc(
+		"prometheus_operator_generated_rule_configmaps",
+		"Number of rule ConfigMaps generated per object",
+		[]string{"resource_hash"},
+		nil,
+	)
+	generatedRuleConfigMapsSizeDesc = prometheus.NewDesc(
+		"prometheus_operator_generated_rule_configmaps_size_bytes",
+		"Total size of the rule files generated per object",
+		[]string{"resource_hash"},
+		nil,
+	)
+	generatedTLSAssetsDesc = prometheus.NewDesc(
+		"prometheus_operator_generated_tls_assets",
+		"Number of TLS assets (certificates and keys) mounted per object",
+		[]string{"resource_hash"},
+		nil,
+	)
 )
 
 type ReconciliationStatus struct {
-	err error
+	err  error
+	time time.Time
 }
 
 func (rs ReconciliationStatus) Reason() string {
@@ -66,7 +102,7 @@ func (rs ReconciliationStatus) Reason() string {
 		return ""
 	}
 
-	return "ReconciliationFailed"
+	return k8sutil.ErrorCategoryOf(rs.err).Reason()
 }
 
 func (rs ReconciliationStatus) Message() string {
@@ -88,6 +124,9 @@ type ReconciliationTracker struct {
 	// mtx protects all fields below.
 	mtx            sync.RWMutex
 	statusByObject map[string]ReconciliationStatus
+	// warningByObject stores the warnings (message by reason) which don't
+	// prevent the reconciliation.
+	warningByObject map[string]map[string]string
 }
 
 // SetStatus updates the last reconciliation status for the given object.
@@ -97,9 +136,50 @@ func (rt *ReconciliationTracker) SetStatus(k string, err error) {
 
 	rt.once.Do(func() {
 		rt.statusByObject = map[string]ReconciliationStatus{}
+		rt.warningByObject = map[string]map[string]string{}
 	})
 
-	rt.statusByObject[k] = ReconciliationStatus{err: err}
+	rt.statusByObject[k] = ReconciliationStatus{err: err, time: time.Now().UTC()}
+}
+
+// SetWarning records a warning for the given object. The warning is reported
+// by the Reconciled condition when the reconciliation succeeds. An empty
+// message clears the warning with the given reason.
+func (rt *ReconciliationTracker) SetWarning(k string, reason, message string) {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+
+	rt.once.Do(func() {
+		rt.statusByObject = map[string]ReconciliationStatus{}
+		rt.warningByObject = map[string]map[string]string{}
+	})
+
+	if message == "" {
+		delete(rt.warningByObject[k], reason)
+		if len(rt.warningByObject[k]) == 0 {
+			delete(rt.warningByObject, k)
+		}
+		return
+	}
+
+	if rt.warningByObject[k] == nil {
+		rt.warningByObject[k] = map[string]string{}
+	}
+	rt.warningByObject[k][reason] = message
+}
+
+// ReportUnwatchedSecrets records a warning and emits an event for the object
+// when its reconciliation depends on Secrets which aren't watched by the
+// operator. It clears the warning when the list of Secrets is empty.
+func ReportUnwatchedSecrets(rt *ReconciliationTracker, recorder record.EventRecorder, obj runtime.Object, key string, secrets []string) {
+	if len(secrets) == 0 {
+		rt.SetWarning(key, UnwatchedSecretsEvent, "")
+		return
+	}
+
+	msg := fmt.Sprintf("The following Secrets don't match the --secret-label-selector and --secret-field-selector flags, the operator doesn't detect their changes: %s", strings.Join(secrets, ", "))
+	rt.SetWarning(key, UnwatchedSecretsEvent, msg)
+	recorder.Event(obj, v1.EventTypeWarning, UnwatchedSecretsEvent, msg)
 }
 
 // GetStatus returns the last reconciliation status for the given object.
@@ -116,6 +196,13 @@ func (rt *ReconciliationTracker) getStatus(k string) (ReconciliationStatus, bool
 	return s, true
 }
 
+// LastReconcileTime returns the time of the last reconciliation of the given
+// object. The second value indicates whether the object is known or not.
+func (rt *ReconciliationTracker) LastReconcileTime(k string) (time.Time, bool) {
+	s, found := rt.getStatus(k)
+	return s.time, found
+}
+
 // GetCondition returns a monitoringv1.Condition for the last-known
 // reconciliation status of the given object.
 func (rt *ReconciliationTracker) GetCondition(k string, gen int64) monitoringv1.Condition {
@@ -139,6 +226,21 @@ func (rt *ReconciliationTracker) GetCondition(k string, gen int64) monitoringv1.
 		}
 		condition.Reason = reconciliationStatus.Reason()
 		condition.Message = reconciliationStatus.Message()
+
+		rt.mtx.RLock()
+		warnings := rt.warningByObject[k]
+		if reconciliationStatus.Ok() && len(warnings) > 0 {
+			// The condition reports the reason of the first warning and
+			// the messages of all the warnings.
+			reasons := slices.Sorted(maps.Keys(warnings))
+			messages := make([]string, 0, len(reasons))
+			for _, reason := range reasons {
+				messages = append(messages, warnings[reason])
+			}
+			condition.Reason = reasons[0]
+			condition.Message = strings.Join(messages, " ")
+		}
+		rt.mtx.RUnlock()
 	}
 
 	return condition
@@ -155,6 +257,7 @@ func (rt *ReconciliationTracker) ForgetObject(k string) {
 	}
 
 	delete(rt.statusByObject, k)
+	delete(rt.warningByObject, k)
 }
 
 // Describe implements the prometheus.Collector interface.
@@ -198,17 +301,34 @@ type Metrics struct {
 	listFailedCounter      prometheus.Counter
 	watchCounter           prometheus.Counter
 	watchFailedCounter     prometheus.Counter
-	stsDeleteCreateCounter prometheus.Counter
+	stsDeleteCreateCounter *prometheus.CounterVec
 	// triggerByCounter is a set of counters keeping track of the amount
 	// of times Prometheus Operator was triggered to reconcile its created
 	// objects. It is split in the dimensions of Kubernetes objects and
 	// corresponding actions (add, delete, update).
 	triggerByCounter *prometheus.CounterVec
 	ready            prometheus.Gauge
+	// configGenerationDuration tracks the duration of the configuration
+	// generation per object.
+	configGenerationDuration *prometheus.HistogramVec
 
 	// mtx protects all fields below.
 	mtx       sync.RWMutex
 	resources map[resourceKey]map[string]int
+	// Sizes of the artifacts generated per object's key.
+	configSizes    map[string]configSize
+	ruleConfigMaps map[string]ruleConfigMaps
+	tlsAssets      map[string]int
+}
+
+type configSize struct {
+	size           int
+	compressedSize int
+}
+
+type ruleConfigMaps struct {
+	count int
+	size  int
 }
 
 type resourceKey struct {
@@ -225,10 +345,10 @@ func NewMetrics(r prometheus.Registerer) *Metrics {
 			Help: "Number of times a Kubernetes object add, delete or update event" +
 				" triggered the Prometheus Operator to reconcile an object",
 		}, []string{"triggered_by", "action"}),
-		stsDeleteCreateCounter: prometheus.NewCounter(prometheus.CounterOpts{
+		stsDeleteCreateCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: "prometheus_operator_reconcile_sts_delete_create_total",
-			Help: "Number of times that reconciling a statefulset required deleting and re-creating it",
-		}),
+			Help: "Number of times that reconciling a statefulset required deleting and re-creating it by immutable field",
+		}, []string{"reason"}),
 		listCounter: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "prometheus_operator_list_operations_total",
 			Help: "Total number of list operations",
@@ -249,8 +369,16 @@ func NewMetrics(r prometheus.Registerer) *Metrics {
 			Name: "prometheus_operator_ready",
 			Help: "1 when the controller is ready to reconcile resources, 0 otherwise",
 		}),
-
-		resources: make(map[resourceKey]map[string]int),
+		configGenerationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "prometheus_operator_config_generation_duration_seconds",
+			Help:    "Histogram of the configuration generation duration per object. The resource_hash label is the hash of the object's \"<namespace>/<name>\" key (see ResourceKeyHash)",
+			Buckets: []float64{.01, .05, .1, .5, 1, 5},
+		}, []string{"resource_hash"}),
+
+		resources:      make(map[resourceKey]map[string]int),
+		configSizes:    make(map[string]configSize),
+		ruleConfigMaps: make(map[string]ruleConfigMaps),
+		tlsAssets:      make(map[string]int),
 	}
 
 	m.reg.MustRegister(
@@ -261,9 +389,14 @@ func NewMetrics(r prometheus.Registerer) *Metrics {
 		m.watchCounter,
 		m.watchFailedCounter,
 		m.ready,
+		m.configGenerationDuration,
 		&m,
 	)
 
+	for _, reason := range statefulSetRecreationReasons {
+		m.stsDeleteCreateCounter.WithLabelValues(reason)
+	}
+
 	return &m
 }
 
@@ -282,9 +415,10 @@ func NewEventRecorderFactory(emitEvents bool) EventRecorderFactory {
 	}
 }
 
-// StsDeleteCreateCounter returns a counter to track statefulset's recreations.
-func (m *Metrics) StsDeleteCreateCounter() prometheus.Counter {
-	return m.stsDeleteCreateCounter
+// StsDeleteCreateCounter returns a counter to track statefulset's recreations
+// for the given reason (e.g. the immutable field which changed).
+func (m *Metrics) StsDeleteCreateCounter(reason string) prometheus.Counter {
+	return m.stsDeleteCreateCounter.WithLabelValues(reason)
 }
 
 type HandlerEvent string
@@ -339,6 +473,52 @@ func (m *Metrics) setResources(objKey string, resKey resourceKey, v int) {
 	m.resources[resKey][objKey] = v
 }
 
+// SetGeneratedConfigSize sets the size of the configuration generated for the
+// given object's key before and after compression.
+func (m *Metrics) SetGeneratedConfigSize(objKey string, size, compressedSize int) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.configSizes[objKey] = configSize{size: size, compressedSize: compressedSize}
+}
+
+// SetGeneratedRuleConfigMaps sets the number of rule ConfigMaps and the total
+// size of the rule files generated for the given object's key.
+func (m *Metrics) SetGeneratedRuleConfigMaps(objKey string, count, size int) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.ruleConfigMaps[objKey] = ruleConfigMaps{count: count, size: size}
+}
+
+// SetGeneratedTLSAssets sets the number of TLS assets mounted for the given
+// object's key.
+func (m *Metrics) SetGeneratedTLSAssets(objKey string, count int) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.tlsAssets[objKey] = count
+}
+
+// ObserveConfigGeneration records the duration of the configuration
+// generation for the given object's key.
+func (m *Metrics) ObserveConfigGeneration(objKey string, d time.Duration) {
+	m.configGenerationDuration.WithLabelValues(ResourceKeyHash(objKey)).Observe(d.Seconds())
+}
+
+// ForgetGeneratedArtifacts removes the metrics of the artifacts generated for
+// the given object's key.
+func (m *Metrics) ForgetGeneratedArtifacts(objKey string) {
+	m.configGenerationDuration.DeleteLabelValues(ResourceKeyHash(objKey))
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.configSizes, objKey)
+	delete(m.ruleConfigMaps, objKey)
+	delete(m.tlsAssets, objKey)
+}
+
 // Ready returns a gauge to track whether the controller is ready or not.
 func (m *Metrics) Ready() prometheus.Gauge {
 	return m.ready
@@ -352,6 +532,10 @@ func (m *Metrics) MustRegister(metrics ...prometheus.Collector) {
 // Describe implements the prometheus.Collector interface.
 func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
 	ch <- resourcesDesc
+	ch <- generatedConfigSizeDesc
+	ch <- generatedRuleConfigMapsDesc
+	ch <- generatedRuleConfigMapsSizeDesc
+	ch <- generatedTLSAssetsDesc
 }
 
 // Collect implements the prometheus.Collector interface.
@@ -372,6 +556,22 @@ func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
 			rKey.state.String(),
 		)
 	}
+
+	for objKey, cs := range m.configSizes {
+		hash := ResourceKeyHash(objKey)
+		ch <- prometheus.MustNewConstMetric(generatedConfigSizeDesc, prometheus.GaugeValue, float64(cs.size), hash, "none")
+		ch <- prometheus.MustNewConstMetric(generatedConfigSizeDesc, prometheus.GaugeValue, float64(cs.compressedSize), hash, "gzip")
+	}
+
+	for objKey, rcm := range m.ruleConfigMaps {
+		hash := ResourceKeyHash(objKey)
+		ch <- prometheus.MustNewConstMetric(generatedRuleConfigMapsDesc, prometheus.GaugeValue, float64(rcm.count), hash)
+		ch <- prometheus.MustNewConstMetric(generatedRuleConfigMapsSizeDesc, prometheus.GaugeValue, float64(rcm.size), hash)
+	}
+
+	for objKey, n := range m.tlsAssets {
+		ch <- prometheus.MustNewConstMetric(generatedTLSAssetsDesc, prometheus.GaugeValue, float64(n), ResourceKeyHash(objKey))
+	}
 }
 
 type instrumentedListerWatcher struct {
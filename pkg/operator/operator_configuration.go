@@ -0,0 +1,302 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringinformers "github.com/prometheus-operator/prometheus-operator/pkg/client/informers/externalversions/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+)
+
+// ErrRestartRequired is returned by OperatorConfigurationWatcher.Run when the
+// OperatorConfiguration resource has changed in a way which can't be applied
+// without restarting the operator.
+var ErrRestartRequired = errors.New("the operator configuration requires a restart")
+
+// ApplyOperatorConfiguration returns a copy of the configuration with the
+// settings defined by the OperatorConfiguration spec. The fields of the spec
+// take precedence over the values of the configuration.
+func ApplyOperatorConfiguration(c Config, spec monitoringv1alpha1.OperatorConfigurationSpec) (Config, error) {
+	if images := spec.Images; images != nil {
+		if images.PrometheusDefaultBaseImage != nil {
+			c.PrometheusDefaultBaseImage = *images.PrometheusDefaultBaseImage
+		}
+		if images.AlertmanagerDefaultBaseImage != nil {
+			c.AlertmanagerDefaultBaseImage = *images.AlertmanagerDefaultBaseImage
+		}
+		if images.ThanosDefaultBaseImage != nil {
+			c.ThanosDefaultBaseImage = *images.ThanosDefaultBaseImage
+		}
+		if images.PrometheusConfigReloader != nil {
+			c.ReloaderConfig.Image = *images.PrometheusConfigReloader
+		}
+	}
+
+	if reloader := spec.ConfigReloader; reloader != nil {
+		if reloader.CPURequest != nil {
+			c.ReloaderConfig.CPURequests = Quantity{q: *reloader.CPURequest}
+		}
+		if reloader.CPULimit != nil {
+			c.ReloaderConfig.CPULimits = Quantity{q: *reloader.CPULimit}
+		}
+		if reloader.MemoryRequest != nil {
+			c.ReloaderConfig.MemoryRequests = Quantity{q: *reloader.MemoryRequest}
+		}
+		if reloader.MemoryLimit != nil {
+			c.ReloaderConfig.MemoryLimits = Quantity{q: *reloader.MemoryLimit}
+		}
+		if reloader.EnableProbes != nil {
+			c.ReloaderConfig.EnableProbes = *reloader.EnableProbes
+		}
+	}
+
+	if ns := spec.Namespaces; ns != nil {
+		if len(ns.AllowList) > 0 && len(ns.DenyList) > 0 {
+			return c, errors.New("namespaces: allowList and denyList are mutually exclusive")
+		}
+
+		c.Namespaces = Namespaces{
+			AllowList:                   newStringSet(ns.AllowList),
+			DenyList:                    newStringSet(ns.DenyList),
+			PrometheusAllowList:         newStringSet(ns.PrometheusAllowList),
+			AlertmanagerAllowList:       newStringSet(ns.AlertmanagerAllowList),
+			AlertmanagerConfigAllowList: newStringSet(ns.AlertmanagerConfigAllowList),
+			ThanosRulerAllowList:        newStringSet(ns.ThanosRulerAllowList),
+		}
+	}
+
+	if len(spec.FeatureGates) > 0 {
+		// The feature gates are cloned because the original map may be read
+		// concurrently.
+		gates := FeatureGates{}
+		if c.Gates != nil {
+			gates = maps.Clone(*c.Gates)
+		}
+		if err := gates.UpdateFeatureGates(spec.FeatureGates); err != nil {
+			return c, fmt.Errorf("featureGates: %w", err)
+		}
+		c.Gates = &gates
+	}
+
+	if spec.Labels != nil {
+		c.Labels = maps.Clone(spec.Labels)
+	}
+
+	if spec.Annotations != nil {
+		c.Annotations = maps.Clone(spec.Annotations)
+	}
+
+	if l := spec.Logging; l != nil {
+		settings := logging.Settings{
+			Level:  ptr.Deref(l.Level, ""),
+			Format: ptr.Deref(l.Format, ""),
+		}
+
+		if len(l.Components) > 0 {
+			settings.Components = make(map[string]logging.ComponentSettings, len(l.Components))
+			for name, cl := range l.Components {
+				settings.Components[name] = logging.ComponentSettings{
+					Level:  ptr.Deref(cl.Level, ""),
+					Format: ptr.Deref(cl.Format, ""),
+				}
+			}
+		}
+
+		if err := settings.Validate(); err != nil {
+			return c, fmt.Errorf("logging: %w", err)
+		}
+		c.LogSettings = settings
+	}
+
+	return c, nil
+}
+
+func newStringSet(values []string) StringSet {
+	s := make(StringSet, len(values))
+	for _, v := range values {
+		s.Insert(v)
+	}
+
+	return s
+}
+
+// requiresRestart returns true if the changes between the 2 specs can't be
+// applied without restarting the operator.
+func requiresRestart(old, cur monitoringv1alpha1.OperatorConfigurationSpec) bool {
+	// The namespaces are used to create the informers and the feature gates
+	// are evaluated when the controllers are instantiated.
+	return !equality.Semantic.DeepEqual(old.Namespaces, cur.Namespaces) ||
+		!maps.Equal(old.FeatureGates, cur.FeatureGates)
+}
+
+// OperatorConfigurationWatcher loads the OperatorConfiguration resource and
+// notifies its subscribers when the settings change.
+type OperatorConfigurationWatcher struct {
+	logger  *slog.Logger
+	mclient monitoringclient.Interface
+	name    string
+	// Configuration derived from the command-line flags.
+	base Config
+
+	mtx         sync.Mutex
+	spec        monitoringv1alpha1.OperatorConfigurationSpec
+	subscribers []func(Config)
+}
+
+// NewOperatorConfigurationWatcher returns a watcher for the
+// OperatorConfiguration resource identified by name. The settings of the
+// resource are applied on top of the base configuration.
+func NewOperatorConfigurationWatcher(logger *slog.Logger, mclient monitoringclient.Interface, name string, base Config) *OperatorConfigurationWatcher {
+	return &OperatorConfigurationWatcher{
+		logger:  logger.With("operatorconfiguration", name),
+		mclient: mclient,
+		name:    name,
+		base:    base,
+	}
+}
+
+// Load reads the OperatorConfiguration resource and returns the resulting
+// configuration. A missing resource is equivalent to an empty spec.
+func (w *OperatorConfigurationWatcher) Load(ctx context.Context) (Config, error) {
+	var spec monitoringv1alpha1.OperatorConfigurationSpec
+
+	oc, err := w.mclient.MonitoringV1alpha1().OperatorConfigurations().Get(ctx, w.name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		w.logger.Warn("operator configuration not found, using the command-line flags")
+	case err != nil:
+		return w.base, fmt.Errorf("failed to get the operator configuration: %w", err)
+	default:
+		spec = oc.Spec
+	}
+
+	c, err := ApplyOperatorConfiguration(w.base, spec)
+	if err != nil {
+		return w.base, fmt.Errorf("invalid operator configuration: %w", err)
+	}
+
+	w.mtx.Lock()
+	w.spec = spec
+	w.mtx.Unlock()
+
+	return c, nil
+}
+
+// Subscribe registers a function called every time the configuration
+// changes.
+func (w *OperatorConfigurationWatcher) Subscribe(f func(Config)) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	w.subscribers = append(w.subscribers, f)
+}
+
+// Run watches the OperatorConfiguration resource until the context is
+// canceled. It returns ErrRestartRequired when a change can't be applied
+// while the operator is running.
+func (w *OperatorConfigurationWatcher) Run(ctx context.Context) error {
+	inf := monitoringinformers.NewFilteredOperatorConfigurationInformer(
+		w.mclient,
+		0,
+		cache.Indexers{},
+		func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", w.name).String()
+		},
+	)
+
+	errCh := make(chan error, 1)
+	onChange := func(obj interface{}) {
+		var spec monitoringv1alpha1.OperatorConfigurationSpec
+		if oc, ok := obj.(*monitoringv1alpha1.OperatorConfiguration); ok {
+			if oc.Name != w.name {
+				return
+			}
+			spec = oc.Spec
+		}
+
+		if err := w.update(spec); err != nil {
+			select {
+			case errCh <- err:
+			default:
+			}
+		}
+	}
+
+	if _, err := inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    onChange,
+		UpdateFunc: func(_, cur interface{}) { onChange(cur) },
+		DeleteFunc: func(interface{}) { onChange(nil) },
+	}); err != nil {
+		return fmt.Errorf("failed to add the event handler: %w", err)
+	}
+
+	go inf.Run(ctx.Done())
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
+// update applies the spec and notifies the subscribers.
+func (w *OperatorConfigurationWatcher) update(spec monitoringv1alpha1.OperatorConfigurationSpec) error {
+	w.mtx.Lock()
+	if equality.Semantic.DeepEqual(w.spec, spec) {
+		w.mtx.Unlock()
+		return nil
+	}
+
+	c, err := ApplyOperatorConfiguration(w.base, spec)
+	if err != nil {
+		w.mtx.Unlock()
+		w.logger.Error("ignoring invalid operator configuration", "err", err)
+		return nil
+	}
+
+	if requiresRestart(w.spec, spec) {
+		w.mtx.Unlock()
+		w.logger.Info("the namespaces or the feature gates have changed, restarting the operator")
+		return ErrRestartRequired
+	}
+
+	w.spec = spec
+	subscribers := slices.Clone(w.subscribers)
+	w.mtx.Unlock()
+
+	w.logger.Info("operator configuration updated")
+	for _, f := range subscribers {
+		f(c)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+)
+
+func TestApplyOperatorConfiguration(t *testing.T) {
+	base := DefaultConfig("10m", "50Mi")
+	base.PrometheusDefaultBaseImage = "quay.io/prometheus/prometheus"
+	base.Labels = Map{"team": "flags"}
+
+	c, err := ApplyOperatorConfiguration(base, monitoringv1alpha1.OperatorConfigurationSpec{})
+	require.NoError(t, err)
+	require.Equal(t, base, c)
+
+	c, err = ApplyOperatorConfiguration(base, monitoringv1alpha1.OperatorConfigurationSpec{
+		Images: &monitoringv1alpha1.OperatorImages{
+			PrometheusDefaultBaseImage: ptr.To("registry.example.com/prometheus"),
+			PrometheusConfigReloader:   ptr.To("registry.example.com/prometheus-config-reloader:v0.84.0"),
+		},
+		ConfigReloader: &monitoringv1alpha1.ConfigReloaderSettings{
+			CPULimit:     ptr.To(resource.MustParse("0")),
+			MemoryLimit:  ptr.To(resource.MustParse("100Mi")),
+			EnableProbes: ptr.To(true),
+		},
+		Namespaces: &monitoringv1alpha1.OperatorNamespaces{
+			AllowList: []string{"monitoring"},
+		},
+		FeatureGates: map[string]bool{
+			string(PrometheusAgentDaemonSetFeature): true,
+		},
+		Labels: map[string]string{"team": "platform"},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "registry.example.com/prometheus", c.PrometheusDefaultBaseImage)
+	require.Equal(t, "registry.example.com/prometheus-config-reloader:v0.84.0", c.ReloaderConfig.Image)
+	require.Equal(t, "10m", c.ReloaderConfig.CPURequests.String())
+	require.Equal(t, "0", c.ReloaderConfig.CPULimits.String())
+	require.Equal(t, "100Mi", c.ReloaderConfig.MemoryLimits.String())
+	require.True(t, c.ReloaderConfig.EnableProbes)
+	require.Equal(t, []string{"monitoring"}, c.Namespaces.AllowList.Slice())
+	require.Empty(t, c.Namespaces.DenyList)
+	require.True(t, c.Gates.Enabled(PrometheusAgentDaemonSetFeature))
+	require.Equal(t, Map{"team": "platform"}, c.Labels)
+
+	// The base configuration isn't modified.
+	require.Equal(t, "quay.io/prometheus/prometheus", base.PrometheusDefaultBaseImage)
+	require.False(t, base.Gates.Enabled(PrometheusAgentDaemonSetFeature))
+	require.Equal(t, Map{"team": "flags"}, base.Labels)
+
+	_, err = ApplyOperatorConfiguration(base, monitoringv1alpha1.OperatorConfigurationSpec{
+		FeatureGates: map[string]bool{"UnknownFeature": true},
+	})
+	require.Error(t, err)
+
+	_, err = ApplyOperatorConfiguration(base, monitoringv1alpha1.OperatorConfigurationSpec{
+		Namespaces: &monitoringv1alpha1.OperatorNamespaces{
+			AllowList: []string{"a"},
+			DenyList:  []string{"b"},
+		},
+	})
+	require.Error(t, err)
+
+	c, err = ApplyOperatorConfiguration(base, monitoringv1alpha1.OperatorConfigurationSpec{
+		Logging: &monitoringv1alpha1.OperatorLogging{
+			Level: ptr.To("warn"),
+			Components: map[string]monitoringv1alpha1.ComponentLogging{
+				"prometheus-controller": {Level: ptr.To("debug"), Format: ptr.To("json")},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, logging.Settings{
+		Level: "warn",
+		Components: map[string]logging.ComponentSettings{
+			"prometheus-controller": {Level: "debug", Format: "json"},
+		},
+	}, c.LogSettings)
+
+	_, err = ApplyOperatorConfiguration(base, monitoringv1alpha1.OperatorConfigurationSpec{
+		Logging: &monitoringv1alpha1.OperatorLogging{
+			Components: map[string]monitoringv1alpha1.ComponentLogging{
+				"prometheus-controller": {Level: ptr.To("verbose")},
+			},
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestOperatorConfigurationWatcher(t *testing.T) {
+	base := DefaultConfig("10m", "50Mi")
+	base.PrometheusDefaultBaseImage = "quay.io/prometheus/prometheus"
+
+	mclient := monitoringfake.NewSimpleClientset(&monitoringv1alpha1.OperatorConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: monitoringv1alpha1.OperatorConfigurationSpec{
+			Images: &monitoringv1alpha1.OperatorImages{
+				PrometheusDefaultBaseImage: ptr.To("registry.example.com/prometheus"),
+			},
+		},
+	})
+
+	// A missing resource is equivalent to an empty spec.
+	w := NewOperatorConfigurationWatcher(promslog.NewNopLogger(), mclient, "missing", base)
+	c, err := w.Load(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, base, c)
+
+	w = NewOperatorConfigurationWatcher(promslog.NewNopLogger(), mclient, "default", base)
+	c, err = w.Load(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "registry.example.com/prometheus", c.PrometheusDefaultBaseImage)
+
+	var updates []Config
+	w.Subscribe(func(c Config) { updates = append(updates, c) })
+
+	// No change.
+	require.NoError(t, w.update(monitoringv1alpha1.OperatorConfigurationSpec{
+		Images: &monitoringv1alpha1.OperatorImages{
+			PrometheusDefaultBaseImage: ptr.To("registry.example.com/prometheus"),
+		},
+	}))
+	require.Empty(t, updates)
+
+	// Hot-reloaded change.
+	require.NoError(t, w.update(monitoringv1alpha1.OperatorConfigurationSpec{
+		Annotations: map[string]string{"owner": "platform"},
+	}))
+	require.Len(t, updates, 1)
+	require.Equal(t, "quay.io/prometheus/prometheus", updates[0].PrometheusDefaultBaseImage)
+	require.Equal(t, Map{"owner": "platform"}, updates[0].Annotations)
+
+	// Invalid changes are ignored.
+	require.NoError(t, w.update(monitoringv1alpha1.OperatorConfigurationSpec{
+		FeatureGates: map[string]bool{"UnknownFeature": true},
+	}))
+	require.Len(t, updates, 1)
+
+	// Changes which require a restart.
+	require.ErrorIs(t, w.update(monitoringv1alpha1.OperatorConfigurationSpec{
+		FeatureGates: map[string]bool{string(PrometheusAgentDaemonSetFeature): true},
+	}), ErrRestartRequired)
+	require.ErrorIs(t, w.update(monitoringv1alpha1.OperatorConfigurationSpec{
+		Namespaces: &monitoringv1alpha1.OperatorNamespaces{AllowList: []string{"monitoring"}},
+	}), ErrRestartRequired)
+	require.Len(t, updates, 1)
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedArtifactsMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := NewMetrics(reg)
+
+	m.SetGeneratedConfigSize("default/main", 2048, 512)
+	m.SetGeneratedRuleConfigMaps("default/main", 2, 1536)
+	m.SetGeneratedTLSAssets("default/main", 3)
+	m.ObserveConfigGeneration("default/main", 20*time.Millisecond)
+
+	hash := ResourceKeyHash("default/main")
+	expected := fmt.Sprintf(`
+# HELP prometheus_operator_generated_rule_configmaps Number of rule ConfigMaps generated per object
+# TYPE prometheus_operator_generated_rule_configmaps gauge
+prometheus_operator_generated_rule_configmaps{resource_hash="%[1]s"} 2
+# HELP prometheus_operator_generated_rule_configmaps_size_bytes Total size of the rule files generated per object
+# TYPE prometheus_operator_generated_rule_configmaps_size_bytes gauge
+prometheus_operator_generated_rule_configmaps_size_bytes{resource_hash="%[1]s"} 1536
+# HELP prometheus_operator_generated_tls_assets Number of TLS assets (certificates and keys) mounted per object
+# TYPE prometheus_operator_generated_tls_assets gauge
+prometheus_operator_generated_tls_assets{resource_hash="%[1]s"} 3
+`, hash)
+	require.NoError(t, testutil.GatherAndCompare(
+		reg,
+		strings.NewReader(expected),
+		"prometheus_operator_generated_rule_configmaps",
+		"prometheus_operator_generated_rule_configmaps_size_bytes",
+		"prometheus_operator_generated_tls_assets",
+	))
+
+	n, err := testutil.GatherAndCount(reg, "prometheus_operator_generated_config_size_bytes", "prometheus_operator_config_generation_duration_seconds")
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	m.ForgetGeneratedArtifacts("default/main")
+
+	n, err = testutil.GatherAndCount(
+		reg,
+		"prometheus_operator_generated_config_size_bytes",
+		"prometheus_operator_generated_rule_configmaps",
+		"prometheus_operator_generated_rule_configmaps_size_bytes",
+		"prometheus_operator_generated_tls_assets",
+		"prometheus_operator_config_generation_duration_seconds",
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+}
@@ -16,29 +16,62 @@ package operator
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 )
 
-// ExecAction returns an ExecAction probing the given URL.
-func ExecAction(u string) *v1.ExecAction {
+// ExecAction returns an ExecAction probing the given URLs. The probe succeeds
+// only if all the URLs return a successful status code.
+func ExecAction(urls ...string) *v1.ExecAction {
 	return &v1.ExecAction{
 		Command: []string{
 			"sh",
 			"-c",
 			fmt.Sprintf(
-				`if [ -x "$(command -v curl)" ]; then exec %s; elif [ -x "$(command -v wget)" ]; then exec %s; else exit 1; fi`,
-				curlProber(u),
-				wgetProber(u),
+				`if [ -x "$(command -v curl)" ]; then %s; elif [ -x "$(command -v wget)" ]; then %s; else exit 1; fi`,
+				chainProbers(curlProber, urls),
+				chainProbers(wgetProber, urls),
 			),
 		},
 	}
 }
 
+func chainProbers(prober func(string) string, urls []string) string {
+	cmds := make([]string, len(urls))
+	for i, u := range urls {
+		cmds[i] = prober(u)
+	}
+	cmds[len(cmds)-1] = "exec " + cmds[len(cmds)-1]
+
+	return strings.Join(cmds, " && ")
+}
+
+var unsafeShellChars = regexp.MustCompile(`[^A-Za-z0-9:/._~%+=-]`)
+
+// quoteURL quotes the URL for the shell if it contains special characters
+// (e.g. a query string).
+func quoteURL(u string) string {
+	if !unsafeShellChars.MatchString(u) {
+		return u
+	}
+
+	return "'" + strings.ReplaceAll(u, "'", `'\''`) + "'"
+}
+
 func curlProber(u string) string {
-	return fmt.Sprintf("curl --fail %s", u)
+	if strings.HasPrefix(u, "https://") {
+		return fmt.Sprintf("curl --fail --insecure %s", quoteURL(u))
+	}
+
+	return fmt.Sprintf("curl --fail %s", quoteURL(u))
 }
 
 func wgetProber(u string) string {
-	return fmt.Sprintf("wget -q -O /dev/null %s", u)
+	if strings.HasPrefix(u, "https://") {
+		return fmt.Sprintf("wget -q --no-check-certificate -O /dev/null %s", quoteURL(u))
+	}
+
+	return fmt.Sprintf("wget -q -O /dev/null %s", quoteURL(u))
 }
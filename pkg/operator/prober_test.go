@@ -82,3 +82,50 @@ func TestProbers(t *testing.T) {
 
 	}
 }
+
+func TestExecAction(t *testing.T) {
+	for _, bin := range []string{"sh", "curl"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			t.Skipf("%s: %v", bin, err)
+		}
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("query") == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	for _, tc := range []struct {
+		name string
+		urls []string
+		err  bool
+	}{
+		{
+			name: "single URL",
+			urls: []string{ts.URL + "/-/ready"},
+		},
+		{
+			name: "all URLs succeed",
+			urls: []string{ts.URL + "/-/ready", ts.URL + "/api/v1/query?query=up%7Bjob%3D%22a%22%7D"},
+		},
+		{
+			name: "last URL fails",
+			urls: []string{ts.URL + "/-/ready", ts.URL + "/api/v1/query?query=fail"},
+			err:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			action := ExecAction(tc.urls...)
+
+			b, err := exec.Command(action.Command[0], action.Command[1:]...).CombinedOutput()
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err, string(b))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"log/slog"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/metadata"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+)
+
+// PruneChildren deletes the objects created by the operator and controlled
+// by the owner which aren't part of the expected children (e.g. excess TLS
+// asset shards).
+func PruneChildren(ctx context.Context, logger *slog.Logger, client metadata.Interface, owner metav1.Object, expected k8sutil.ExpectedChildren) error {
+	deleted, err := k8sutil.PruneChildren(ctx, client, owner, ManagedByOperatorSelector(), expected)
+	for _, ref := range deleted {
+		logger.Info("obsolete object deleted", "object", ref)
+	}
+
+	return err
+}
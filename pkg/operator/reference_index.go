@@ -0,0 +1,150 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	SecretKind    = "Secret"
+	ConfigMapKind = "ConfigMap"
+)
+
+// ReferenceIndex maps the Secrets and ConfigMaps to the keys of the objects
+// (e.g. Prometheus or Alertmanager) which reference them. It allows the
+// controllers to enqueue only the affected objects when a Secret or ConfigMap
+// changes.
+//
+// The index for a given object is replaced after each reconciliation.
+type ReferenceIndex struct {
+	mtx sync.RWMutex
+
+	// Referenced resources (<kind>/<namespace>/<name>) indexed by object key.
+	byObject map[string]map[string]struct{}
+	// Object keys indexed by referenced resource.
+	byReference map[string]map[string]struct{}
+}
+
+// NewReferenceIndex returns an empty index and registers the
+// prometheus_operator_reference_index_entries metric with the registerer.
+func NewReferenceIndex(r prometheus.Registerer) *ReferenceIndex {
+	ri := &ReferenceIndex{
+		byObject:    map[string]map[string]struct{}{},
+		byReference: map[string]map[string]struct{}{},
+	}
+
+	r.MustRegister(prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "prometheus_operator_reference_index_entries",
+			Help: "Number of references from the managed objects to Secrets and ConfigMaps tracked by the controller",
+		},
+		func() float64 { return float64(ri.Len()) },
+	))
+
+	return ri
+}
+
+func referenceKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// Set replaces the Secrets and ConfigMaps ("<namespace>/<name>") referenced
+// by the object identified by key.
+func (ri *ReferenceIndex) Set(key string, secrets, configMaps []string) {
+	refs := make(map[string]struct{}, len(secrets)+len(configMaps))
+	for _, s := range secrets {
+		refs[SecretKind+"/"+s] = struct{}{}
+	}
+	for _, cm := range configMaps {
+		refs[ConfigMapKind+"/"+cm] = struct{}{}
+	}
+
+	ri.mtx.Lock()
+	defer ri.mtx.Unlock()
+
+	ri.forget(key)
+
+	ri.byObject[key] = refs
+	for ref := range refs {
+		if _, found := ri.byReference[ref]; !found {
+			ri.byReference[ref] = map[string]struct{}{}
+		}
+		ri.byReference[ref][key] = struct{}{}
+	}
+}
+
+// Forget removes the object identified by key from the index.
+func (ri *ReferenceIndex) Forget(key string) {
+	ri.mtx.Lock()
+	defer ri.mtx.Unlock()
+
+	ri.forget(key)
+}
+
+func (ri *ReferenceIndex) forget(key string) {
+	for ref := range ri.byObject[key] {
+		delete(ri.byReference[ref], key)
+		if len(ri.byReference[ref]) == 0 {
+			delete(ri.byReference, ref)
+		}
+	}
+
+	delete(ri.byObject, key)
+}
+
+// Has returns true if the object identified by key has been indexed.
+func (ri *ReferenceIndex) Has(key string) bool {
+	ri.mtx.RLock()
+	defer ri.mtx.RUnlock()
+
+	_, found := ri.byObject[key]
+	return found
+}
+
+// Lookup returns the keys of the objects referencing the given resource.
+func (ri *ReferenceIndex) Lookup(kind, namespace, name string) []string {
+	ri.mtx.RLock()
+	defer ri.mtx.RUnlock()
+
+	keys := ri.byReference[referenceKey(kind, namespace, name)]
+	if len(keys) == 0 {
+		return nil
+	}
+
+	ret := make([]string, 0, len(keys))
+	for k := range keys {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+
+	return ret
+}
+
+// Len returns the total number of references in the index.
+func (ri *ReferenceIndex) Len() int {
+	ri.mtx.RLock()
+	defer ri.mtx.RUnlock()
+
+	var n int
+	for _, refs := range ri.byObject {
+		n += len(refs)
+	}
+
+	return n
+}
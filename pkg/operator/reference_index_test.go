@@ -0,0 +1,59 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferenceIndex(t *testing.T) {
+	ri := NewReferenceIndex(prometheus.NewRegistry())
+
+	require.False(t, ri.Has("ns1/a"))
+
+	ri.Set("ns1/a", []string{"ns1/secret1", "ns1/secret2"}, []string{"ns1/cm1"})
+	ri.Set("ns1/b", []string{"ns1/secret1"}, nil)
+
+	require.True(t, ri.Has("ns1/a"))
+	require.True(t, ri.Has("ns1/b"))
+	require.Equal(t, 4, ri.Len())
+
+	require.Equal(t, []string{"ns1/a", "ns1/b"}, ri.Lookup(SecretKind, "ns1", "secret1"))
+	require.Equal(t, []string{"ns1/a"}, ri.Lookup(SecretKind, "ns1", "secret2"))
+	require.Equal(t, []string{"ns1/a"}, ri.Lookup(ConfigMapKind, "ns1", "cm1"))
+	require.Nil(t, ri.Lookup(ConfigMapKind, "ns1", "secret1"))
+	require.Nil(t, ri.Lookup(SecretKind, "ns2", "secret1"))
+
+	// Replacing the references drops the previous ones.
+	ri.Set("ns1/a", []string{"ns1/secret3"}, nil)
+	require.Equal(t, []string{"ns1/b"}, ri.Lookup(SecretKind, "ns1", "secret1"))
+	require.Nil(t, ri.Lookup(SecretKind, "ns1", "secret2"))
+	require.Nil(t, ri.Lookup(ConfigMapKind, "ns1", "cm1"))
+	require.Equal(t, []string{"ns1/a"}, ri.Lookup(SecretKind, "ns1", "secret3"))
+	require.Equal(t, 2, ri.Len())
+
+	// An object without references is still indexed.
+	ri.Set("ns1/b", nil, nil)
+	require.True(t, ri.Has("ns1/b"))
+	require.Nil(t, ri.Lookup(SecretKind, "ns1", "secret1"))
+
+	ri.Forget("ns1/a")
+	require.False(t, ri.Has("ns1/a"))
+	require.Nil(t, ri.Lookup(SecretKind, "ns1", "secret3"))
+	require.Equal(t, 0, ri.Len())
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"errors"
+)
+
+// RejectionReason is the reason of the Accepted condition when a
+// configuration resource is rejected by a workload resource. The values are
+// stable so that tools can aggregate the failures without parsing the
+// messages.
+type RejectionReason string
+
+const (
+	// InvalidConfigurationReason is the reason of the rejections which
+	// don't have a more specific reason.
+	InvalidConfigurationReason RejectionReason = "InvalidConfiguration"
+	// InvalidRelabelConfigReason is used when a relabeling configuration
+	// is invalid.
+	InvalidRelabelConfigReason RejectionReason = "InvalidRelabelConfig"
+	// MissingSecretKeyReason is used when a referenced Secret (or the key
+	// in the Secret) doesn't exist.
+	MissingSecretKeyReason RejectionReason = "MissingSecretKey"
+	// MissingConfigMapKeyReason is used when a referenced ConfigMap (or
+	// the key in the ConfigMap) doesn't exist.
+	MissingConfigMapKeyReason RejectionReason = "MissingConfigMapKey"
+	// UnsupportedVersionFieldReason is used when a field isn't supported
+	// by the version of the workload (e.g. Prometheus).
+	UnsupportedVersionFieldReason RejectionReason = "UnsupportedVersionField"
+	// ScrapeClassNotFoundReason is used when the scrape class isn't
+	// defined by the workload.
+	ScrapeClassNotFoundReason RejectionReason = "ScrapeClassNotFound"
+	// FileSystemAccessDeniedReason is used when the resource accesses the
+	// file system while the workload prohibits it.
+	FileSystemAccessDeniedReason RejectionReason = "FileSystemAccessDenied"
+	// DuplicateTargetsReason is used when the resource generates the same
+	// targets as other resources.
+	DuplicateTargetsReason RejectionReason = "DuplicateTargets"
+)
+
+type rejectionError struct {
+	reason RejectionReason
+	err    error
+}
+
+func (e *rejectionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *rejectionError) Unwrap() error {
+	return e.err
+}
+
+// NewRejectionError wraps err with the rejection reason. It returns err
+// unchanged if it's nil or if it already has a reason: the innermost reason
+// is the most accurate one (e.g. an unsupported relabel action detected
+// while validating the relabeling configuration).
+func NewRejectionError(reason RejectionReason, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var re *rejectionError
+	if errors.As(err, &re) {
+		return err
+	}
+
+	return &rejectionError{reason: reason, err: err}
+}
+
+// RejectionReasonOf returns the rejection reason of err. The errors without
+// reason return InvalidConfigurationReason.
+func RejectionReasonOf(err error) RejectionReason {
+	var re *rejectionError
+	if errors.As(err, &re) {
+		return re.reason
+	}
+
+	return InvalidConfigurationReason
+}
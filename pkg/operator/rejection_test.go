@@ -0,0 +1,39 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectionReasonOf(t *testing.T) {
+	require.NoError(t, NewRejectionError(InvalidRelabelConfigReason, nil))
+	require.Equal(t, InvalidConfigurationReason, RejectionReasonOf(errors.New("invalid")))
+
+	err := NewRejectionError(UnsupportedVersionFieldReason, errors.New("lowercase relabel action is only supported from Prometheus version 2.36.0"))
+	require.Equal(t, UnsupportedVersionFieldReason, RejectionReasonOf(err))
+
+	// The innermost reason wins.
+	err = NewRejectionError(InvalidRelabelConfigReason, fmt.Errorf("[0]: %w", err))
+	require.Equal(t, UnsupportedVersionFieldReason, RejectionReasonOf(err))
+	require.Equal(t, "[0]: lowercase relabel action is only supported from Prometheus version 2.36.0", err.Error())
+
+	err = fmt.Errorf("endpoints[0]: relabelConfigs: %w", err)
+	require.Equal(t, UnsupportedVersionFieldReason, RejectionReasonOf(err))
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// RemoteClusterKubeconfigKey is the key of the kubeconfig in the remote
+// cluster Secrets.
+const RemoteClusterKubeconfigKey = "kubeconfig"
+
+// RemoteClustersConfig defines how the operator discovers the remote
+// clusters.
+type RemoteClustersConfig struct {
+	// Namespace of the kubeconfig Secrets.
+	SecretNamespace string
+	// Label selector of the kubeconfig Secrets. The multi-cluster mode is
+	// disabled when empty.
+	SecretSelector LabelSelector
+}
+
+// Enabled returns true if the operator should watch remote clusters.
+func (c RemoteClustersConfig) Enabled() bool {
+	return c.SecretSelector != ""
+}
+
+// RemoteCluster is a cluster in which the operator reconciles the workload
+// resources in addition to the cluster where it runs.
+type RemoteCluster struct {
+	// Name of the cluster (the name of the kubeconfig Secret).
+	Name string
+	// Client configuration of the cluster.
+	RESTConfig *rest.Config
+}
+
+// LoadRemoteClusters returns the remote clusters defined by the kubeconfig
+// Secrets matching the configuration. The clusters are sorted by name.
+func LoadRemoteClusters(ctx context.Context, kclient kubernetes.Interface, config RemoteClustersConfig) ([]RemoteCluster, error) {
+	namespace := config.SecretNamespace
+	if namespace == "" {
+		namespace = inClusterNamespace()
+	}
+
+	secrets, err := kclient.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: config.SecretSelector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the remote cluster secrets: %w", err)
+	}
+
+	clusters := make([]RemoteCluster, 0, len(secrets.Items))
+	for _, s := range secrets.Items {
+		kubeconfig, found := s.Data[RemoteClusterKubeconfigKey]
+		if !found {
+			return nil, fmt.Errorf("secret %s/%s: key %q not found", s.Namespace, s.Name, RemoteClusterKubeconfigKey)
+		}
+
+		restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("secret %s/%s: invalid kubeconfig: %w", s.Namespace, s.Name, err)
+		}
+
+		clusters = append(clusters, RemoteCluster{
+			Name:       s.Name,
+			RESTConfig: restConfig,
+		})
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Name < clusters[j].Name
+	})
+
+	return clusters, nil
+}
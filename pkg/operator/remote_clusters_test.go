@@ -0,0 +1,113 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: https://remote.example.com:6443
+contexts:
+- name: remote
+  context:
+    cluster: remote
+    user: remote
+current-context: remote
+users:
+- name: remote
+  user: {}
+`
+
+func newKubeconfigSecret(name string, lbls map[string]string, data map[string][]byte) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "monitoring",
+			Labels:    lbls,
+		},
+		Data: data,
+	}
+}
+
+func TestLoadRemoteClusters(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		secrets  []*v1.Secret
+		expected map[string]string
+		err      bool
+	}{
+		{
+			name: "valid secrets",
+			secrets: []*v1.Secret{
+				newKubeconfigSecret("b", map[string]string{"remote-cluster": "true"}, map[string][]byte{"kubeconfig": []byte(testKubeconfig)}),
+				newKubeconfigSecret("a", map[string]string{"remote-cluster": "true"}, map[string][]byte{"kubeconfig": []byte(testKubeconfig)}),
+				newKubeconfigSecret("c", nil, map[string][]byte{"kubeconfig": []byte(testKubeconfig)}),
+			},
+			expected: map[string]string{
+				"a": "https://remote.example.com:6443",
+				"b": "https://remote.example.com:6443",
+			},
+		},
+		{
+			name: "missing key",
+			secrets: []*v1.Secret{
+				newKubeconfigSecret("a", map[string]string{"remote-cluster": "true"}, map[string][]byte{"config": []byte(testKubeconfig)}),
+			},
+			err: true,
+		},
+		{
+			name: "invalid kubeconfig",
+			secrets: []*v1.Secret{
+				newKubeconfigSecret("a", map[string]string{"remote-cluster": "true"}, map[string][]byte{"kubeconfig": []byte("invalid")}),
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kclient := fake.NewClientset()
+			for _, s := range tc.secrets {
+				_, err := kclient.CoreV1().Secrets(s.Namespace).Create(context.Background(), s, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			clusters, err := LoadRemoteClusters(context.Background(), kclient, RemoteClustersConfig{
+				SecretNamespace: "monitoring",
+				SecretSelector:  "remote-cluster=true",
+			})
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, clusters, len(tc.expected))
+			for i, name := range []string{"a", "b"} {
+				require.Equal(t, name, clusters[i].Name)
+				require.Equal(t, tc.expected[name], clusters[i].RESTConfig.Host)
+			}
+		})
+	}
+}
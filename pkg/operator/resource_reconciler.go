@@ -17,12 +17,16 @@ package operator
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"log/slog"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
 	"golang.org/x/sync/errgroup"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -48,9 +52,11 @@ type Syncer interface {
 	UpdateStatus(context.Context, string) error
 }
 
-// OwnedResourceOwner returns an object from its "<namespace>/<name>" key.
+// OwnedResourceOwner returns an object from its "<namespace>/<name>" key and
+// lists all the objects.
 type OwnedResourceOwner interface {
 	Get(string) (runtime.Object, error)
+	ListAll(labels.Selector, cache.AppendFunc) error
 }
 
 // ReconcilerMetrics tracks reconciler metrics.
@@ -81,11 +87,15 @@ type ResourceReconciler struct {
 	getter OwnedResourceOwner
 
 	reconcileTotal    prometheus.Counter
-	reconcileErrors   prometheus.Counter
+	reconcileErrors   *prometheus.CounterVec
 	reconcileDuration prometheus.Histogram
 	statusTotal       prometheus.Counter
 	statusErrors      prometheus.Counter
 
+	// Per-object reconcile metrics labeled by the hash of the object's key.
+	resourceReconcileTotal    *prometheus.CounterVec
+	resourceReconcileDuration *prometheus.HistogramVec
+
 	metrics ReconcilerMetrics
 
 	// Queue to trigger state reconciliations of  objects.
@@ -96,6 +106,19 @@ type ResourceReconciler struct {
 	g errgroup.Group
 
 	controllerID string
+
+	// Splits the objects between the operator replicas (optional).
+	sharder *Sharder
+
+	// Number of goroutines processing each queue.
+	workers int
+
+	// Set to true once Run() has been called.
+	running atomic.Bool
+	// Last time (in Unix nanoseconds) an item of the reconciliation or
+	// status queue has been processed.
+	reconcileProgress atomic.Int64
+	statusProgress    atomic.Int64
 }
 
 var (
@@ -104,8 +127,43 @@ var (
 
 const (
 	controllerIDAnnotation = "operator.prometheus.io/controller-id"
+
+	// PausedAnnotation pauses the reconciliation of a workload resource
+	// when its value is "true", like the `spec.paused` field.
+	PausedAnnotation = "operator.prometheus.io/paused"
+
+	// ReconcileIntervalAnnotation defines the interval at which the workload
+	// resource is periodically reconciled (e.g. "30s" or "10m"),
+	// independently of the events received by the controller.
+	ReconcileIntervalAnnotation = "operator.prometheus.io/reconcile-interval"
 )
 
+// IsPaused returns true if the reconciliation of the workload resource is
+// paused either by the spec's field or by the annotation.
+func IsPaused(o metav1.Object, paused bool) bool {
+	return paused || o.GetAnnotations()[PausedAnnotation] == "true"
+}
+
+// ReconcileInterval returns the periodic reconciliation interval defined by
+// the object's annotation. It returns zero if the annotation isn't present.
+func ReconcileInterval(o metav1.Object) (time.Duration, error) {
+	v, found := o.GetAnnotations()[ReconcileIntervalAnnotation]
+	if !found {
+		return 0, nil
+	}
+
+	d, err := model.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for the %q annotation: %w", ReconcileIntervalAnnotation, err)
+	}
+
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid value for the %q annotation: duration must be greater than 0", ReconcileIntervalAnnotation)
+	}
+
+	return time.Duration(d), nil
+}
+
 // NewResourceReconciler returns a reconciler for the "kind" resource.
 func NewResourceReconciler(
 	l *slog.Logger,
@@ -115,16 +173,21 @@ func NewResourceReconciler(
 	kind string,
 	reg prometheus.Registerer,
 	controllerID string,
+	sharder *Sharder,
+	workers int,
 ) *ResourceReconciler {
 	reconcileTotal := prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "prometheus_operator_reconcile_operations_total",
 		Help: "Total number of reconcile operations",
 	})
 
-	reconcileErrors := prometheus.NewCounter(prometheus.CounterOpts{
+	reconcileErrors := prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "prometheus_operator_reconcile_errors_total",
 		Help: "Number of errors that occurred during reconcile operations",
-	})
+	}, []string{"category"})
+	for _, c := range k8sutil.ErrorCategories {
+		reconcileErrors.WithLabelValues(string(c))
+	}
 
 	reconcileDuration := prometheus.NewHistogram(prometheus.HistogramOpts{
 		Name:    "prometheus_operator_reconcile_duration_seconds",
@@ -132,6 +195,17 @@ func NewResourceReconciler(
 		Buckets: []float64{.1, .5, 1, 5, 10},
 	})
 
+	resourceReconcileTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "prometheus_operator_resource_reconcile_operations_total",
+		Help: "Total number of reconcile operations per object and outcome (success, config_error or api_error). The resource_hash label is the hash of the object's \"<namespace>/<name>\" key (see ResourceKeyHash)",
+	}, []string{"resource_hash", "outcome"})
+
+	resourceReconcileDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "prometheus_operator_resource_reconcile_duration_seconds",
+		Help:    "Histogram of reconcile operations per object. The resource_hash label is the hash of the object's \"<namespace>/<name>\" key (see ResourceKeyHash)",
+		Buckets: []float64{.1, .5, 1, 5, 10},
+	}, []string{"resource_hash"})
+
 	statusTotal := prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "prometheus_operator_status_update_operations_total",
 		Help: "Total number of update operations to status subresources",
@@ -142,7 +216,15 @@ func NewResourceReconciler(
 		Help: "Number of errors that occurred during update operations to status subresources",
 	})
 
-	reg.MustRegister(reconcileTotal, reconcileErrors, reconcileDuration, statusTotal, statusErrors)
+	reg.MustRegister(
+		reconcileTotal,
+		reconcileErrors,
+		reconcileDuration,
+		resourceReconcileTotal,
+		resourceReconcileDuration,
+		statusTotal,
+		statusErrors,
+	)
 
 	qname := strings.ToLower(kind)
 
@@ -153,7 +235,7 @@ func NewResourceReconciler(
 		}
 	}
 
-	return &ResourceReconciler{
+	rr := &ResourceReconciler{
 		logger:       l,
 		resourceKind: kind,
 		syncer:       syncer,
@@ -166,10 +248,21 @@ func NewResourceReconciler(
 		statusErrors:      statusErrors,
 		metrics:           metrics,
 		controllerID:      controllerID,
+		sharder:           sharder,
+		workers:           max(workers, 1),
+
+		resourceReconcileTotal:    resourceReconcileTotal,
+		resourceReconcileDuration: resourceReconcileDuration,
 
 		reconcileQ: workqueue.NewTypedRateLimitingQueueWithConfig[string](workqueue.DefaultTypedControllerRateLimiter[string](), workqueue.TypedRateLimitingQueueConfig[string]{Name: qname}),
 		statusQ:    workqueue.NewTypedRateLimitingQueueWithConfig[string](workqueue.DefaultTypedControllerRateLimiter[string](), workqueue.TypedRateLimitingQueueConfig[string]{Name: qname + "_status"}),
 	}
+
+	// The objects which have moved to this replica need to be reconciled
+	// when the sharding membership changes.
+	sharder.Subscribe(rr.EnqueueAll)
+
+	return rr
 }
 
 // DeletionInProgress returns true if the object deletion has been requested.
@@ -232,6 +325,20 @@ func (rr *ResourceReconciler) hasStateChanged(old, cur metav1.Object) bool {
 	return false
 }
 
+// isScheduledResync returns true if the update event comes from the periodic
+// resync of the informer and the object defines its own reconciliation
+// interval. The reconciliations of such objects are only scheduled by
+// scheduleNextReconciliation so that the annotation can lengthen the interval
+// beyond the informer's resync period.
+func (rr *ResourceReconciler) isScheduledResync(old, cur metav1.Object) bool {
+	if rr.hasObjectChanged(old, cur) {
+		return false
+	}
+
+	interval, err := ReconcileInterval(cur)
+	return err == nil && interval > 0
+}
+
 // objectKey returns the `namespace/name` key of a Kubernetes object, typically
 // retrieved from a controller's cache.
 func (rr *ResourceReconciler) objectKey(obj interface{}) (string, bool) {
@@ -338,7 +445,11 @@ func (rr *ResourceReconciler) OnUpdate(old, cur interface{}) {
 		return
 	}
 
-	if !k8sutil.HasStatusCleanupFinalizer(mCur) && rr.DeletionInProgress(mCur) {
+	if !k8sutil.HasOperatorFinalizer(mCur) && rr.DeletionInProgress(mCur) {
+		return
+	}
+
+	if rr.isScheduledResync(mOld, mCur) {
 		return
 	}
 
@@ -497,6 +608,16 @@ func (rr *ResourceReconciler) EnqueueForReconciliation(obj metav1.Object) {
 	rr.reconcileQ.Add(obj.GetNamespace() + "/" + obj.GetName())
 }
 
+// EnqueueForReconciliationAfter asks for reconciling the object once the
+// delay has elapsed.
+func (rr *ResourceReconciler) EnqueueForReconciliationAfter(obj metav1.Object, delay time.Duration) {
+	if !rr.isManagedByController(obj) {
+		return
+	}
+
+	rr.reconcileQ.AddAfter(obj.GetNamespace()+"/"+obj.GetName(), delay)
+}
+
 // EnqueueForStatus asks for updating the status of the object.
 func (rr *ResourceReconciler) EnqueueForStatus(obj metav1.Object) {
 	if !rr.isManagedByController(obj) {
@@ -509,19 +630,70 @@ func (rr *ResourceReconciler) EnqueueForStatus(obj metav1.Object) {
 // Run the goroutines responsible for processing the reconciliation and status
 // queues.
 func (rr *ResourceReconciler) Run(ctx context.Context) {
-	// Goroutine that reconciles the desired state of objects.
-	rr.g.Go(func() error {
-		for rr.processNextReconcileItem(ctx) {
-		}
+	now := time.Now().UnixNano()
+	rr.reconcileProgress.Store(now)
+	rr.statusProgress.Store(now)
+	rr.running.Store(true)
+
+	for range rr.workers {
+		// Goroutine that reconciles the desired state of objects.
+		rr.g.Go(func() error {
+			for rr.processNextReconcileItem(ctx) {
+			}
+			return nil
+		})
+
+		// Goroutine that reconciles the status of objects.
+		rr.g.Go(func() error {
+			for rr.processNextStatusItem(ctx) {
+			}
+			return nil
+		})
+	}
+}
+
+// stuckQueueThreshold is the duration after which a queue with pending items
+// is considered stuck if no item has been processed.
+const stuckQueueThreshold = 5 * time.Minute
+
+// CheckHealth returns an error if the reconciler is running and either one of
+// the informers isn't synced or one of the queues is stuck. The reconcilers
+// which haven't been started (e.g. when the operator isn't the leader) are
+// considered healthy.
+func (rr *ResourceReconciler) CheckHealth(informers NamedInformers) error {
+	if !rr.running.Load() {
 		return nil
-	})
+	}
+
+	if err := informers.CheckSynced(); err != nil {
+		return err
+	}
 
-	// Goroutine that reconciles the status of objects.
-	rr.g.Go(func() error {
-		for rr.processNextStatusItem(ctx) {
+	return rr.checkQueues(stuckQueueThreshold)
+}
+
+// checkQueues returns an error if a queue has pending items while no item
+// has been processed for longer than the given duration.
+func (rr *ResourceReconciler) checkQueues(stuckAfter time.Duration) error {
+	for _, q := range []struct {
+		name     string
+		queue    workqueue.TypedRateLimitingInterface[string]
+		progress *atomic.Int64
+	}{
+		{"reconciliation", rr.reconcileQ, &rr.reconcileProgress},
+		{"status", rr.statusQ, &rr.statusProgress},
+	} {
+		n := q.queue.Len()
+		if n == 0 {
+			continue
 		}
-		return nil
-	})
+
+		if since := time.Since(time.Unix(0, q.progress.Load())); since > stuckAfter {
+			return fmt.Errorf("%s queue has %d pending items but no item processed for %s", q.name, n, since.Truncate(time.Second))
+		}
+	}
+
+	return nil
 }
 
 // Stop the processing queues and wait for goroutines to exit.
@@ -543,25 +715,125 @@ func (rr *ResourceReconciler) processNextReconcileItem(ctx context.Context) bool
 	}
 
 	defer rr.reconcileQ.Done(key)
+	defer func() { rr.reconcileProgress.Store(time.Now().UnixNano()) }()
+
+	// The object may have moved to another replica since it was enqueued.
+	if !rr.sharder.OwnsKey(key) {
+		rr.reconcileQ.Forget(key)
+		rr.deleteResourceReconcileMetrics(key)
+		return true
+	}
+
 	defer rr.statusQ.Add(key) // enqueues the object's key to update the status subresource
 
 	rr.reconcileTotal.Inc()
 	startTime := time.Now()
 	err := rr.syncer.Sync(ctx, key)
-	rr.reconcileDuration.Observe(time.Since(startTime).Seconds())
+	duration := time.Since(startTime).Seconds()
+	rr.reconcileDuration.Observe(duration)
 
 	if err == nil {
+		rr.reconcileQ.Forget(key)
+		rr.observeResourceReconcile(key, duration, reconcileOutcomeSuccess)
+		rr.scheduleNextReconciliation(key)
+		return true
+	}
+
+	category := k8sutil.ErrorCategoryOf(err)
+	rr.reconcileErrors.WithLabelValues(string(category)).Inc()
+	rr.observeResourceReconcile(key, duration, reconcileOutcomeOf(category))
+	utilruntime.HandleError(fmt.Errorf("sync %q failed (%s): %w", key, category, err))
+
+	// The periodic reconciliation continues even if the sync failed.
+	defer rr.scheduleNextReconciliation(key)
+
+	if !category.Retryable() {
+		// The object is reconciled again when it changes.
 		rr.reconcileQ.Forget(key)
 		return true
 	}
 
-	rr.reconcileErrors.Inc()
-	utilruntime.HandleError(fmt.Errorf("sync %q failed: %w", key, err))
 	rr.reconcileQ.AddRateLimited(key)
 
 	return true
 }
 
+const (
+	reconcileOutcomeSuccess     = "success"
+	reconcileOutcomeConfigError = "config_error"
+	reconcileOutcomeAPIError    = "api_error"
+)
+
+// reconcileOutcomeOf returns the outcome label of a failed reconciliation.
+// The errors which require a change of the resources (invalid spec or
+// missing dependency) are configuration errors, the other errors come from
+// the Kubernetes API (or other external services).
+func reconcileOutcomeOf(category k8sutil.ErrorCategory) string {
+	switch category {
+	case k8sutil.InvalidSpecError, k8sutil.DependencyMissingError:
+		return reconcileOutcomeConfigError
+	default:
+		return reconcileOutcomeAPIError
+	}
+}
+
+// ResourceKeyHash returns the value of the resource_hash label of the
+// per-object reconcile metrics for the "<namespace>/<name>" key. It is the
+// hexadecimal representation of the 32-bit FNV-1a hash of the key.
+func ResourceKeyHash(key string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return strconv.FormatUint(uint64(h.Sum32()), 16)
+}
+
+// observeResourceReconcile updates the per-object reconcile metrics. The
+// series of the objects which don't exist anymore are removed once they have
+// been successfully reconciled.
+func (rr *ResourceReconciler) observeResourceReconcile(key string, duration float64, outcome string) {
+	if outcome == reconcileOutcomeSuccess {
+		if _, err := rr.getter.Get(key); apierrors.IsNotFound(err) {
+			rr.deleteResourceReconcileMetrics(key)
+			return
+		}
+	}
+
+	hash := ResourceKeyHash(key)
+	rr.resourceReconcileTotal.WithLabelValues(hash, outcome).Inc()
+	rr.resourceReconcileDuration.WithLabelValues(hash).Observe(duration)
+}
+
+func (rr *ResourceReconciler) deleteResourceReconcileMetrics(key string) {
+	hash := ResourceKeyHash(key)
+	rr.resourceReconcileTotal.DeletePartialMatch(prometheus.Labels{"resource_hash": hash})
+	rr.resourceReconcileDuration.DeleteLabelValues(hash)
+}
+
+// scheduleNextReconciliation enqueues the object again after the
+// reconciliation interval defined by its annotation (if any).
+func (rr *ResourceReconciler) scheduleNextReconciliation(key string) {
+	obj, err := rr.getter.Get(key)
+	if err != nil {
+		return
+	}
+
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+
+	interval, err := ReconcileInterval(o)
+	if err != nil {
+		rr.logger.Warn("ignoring the reconciliation interval", "key", key, "err", err)
+		return
+	}
+
+	if interval == 0 {
+		return
+	}
+
+	rr.reconcileQ.AddAfter(key, interval)
+}
+
 func (rr *ResourceReconciler) processNextStatusItem(ctx context.Context) bool {
 	key, quit := rr.statusQ.Get()
 	if quit {
@@ -569,6 +841,12 @@ func (rr *ResourceReconciler) processNextStatusItem(ctx context.Context) bool {
 	}
 
 	defer rr.statusQ.Done(key)
+	defer func() { rr.statusProgress.Store(time.Now().UnixNano()) }()
+
+	if !rr.sharder.OwnsKey(key) {
+		rr.statusQ.Forget(key)
+		return true
+	}
 
 	rr.statusTotal.Inc()
 	err := rr.syncer.UpdateStatus(ctx, key)
@@ -612,5 +890,25 @@ func (rr *ResourceReconciler) isManagedByController(obj metav1.Object) bool {
 		return false
 	}
 
+	if !rr.sharder.Owns(obj.GetNamespace(), obj.GetName()) {
+		rr.logger.Debug("skipping object managed by another shard", "object", fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName()))
+		return false
+	}
+
 	return true
 }
+
+// EnqueueAll asks for reconciling all the objects managed by the controller.
+func (rr *ResourceReconciler) EnqueueAll() {
+	err := rr.getter.ListAll(labels.Everything(), func(obj interface{}) {
+		o, err := meta.Accessor(obj)
+		if err != nil {
+			return
+		}
+
+		rr.EnqueueForReconciliation(o)
+	})
+	if err != nil {
+		rr.logger.Error("failed to list the objects", "err", err)
+	}
+}
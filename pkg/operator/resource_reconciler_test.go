@@ -0,0 +1,412 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+)
+
+func TestReconcileInterval(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		value    *string
+		expected time.Duration
+		err      bool
+	}{
+		{
+			name: "no annotation",
+		},
+		{
+			name:     "valid duration",
+			value:    ptr.To("10m"),
+			expected: 10 * time.Minute,
+		},
+		{
+			name:     "compound duration",
+			value:    ptr.To("1h30m"),
+			expected: 90 * time.Minute,
+		},
+		{
+			name:  "zero duration",
+			value: ptr.To("0s"),
+			err:   true,
+		},
+		{
+			name:  "invalid duration",
+			value: ptr.To("ten minutes"),
+			err:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{}
+			if tc.value != nil {
+				p.Annotations = map[string]string{ReconcileIntervalAnnotation: *tc.value}
+			}
+
+			d, err := ReconcileInterval(p)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, d)
+		})
+	}
+}
+
+type noopSyncer struct{}
+
+func (noopSyncer) Sync(context.Context, string) error { return nil }
+
+func (noopSyncer) UpdateStatus(context.Context, string) error { return nil }
+
+type staticGetter map[string]runtime.Object
+
+func (g staticGetter) Get(key string) (runtime.Object, error) {
+	o, found := g[key]
+	if !found {
+		return nil, apierrors.NewNotFound(schema.GroupResource{}, key)
+	}
+
+	return o, nil
+}
+
+func (g staticGetter) ListAll(labels.Selector, cache.AppendFunc) error { return nil }
+
+func TestScheduleNextReconciliation(t *testing.T) {
+	getter := staticGetter{
+		"default/periodic": &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "periodic",
+				Namespace:   "default",
+				Annotations: map[string]string{ReconcileIntervalAnnotation: "50ms"},
+			},
+		},
+		"default/default": &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "default",
+				Namespace: "default",
+			},
+		},
+	}
+
+	reg := prometheus.NewRegistry()
+	rr := NewResourceReconciler(slog.New(slog.DiscardHandler), noopSyncer{}, getter, NewMetrics(reg), monitoringv1.PrometheusesKind, reg, "", nil, 1)
+	defer rr.Stop()
+
+	ctx := context.Background()
+	for _, key := range []string{"default/periodic", "default/default"} {
+		rr.reconcileQ.Add(key)
+		require.True(t, rr.processNextReconcileItem(ctx))
+	}
+
+	// Only the object with the annotation is enqueued again.
+	require.Eventually(t, func() bool { return rr.reconcileQ.Len() == 1 }, time.Second, 10*time.Millisecond)
+
+	key, _ := rr.reconcileQ.Get()
+	require.Equal(t, "default/periodic", key)
+	rr.reconcileQ.Done(key)
+}
+
+func TestScheduleNextReconciliationAfterFailure(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "transient error",
+			err:  errors.New("connection refused"),
+		},
+		{
+			name: "invalid spec",
+			err:  k8sutil.NewInvalidSpecError(errors.New("invalid configuration")),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := staticGetter{
+				"default/periodic": &monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "periodic",
+						Namespace:   "default",
+						Annotations: map[string]string{ReconcileIntervalAnnotation: "50ms"},
+					},
+				},
+			}
+
+			reg := prometheus.NewRegistry()
+			rr := NewResourceReconciler(slog.New(slog.DiscardHandler), failingSyncer{err: tc.err}, getter, NewMetrics(reg), monitoringv1.PrometheusesKind, reg, "", nil, 1)
+			defer rr.Stop()
+
+			rr.reconcileQ.Add("default/periodic")
+			require.True(t, rr.processNextReconcileItem(context.Background()))
+
+			// The object is enqueued again even if the sync isn't retried.
+			require.Eventually(t, func() bool { return rr.reconcileQ.Len() == 1 }, time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestResyncEventWithReconcileInterval(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		annotations map[string]string
+		old         string
+		cur         string
+		expected    int
+	}{
+		{
+			name:        "resync with interval",
+			annotations: map[string]string{ReconcileIntervalAnnotation: "10m"},
+			old:         "1",
+			cur:         "1",
+		},
+		{
+			name:        "update with interval",
+			annotations: map[string]string{ReconcileIntervalAnnotation: "10m"},
+			old:         "1",
+			cur:         "2",
+			expected:    1,
+		},
+		{
+			name: "resync without interval",
+			old:  "1",
+			cur:  "1",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			reg := prometheus.NewRegistry()
+			rr := NewResourceReconciler(slog.New(slog.DiscardHandler), noopSyncer{}, staticGetter{}, NewMetrics(reg), monitoringv1.PrometheusesKind, reg, "", nil, 1)
+			defer rr.Stop()
+
+			old := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "test",
+					Namespace:       "default",
+					Generation:      1,
+					ResourceVersion: tc.old,
+					Annotations:     tc.annotations,
+				},
+			}
+			cur := old.DeepCopy()
+			cur.ResourceVersion = tc.cur
+			if tc.old != tc.cur {
+				cur.Generation++
+			}
+
+			rr.OnUpdate(old, cur)
+			require.Equal(t, tc.expected, rr.reconcileQ.Len())
+		})
+	}
+}
+
+type failingSyncer struct {
+	err error
+}
+
+func (s failingSyncer) Sync(context.Context, string) error { return s.err }
+
+func (failingSyncer) UpdateStatus(context.Context, string) error { return nil }
+
+func TestProcessNextReconcileItemRetry(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		err     error
+		retried bool
+		outcome string
+	}{
+		{
+			name:    "transient error",
+			err:     errors.New("connection refused"),
+			retried: true,
+			outcome: "api_error",
+		},
+		{
+			name:    "dependency missing",
+			err:     k8sutil.NewDependencyMissingError(errors.New("secret not found")),
+			retried: true,
+			outcome: "config_error",
+		},
+		{
+			name:    "invalid spec",
+			err:     k8sutil.NewInvalidSpecError(errors.New("invalid configuration")),
+			outcome: "config_error",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			reg := prometheus.NewRegistry()
+			rr := NewResourceReconciler(slog.New(slog.DiscardHandler), failingSyncer{err: tc.err}, staticGetter{}, NewMetrics(reg), monitoringv1.PrometheusesKind, reg, "", nil, 1)
+			defer rr.Stop()
+
+			rr.reconcileQ.Add("default/test")
+			require.True(t, rr.processNextReconcileItem(context.Background()))
+
+			if tc.retried {
+				require.Equal(t, 1, rr.reconcileQ.NumRequeues("default/test"))
+			} else {
+				require.Equal(t, 0, rr.reconcileQ.NumRequeues("default/test"))
+			}
+			require.Equal(t, 1.0, testutil.ToFloat64(rr.reconcileErrors.WithLabelValues(string(k8sutil.ErrorCategoryOf(tc.err)))))
+			require.Equal(t, 1.0, testutil.ToFloat64(rr.resourceReconcileTotal.WithLabelValues(ResourceKeyHash("default/test"), tc.outcome)))
+		})
+	}
+}
+
+func TestResourceReconcileMetrics(t *testing.T) {
+	getter := staticGetter{
+		"default/test": &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+			},
+		},
+	}
+
+	reg := prometheus.NewRegistry()
+	rr := NewResourceReconciler(slog.New(slog.DiscardHandler), noopSyncer{}, getter, NewMetrics(reg), monitoringv1.PrometheusesKind, reg, "", nil, 1)
+	defer rr.Stop()
+
+	ctx := context.Background()
+	hash := ResourceKeyHash("default/test")
+	for range 2 {
+		rr.reconcileQ.Add("default/test")
+		require.True(t, rr.processNextReconcileItem(ctx))
+	}
+
+	require.Equal(t, 2.0, testutil.ToFloat64(rr.resourceReconcileTotal.WithLabelValues(hash, "success")))
+	require.Equal(t, 1, testutil.CollectAndCount(rr.resourceReconcileDuration))
+
+	// The series are removed once the deleted object has been reconciled.
+	delete(getter, "default/test")
+	rr.reconcileQ.Add("default/test")
+	require.True(t, rr.processNextReconcileItem(ctx))
+
+	require.Equal(t, 0, testutil.CollectAndCount(rr.resourceReconcileTotal))
+	require.Equal(t, 0, testutil.CollectAndCount(rr.resourceReconcileDuration))
+}
+
+type fakeInformer struct {
+	cache.SharedIndexInformer
+	synced bool
+}
+
+func (f *fakeInformer) HasSynced() bool { return f.synced }
+
+func TestCheckHealth(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	rr := NewResourceReconciler(slog.New(slog.DiscardHandler), noopSyncer{}, staticGetter{}, NewMetrics(reg), monitoringv1.PrometheusesKind, reg, "", nil, 1)
+	defer rr.Stop()
+
+	unsynced := NamedInformers{
+		{Name: "Prometheus", Informer: &fakeInformer{synced: true}},
+		{Name: "Secret", Informer: &fakeInformer{}},
+	}
+
+	// The reconciler isn't running yet.
+	require.NoError(t, rr.CheckHealth(unsynced))
+
+	rr.running.Store(true)
+	rr.reconcileProgress.Store(time.Now().UnixNano())
+	rr.statusProgress.Store(time.Now().UnixNano())
+
+	err := rr.CheckHealth(unsynced)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Secret")
+
+	unsynced[1].Informer.(*fakeInformer).synced = true
+	require.NoError(t, rr.CheckHealth(unsynced))
+
+	// Pending items are fine as long as the queue makes progress.
+	rr.reconcileQ.Add("default/test")
+	require.NoError(t, rr.checkQueues(time.Minute))
+
+	rr.reconcileProgress.Store(time.Now().Add(-2 * time.Minute).UnixNano())
+	err = rr.checkQueues(time.Minute)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "reconciliation queue")
+
+	require.True(t, rr.processNextReconcileItem(context.Background()))
+	require.NoError(t, rr.checkQueues(time.Minute))
+}
+
+func TestOnUpdateDeletionInProgress(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		finalizers []string
+		enqueued   bool
+	}{
+		{
+			name: "no finalizer",
+		},
+		{
+			name:       "foreign finalizer",
+			finalizers: []string{"example.com/finalizer"},
+		},
+		{
+			name:       "status cleanup finalizer",
+			finalizers: []string{k8sutil.StatusCleanupFinalizerName},
+			enqueued:   true,
+		},
+		{
+			name:       "artifact store finalizer",
+			finalizers: []string{k8sutil.ArtifactStoreFinalizerName},
+			enqueued:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			reg := prometheus.NewRegistry()
+			rr := NewResourceReconciler(slog.New(slog.DiscardHandler), noopSyncer{}, staticGetter{}, NewMetrics(reg), monitoringv1.PrometheusesKind, reg, "", nil, 1)
+			defer rr.Stop()
+
+			old := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test",
+					Namespace:  "default",
+					Generation: 1,
+					Finalizers: tc.finalizers,
+				},
+			}
+			cur := old.DeepCopy()
+			cur.Generation = 2
+			cur.DeletionTimestamp = ptr.To(metav1.Now())
+
+			rr.OnUpdate(old, cur)
+
+			if tc.enqueued {
+				require.Equal(t, 1, rr.reconcileQ.Len())
+			} else {
+				require.Equal(t, 0, rr.reconcileQ.Len())
+			}
+		})
+	}
+}
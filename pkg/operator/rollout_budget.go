@@ -0,0 +1,183 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+const (
+	// RolloutPendingReason is the reason of the Reconciled condition when
+	// the update of a StatefulSet waits for the rollout budget.
+	RolloutPendingReason = "RolloutPending"
+
+	// RolloutBudgetRetryInterval is the delay after which the controllers
+	// check again whether a pending rollout can start.
+	RolloutBudgetRetryInterval = 15 * time.Second
+)
+
+type rolloutKey struct {
+	owner string
+	name  string
+}
+
+// RolloutBudget limits the number of StatefulSets being rolled out
+// concurrently across all the controllers.
+//
+// A StatefulSet holds a slot from the time its update is allowed until the
+// rollout has completed. The StatefulSets waiting for a slot are served in
+// the order of their first request.
+//
+// A nil RolloutBudget allows all the rollouts.
+type RolloutBudget struct {
+	max int
+
+	mtx        sync.Mutex
+	inProgress map[rolloutKey]struct{}
+	pending    []rolloutKey
+}
+
+// NewRolloutBudget returns a RolloutBudget allowing at most max concurrent
+// rollouts and registers its metrics with the registerer.
+func NewRolloutBudget(r prometheus.Registerer, max int) *RolloutBudget {
+	b := &RolloutBudget{
+		max:        max,
+		inProgress: map[rolloutKey]struct{}{},
+	}
+
+	r.MustRegister(
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name: "prometheus_operator_workload_rollouts_max",
+				Help: "Maximum number of workloads rolled out concurrently.",
+			},
+			func() float64 { return float64(b.max) },
+		),
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name: "prometheus_operator_workload_rollouts_in_progress",
+				Help: "Number of workloads being rolled out.",
+			},
+			func() float64 {
+				b.mtx.Lock()
+				defer b.mtx.Unlock()
+				return float64(len(b.inProgress))
+			},
+		),
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name: "prometheus_operator_workload_rollouts_pending",
+				Help: "Number of workloads waiting for the rollout budget.",
+			},
+			func() float64 {
+				b.mtx.Lock()
+				defer b.mtx.Unlock()
+				return float64(len(b.pending))
+			},
+		),
+	)
+
+	return b
+}
+
+// Acquire returns true if the rollout of the StatefulSet identified by name
+// and managed by owner can proceed. Otherwise the StatefulSet is queued and
+// the function returns false with its position in the queue (starting at 1).
+func (b *RolloutBudget) Acquire(owner, name string) (bool, int) {
+	if b == nil {
+		return true, 0
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	k := rolloutKey{owner: owner, name: name}
+	if _, found := b.inProgress[k]; found {
+		return true, 0
+	}
+
+	i := slices.Index(b.pending, k)
+	if i < 0 {
+		b.pending = append(b.pending, k)
+		i = len(b.pending) - 1
+	}
+
+	if i < b.max-len(b.inProgress) {
+		b.pending = slices.Delete(b.pending, i, i+1)
+		b.inProgress[k] = struct{}{}
+		return true, 0
+	}
+
+	return false, i + 1
+}
+
+// Release frees the slot held by the StatefulSet and removes it from the
+// queue.
+func (b *RolloutBudget) Release(owner, name string) {
+	if b == nil {
+		return
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	k := rolloutKey{owner: owner, name: name}
+	delete(b.inProgress, k)
+	b.pending = slices.DeleteFunc(b.pending, func(e rolloutKey) bool { return e == k })
+}
+
+// Forget releases all the slots and queued requests of the owner. It should
+// be called when the owner has been deleted.
+func (b *RolloutBudget) Forget(owner string) {
+	if b == nil {
+		return
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for k := range b.inProgress {
+		if k.owner == owner {
+			delete(b.inProgress, k)
+		}
+	}
+	b.pending = slices.DeleteFunc(b.pending, func(e rolloutKey) bool { return e.owner == owner })
+}
+
+// RolloutPendingMessage returns the message reported when the update of the
+// StatefulSet waits for the rollout budget.
+func (b *RolloutBudget) RolloutPendingMessage(name string, position int) string {
+	return fmt.Sprintf("The rollout of StatefulSet %q is waiting for the rollout budget (position %d in the queue, at most %d concurrent rollouts).", name, position, b.max)
+}
+
+// StatefulSetRolledOut returns true if all the pods of the StatefulSet run
+// the current revision and are ready.
+func StatefulSetRolledOut(sset *appsv1.StatefulSet) bool {
+	replicas := int32(1)
+	if sset.Spec.Replicas != nil {
+		replicas = *sset.Spec.Replicas
+	}
+
+	return sset.Status.ObservedGeneration >= sset.Generation &&
+		sset.Status.CurrentRevision == sset.Status.UpdateRevision &&
+		sset.Status.UpdatedReplicas == replicas &&
+		sset.Status.ReadyReplicas == replicas
+}
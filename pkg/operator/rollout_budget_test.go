@@ -0,0 +1,118 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRolloutBudget(t *testing.T) {
+	b := NewRolloutBudget(prometheus.NewRegistry(), 2)
+
+	requireAcquire := func(owner, name string, expected bool, position int) {
+		t.Helper()
+		ok, pos := b.Acquire(owner, name)
+		require.Equal(t, expected, ok)
+		require.Equal(t, position, pos)
+	}
+
+	requireAcquire("Prometheus/ns/a", "prometheus-a", true, 0)
+	requireAcquire("Prometheus/ns/b", "prometheus-b", true, 0)
+	requireAcquire("Prometheus/ns/c", "prometheus-c", false, 1)
+	requireAcquire("Alertmanager/ns/d", "alertmanager-d", false, 2)
+
+	// Acquiring an already granted slot succeeds.
+	requireAcquire("Prometheus/ns/a", "prometheus-a", true, 0)
+
+	// The queued requests are served in order.
+	b.Release("Prometheus/ns/a", "prometheus-a")
+	requireAcquire("Alertmanager/ns/d", "alertmanager-d", false, 2)
+	requireAcquire("Prometheus/ns/c", "prometheus-c", true, 0)
+	requireAcquire("Alertmanager/ns/d", "alertmanager-d", false, 1)
+
+	// Forgetting an owner releases its slots.
+	b.Forget("Prometheus/ns/b")
+	requireAcquire("Alertmanager/ns/d", "alertmanager-d", true, 0)
+
+	// A nil budget allows all the rollouts.
+	var nilBudget *RolloutBudget
+	ok, _ := nilBudget.Acquire("Prometheus/ns/a", "prometheus-a")
+	require.True(t, ok)
+}
+
+func TestStatefulSetRolledOut(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		status   appsv1.StatefulSetStatus
+		expected bool
+	}{
+		{
+			name: "rolled out",
+			status: appsv1.StatefulSetStatus{
+				ObservedGeneration: 2,
+				CurrentRevision:    "rev-2",
+				UpdateRevision:     "rev-2",
+				UpdatedReplicas:    2,
+				ReadyReplicas:      2,
+			},
+			expected: true,
+		},
+		{
+			name: "generation not observed",
+			status: appsv1.StatefulSetStatus{
+				ObservedGeneration: 1,
+				CurrentRevision:    "rev-1",
+				UpdateRevision:     "rev-1",
+				UpdatedReplicas:    2,
+				ReadyReplicas:      2,
+			},
+		},
+		{
+			name: "rollout in progress",
+			status: appsv1.StatefulSetStatus{
+				ObservedGeneration: 2,
+				CurrentRevision:    "rev-1",
+				UpdateRevision:     "rev-2",
+				UpdatedReplicas:    1,
+				ReadyReplicas:      2,
+			},
+		},
+		{
+			name: "pod not ready",
+			status: appsv1.StatefulSetStatus{
+				ObservedGeneration: 2,
+				CurrentRevision:    "rev-2",
+				UpdateRevision:     "rev-2",
+				UpdatedReplicas:    2,
+				ReadyReplicas:      1,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(int32(2))},
+				Status:     tc.status,
+			}
+			require.Equal(t, tc.expected, StatefulSetRolledOut(sset))
+		})
+	}
+}
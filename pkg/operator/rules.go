@@ -15,9 +15,13 @@
 package operator
 
 import (
+	"crypto/md5"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/blang/semver/v4"
@@ -52,6 +56,29 @@ const (
 	ThanosFormat
 )
 
+// RuleShardKeyAnnotation assigns the recording rules of a PrometheusRule
+// object to a single Prometheus shard. The shard is selected from the
+// annotation's value with the same algorithm as the sharding of the targets:
+// the recording rules are evaluated only by the shard which scrapes the
+// targets having the same value for the `__tmp_hash` label.
+const RuleShardKeyAnnotation = "operator.prometheus.io/shard-key"
+
+// ShardedRuleFilesGlob matches the rule files which are loaded only by the
+// Prometheus shard identified by the SHARD environment variable (expanded by
+// the config-reloader).
+var ShardedRuleFilesGlob = "*.shard-$(" + ShardEnvVar + ").yml"
+
+// ShardForValue returns the shard to which the value is assigned by the
+// "hashmod" relabeling action of the sharding relabel configuration.
+func ShardForValue(value string, shards int32) int32 {
+	if shards <= 1 {
+		return 0
+	}
+
+	hash := md5.Sum([]byte(value))
+	return int32(binary.BigEndian.Uint64(hash[8:]) % uint64(shards))
+}
+
 // The maximum `Data` size of a ConfigMap seems to differ between
 // environments. This is probably due to different meta data sizes which count
 // into the overall maximum size of a ConfigMap. Thereby lets leave a
@@ -67,6 +94,17 @@ type PrometheusRuleSelector struct {
 
 	eventRecorder record.EventRecorder
 
+	// droppedKeepFiringFor lists the selected PrometheusRules defining the
+	// `keep_firing_for` field which isn't supported by the component.
+	droppedKeepFiringFor []string
+
+	// selection records the outcome of the last selection.
+	selection []SelectionResult
+
+	// Number of shards across which the recording rules are partitioned (0
+	// or 1 disables the partitioning).
+	shards int32
+
 	logger *slog.Logger
 }
 
@@ -92,6 +130,13 @@ func NewPrometheusRuleSelector(ruleFormat RuleConfigurationFormat, version strin
 	}, nil
 }
 
+// ShardRecordingRules enables the partitioning of the recording rules
+// across the given number of shards for the PrometheusRule objects with the
+// RuleShardKeyAnnotation annotation.
+func (prs *PrometheusRuleSelector) ShardRecordingRules(shards int32) {
+	prs.shards = shards
+}
+
 func (prs *PrometheusRuleSelector) generateRulesConfiguration(promRule *monitoringv1.PrometheusRule) (string, error) {
 	logger := prs.logger.With("prometheusrule", promRule.Name, "prometheusrule-namespace", promRule.Namespace)
 	promRuleSpec := promRule.Spec
@@ -116,16 +161,87 @@ func (prs *PrometheusRuleSelector) generateRulesConfiguration(promRule *monitori
 	return string(content), nil
 }
 
+// generateRuleFiles returns the rule files generated from the PrometheusRule
+// object indexed by file name. When the partitioning of the recording rules
+// is enabled and the object has the RuleShardKeyAnnotation annotation, the
+// groups containing only recording rules are written to a separate file
+// loaded only by the owning shard.
+func (prs *PrometheusRuleSelector) generateRuleFiles(ruleName string, promRule *monitoringv1.PrometheusRule) (map[string]string, error) {
+	// The object is validated as a whole before being split.
+	content, err := prs.generateRulesConfiguration(promRule)
+	if err != nil {
+		return nil, err
+	}
+
+	shardKey := promRule.Annotations[RuleShardKeyAnnotation]
+	if prs.shards <= 1 || shardKey == "" {
+		return map[string]string{ruleName: content}, nil
+	}
+
+	var recording, others []monitoringv1.RuleGroup
+	for _, g := range promRule.Spec.Groups {
+		if isRecordingRuleGroup(g) {
+			recording = append(recording, g)
+			continue
+		}
+		others = append(others, g)
+	}
+
+	if len(recording) == 0 {
+		return map[string]string{ruleName: content}, nil
+	}
+
+	files := make(map[string]string, 2)
+	for name, groups := range map[string][]monitoringv1.RuleGroup{
+		shardedRuleFileName(ruleName, ShardForValue(shardKey, prs.shards)): recording,
+		ruleName: others,
+	} {
+		if len(groups) == 0 {
+			continue
+		}
+
+		pr := promRule.DeepCopy()
+		pr.Spec.Groups = groups
+		content, err := prs.generateRulesConfiguration(pr)
+		if err != nil {
+			return nil, err
+		}
+
+		files[name] = content
+	}
+
+	return files, nil
+}
+
+func isRecordingRuleGroup(g monitoringv1.RuleGroup) bool {
+	if len(g.Rules) == 0 {
+		return false
+	}
+
+	for _, r := range g.Rules {
+		if r.Record == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// shardedRuleFileName returns the name of the rule file loaded only by the
+// given shard (see ShardedRuleFilesGlob).
+func shardedRuleFileName(ruleName string, shard int32) string {
+	return fmt.Sprintf("%s.shard-%d.yml", strings.TrimSuffix(ruleName, ".yaml"), shard)
+}
+
 // sanitizePrometheusRulesSpec sanitizes the PrometheusRules spec depending on the Prometheus/Thanos version.
 func (prs *PrometheusRuleSelector) sanitizePrometheusRulesSpec(promRuleSpec monitoringv1.PrometheusRuleSpec, logger *slog.Logger) monitoringv1.PrometheusRuleSpec {
-	minVersionKeepFiringFor := semver.MustParse("2.42.0")
+	minVersionKeepFiringFor := prs.minVersionKeepFiringFor()
 	minVersionLimits := semver.MustParse("2.31.0")
 	minVersionQueryOffset := semver.MustParse("2.53.0")
 	minVersionRuleGroupLabels := semver.MustParse("3.0.0")
 	component := "Prometheus"
 
 	if prs.ruleFormat == ThanosFormat {
-		minVersionKeepFiringFor = semver.MustParse("0.34.0")
 		minVersionLimits = semver.MustParse("0.24.0")
 		minVersionQueryOffset = semver.MustParse("100.0.0")     // Arbitrary very high major version because it's not yet supported by Thanos.
 		minVersionRuleGroupLabels = semver.MustParse("100.0.0") // Arbitrary very high major version because it's not yet supported by Thanos.
@@ -154,7 +270,7 @@ func (prs *PrometheusRuleSelector) sanitizePrometheusRulesSpec(promRuleSpec moni
 		}
 
 		for j := range promRuleSpec.Groups[i].Rules {
-			if promRuleSpec.Groups[i].Rules[j].KeepFiringFor != nil && prs.version.LT(minVersionKeepFiringFor) {
+			if promRuleSpec.Groups[i].Rules[j].KeepFiringFor != nil && !prs.supportsKeepFiringFor() {
 				promRuleSpec.Groups[i].Rules[j].KeepFiringFor = nil
 				logger.Warn(fmt.Sprintf("ignoring 'keep_firing_for' not supported by %s", component), "minimum_version", minVersionKeepFiringFor)
 			}
@@ -164,6 +280,61 @@ func (prs *PrometheusRuleSelector) sanitizePrometheusRulesSpec(promRuleSpec moni
 	return promRuleSpec
 }
 
+func (prs *PrometheusRuleSelector) componentName() string {
+	if prs.ruleFormat == ThanosFormat {
+		return "Thanos"
+	}
+
+	return "Prometheus"
+}
+
+func (prs *PrometheusRuleSelector) minVersionKeepFiringFor() semver.Version {
+	if prs.ruleFormat == ThanosFormat {
+		return semver.MustParse("0.34.0")
+	}
+
+	return semver.MustParse("2.42.0")
+}
+
+func (prs *PrometheusRuleSelector) supportsKeepFiringFor() bool {
+	return prs.version.GTE(prs.minVersionKeepFiringFor())
+}
+
+func hasKeepFiringFor(promRule *monitoringv1.PrometheusRule) bool {
+	for _, g := range promRule.Spec.Groups {
+		for _, r := range g.Rules {
+			if r.KeepFiringFor != nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// UnsupportedFieldsWarning returns a message listing the selected
+// PrometheusRules for which the operator removed the `keep_firing_for` field
+// because it isn't supported by the version of the component. It returns an
+// empty string if no field was removed.
+func (prs *PrometheusRuleSelector) UnsupportedFieldsWarning() string {
+	if len(prs.droppedKeepFiringFor) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"The `keep_firing_for` field requires %s >= v%s and has been removed from the following PrometheusRules: %s",
+		prs.componentName(),
+		prs.minVersionKeepFiringFor(),
+		strings.Join(prs.droppedKeepFiringFor, ", "),
+	)
+}
+
+// Selection returns the outcome of the last selection for each
+// PrometheusRule matching the selectors.
+func (prs *PrometheusRuleSelector) Selection() []SelectionResult {
+	return prs.selection
+}
+
 // ValidateRule takes PrometheusRuleSpec and validates it using the upstream prometheus rule validator.
 func ValidateRule(promRuleSpec monitoringv1.PrometheusRuleSpec) []error {
 	for i := range promRuleSpec.Groups {
@@ -220,16 +391,26 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (map[string]strin
 	}
 
 	var rejected int
+	prs.droppedKeepFiringFor = nil
+	prs.selection = make([]SelectionResult, 0, len(promRules))
 	rules := make(map[string]string, len(promRules))
 
 	for ruleName, promRule := range promRules {
-		var err error
-		var content string
 		if err := prs.nsLabeler.EnforceNamespaceLabel(promRule); err != nil {
+			prs.selection = append(prs.selection, SelectionResult{
+				Namespace: promRule.Namespace,
+				Name:      promRule.Name,
+				Reason:    InvalidConfigurationEvent,
+				Message:   err.Error(),
+			})
 			continue
 		}
 
-		content, err = prs.generateRulesConfiguration(promRule)
+		// The check happens before generating the configuration which
+		// removes the unsupported fields.
+		dropKeepFiringFor := hasKeepFiringFor(promRule) && !prs.supportsKeepFiringFor()
+
+		files, err := prs.generateRuleFiles(ruleName, promRule)
 		if err != nil {
 			rejected++
 			prs.logger.Warn(
@@ -239,11 +420,30 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (map[string]strin
 				"namespace", promRule.Namespace,
 			)
 			prs.eventRecorder.Eventf(promRule, v1.EventTypeWarning, "InvalidConfiguration", "PrometheusRule %s was rejected due to invalid configuration: %v", promRule.Name, err)
+			prs.selection = append(prs.selection, SelectionResult{
+				Namespace: promRule.Namespace,
+				Name:      promRule.Name,
+				Reason:    InvalidConfigurationEvent,
+				Message:   err.Error(),
+			})
 			continue
 		}
 
-		rules[ruleName] = content
+		prs.selection = append(prs.selection, SelectionResult{
+			Namespace: promRule.Namespace,
+			Name:      promRule.Name,
+			Accepted:  true,
+		})
+
+		if dropKeepFiringFor {
+			prs.droppedKeepFiringFor = append(prs.droppedKeepFiringFor, fmt.Sprintf("%s/%s", promRule.Namespace, promRule.Name))
+			prs.eventRecorder.Eventf(promRule, v1.EventTypeWarning, UnsupportedRuleFieldsEvent, "The keep_firing_for field of PrometheusRule %s isn't supported by %s v%s and has been removed", promRule.Name, prs.componentName(), prs.version)
+		}
+
+		maps.Copy(rules, files)
 	}
+	slices.Sort(prs.droppedKeepFiringFor)
+	SortSelectionResults(prs.selection)
 
 	ruleNames := []string{}
 	for name := range rules {
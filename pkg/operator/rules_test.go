@@ -15,16 +15,30 @@
 package operator
 
 import (
+	"context"
 	"log/slog"
+	"maps"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/blang/semver/v4"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/relabel"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	namespacelabeler "github.com/prometheus-operator/prometheus-operator/pkg/namespacelabeler"
 )
 
 func TestMakeRulesConfigMaps(t *testing.T) {
@@ -509,3 +523,275 @@ func shouldAcceptRuleWithGroupLabels(t *testing.T) {
 	_, err := pr.generateRulesConfiguration(rules)
 	require.NoError(t, err)
 }
+
+func TestSelectReportsUnsupportedKeepFiringFor(t *testing.T) {
+	duration := monitoringv1.NonEmptyDuration("5m")
+	newRule := func(name string, keepFiringFor *monitoringv1.NonEmptyDuration) *monitoringv1.PrometheusRule {
+		return &monitoringv1.PrometheusRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{
+				{
+					Name: "group",
+					Rules: []monitoringv1.Rule{
+						{
+							Alert:         "alert",
+							Expr:          intstr.FromString("vector(1)"),
+							KeepFiringFor: keepFiringFor,
+						},
+					},
+				},
+			}},
+		}
+	}
+
+	mclient := monitoringfake.NewSimpleClientset(
+		newRule("with-keep-firing-for", &duration),
+		newRule("without-keep-firing-for", nil),
+	)
+	ruleInfs, err := informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			nil,
+			mclient,
+			0,
+			nil,
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ruleInfs.Start(ctx.Done())
+	require.Eventually(t, ruleInfs.HasSynced, 5*time.Second, 10*time.Millisecond)
+
+	for _, tc := range []struct {
+		name    string
+		format  RuleConfigurationFormat
+		version string
+		warning string
+	}{
+		{
+			name:    "supported by Prometheus",
+			format:  PrometheusFormat,
+			version: DefaultPrometheusVersion,
+		},
+		{
+			name:    "unsupported by Prometheus",
+			format:  PrometheusFormat,
+			version: "v2.41.0",
+			warning: "The `keep_firing_for` field requires Prometheus >= v2.42.0 and has been removed from the following PrometheusRules: default/with-keep-firing-for",
+		},
+		{
+			name:    "unsupported by Thanos",
+			format:  ThanosFormat,
+			version: "v0.33.0",
+			warning: "The `keep_firing_for` field requires Thanos >= v0.34.0 and has been removed from the following PrometheusRules: default/with-keep-firing-for",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			prs, err := NewPrometheusRuleSelector(tc.format, tc.version, &metav1.LabelSelector{}, namespacelabeler.New("", nil, false), ruleInfs, recorder, slog.New(slog.DiscardHandler))
+			require.NoError(t, err)
+
+			rules, rejected, err := prs.Select([]string{"default"})
+			require.NoError(t, err)
+			// The rules aren't rejected.
+			require.Len(t, rules, 2)
+			require.Equal(t, 0, rejected)
+			require.Equal(t, tc.warning, prs.UnsupportedFieldsWarning())
+
+			if tc.warning == "" {
+				require.Empty(t, recorder.Events)
+				return
+			}
+			require.Len(t, recorder.Events, 1)
+			require.Contains(t, <-recorder.Events, UnsupportedRuleFieldsEvent)
+		})
+	}
+}
+
+func TestSelectShardsRecordingRules(t *testing.T) {
+	recordingGroup := monitoringv1.RuleGroup{
+		Name: "recording",
+		Rules: []monitoringv1.Rule{
+			{
+				Record: "job:up:sum",
+				Expr:   intstr.FromString("sum by (job) (up)"),
+			},
+		},
+	}
+	alertingGroup := monitoringv1.RuleGroup{
+		Name: "alerting",
+		Rules: []monitoringv1.Rule{
+			{
+				Record: "instance:up:sum",
+				Expr:   intstr.FromString("sum by (instance) (up)"),
+			},
+			{
+				Alert: "alert",
+				Expr:  intstr.FromString("job:up:sum == 0"),
+			},
+		},
+	}
+
+	mclient := monitoringfake.NewSimpleClientset(
+		&monitoringv1.PrometheusRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "sharded",
+				Namespace:   "default",
+				Annotations: map[string]string{RuleShardKeyAnnotation: "team-a"},
+			},
+			Spec: monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{recordingGroup, alertingGroup}},
+		},
+		&monitoringv1.PrometheusRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "unsharded",
+				Namespace: "default",
+			},
+			Spec: monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{recordingGroup}},
+		},
+	)
+	ruleInfs, err := informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			nil,
+			mclient,
+			0,
+			nil,
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ruleInfs.Start(ctx.Done())
+	require.Eventually(t, ruleInfs.HasSynced, 5*time.Second, 10*time.Millisecond)
+
+	for _, tc := range []struct {
+		name   string
+		shards int32
+		files  []string
+	}{
+		{
+			name:   "single shard",
+			shards: 1,
+			files:  []string{"default-sharded-.yaml", "default-unsharded-.yaml"},
+		},
+		{
+			name:   "3 shards",
+			shards: 3,
+			files: []string{
+				"default-sharded-.shard-" + strconv.Itoa(int(ShardForValue("team-a", 3))) + ".yml",
+				"default-sharded-.yaml",
+				"default-unsharded-.yaml",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			prs, err := NewPrometheusRuleSelector(PrometheusFormat, DefaultPrometheusVersion, &metav1.LabelSelector{}, namespacelabeler.New("", nil, false), ruleInfs, record.NewFakeRecorder(10), slog.New(slog.DiscardHandler))
+			require.NoError(t, err)
+			prs.ShardRecordingRules(tc.shards)
+
+			rules, rejected, err := prs.Select([]string{"default"})
+			require.NoError(t, err)
+			require.Equal(t, 0, rejected)
+			require.ElementsMatch(t, tc.files, slices.Collect(maps.Keys(rules)))
+
+			if tc.shards == 1 {
+				return
+			}
+
+			// The groups with alerting rules are evaluated by all shards.
+			require.NotContains(t, rules["default-sharded-.yaml"], "name: recording")
+			require.Contains(t, rules["default-sharded-.yaml"], "name: alerting")
+			require.Contains(t, rules[tc.files[0]], "name: recording")
+			require.NotContains(t, rules[tc.files[0]], "name: alerting")
+		})
+	}
+}
+
+func TestShardForValue(t *testing.T) {
+	for _, value := range []string{"team-a", "team-b", "10.0.0.1:9090", ""} {
+		for _, shards := range []int32{1, 2, 3, 10} {
+			// The result must match the "hashmod" relabeling action.
+			lset, keep := relabel.Process(
+				labels.FromStrings("__tmp_hash", value),
+				&relabel.Config{
+					SourceLabels: model.LabelNames{"__tmp_hash"},
+					Separator:    ";",
+					Regex:        relabel.MustNewRegexp("(.*)"),
+					TargetLabel:  "__tmp_hash",
+					Modulus:      uint64(shards),
+					Action:       relabel.HashMod,
+				},
+			)
+			require.True(t, keep)
+			require.Equal(t, lset.Get("__tmp_hash"), strconv.Itoa(int(ShardForValue(value, shards))))
+		}
+	}
+}
+
+func TestSelectRecordsSelection(t *testing.T) {
+	newRule := func(name, expr string) *monitoringv1.PrometheusRule {
+		return &monitoringv1.PrometheusRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{
+				{
+					Name: "group",
+					Rules: []monitoringv1.Rule{
+						{
+							Alert: "alert",
+							Expr:  intstr.FromString(expr),
+						},
+					},
+				},
+			}},
+		}
+	}
+
+	mclient := monitoringfake.NewSimpleClientset(
+		newRule("valid", "vector(1)"),
+		newRule("invalid", "vector(1"),
+	)
+	ruleInfs, err := informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			nil,
+			mclient,
+			0,
+			nil,
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ruleInfs.Start(ctx.Done())
+	require.Eventually(t, ruleInfs.HasSynced, 5*time.Second, 10*time.Millisecond)
+
+	prs, err := NewPrometheusRuleSelector(PrometheusFormat, DefaultPrometheusVersion, &metav1.LabelSelector{}, namespacelabeler.New("", nil, false), ruleInfs, record.NewFakeRecorder(10), slog.New(slog.DiscardHandler))
+	require.NoError(t, err)
+
+	_, rejected, err := prs.Select([]string{"default"})
+	require.NoError(t, err)
+	require.Equal(t, 1, rejected)
+
+	selection := prs.Selection()
+	require.Len(t, selection, 2)
+
+	require.Equal(t, "invalid", selection[0].Name)
+	require.False(t, selection[0].Accepted)
+	require.Equal(t, InvalidConfigurationEvent, selection[0].Reason)
+	require.NotEmpty(t, selection[0].Message)
+
+	require.Equal(t, SelectionResult{Namespace: "default", Name: "valid", Accepted: true}, selection[1])
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SelectionResult describes the outcome of the selection of a configuration
+// resource (e.g. ServiceMonitor or PrometheusRule) by a workload resource.
+type SelectionResult struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Accepted is false when the resource matched the selectors but has been
+	// rejected by the operator.
+	Accepted bool `json:"accepted"`
+	// Reason of the rejection (e.g. "InvalidConfiguration").
+	Reason string `json:"reason,omitempty"`
+	// Message details the rejection.
+	Message string `json:"message,omitempty"`
+}
+
+// SortSelectionResults sorts the results by namespace and name.
+func SortSelectionResults(results []SelectionResult) {
+	slices.SortFunc(results, func(a, b SelectionResult) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+}
@@ -0,0 +1,329 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+)
+
+const (
+	// SelfMonitoringName is the name of the self-monitoring resources.
+	SelfMonitoringName = "prometheus-operator-self-monitoring"
+
+	selfMonitoringResyncPeriod = 5 * time.Minute
+
+	operatorJobName       = "prometheus-operator"
+	configReloaderJobName = "config-reloader"
+)
+
+// SelfMonitoringConfig defines the monitoring resources which the operator
+// creates for itself and for the managed workloads.
+type SelfMonitoringConfig struct {
+	// Whether the self-monitoring resources are created.
+	Enabled bool
+	// Namespace of the self-monitoring resources.
+	Namespace string
+	// Label selector of the operator's Service.
+	ServiceSelector LabelSelector
+	// Name of the operator's Service port exposing the metrics.
+	PortName string
+	// Labels added to the self-monitoring resources (e.g. to match the
+	// selectors of the Prometheus resources).
+	Labels Map
+}
+
+// SelfMonitoring creates and updates the ServiceMonitor scraping the
+// operator, the PodMonitor scraping the managed workloads and their
+// config-reloader sidecars, and the PrometheusRule with the baseline alerts.
+type SelfMonitoring struct {
+	logger  *slog.Logger
+	mclient monitoringclient.Interface
+
+	namespace       string
+	serviceSelector metav1.LabelSelector
+	portName        string
+	extraLabels     Map
+
+	mtx         sync.RWMutex
+	annotations Map
+	labels      Map
+}
+
+// NewSelfMonitoring returns a SelfMonitoring controller. The namespace of the
+// operator's service account is used when the namespace isn't configured.
+func NewSelfMonitoring(logger *slog.Logger, mclient monitoringclient.Interface, c Config) (*SelfMonitoring, error) {
+	config := c.SelfMonitoring
+	if config.PortName == "" {
+		return nil, errors.New("the port name is required")
+	}
+
+	selector, err := metav1.ParseToLabelSelector(config.ServiceSelector.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid service selector: %w", err)
+	}
+
+	if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+		return nil, errors.New("the service selector is required")
+	}
+
+	if config.Namespace == "" {
+		config.Namespace = inClusterNamespace()
+	}
+
+	return &SelfMonitoring{
+		logger:          logger.With("namespace", config.Namespace),
+		mclient:         mclient,
+		namespace:       config.Namespace,
+		serviceSelector: *selector,
+		portName:        config.PortName,
+		extraLabels:     config.Labels,
+		annotations:     c.Annotations,
+		labels:          c.Labels,
+	}, nil
+}
+
+// UpdateConfig updates the common labels and annotations. They are applied
+// on the next synchronization.
+func (sm *SelfMonitoring) UpdateConfig(c Config) {
+	sm.mtx.Lock()
+	defer sm.mtx.Unlock()
+
+	sm.annotations = c.Annotations
+	sm.labels = c.Labels
+}
+
+// Run synchronizes the self-monitoring resources periodically until the
+// context is canceled.
+func (sm *SelfMonitoring) Run(ctx context.Context) error {
+	sm.logger.Info("Starting self-monitoring controller")
+
+	ticker := time.NewTicker(selfMonitoringResyncPeriod)
+	defer ticker.Stop()
+
+	for {
+		if err := sm.sync(ctx); err != nil {
+			sm.logger.Error("failed to synchronize the self-monitoring resources", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (sm *SelfMonitoring) sync(ctx context.Context) error {
+	monitoringV1 := sm.mclient.MonitoringV1()
+
+	var errs []error
+	if err := k8sutil.CreateOrUpdateServiceMonitor(ctx, monitoringV1.ServiceMonitors(sm.namespace), sm.makeServiceMonitor()); err != nil {
+		errs = append(errs, fmt.Errorf("failed to reconcile the ServiceMonitor: %w", err))
+	}
+
+	if err := k8sutil.CreateOrUpdatePodMonitor(ctx, monitoringV1.PodMonitors(sm.namespace), sm.makePodMonitor()); err != nil {
+		errs = append(errs, fmt.Errorf("failed to reconcile the PodMonitor: %w", err))
+	}
+
+	if err := k8sutil.CreateOrUpdatePrometheusRule(ctx, monitoringV1.PrometheusRules(sm.namespace), sm.makePrometheusRule()); err != nil {
+		errs = append(errs, fmt.Errorf("failed to reconcile the PrometheusRule: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (sm *SelfMonitoring) objectOptions() []ObjectOption {
+	sm.mtx.RLock()
+	defer sm.mtx.RUnlock()
+
+	return []ObjectOption{
+		WithName(SelfMonitoringName),
+		WithNamespace(sm.namespace),
+		WithAnnotations(sm.annotations),
+		WithLabels(sm.labels),
+		WithLabels(sm.extraLabels),
+		WithLabels(map[string]string{"app.kubernetes.io/managed-by": "prometheus-operator"}),
+	}
+}
+
+// makeServiceMonitor returns the ServiceMonitor scraping the operator's
+// Service. The job label of the targets is "prometheus-operator".
+func (sm *SelfMonitoring) makeServiceMonitor() *monitoringv1.ServiceMonitor {
+	s := &monitoringv1.ServiceMonitor{
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector: *sm.serviceSelector.DeepCopy(),
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					Port:        sm.portName,
+					HonorLabels: true,
+					RelabelConfigs: []monitoringv1.RelabelConfig{
+						{
+							Action:      "replace",
+							TargetLabel: "job",
+							Replacement: ptr.To(operatorJobName),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	UpdateObject(s, sm.objectOptions()...)
+
+	return s
+}
+
+// makePodMonitor returns the PodMonitor scraping the pods managed by the
+// operator in all namespaces. The job label of the workload targets is the
+// value of the "app.kubernetes.io/name" label (e.g. "prometheus" or
+// "alertmanager") and the job label of the config-reloader targets is
+// "config-reloader".
+//
+// A PodMonitor is used because the governing services don't expose the
+// port of the config-reloader sidecar.
+func (sm *SelfMonitoring) makePodMonitor() *monitoringv1.PodMonitor {
+	pm := &monitoringv1.PodMonitor{
+		Spec: monitoringv1.PodMonitorSpec{
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"app.kubernetes.io/managed-by": "prometheus-operator"},
+			},
+			NamespaceSelector: monitoringv1.NamespaceSelector{Any: true},
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+				{
+					Port: ptr.To("web"),
+					RelabelConfigs: []monitoringv1.RelabelConfig{
+						{
+							Action:       "replace",
+							SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_label_app_kubernetes_io_name"},
+							TargetLabel:  "job",
+						},
+					},
+				},
+				{
+					Port: ptr.To("reloader-web"),
+					RelabelConfigs: []monitoringv1.RelabelConfig{
+						{
+							Action:      "replace",
+							TargetLabel: "job",
+							Replacement: ptr.To(configReloaderJobName),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	UpdateObject(pm, sm.objectOptions()...)
+
+	return pm
+}
+
+// makePrometheusRule returns a subset of the alerting rules of the
+// prometheus-operator mixin.
+func (sm *SelfMonitoring) makePrometheusRule() *monitoringv1.PrometheusRule {
+	selector := fmt.Sprintf("job=%q,namespace=%q", operatorJobName, sm.namespace)
+
+	alert := func(name, expr, duration, severity, summary, description string) monitoringv1.Rule {
+		return monitoringv1.Rule{
+			Alert: name,
+			Expr:  intstr.FromString(expr),
+			For:   ptr.To(monitoringv1.Duration(duration)),
+			Labels: map[string]string{
+				"severity": severity,
+			},
+			Annotations: map[string]string{
+				"summary":     summary,
+				"description": description,
+			},
+		}
+	}
+
+	rule := &monitoringv1.PrometheusRule{
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name: "prometheus-operator",
+					Rules: []monitoringv1.Rule{
+						alert(
+							"PrometheusOperatorDown",
+							fmt.Sprintf("absent(up{%s} == 1)", selector),
+							"10m",
+							"critical",
+							"Prometheus operator is down.",
+							"Prometheus operator in the "+sm.namespace+" namespace has been unreachable for more than 10 minutes.",
+						),
+						alert(
+							"PrometheusOperatorNotReady",
+							fmt.Sprintf("min by (controller,namespace) (max_over_time(prometheus_operator_ready{%s}[5m]) == 0)", selector),
+							"5m",
+							"warning",
+							"Prometheus operator not ready",
+							"Prometheus operator in {{ $labels.namespace }} namespace isn't ready to reconcile {{ $labels.controller }} resources.",
+						),
+						alert(
+							"PrometheusOperatorReconcileErrors",
+							fmt.Sprintf(
+								"(sum by (controller,namespace) (rate(prometheus_operator_reconcile_errors_total{%[1]s}[5m]))) / (sum by (controller,namespace) (rate(prometheus_operator_reconcile_operations_total{%[1]s}[5m]))) > 0.1",
+								selector,
+							),
+							"10m",
+							"warning",
+							"Errors while reconciling objects.",
+							"{{ $value | humanizePercentage }} of reconciling operations failed for {{ $labels.controller }} controller in {{ $labels.namespace }} namespace.",
+						),
+						alert(
+							"PrometheusOperatorRejectedResources",
+							fmt.Sprintf(`min_over_time(prometheus_operator_managed_resources{state="rejected",%s}[5m]) > 0`, selector),
+							"5m",
+							"warning",
+							"Resources rejected by Prometheus operator",
+							`Prometheus operator in {{ $labels.namespace }} namespace rejected {{ printf "%0.0f" $value }} {{ $labels.controller }}/{{ $labels.resource }} resources.`,
+						),
+					},
+				},
+				{
+					Name: "config-reloaders",
+					Rules: []monitoringv1.Rule{
+						alert(
+							"ConfigReloaderSidecarErrors",
+							fmt.Sprintf("max_over_time(reloader_last_reload_successful{job=%q}[5m]) == 0", configReloaderJobName),
+							"10m",
+							"warning",
+							"config-reloader sidecar has not had a successful reload for 10m",
+							"Errors encountered while the {{$labels.pod}} config-reloader sidecar attempts to sync config in {{$labels.namespace}} namespace.\nAs a result, configuration for service running in {{$labels.pod}} may be stale and cannot be updated anymore.",
+						),
+					},
+				},
+			},
+		},
+	}
+
+	UpdateObject(rule, sm.objectOptions()...)
+
+	return rule
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+)
+
+func TestSelfMonitoring(t *testing.T) {
+	ctx := context.Background()
+	mclient := monitoringfake.NewSimpleClientset()
+
+	c := DefaultConfig("10m", "50Mi")
+	c.Labels = Map{"team": "platform"}
+	c.SelfMonitoring.Namespace = "monitoring"
+	c.SelfMonitoring.Labels = Map{"release": "main"}
+
+	sm, err := NewSelfMonitoring(promslog.NewNopLogger(), mclient, c)
+	require.NoError(t, err)
+	require.NoError(t, sm.sync(ctx))
+
+	svcMon, err := mclient.MonitoringV1().ServiceMonitors("monitoring").Get(ctx, SelfMonitoringName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"app.kubernetes.io/name": "prometheus-operator"}, svcMon.Spec.Selector.MatchLabels)
+	require.Equal(t, "http", svcMon.Spec.Endpoints[0].Port)
+	require.Equal(t, "platform", svcMon.Labels["team"])
+	require.Equal(t, "main", svcMon.Labels["release"])
+
+	podMon, err := mclient.MonitoringV1().PodMonitors("monitoring").Get(ctx, SelfMonitoringName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.True(t, podMon.Spec.NamespaceSelector.Any)
+	require.Len(t, podMon.Spec.PodMetricsEndpoints, 2)
+
+	rule, err := mclient.MonitoringV1().PrometheusRules("monitoring").Get(ctx, SelfMonitoringName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, rule.Spec.Groups, 2)
+	require.Equal(t, `absent(up{job="prometheus-operator",namespace="monitoring"} == 1)`, rule.Spec.Groups[0].Rules[0].Expr.String())
+
+	// The common labels are updated on the next synchronization.
+	c.Labels = Map{"team": "observability"}
+	sm.UpdateConfig(c)
+	require.NoError(t, sm.sync(ctx))
+
+	svcMon, err = mclient.MonitoringV1().ServiceMonitors("monitoring").Get(ctx, SelfMonitoringName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "observability", svcMon.Labels["team"])
+}
+
+func TestNewSelfMonitoringInvalidConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		update func(*SelfMonitoringConfig)
+	}{
+		{
+			name:   "empty selector",
+			update: func(c *SelfMonitoringConfig) { c.ServiceSelector = "" },
+		},
+		{
+			name:   "empty port",
+			update: func(c *SelfMonitoringConfig) { c.PortName = "" },
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := DefaultConfig("10m", "50Mi")
+			tc.update(&c.SelfMonitoring)
+
+			_, err := NewSelfMonitoring(promslog.NewNopLogger(), monitoringfake.NewSimpleClientset(), c)
+			require.Error(t, err)
+		})
+	}
+}
@@ -18,13 +18,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/mitchellh/hashstructure"
 	v1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 )
 
@@ -33,26 +33,46 @@ import (
 // metadata and the rest of the secret k8s object.
 const MaxSecretDataSizeBytes = v1.MaxSecretSize - 50_000
 
+// CheckSecretSize returns an error if the data of the Secret exceeds the
+// maximum size accepted by the Kubernetes API.
+func CheckSecretSize(s *v1.Secret) error {
+	var size int
+	for k, v := range s.Data {
+		size += len(k) + len(v)
+	}
+
+	if size > v1.MaxSecretSize {
+		return k8sutil.NewInvalidSpecError(fmt.Errorf("the data of Secret %q is %d bytes, exceeding the maximum size of %d bytes", s.Name, size, v1.MaxSecretSize))
+	}
+
+	return nil
+}
+
 // ShardedSecret can shard Secret data across multiple k8s Secrets.
 // This is used to circumvent the size limitation of k8s Secrets.
 type ShardedSecret struct {
 	template     *v1.Secret
 	data         map[string][]byte
 	secretShards []*v1.Secret
+
+	// Relative paths of the keys in the volume (optional).
+	paths map[string]string
+	// Additional sources of the volume (optional).
+	projections []v1.VolumeProjection
 }
 
 // updateSecrets updates the concrete Secrets from the stored data.
-func (s *ShardedSecret) updateSecrets(ctx context.Context, sClient corev1.SecretInterface) error {
+func (s *ShardedSecret) updateSecrets(ctx context.Context, sClient corev1.SecretInterface, fieldManager string) error {
 	secrets := s.shard()
 
 	for _, secret := range secrets {
-		err := k8sutil.CreateOrUpdateSecret(ctx, sClient, secret)
+		err := k8sutil.CreateOrUpdateSecret(ctx, sClient, secret, fieldManager)
 		if err != nil {
 			return fmt.Errorf("failed to create secret %q: %w", secret.Name, err)
 		}
 	}
 
-	return s.cleanupExcessSecretShards(ctx, sClient, len(secrets)-1)
+	return nil
 }
 
 // shard does the in-memory sharding of the secret data.
@@ -90,33 +110,29 @@ func (s *ShardedSecret) newSecretAt(index int) *v1.Secret {
 	return newShardSecret
 }
 
-// cleanupExcessSecretShards removes excess secret shards that are no longer in use.
-// It also tries to remove a non-sharded secret that exactly matches the name
-// prefix in order to make sure that operator version upgrades run smoothly.
-func (s *ShardedSecret) cleanupExcessSecretShards(ctx context.Context, sClient corev1.SecretInterface, lastSecretIndex int) error {
-	for i := lastSecretIndex + 1; ; i++ {
-		secretName := s.secretNameAt(i)
-		err := sClient.Delete(ctx, secretName, metav1.DeleteOptions{})
-		if apierrors.IsNotFound(err) {
-			// we reached the end of existing secrets
-			break
-		}
+func (s *ShardedSecret) secretNameAt(index int) string {
+	return fmt.Sprintf("%s-%d", s.template.Name, index)
+}
 
-		if err != nil {
-			return fmt.Errorf("failed to delete secret %q: %w", secretName, err)
-		}
+// SecretNames returns the names of the concrete Secrets.
+// It must be called after UpdateSecrets().
+func (s *ShardedSecret) SecretNames() []string {
+	names := make([]string, 0, len(s.secretShards))
+	for i := range s.secretShards {
+		names = append(names, s.secretNameAt(i))
 	}
 
-	return nil
-}
-
-func (s *ShardedSecret) secretNameAt(index int) string {
-	return fmt.Sprintf("%s-%d", s.template.Name, index)
+	return names
 }
 
 // Hash implements the Hashable interface from github.com/mitchellh/hashstructure.
 func (s *ShardedSecret) Hash() (uint64, error) {
-	return uint64(len(s.secretShards)), nil
+	if s.paths == nil && len(s.projections) == 0 {
+		return uint64(len(s.secretShards)), nil
+	}
+
+	// The volume depends on the keys and the additional sources.
+	return hashstructure.Hash(s.Volume(""), nil)
 }
 
 // Volume returns a v1.Volume object with all TLS assets ready to be mounted in a container.
@@ -132,26 +148,52 @@ func (s *ShardedSecret) Volume(name string) v1.Volume {
 	}
 
 	for i := 0; i < len(s.secretShards); i++ {
-		volume.Projected.Sources = append(volume.Projected.Sources,
-			v1.VolumeProjection{
-				Secret: &v1.SecretProjection{
-					LocalObjectReference: v1.LocalObjectReference{Name: s.secretNameAt(i)},
-				},
-			})
+		projection := v1.VolumeProjection{
+			Secret: &v1.SecretProjection{
+				LocalObjectReference: v1.LocalObjectReference{Name: s.secretNameAt(i)},
+			},
+		}
+
+		if s.paths != nil {
+			for _, k := range sortutil.SortedKeys(s.secretShards[i].Data) {
+				projection.Secret.Items = append(projection.Secret.Items, v1.KeyToPath{Key: k, Path: s.paths[k]})
+			}
+		}
+
+		volume.Projected.Sources = append(volume.Projected.Sources, projection)
 	}
 
+	volume.Projected.Sources = append(volume.Projected.Sources, s.projections...)
+
 	return volume
 }
 
-func ReconcileShardedSecret(ctx context.Context, data map[string][]byte, client kubernetes.Interface, template *v1.Secret) (*ShardedSecret, error) {
+func ReconcileShardedSecret(ctx context.Context, data map[string][]byte, client kubernetes.Interface, template *v1.Secret, fieldManager string) (*ShardedSecret, error) {
 	shardedSecret := &ShardedSecret{
 		template: template,
 		data:     data,
 	}
 
-	if err := shardedSecret.updateSecrets(ctx, client.CoreV1().Secrets(template.Namespace)); err != nil {
+	if err := shardedSecret.updateSecrets(ctx, client.CoreV1().Secrets(template.Namespace), fieldManager); err != nil {
 		return nil, fmt.Errorf("failed to update the TLS secrets: %w", err)
 	}
 
 	return shardedSecret, nil
 }
+
+// ReconcileTLSAssets reconciles the Secrets holding the TLS assets of the
+// store. When the projection of the TLS assets is enabled, the volume maps
+// the keys of the Secrets to the paths of the assets and it projects the
+// assets located in the namespace of the workload from their Secrets and
+// ConfigMaps.
+func ReconcileTLSAssets(ctx context.Context, store *assets.StoreBuilder, client kubernetes.Interface, template *v1.Secret, fieldManager string) (*ShardedSecret, error) {
+	shardedSecret, err := ReconcileShardedSecret(ctx, store.TLSAssets(), client, template, fieldManager)
+	if err != nil {
+		return nil, err
+	}
+
+	shardedSecret.paths = store.TLSAssetPaths()
+	shardedSecret.projections = store.TLSAssetProjections()
+
+	return shardedSecret, nil
+}
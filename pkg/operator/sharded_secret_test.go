@@ -17,6 +17,7 @@ package operator
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -88,3 +89,80 @@ func TestShardedSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckSecretSize(t *testing.T) {
+	for _, tc := range []struct {
+		desc string
+		data map[string][]byte
+		err  bool
+	}{
+		{
+			desc: "empty data",
+		},
+		{
+			desc: "exactly the size limit",
+			data: map[string][]byte{
+				"key": make([]byte, v1.MaxSecretSize-3), // -3 because of the key size
+			},
+		},
+		{
+			desc: "over the size limit",
+			data: map[string][]byte{
+				"key": make([]byte, v1.MaxSecretSize),
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := CheckSecretSize(&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret"},
+				Data:       tc.data,
+			})
+			if tc.err != (err != nil) {
+				t.Errorf("expected error %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestShardedSecretVolumeWithPaths(t *testing.T) {
+	s := &ShardedSecret{
+		template: &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "tls-assets"}},
+		data: map[string][]byte{
+			"1_ns2_ca_ca.crt": []byte("ca"),
+		},
+		paths: map[string]string{
+			"1_ns2_ca_ca.crt": "ns2/configmap/ca/ca.crt",
+		},
+		projections: []v1.VolumeProjection{{
+			Secret: &v1.SecretProjection{
+				LocalObjectReference: v1.LocalObjectReference{Name: "tls"},
+				Items:                []v1.KeyToPath{{Key: "tls.crt", Path: "ns1/secret/tls/tls.crt"}},
+			},
+		}},
+	}
+	s.shard()
+
+	require.Equal(t, []v1.VolumeProjection{
+		{
+			Secret: &v1.SecretProjection{
+				LocalObjectReference: v1.LocalObjectReference{Name: "tls-assets-0"},
+				Items:                []v1.KeyToPath{{Key: "1_ns2_ca_ca.crt", Path: "ns2/configmap/ca/ca.crt"}},
+			},
+		},
+		{
+			Secret: &v1.SecretProjection{
+				LocalObjectReference: v1.LocalObjectReference{Name: "tls"},
+				Items:                []v1.KeyToPath{{Key: "tls.crt", Path: "ns1/secret/tls/tls.crt"}},
+			},
+		},
+	}, s.Volume("tls-assets").Projected.Sources)
+
+	// The hash changes with the projected assets.
+	h1, err := s.Hash()
+	require.NoError(t, err)
+	s.projections = nil
+	h2, err := s.Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, h1, h2)
+}
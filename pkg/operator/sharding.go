@@ -0,0 +1,336 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// shardingGroupLabel identifies the Lease objects of the operator
+	// replicas belonging to the same sharding group.
+	shardingGroupLabel = "operator.prometheus.io/sharding-group"
+
+	// Number of points per member on the hash ring.
+	shardingVirtualNodes = 100
+)
+
+// ShardingConfig defines the settings of the horizontal sharding.
+type ShardingConfig struct {
+	// Whether the sharding is enabled.
+	Enabled bool
+	// Name of the sharding group. It is used as the prefix of the Lease
+	// objects.
+	GroupName string
+	// Namespace of the Lease objects.
+	LeaseNamespace string
+	// Duration after which a replica which hasn't renewed its Lease is
+	// considered gone.
+	LeaseDuration time.Duration
+	// Interval at which the replicas renew their Lease and refresh the
+	// membership.
+	RenewInterval time.Duration
+}
+
+// Sharder splits the resources between the operator replicas of a sharding
+// group.
+//
+// Each replica maintains a Lease object labeled with the name of the group.
+// The members of the group are the holders of the Leases which have been
+// renewed within the lease duration. A resource is owned by exactly one member
+// which is chosen by consistent hashing of the resource's namespace and name,
+// meaning that only a fraction of the resources moves to another replica when
+// the membership changes.
+//
+// Because the replicas don't observe the membership changes at the same
+// time, a resource may be reconciled by 2 replicas for the duration of the
+// renew interval.
+//
+// A nil Sharder owns all the resources.
+type Sharder struct {
+	logger   *slog.Logger
+	kclient  kubernetes.Interface
+	config   ShardingConfig
+	identity string
+
+	mtx         sync.RWMutex
+	members     []string
+	ring        *hashRing
+	subscribers []func()
+
+	membersGauge prometheus.Gauge
+}
+
+// NewSharder returns a Sharder and registers its metrics with the registerer.
+func NewSharder(logger *slog.Logger, kclient kubernetes.Interface, r prometheus.Registerer, config ShardingConfig) (*Sharder, error) {
+	if config.LeaseNamespace == "" {
+		config.LeaseNamespace = inClusterNamespace()
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the hostname: %w", err)
+	}
+
+	s := &Sharder{
+		logger:   logger.With("group", config.GroupName, "identity", hostname),
+		kclient:  kclient,
+		config:   config,
+		identity: hostname,
+		ring:     newHashRing(nil),
+		membersGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "prometheus_operator_sharding_members",
+			Help: "Number of operator replicas in the sharding group.",
+		}),
+	}
+	r.MustRegister(s.membersGauge)
+
+	return s, nil
+}
+
+// Subscribe registers a function called every time the membership of the
+// group changes.
+func (s *Sharder) Subscribe(f func()) {
+	if s == nil {
+		return
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.subscribers = append(s.subscribers, f)
+}
+
+// Owns returns true if the replica is responsible for the resource identified
+// by its namespace and name.
+// It returns false until the Sharder has retrieved the membership.
+func (s *Sharder) Owns(namespace, name string) bool {
+	if s == nil {
+		return true
+	}
+
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.ring.get(namespace+"/"+name) == s.identity
+}
+
+// OwnsKey is like Owns for a "<namespace>/<name>" key.
+func (s *Sharder) OwnsKey(key string) bool {
+	ns, name, _ := strings.Cut(key, "/")
+	return s.Owns(ns, name)
+}
+
+// Run renews the Lease of the replica and refreshes the membership until the
+// context is canceled. The Lease is deleted before returning so that the
+// other replicas can take over the resources without waiting for the
+// expiration.
+func (s *Sharder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.config.RenewInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.refresh(ctx); err != nil {
+			s.logger.Warn("failed to refresh the sharding membership", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			s.release()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Sharder) leaseName() string {
+	return s.config.GroupName + "-" + s.identity
+}
+
+func (s *Sharder) refresh(ctx context.Context) error {
+	if err := s.renew(ctx); err != nil {
+		return fmt.Errorf("failed to renew the lease: %w", err)
+	}
+
+	leases, err := s.kclient.CoordinationV1().Leases(s.config.LeaseNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{shardingGroupLabel: s.config.GroupName}).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the leases: %w", err)
+	}
+
+	s.setMembers(activeMembers(leases.Items, time.Now()))
+
+	return nil
+}
+
+func (s *Sharder) renew(ctx context.Context) error {
+	lclient := s.kclient.CoordinationV1().Leases(s.config.LeaseNamespace)
+	now := metav1.NewMicroTime(time.Now())
+
+	lease, err := lclient.Get(ctx, s.leaseName(), metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		_, err = lclient.Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   s.leaseName(),
+				Labels: map[string]string{shardingGroupLabel: s.config.GroupName},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(s.identity),
+				LeaseDurationSeconds: ptr.To(int32(s.config.LeaseDuration.Seconds())),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}, metav1.CreateOptions{})
+		return err
+	}
+
+	lease.Spec.HolderIdentity = ptr.To(s.identity)
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(s.config.LeaseDuration.Seconds()))
+	lease.Spec.RenewTime = &now
+
+	_, err = lclient.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
+
+func (s *Sharder) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.RenewInterval)
+	defer cancel()
+
+	err := s.kclient.CoordinationV1().Leases(s.config.LeaseNamespace).Delete(ctx, s.leaseName(), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		s.logger.Warn("failed to delete the lease", "err", err)
+	}
+}
+
+func (s *Sharder) setMembers(members []string) {
+	// The replica always considers itself as a member even if the lease
+	// couldn't be read back.
+	if !slices.Contains(members, s.identity) {
+		members = append(members, s.identity)
+		sort.Strings(members)
+	}
+
+	s.mtx.Lock()
+	if slices.Equal(s.members, members) {
+		s.mtx.Unlock()
+		return
+	}
+
+	s.members = members
+	s.ring = newHashRing(members)
+	subscribers := slices.Clone(s.subscribers)
+	s.mtx.Unlock()
+
+	s.membersGauge.Set(float64(len(members)))
+	s.logger.Info("sharding membership changed", "members", strings.Join(members, ","))
+
+	for _, f := range subscribers {
+		f()
+	}
+}
+
+// activeMembers returns the sorted identities of the holders of the leases
+// which haven't expired.
+func activeMembers(leases []coordinationv1.Lease, now time.Time) []string {
+	var members []string
+	for _, l := range leases {
+		if l.Spec.HolderIdentity == nil || l.Spec.RenewTime == nil {
+			continue
+		}
+
+		d := time.Duration(ptr.Deref(l.Spec.LeaseDurationSeconds, 0)) * time.Second
+		if now.After(l.Spec.RenewTime.Add(d)) {
+			continue
+		}
+
+		members = append(members, *l.Spec.HolderIdentity)
+	}
+
+	sort.Strings(members)
+	return slices.Compact(members)
+}
+
+// hashRing implements consistent hashing with virtual nodes.
+type hashRing struct {
+	hashes []uint64
+	owners map[uint64]string
+}
+
+func newHashRing(members []string) *hashRing {
+	r := &hashRing{
+		owners: make(map[uint64]string, len(members)*shardingVirtualNodes),
+	}
+
+	for _, m := range members {
+		for i := range shardingVirtualNodes {
+			h := hashKey(m + "-" + strconv.Itoa(i))
+			if _, found := r.owners[h]; found {
+				continue
+			}
+
+			r.owners[h] = m
+			r.hashes = append(r.hashes, h)
+		}
+	}
+
+	slices.Sort(r.hashes)
+
+	return r
+}
+
+// get returns the member owning the key or an empty string if the ring has
+// no member.
+func (r *hashRing) get(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+
+	h := hashKey(key)
+	i, _ := slices.BinarySearch(r.hashes, h)
+	if i == len(r.hashes) {
+		i = 0
+	}
+
+	return r.owners[r.hashes[i]]
+}
+
+func hashKey(s string) uint64 {
+	h := sha256.Sum256([]byte(s))
+	return binary.BigEndian.Uint64(h[:8])
+}
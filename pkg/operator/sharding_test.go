@@ -0,0 +1,141 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestHashRing(t *testing.T) {
+	keys := make([]string, 1000)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("ns/prometheus-%d", i)
+	}
+
+	require.Empty(t, newHashRing(nil).get("ns/prometheus-0"))
+
+	r3 := newHashRing([]string{"a", "b", "c"})
+	owned := map[string]int{}
+	for _, k := range keys {
+		owned[r3.get(k)]++
+	}
+	require.Len(t, owned, 3)
+	for m, n := range owned {
+		require.Greater(t, n, 200, "member %q owns too few keys", m)
+	}
+
+	// Removing a member only moves its keys.
+	r2 := newHashRing([]string{"a", "c"})
+	for _, k := range keys {
+		if r3.get(k) != "b" {
+			require.Equal(t, r3.get(k), r2.get(k))
+		}
+	}
+}
+
+func TestActiveMembers(t *testing.T) {
+	now := time.Now()
+	lease := func(holder string, renew time.Time) coordinationv1.Lease {
+		return coordinationv1.Lease{
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(holder),
+				LeaseDurationSeconds: ptr.To(int32(30)),
+				RenewTime:            ptr.To(metav1.NewMicroTime(renew)),
+			},
+		}
+	}
+
+	require.Equal(t,
+		[]string{"a", "c"},
+		activeMembers([]coordinationv1.Lease{
+			lease("c", now.Add(-10*time.Second)),
+			lease("b", now.Add(-time.Minute)),
+			lease("a", now),
+			{},
+		}, now),
+	)
+}
+
+func TestSharder(t *testing.T) {
+	kclient := fake.NewClientset(
+		&coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "prometheus-operator-other",
+				Namespace: "monitoring",
+				Labels:    map[string]string{shardingGroupLabel: "prometheus-operator"},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To("other"),
+				LeaseDurationSeconds: ptr.To(int32(30)),
+				RenewTime:            ptr.To(metav1.NewMicroTime(time.Now())),
+			},
+		},
+	)
+
+	s, err := NewSharder(promslog.NewNopLogger(), kclient, prometheus.NewRegistry(), ShardingConfig{
+		Enabled:        true,
+		GroupName:      "prometheus-operator",
+		LeaseNamespace: "monitoring",
+		LeaseDuration:  30 * time.Second,
+		RenewInterval:  10 * time.Second,
+	})
+	require.NoError(t, err)
+
+	var notified int
+	s.Subscribe(func() { notified++ })
+
+	// The sharder owns nothing until the membership is known.
+	require.False(t, s.Owns("ns", "a"))
+
+	require.NoError(t, s.refresh(context.Background()))
+	require.Equal(t, 1, notified)
+
+	_, err = kclient.CoordinationV1().Leases("monitoring").Get(context.Background(), s.leaseName(), metav1.GetOptions{})
+	require.NoError(t, err)
+
+	var owned int
+	for i := range 100 {
+		if s.Owns("ns", fmt.Sprintf("p%d", i)) {
+			owned++
+		}
+	}
+	require.Greater(t, owned, 0)
+	require.Less(t, owned, 100)
+
+	// No notification when the membership is unchanged.
+	require.NoError(t, s.refresh(context.Background()))
+	require.Equal(t, 1, notified)
+
+	// The sharder owns everything once the other member is gone.
+	require.NoError(t, kclient.CoordinationV1().Leases("monitoring").Delete(context.Background(), "prometheus-operator-other", metav1.DeleteOptions{}))
+	require.NoError(t, s.refresh(context.Background()))
+	require.Equal(t, 2, notified)
+	require.True(t, s.OwnsKey("ns/p0"))
+
+	// A nil sharder owns everything.
+	var nilSharder *Sharder
+	require.True(t, nilSharder.Owns("ns", "p0"))
+}
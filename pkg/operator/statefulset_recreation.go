@@ -0,0 +1,232 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientappsv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	selectorField             = "spec.selector"
+	serviceNameField          = "spec.serviceName"
+	podManagementPolicyField  = "spec.podManagementPolicy"
+	volumeClaimTemplatesField = "spec.volumeClaimTemplates"
+
+	// unknownRecreationReason is the reason of the recreations for which no
+	// immutable field change was detected.
+	unknownRecreationReason = "unknown"
+)
+
+// statefulSetRecreationReasons lists the possible reasons of the
+// StatefulSet recreations.
+var statefulSetRecreationReasons = []string{
+	selectorField,
+	serviceNameField,
+	podManagementPolicyField,
+	volumeClaimTemplatesField,
+	unknownRecreationReason,
+}
+
+// StatefulSetRecreationPolicy defines how a StatefulSet is deleted when it
+// needs to be recreated because of changes to immutable fields.
+type StatefulSetRecreationPolicy string
+
+const (
+	// CascadeRecreationPolicy deletes the pods with the StatefulSet
+	// (foreground deletion). The pods are recreated by the new StatefulSet.
+	CascadeRecreationPolicy StatefulSetRecreationPolicy = "cascade"
+	// OrphanRecreationPolicy keeps the pods running while the StatefulSet is
+	// recreated. The new StatefulSet adopts the pods and rolls them out
+	// according to its update strategy.
+	//
+	// The cascade policy is used when the selector changes because the pods
+	// wouldn't be adopted by the new StatefulSet.
+	OrphanRecreationPolicy StatefulSetRecreationPolicy = "orphan"
+)
+
+// String implements the flag.Value interface.
+func (p *StatefulSetRecreationPolicy) String() string {
+	if p == nil || *p == "" {
+		return string(CascadeRecreationPolicy)
+	}
+	return string(*p)
+}
+
+// Set implements the flag.Value interface.
+func (p *StatefulSetRecreationPolicy) Set(value string) error {
+	if value != string(CascadeRecreationPolicy) && value != string(OrphanRecreationPolicy) {
+		return fmt.Errorf("invalid StatefulSet recreation policy, expected %q or %q but got: %q", CascadeRecreationPolicy, OrphanRecreationPolicy, value)
+	}
+	*p = StatefulSetRecreationPolicy(value)
+	return nil
+}
+
+// StatefulSetRecreator deletes the StatefulSets which can't be updated
+// because of changes to immutable fields. The StatefulSets are created again
+// by the next reconciliation.
+type StatefulSetRecreator struct {
+	policy   StatefulSetRecreationPolicy
+	metrics  *Metrics
+	recorder record.EventRecorder
+}
+
+// NewStatefulSetRecreator returns a StatefulSetRecreator. The cascade
+// policy is used when the policy is empty.
+func NewStatefulSetRecreator(policy StatefulSetRecreationPolicy, metrics *Metrics, recorder record.EventRecorder) *StatefulSetRecreator {
+	if policy == "" {
+		policy = CascadeRecreationPolicy
+	}
+
+	return &StatefulSetRecreator{
+		policy:   policy,
+		metrics:  metrics,
+		recorder: recorder,
+	}
+}
+
+// Recreate deletes the existing StatefulSet if updateErr tells that the
+// update was rejected by the API server because of invalid changes. It
+// returns true if the StatefulSet was deleted.
+//
+// An event explaining which fields triggered the recreation is emitted for
+// the owner and the recreation is counted by field in the
+// prometheus_operator_reconcile_sts_delete_create_total metric.
+func (r *StatefulSetRecreator) Recreate(
+	ctx context.Context,
+	logger *slog.Logger,
+	ssetClient clientappsv1.StatefulSetInterface,
+	owner runtime.Object,
+	existing, desired *appsv1.StatefulSet,
+	updateErr error,
+) (bool, error) {
+	var sErr *apierrors.StatusError
+	if !errors.As(updateErr, &sErr) || sErr.ErrStatus.Code != http.StatusUnprocessableEntity || sErr.ErrStatus.Reason != metav1.StatusReasonInvalid {
+		return false, nil
+	}
+
+	fields := ImmutableStatefulSetFields(existing, desired)
+
+	reasons := fields
+	if len(reasons) == 0 {
+		reasons = []string{unknownRecreationReason}
+	}
+	for _, reason := range reasons {
+		r.metrics.StsDeleteCreateCounter(reason).Inc()
+	}
+
+	// Gather only reason for failed update
+	var failMsg []string
+	if sErr.ErrStatus.Details != nil {
+		for _, cause := range sErr.ErrStatus.Details.Causes {
+			failMsg = append(failMsg, cause.Message)
+		}
+	}
+
+	policy := r.policy
+	if policy == OrphanRecreationPolicy && !apiequality.Semantic.DeepEqual(existing.Spec.Selector, desired.Spec.Selector) {
+		logger.Info("falling back to the cascade policy because the selector of the StatefulSet changed")
+		policy = CascadeRecreationPolicy
+	}
+
+	propagationPolicy := metav1.DeletePropagationForeground
+	if policy == OrphanRecreationPolicy {
+		propagationPolicy = metav1.DeletePropagationOrphan
+	}
+
+	msg := fmt.Sprintf("Recreating StatefulSet %s (%s policy) because the update operation wasn't possible", desired.Name, policy)
+	if len(fields) > 0 {
+		msg += fmt.Sprintf(", the following immutable fields changed: %s", strings.Join(fields, ", "))
+	}
+	if len(failMsg) > 0 {
+		msg += fmt.Sprintf(": %s", strings.Join(failMsg, ", "))
+	}
+
+	logger.Info("recreating StatefulSet because the update operation wasn't possible", "fields", strings.Join(fields, ","), "policy", policy, "reason", strings.Join(failMsg, ", "))
+	r.recorder.Event(owner, v1.EventTypeNormal, StatefulSetRecreatedEvent, msg)
+
+	if err := ssetClient.Delete(ctx, desired.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
+		return false, fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
+	}
+
+	return true, nil
+}
+
+// ImmutableStatefulSetFields returns the immutable fields of the StatefulSet
+// spec which differ between the existing and the desired StatefulSets.
+//
+// The fields defaulted by the API server are only compared when they are set
+// in the desired StatefulSet.
+func ImmutableStatefulSetFields(existing, desired *appsv1.StatefulSet) []string {
+	var fields []string
+
+	if !apiequality.Semantic.DeepEqual(existing.Spec.Selector, desired.Spec.Selector) {
+		fields = append(fields, selectorField)
+	}
+
+	if existing.Spec.ServiceName != desired.Spec.ServiceName {
+		fields = append(fields, serviceNameField)
+	}
+
+	if desired.Spec.PodManagementPolicy != "" && existing.Spec.PodManagementPolicy != desired.Spec.PodManagementPolicy {
+		fields = append(fields, podManagementPolicyField)
+	}
+
+	if !equalVolumeClaimTemplates(existing.Spec.VolumeClaimTemplates, desired.Spec.VolumeClaimTemplates) {
+		fields = append(fields, volumeClaimTemplatesField)
+	}
+
+	return fields
+}
+
+func equalVolumeClaimTemplates(existing, desired []v1.PersistentVolumeClaim) bool {
+	if len(existing) != len(desired) {
+		return false
+	}
+
+	for i := range desired {
+		e, d := existing[i], desired[i]
+
+		if e.Name != d.Name ||
+			!apiequality.Semantic.DeepEqual(e.Spec.AccessModes, d.Spec.AccessModes) ||
+			!apiequality.Semantic.DeepEqual(e.Spec.Resources, d.Spec.Resources) ||
+			!apiequality.Semantic.DeepEqual(e.Spec.Selector, d.Spec.Selector) {
+			return false
+		}
+
+		if d.Spec.StorageClassName != nil && !apiequality.Semantic.DeepEqual(e.Spec.StorageClassName, d.Spec.StorageClassName) {
+			return false
+		}
+
+		if d.Spec.VolumeMode != nil && !apiequality.Semantic.DeepEqual(e.Spec.VolumeMode, d.Spec.VolumeMode) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,222 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func newTestStatefulSet() *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus-main",
+			Namespace: "default",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			ServiceName: "prometheus-operated",
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app.kubernetes.io/name": "prometheus"},
+			},
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "data"},
+					Spec: v1.PersistentVolumeClaimSpec{
+						Resources: v1.VolumeResourceRequirements{
+							Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("10Gi")},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestImmutableStatefulSetFields(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		update   func(*appsv1.StatefulSet)
+		expected []string
+	}{
+		{
+			name:   "no change",
+			update: func(*appsv1.StatefulSet) {},
+		},
+		{
+			name: "mutable field",
+			update: func(s *appsv1.StatefulSet) {
+				s.Spec.Template.Spec.ServiceAccountName = "prometheus"
+			},
+		},
+		{
+			name: "defaulted field",
+			update: func(s *appsv1.StatefulSet) {
+				s.Spec.PodManagementPolicy = ""
+			},
+		},
+		{
+			name: "volume claim template size",
+			update: func(s *appsv1.StatefulSet) {
+				s.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[v1.ResourceStorage] = resource.MustParse("20Gi")
+			},
+			expected: []string{"spec.volumeClaimTemplates"},
+		},
+		{
+			name: "selector and service name",
+			update: func(s *appsv1.StatefulSet) {
+				s.Spec.Selector.MatchLabels["foo"] = "bar"
+				s.Spec.ServiceName = "prometheus-main"
+			},
+			expected: []string{"spec.selector", "spec.serviceName"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			existing := newTestStatefulSet()
+			existing.Spec.PodManagementPolicy = appsv1.ParallelPodManagement
+
+			desired := existing.DeepCopy()
+			tc.update(desired)
+
+			require.Equal(t, tc.expected, ImmutableStatefulSetFields(existing, desired))
+		})
+	}
+}
+
+func TestStatefulSetRecreator(t *testing.T) {
+	invalidErr := apierrors.NewInvalid(
+		schema.GroupKind{Group: "apps", Kind: "StatefulSet"},
+		"prometheus-main",
+		field.ErrorList{field.Forbidden(field.NewPath("spec"), "updates to statefulset spec for fields other than 'replicas' are forbidden")},
+	)
+
+	for _, tc := range []struct {
+		name           string
+		policy         StatefulSetRecreationPolicy
+		updateErr      error
+		changeSelector bool
+
+		recreated         bool
+		expectedPolicy    metav1.DeletionPropagation
+		expectedReason    string
+		expectedEventPart string
+	}{
+		{
+			name:      "no error",
+			updateErr: nil,
+		},
+		{
+			name:      "other error",
+			updateErr: errors.New("connection refused"),
+		},
+		{
+			name:              "default policy",
+			updateErr:         invalidErr,
+			recreated:         true,
+			expectedPolicy:    metav1.DeletePropagationForeground,
+			expectedReason:    "spec.volumeClaimTemplates",
+			expectedEventPart: "(cascade policy) because the update operation wasn't possible, the following immutable fields changed: spec.volumeClaimTemplates",
+		},
+		{
+			name:              "orphan policy",
+			policy:            OrphanRecreationPolicy,
+			updateErr:         invalidErr,
+			recreated:         true,
+			expectedPolicy:    metav1.DeletePropagationOrphan,
+			expectedReason:    "spec.volumeClaimTemplates",
+			expectedEventPart: "(orphan policy)",
+		},
+		{
+			name:              "orphan policy with selector change",
+			policy:            OrphanRecreationPolicy,
+			updateErr:         invalidErr,
+			changeSelector:    true,
+			recreated:         true,
+			expectedPolicy:    metav1.DeletePropagationForeground,
+			expectedReason:    "spec.selector",
+			expectedEventPart: "(cascade policy)",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			existing := newTestStatefulSet()
+			desired := existing.DeepCopy()
+			desired.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[v1.ResourceStorage] = resource.MustParse("20Gi")
+			if tc.changeSelector {
+				desired.Spec.Selector.MatchLabels["foo"] = "bar"
+			}
+
+			clientset := fake.NewSimpleClientset(existing)
+			var propagationPolicy *metav1.DeletionPropagation
+			clientset.PrependReactor("delete", "statefulsets", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				propagationPolicy = action.(clienttesting.DeleteAction).GetDeleteOptions().PropagationPolicy
+				return false, nil, nil
+			})
+
+			metrics := NewMetrics(prometheus.NewRegistry())
+			recorder := record.NewFakeRecorder(1)
+			r := NewStatefulSetRecreator(tc.policy, metrics, recorder)
+
+			recreated, err := r.Recreate(
+				context.Background(),
+				slog.New(slog.DiscardHandler),
+				clientset.AppsV1().StatefulSets("default"),
+				&monitoringv1.Prometheus{},
+				existing,
+				desired,
+				tc.updateErr,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.recreated, recreated)
+
+			if !tc.recreated {
+				require.Nil(t, propagationPolicy)
+				require.Empty(t, recorder.Events)
+				return
+			}
+
+			require.Equal(t, tc.expectedPolicy, *propagationPolicy)
+			require.Equal(t, 1.0, testutil.ToFloat64(metrics.StsDeleteCreateCounter(tc.expectedReason)))
+			require.Contains(t, <-recorder.Events, tc.expectedEventPart)
+		})
+	}
+}
+
+func TestStatefulSetRecreationPolicyFlag(t *testing.T) {
+	var p StatefulSetRecreationPolicy
+	require.Equal(t, "cascade", p.String())
+
+	require.NoError(t, p.Set("orphan"))
+	require.Equal(t, OrphanRecreationPolicy, p)
+
+	require.Error(t, p.Set("background"))
+}
@@ -17,6 +17,8 @@ package operator
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,6 +29,8 @@ import (
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 )
 
 // Pod is an alias for the Kubernetes v1.Pod type.
@@ -48,6 +52,21 @@ func (p *Pod) Ready() bool {
 	return false
 }
 
+// containerWaitingReasons returns the reasons of the containers which are
+// waiting to run (e.g. "CrashLoopBackOff").
+func (p *Pod) containerWaitingReasons() []string {
+	var reasons []string
+	for _, cs := range p.Status.ContainerStatuses {
+		if cs.State.Waiting == nil || cs.State.Waiting.Reason == "" {
+			continue
+		}
+
+		reasons = append(reasons, fmt.Sprintf("container %s is waiting (%s)", cs.Name, cs.State.Waiting.Reason))
+	}
+
+	return reasons
+}
+
 // Message returns a human-readable and terse message about the state of the pod.
 func (p *Pod) Message() string {
 	for _, condType := range []v1.PodConditionType{
@@ -102,6 +121,106 @@ func (sr *StatefulSetReporter) filterPods(f func(*Pod) bool) []*Pod {
 	return pods
 }
 
+// RolloutStatus returns the progress of the statefulset's rollout or nil if
+// the statefulset doesn't exist.
+func (sr *StatefulSetReporter) RolloutStatus() *monitoringv1.RolloutStatus {
+	if sr.sset == nil {
+		return nil
+	}
+
+	pods := make([]*v1.Pod, 0, len(sr.Pods))
+	for _, p := range sr.Pods {
+		pods = append(pods, (*v1.Pod)(p))
+	}
+
+	progress := k8sutil.NewRolloutProgress(sr.sset, pods, time.Now())
+
+	return &monitoringv1.RolloutStatus{
+		CurrentRevision:          progress.Current.Revision,
+		CurrentReplicas:          progress.Current.Replicas,
+		UpdateRevision:           progress.Update.Revision,
+		UpdatedReplicas:          progress.Update.Replicas,
+		UpdatedAvailableReplicas: progress.Update.AvailableReplicas,
+		Complete:                 progress.Complete(),
+	}
+}
+
+// ObservedGeneration returns the generation of the governing object from
+// which the statefulset was generated. It returns zero if the statefulset
+// doesn't exist or if the generation isn't known.
+func (sr *StatefulSetReporter) ObservedGeneration() int64 {
+	if sr.sset == nil {
+		return 0
+	}
+
+	g, err := strconv.ParseInt(sr.sset.Annotations[GenerationAnnotationName], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return g
+}
+
+// RolloutBlockedReason returns a human-readable explanation of what the
+// statefulset's rollout is waiting for. It returns an empty string if the
+// rollout is complete or if the statefulset doesn't exist.
+func (sr *StatefulSetReporter) RolloutBlockedReason() string {
+	rs := sr.RolloutStatus()
+	if rs == nil || rs.Complete {
+		return ""
+	}
+
+	if sr.sset.Status.ObservedGeneration < sr.sset.Generation {
+		return "the StatefulSet controller hasn't observed the latest StatefulSet spec yet"
+	}
+
+	pods := slices.Clone(sr.Pods)
+	slices.SortFunc(pods, func(a, b *Pod) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var running int32
+	for _, p := range pods {
+		if p.DeletionTimestamp != nil {
+			return fmt.Sprintf("pod %s is terminating", p.Name)
+		}
+		running++
+
+		if p.Ready() {
+			continue
+		}
+
+		reasons := p.containerWaitingReasons()
+		if m := p.Message(); m != "" {
+			reasons = append([]string{m}, reasons...)
+		}
+
+		if len(reasons) == 0 {
+			return fmt.Sprintf("pod %s isn't ready", p.Name)
+		}
+
+		return fmt.Sprintf("pod %s isn't ready: %s", p.Name, strings.Join(reasons, "; "))
+	}
+
+	if desired := ptr.Deref(sr.sset.Spec.Replicas, 1); running < desired {
+		return fmt.Sprintf("%d pod(s) not created yet", desired-running)
+	}
+
+	return ""
+}
+
+// RolloutStatusApplyConfiguration returns the apply configuration of the
+// rollout status.
+func RolloutStatusApplyConfiguration(rs *monitoringv1.RolloutStatus) *monitoringv1ac.RolloutStatusApplyConfiguration {
+	return monitoringv1ac.RolloutStatus().
+		WithCurrentRevision(rs.CurrentRevision).
+		WithCurrentReplicas(rs.CurrentReplicas).
+		WithUpdateRevision(rs.UpdateRevision).
+		WithUpdatedReplicas(rs.UpdatedReplicas).
+		WithUpdatedAvailableReplicas(rs.UpdatedAvailableReplicas).
+		WithComplete(rs.Complete)
+}
+
 type GoverningObject interface {
 	metav1.Object
 	ExpectedReplicas() int
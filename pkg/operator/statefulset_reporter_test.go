@@ -0,0 +1,151 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func newReporterPod(i int, revision string, ready bool) *Pod {
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+
+	return &Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("prometheus-main-%d", i),
+			Labels: map[string]string{
+				appsv1.ControllerRevisionHashLabelKey: revision,
+			},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+			Conditions: []v1.PodCondition{{
+				Type:   v1.PodReady,
+				Status: status,
+			}},
+		},
+	}
+}
+
+func TestStatefulSetReporterRollout(t *testing.T) {
+	crashing := newReporterPod(1, "rev-2", false)
+	crashing.Status.Conditions = append(crashing.Status.Conditions, v1.PodCondition{
+		Type:    v1.ContainersReady,
+		Status:  v1.ConditionFalse,
+		Message: "containers with unready status: [prometheus]",
+	})
+	crashing.Status.ContainerStatuses = []v1.ContainerStatus{{
+		Name: "prometheus",
+		State: v1.ContainerState{
+			Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+		},
+	}}
+
+	terminating := newReporterPod(0, "rev-1", true)
+	terminating.DeletionTimestamp = ptr.To(metav1.Now())
+
+	for _, tc := range []struct {
+		name               string
+		generation         int64
+		observedGeneration int64
+		pods               []*Pod
+		expected           string
+	}{
+		{
+			name: "complete",
+			pods: []*Pod{
+				newReporterPod(0, "rev-2", true),
+				newReporterPod(1, "rev-2", true),
+			},
+		},
+		{
+			name:       "statefulset not observed",
+			generation: 2,
+			pods: []*Pod{
+				newReporterPod(0, "rev-1", true),
+				newReporterPod(1, "rev-1", true),
+			},
+			expected: "the StatefulSet controller hasn't observed the latest StatefulSet spec yet",
+		},
+		{
+			name: "pod not ready",
+			pods: []*Pod{
+				crashing,
+				newReporterPod(0, "rev-1", true),
+			},
+			expected: "pod prometheus-main-1 isn't ready: containers with unready status: [prometheus]; container prometheus is waiting (CrashLoopBackOff)",
+		},
+		{
+			name: "pod terminating",
+			pods: []*Pod{
+				terminating,
+				newReporterPod(1, "rev-2", true),
+			},
+			expected: "pod prometheus-main-0 is terminating",
+		},
+		{
+			name: "missing pod",
+			pods: []*Pod{
+				newReporterPod(0, "rev-2", true),
+			},
+			expected: "1 pod(s) not created yet",
+		},
+		{
+			name: "progressing",
+			pods: []*Pod{
+				newReporterPod(0, "rev-1", true),
+				newReporterPod(1, "rev-2", true),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sr := &StatefulSetReporter{
+				Pods: tc.pods,
+				sset: &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "prometheus-main",
+						Generation: tc.generation,
+						Annotations: map[string]string{
+							GenerationAnnotationName: "5",
+						},
+					},
+					Spec: appsv1.StatefulSetSpec{
+						Replicas: ptr.To(int32(2)),
+					},
+					Status: appsv1.StatefulSetStatus{
+						ObservedGeneration: tc.observedGeneration,
+						CurrentRevision:    "rev-1",
+						UpdateRevision:     "rev-2",
+					},
+				},
+			}
+
+			require.Equal(t, tc.expected, sr.RolloutBlockedReason())
+			require.Equal(t, int64(5), sr.ObservedGeneration())
+		})
+	}
+
+	require.Empty(t, (&StatefulSetReporter{}).RolloutBlockedReason())
+	require.Zero(t, (&StatefulSetReporter{}).ObservedGeneration())
+}
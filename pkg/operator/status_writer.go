@@ -0,0 +1,194 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	statusWriterMaxRetries = 5
+
+	statusWriterBaseDelay = 500 * time.Millisecond
+	statusWriterMaxDelay  = 30 * time.Second
+)
+
+// StatusWriterConfig defines the settings of the StatusWriter.
+type StatusWriterConfig struct {
+	// Number of status updates applied concurrently.
+	Workers int
+	// Maximum number of status updates applied per second.
+	QPS float64
+	// Maximum burst of status updates.
+	Burst int
+}
+
+// StatusWriteFunc applies a status update to the Kubernetes API.
+type StatusWriteFunc func(context.Context) error
+
+// StatusWriter applies the status updates of the configuration resources
+// (ServiceMonitor, PodMonitor, ...) asynchronously so that the reconciliation
+// loops of the workload controllers don't wait on the Kubernetes API.
+//
+// The writer is meant to be shared by all the controllers and resource types:
+//   - Pending updates are batched per key: when several updates are enqueued
+//     for the same key before being processed, only the last one is applied.
+//   - The updates are rate-limited across all the workers.
+//   - Failed updates are retried with an exponential backoff before being
+//     dropped.
+type StatusWriter struct {
+	logger  *slog.Logger
+	config  StatusWriterConfig
+	limiter *rate.Limiter
+	queue   workqueue.TypedRateLimitingInterface[string]
+
+	mtx     sync.Mutex
+	pending map[string]StatusWriteFunc
+
+	writes *prometheus.CounterVec
+}
+
+// NewStatusWriter returns a StatusWriter and registers the
+// prometheus_operator_status_writes_total metric with the registerer.
+func NewStatusWriter(logger *slog.Logger, r prometheus.Registerer, config StatusWriterConfig) *StatusWriter {
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+
+	if config.Burst <= 0 {
+		config.Burst = 1
+	}
+
+	limit := rate.Inf
+	if config.QPS > 0 {
+		limit = rate.Limit(config.QPS)
+	}
+
+	w := &StatusWriter{
+		logger:  logger,
+		config:  config,
+		limiter: rate.NewLimiter(limit, config.Burst),
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](statusWriterBaseDelay, statusWriterMaxDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "status_writer"},
+		),
+		pending: map[string]StatusWriteFunc{},
+		writes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "prometheus_operator_status_writes_total",
+				Help: "Number of status updates processed by the status writer.",
+			},
+			[]string{"result"},
+		),
+	}
+
+	for _, result := range []string{"success", "retry", "dropped"} {
+		w.writes.WithLabelValues(result)
+	}
+	r.MustRegister(w.writes)
+
+	return w
+}
+
+// Enqueue schedules the status update identified by key. It replaces any
+// pending update for the same key.
+func (w *StatusWriter) Enqueue(key string, fn StatusWriteFunc) {
+	w.mtx.Lock()
+	w.pending[key] = fn
+	w.mtx.Unlock()
+
+	w.queue.Add(key)
+}
+
+// Cancel discards the pending update identified by key, if any.
+func (w *StatusWriter) Cancel(key string) {
+	w.mtx.Lock()
+	delete(w.pending, key)
+	w.mtx.Unlock()
+}
+
+// Run starts the workers and blocks until the context is canceled.
+func (w *StatusWriter) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range w.config.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for w.processNextItem(ctx) {
+			}
+		}()
+	}
+
+	<-ctx.Done()
+	w.queue.ShutDown()
+	wg.Wait()
+}
+
+func (w *StatusWriter) processNextItem(ctx context.Context) bool {
+	key, quit := w.queue.Get()
+	if quit {
+		return false
+	}
+	defer w.queue.Done(key)
+
+	w.mtx.Lock()
+	fn, found := w.pending[key]
+	delete(w.pending, key)
+	w.mtx.Unlock()
+
+	if !found {
+		w.queue.Forget(key)
+		return true
+	}
+
+	if err := w.limiter.Wait(ctx); err != nil {
+		// The context has been canceled.
+		return true
+	}
+
+	err := fn(ctx)
+	if err == nil {
+		w.writes.WithLabelValues("success").Inc()
+		w.queue.Forget(key)
+		return true
+	}
+
+	if w.queue.NumRequeues(key) >= statusWriterMaxRetries {
+		w.writes.WithLabelValues("dropped").Inc()
+		w.logger.Warn("failed to update status, giving up", "key", key, "err", err)
+		w.queue.Forget(key)
+		return true
+	}
+
+	w.writes.WithLabelValues("retry").Inc()
+	w.logger.Debug("failed to update status, retrying", "key", key, "err", err)
+
+	// Don't override an update which has been enqueued in the meantime.
+	w.mtx.Lock()
+	if _, found := w.pending[key]; !found {
+		w.pending[key] = fn
+	}
+	w.mtx.Unlock()
+	w.queue.AddRateLimited(key)
+
+	return true
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusWriter(t *testing.T) {
+	w := NewStatusWriter(slog.New(slog.DiscardHandler), prometheus.NewRegistry(), StatusWriterConfig{Workers: 2, QPS: 100, Burst: 10})
+
+	var (
+		mtx   sync.Mutex
+		calls = map[string][]string{}
+		done  = make(chan struct{}, 10)
+	)
+	record := func(key, value string, err error) StatusWriteFunc {
+		return func(context.Context) error {
+			mtx.Lock()
+			calls[key] = append(calls[key], value)
+			mtx.Unlock()
+			done <- struct{}{}
+			return err
+		}
+	}
+
+	// Updates enqueued for the same key before the writer starts are
+	// coalesced.
+	w.Enqueue("a", record("a", "1", nil))
+	w.Enqueue("a", record("a", "2", nil))
+	// Canceled updates aren't applied.
+	w.Enqueue("c", record("c", "1", nil))
+	w.Cancel("c")
+	// Failed updates are retried.
+	var failed bool
+	w.Enqueue("b", func(ctx context.Context) error {
+		if !failed {
+			failed = true
+			return record("b", "1", errors.New("conflict"))(ctx)
+		}
+		return record("b", "1", nil)(ctx)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Run(ctx)
+
+	for range 3 {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for the status updates")
+		}
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	require.Equal(t, []string{"2"}, calls["a"])
+	require.Equal(t, []string{"1", "1"}, calls["b"])
+	require.Empty(t, calls["c"])
+	require.Equal(t, 1.0, testutil.ToFloat64(w.writes.WithLabelValues("retry")))
+}
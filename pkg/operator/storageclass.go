@@ -24,6 +24,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 )
 
 func CheckStorageClass(ctx context.Context, canReadStorageClass bool, kclient kubernetes.Interface, storage *monitoringv1.StorageSpec) error {
@@ -40,7 +41,7 @@ func CheckStorageClass(ctx context.Context, canReadStorageClass bool, kclient ku
 	_, err := kclient.StorageV1().StorageClasses().Get(ctx, storageClassName, metav1.GetOptions{})
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return fmt.Errorf("storage class %q does not exist", storageClassName)
+			return k8sutil.NewDependencyMissingError(fmt.Errorf("storage class %q does not exist", storageClassName))
 		}
 		return fmt.Errorf("cannot get %q storageclass: %w", storageClassName, err)
 	}
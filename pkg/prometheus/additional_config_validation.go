@@ -0,0 +1,115 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+
+	"github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/relabel"
+	"gopkg.in/yaml.v2"
+)
+
+// scrapeConfig holds the fields of a Prometheus scrape configuration which
+// are validated by the operator.
+type scrapeConfig struct {
+	JobName              string            `yaml:"job_name"`
+	ScrapeInterval       model.Duration    `yaml:"scrape_interval,omitempty"`
+	ScrapeTimeout        model.Duration    `yaml:"scrape_timeout,omitempty"`
+	RelabelConfigs       []*relabel.Config `yaml:"relabel_configs,omitempty"`
+	MetricRelabelConfigs []*relabel.Config `yaml:"metric_relabel_configs,omitempty"`
+
+	HTTPClientConfig config.HTTPClientConfig `yaml:",inline"`
+}
+
+// alertmanagerConfig holds the fields of a Prometheus Alertmanager
+// configuration which are validated by the operator.
+type alertmanagerConfig struct {
+	APIVersion          string            `yaml:"api_version,omitempty"`
+	RelabelConfigs      []*relabel.Config `yaml:"relabel_configs,omitempty"`
+	AlertRelabelConfigs []*relabel.Config `yaml:"alert_relabel_configs,omitempty"`
+
+	HTTPClientConfig config.HTTPClientConfig `yaml:",inline"`
+}
+
+// ValidateAdditionalScrapeConfigs verifies that the raw content of the
+// additionalScrapeConfigs Secret is a list of valid Prometheus scrape
+// configurations with unique job names. Only the job names, the scrape
+// interval and timeout, the relabeling configurations and the HTTP client
+// configuration are checked.
+//
+// The unknown fields are ignored because they may be supported by a more
+// recent Prometheus version than the one used by the operator.
+func ValidateAdditionalScrapeConfigs(b []byte) error {
+	var scs []*scrapeConfig
+	if err := yaml.Unmarshal(b, &scs); err != nil {
+		return err
+	}
+
+	jobNames := make(map[string]int, len(scs))
+	for i, sc := range scs {
+		if sc == nil {
+			return fmt.Errorf("[%d]: empty scrape config", i)
+		}
+
+		if sc.JobName == "" {
+			return fmt.Errorf("[%d]: job_name is empty", i)
+		}
+
+		if j, found := jobNames[sc.JobName]; found {
+			return fmt.Errorf("[%d]: job name %q already used by scrape config [%d]", i, sc.JobName, j)
+		}
+		jobNames[sc.JobName] = i
+
+		if sc.ScrapeInterval != 0 && sc.ScrapeTimeout > sc.ScrapeInterval {
+			return fmt.Errorf("[%d]: scrape timeout greater than scrape interval for job %q", i, sc.JobName)
+		}
+
+		if err := sc.HTTPClientConfig.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateAdditionalAlertmanagerConfigs verifies that the raw content of the
+// additionalAlertManagerConfigs Secret is a list of valid Prometheus
+// Alertmanager configurations.
+func ValidateAdditionalAlertmanagerConfigs(b []byte) error {
+	var amcs []*alertmanagerConfig
+	if err := yaml.Unmarshal(b, &amcs); err != nil {
+		return err
+	}
+
+	for i, amc := range amcs {
+		if amc == nil {
+			return fmt.Errorf("[%d]: empty alertmanager config", i)
+		}
+
+		switch amc.APIVersion {
+		case "", "v1", "v2":
+		default:
+			return fmt.Errorf("[%d]: expected Alertmanager api version to be one of [v1 v2] but got %s", i, amc.APIVersion)
+		}
+
+		if err := amc.HTTPClientConfig.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
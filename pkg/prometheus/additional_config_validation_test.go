@@ -0,0 +1,155 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAdditionalScrapeConfigs(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			data: `
+- job_name: node
+  scrape_interval: 30s
+  kubernetes_sd_configs:
+  - role: node
+  relabel_configs:
+  - source_labels: [__meta_kubernetes_node_name]
+    target_label: node
+  unknown_field_from_a_newer_version: true
+- job_name: static
+  static_configs:
+  - targets: [localhost:9090]
+`,
+		},
+		{
+			name: "not a list",
+			data: `job_name: node`,
+			err:  "cannot unmarshal",
+		},
+		{
+			name: "missing job name",
+			data: `- scrape_interval: 30s`,
+			err:  "job_name is empty",
+		},
+		{
+			name: "invalid relabel action",
+			data: `
+- job_name: node
+  relabel_configs:
+  - action: unknown
+`,
+			err: "unknown relabel action",
+		},
+		{
+			name: "invalid regex",
+			data: `
+- job_name: node
+  metric_relabel_configs:
+  - source_labels: [__name__]
+    regex: "foo("
+    action: drop
+`,
+			err: "error parsing regexp",
+		},
+		{
+			name: "duplicate job names",
+			data: `
+- job_name: node
+- job_name: node
+`,
+			err: `[1]: job name "node" already used by scrape config [0]`,
+		},
+		{
+			name: "scrape timeout greater than scrape interval",
+			data: `
+- job_name: node
+  scrape_interval: 10s
+  scrape_timeout: 20s
+`,
+			err: "scrape timeout greater than scrape interval",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAdditionalScrapeConfigs([]byte(tc.data))
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestValidateAdditionalAlertmanagerConfigs(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			data: `
+- scheme: https
+  static_configs:
+  - targets: [alertmanager:9093]
+`,
+		},
+		{
+			name: "invalid api version",
+			data: `
+- api_version: v3
+  static_configs:
+  - targets: [alertmanager:9093]
+`,
+			err: "expected Alertmanager api version",
+		},
+		{
+			name: "several authentication methods",
+			data: `
+- basic_auth:
+    username: foo
+    password: bar
+  bearer_token: baz
+`,
+			err: "at most one of",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAdditionalAlertmanagerConfigs([]byte(tc.data))
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -113,6 +113,14 @@ func makeDaemonSetSpec(
 	configReloaderWebConfigFile = confArg.Value
 	configReloaderVolumeMounts = append(configReloaderVolumeMounts, configMount...)
 
+	reloadClient, reloadClientVols, reloadClientMounts, err := prompkg.BuildReloadClient(cpf, p)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes = append(volumes, reloadClientVols...)
+	configReloaderVolumeMounts = append(configReloaderVolumeMounts, reloadClientMounts...)
+
 	startupProbe, readinessProbe, livenessProbe := cg.BuildProbes()
 
 	podAnnotations, podLabels := cg.BuildPodMetadata()
@@ -154,18 +162,23 @@ func makeDaemonSetSpec(
 		return nil, fmt.Errorf("failed to merge init containers spec: %w", err)
 	}
 
+	if err := operator.PrometheusAgentFlags.ValidateArgs("spec.additionalArgs", cg.Version(), cpf.AdditionalArgs); err != nil {
+		return nil, err
+	}
+
 	containerArgs, err := operator.BuildArgs(promArgs, cpf.AdditionalArgs)
 	if err != nil {
 		return nil, err
 	}
 
-	operatorContainers := append([]v1.Container{
+	operatorContainers := []v1.Container{
 		{
 			Name:                     "prometheus",
 			Image:                    pImagePath,
 			ImagePullPolicy:          cpf.ImagePullPolicy,
 			Ports:                    prompkg.MakeContainerPorts(cpf),
 			Args:                     containerArgs,
+			Env:                      c.GoRuntime.EnvVars(cpf.Resources),
 			VolumeMounts:             promVolumeMounts,
 			StartupProbe:             startupProbe,
 			LivenessProbe:            livenessProbe,
@@ -180,22 +193,33 @@ func makeDaemonSetSpec(
 				},
 			},
 		},
-		prompkg.BuildConfigReloader(
+	}
+
+	// With the OperatorExec reload strategy, the operator reloads the
+	// configuration with the Kubernetes exec API.
+	if !prompkg.UsesOperatorExecReload(p) {
+		operatorContainers = append(operatorContainers, prompkg.BuildConfigReloader(
 			p,
 			c,
 			false,
 			configReloaderVolumeMounts,
 			watchedDirectories,
 			operator.WebConfigFile(configReloaderWebConfigFile),
+			operator.ReloadClient(reloadClient),
 			operator.WithDaemonSetMode(),
-		),
-	}, additionalContainers...)
+		))
+	}
+	operatorContainers = append(operatorContainers, additionalContainers...)
 
 	containers, err := k8sutil.MergePatchContainers(operatorContainers, cpf.Containers)
 	if err != nil {
 		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
 	}
 
+	if c.NativeSidecars {
+		containers, initContainers = k8sutil.MoveToNativeSidecars(containers, initContainers, "config-reloader")
+	}
+
 	spec := appsv1.DaemonSetSpec{
 		Selector: &metav1.LabelSelector{
 			MatchLabels: finalSelectorLabels,
@@ -18,7 +18,10 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"reflect"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mitchellh/hashstructure"
@@ -29,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
@@ -49,7 +53,6 @@ import (
 )
 
 const (
-	resyncPeriod   = 5 * time.Minute
 	controllerName = "prometheusagent-controller"
 )
 
@@ -78,24 +81,65 @@ type Operator struct {
 	secrInfs  *informers.ForResource
 	ssetInfs  *informers.ForResource
 	dsetInfs  *informers.ForResource
+	podInfs   *informers.ForResource
 
 	rr *operator.ResourceReconciler
 
 	metrics         *operator.Metrics
 	reconciliations *operator.ReconciliationTracker
+	configHashes    *operator.ConfigHashCache
+	debouncer       *operator.Debouncer
 
 	config prompkg.Config
+	// Protects the config field which is updated when the operator
+	// configuration changes.
+	configMtx sync.RWMutex
+
+	// Field manager of the server-side apply requests (empty when disabled).
+	fieldManager string
+	// The write requests are sent as dry-run requests.
+	auditMode bool
 
 	endpointSliceSupported bool // Whether the Kubernetes API suports the EndpointSlice kind.
 	scrapeConfigSupported  bool
 	canReadStorageClass    bool
 
 	eventRecorder record.EventRecorder
+	rwProber      *prompkg.RemoteWriteProber
+	remoteWrite   *remoteWriteCache
+	duplicates    *prompkg.DuplicateTargetsTracker
 
 	statusReporter prompkg.StatusReporter
 
 	daemonSetFeatureGateEnabled  bool
 	configResourcesStatusEnabled bool
+
+	// Applies the status updates of the configuration resources.
+	statusWriter *operator.StatusWriter
+	// Configuration resources bound to each workload.
+	boundResources *operator.BoundConfigResources
+
+	// Materializes the targets of the custom resource SD configurations.
+	crDiscoverer *prompkg.CustomResourceDiscoverer
+
+	// Limits the number of StatefulSets rolled out concurrently.
+	rolloutBudget *operator.RolloutBudget
+
+	// Reloads the pods using the OperatorExec reload strategy.
+	execReloader *prompkg.ExecReloader
+
+	// Deletes the StatefulSets which can't be updated.
+	ssetRecreator *operator.StatefulSetRecreator
+
+	// Splits the objects between the operator replicas.
+	sharder *operator.Sharder
+
+	// Reported in the status of the reconciled resources.
+	operatorInfo *monitoringv1.OperatorInfo
+
+	// Selectors restricting the watched Secrets.
+	secretLabelSelector labels.Selector
+	secretFieldSelector fields.Selector
 }
 
 type ControllerOption func(*Operator)
@@ -122,6 +166,31 @@ func WithStorageClassValidation() ControllerOption {
 	}
 }
 
+// WithStatusWriter tells the controller to update the status of the selected
+// configuration resources using the given writer. It only takes effect when
+// the StatusForConfigurationResources feature gate is enabled.
+func WithStatusWriter(w *operator.StatusWriter) ControllerOption {
+	return func(o *Operator) {
+		o.statusWriter = w
+	}
+}
+
+// WithRolloutBudget tells the controller to wait for a slot of the budget
+// before updating a StatefulSet.
+func WithRolloutBudget(b *operator.RolloutBudget) ControllerOption {
+	return func(o *Operator) {
+		o.rolloutBudget = b
+	}
+}
+
+// WithSharder tells the controller to reconcile only the objects assigned to
+// the operator replica.
+func WithSharder(s *operator.Sharder) ControllerOption {
+	return func(o *Operator) {
+		o.sharder = s
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -141,27 +210,36 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
 	}
 
+	dclient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating dynamic client failed: %w", err)
+	}
+
 	// All the metrics exposed by the controller get the controller="prometheus-agent" label.
 	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "prometheus-agent"}, r)
 
 	o := &Operator{
-		kclient:  client,
-		mdClient: mdClient,
-		mclient:  mclient,
-		logger:   logger,
-		config: prompkg.Config{
-			LocalHost:                  c.LocalHost,
-			ReloaderConfig:             c.ReloaderConfig,
-			PrometheusDefaultBaseImage: c.PrometheusDefaultBaseImage,
-			ThanosDefaultBaseImage:     c.ThanosDefaultBaseImage,
-			Annotations:                c.Annotations,
-			Labels:                     c.Labels,
-		},
+		kclient:                      client,
+		mdClient:                     mdClient,
+		mclient:                      mclient,
+		crDiscoverer:                 prompkg.NewCustomResourceDiscoverer(logger, client, dclient),
+		logger:                       logger,
+		config:                       prompkg.NewConfig(c),
+		fieldManager:                 c.ApplyFieldManager(),
+		auditMode:                    c.Mode == operator.AuditMode,
 		metrics:                      operator.NewMetrics(r),
 		reconciliations:              &operator.ReconciliationTracker{},
+		configHashes:                 operator.NewConfigHashCache(r),
+		debouncer:                    operator.NewDebouncer(),
+		rwProber:                     prompkg.NewRemoteWriteProber(),
+		remoteWrite:                  newRemoteWriteCache(),
+		duplicates:                   prompkg.NewDuplicateTargetsTracker(),
+		execReloader:                 prompkg.NewExecReloader(prompkg.NewPodExecutor(restConfig, client)),
+		boundResources:               operator.NewBoundConfigResources(),
 		controllerID:                 c.ControllerID,
 		eventRecorder:                c.EventRecorderFactory(client, controllerName),
 		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
+		operatorInfo:                 c.Gates.OperatorInfo(),
 	}
 	o.metrics.MustRegister(
 		o.reconciliations,
@@ -169,13 +247,15 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 	for _, opt := range options {
 		opt(o)
 	}
+	o.ssetRecreator = operator.NewStatefulSetRecreator(c.StatefulSetRecreationPolicy, o.metrics, o.eventRecorder)
+	o.secretLabelSelector, o.secretFieldSelector = c.SecretWatchSelectors()
 
 	o.promInfs, err = informers.NewInformersForResource(
 		informers.NewMonitoringInformerFactories(
 			c.Namespaces.PrometheusAllowList,
 			c.Namespaces.DenyList,
 			mclient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			func(options *metav1.ListOptions) {
 				options.LabelSelector = c.PromSelector.String()
 			},
@@ -200,6 +280,8 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		monitoringv1alpha1.PrometheusAgentsKind,
 		r,
 		o.controllerID,
+		o.sharder,
+		c.Workers.PrometheusAgent,
 	)
 
 	o.smonInfs, err = informers.NewInformersForResource(
@@ -207,7 +289,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.AllowList,
 			c.Namespaces.DenyList,
 			mclient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			nil,
 		),
 		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ServiceMonitorName),
@@ -221,7 +303,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.AllowList,
 			c.Namespaces.DenyList,
 			mclient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			nil,
 		),
 		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PodMonitorName),
@@ -235,7 +317,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.AllowList,
 			c.Namespaces.DenyList,
 			mclient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			nil,
 		),
 		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ProbeName),
@@ -250,7 +332,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 				c.Namespaces.AllowList,
 				c.Namespaces.DenyList,
 				mclient,
-				resyncPeriod,
+				c.ResyncPeriods.ScrapeConfig,
 				nil,
 			),
 			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ScrapeConfigName),
@@ -265,7 +347,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.PrometheusAllowList,
 			c.Namespaces.DenyList,
 			o.mdClient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			func(options *metav1.ListOptions) {
 				options.LabelSelector = prompkg.LabelPrometheusName
 			},
@@ -282,7 +364,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.PrometheusAllowList,
 			c.Namespaces.DenyList,
 			o.mdClient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			func(options *metav1.ListOptions) {
 				options.FieldSelector = c.SecretListWatchFieldSelector.String()
 				options.LabelSelector = c.SecretListWatchLabelSelector.String()
@@ -300,7 +382,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.PrometheusAllowList,
 			c.Namespaces.DenyList,
 			o.kclient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			nil,
 		),
 		appsv1.SchemeGroupVersion.WithResource("statefulsets"),
@@ -317,7 +399,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 				c.Namespaces.PrometheusAllowList,
 				c.Namespaces.DenyList,
 				o.kclient,
-				resyncPeriod,
+				c.ResyncPeriods.Prometheus,
 				nil,
 			),
 			appsv1.SchemeGroupVersion.WithResource("daemonsets"),
@@ -327,6 +409,27 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		}
 	}
 
+	// The pods are used to reload the configuration with the OperatorExec
+	// reload strategy.
+	o.podInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.Namespaces.PrometheusAllowList,
+			c.Namespaces.DenyList,
+			o.kclient,
+			c.ResyncPeriods.Prometheus,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = labels.SelectorFromSet(labels.Set{
+					"app.kubernetes.io/managed-by": "prometheus-operator",
+					"app.kubernetes.io/name":       "prometheus-agent",
+				}).String()
+			},
+		),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourcePods)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating pod informers: %w", err)
+	}
+
 	newNamespaceInformer := func(o *Operator, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
 		lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
 			ctx,
@@ -344,7 +447,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		logger.Debug("creating namespace informer", "privileged", privileged)
 		return cache.NewSharedIndexInformer(
 			o.metrics.NewInstrumentedListerWatcher(lw),
-			&v1.Namespace{}, resyncPeriod, cache.Indexers{},
+			&v1.Namespace{}, c.ResyncPeriods.Prometheus, cache.Indexers{},
 		), nil
 	}
 
@@ -390,6 +493,7 @@ func (c *Operator) Run(ctx context.Context) error {
 	if c.dsetInfs != nil {
 		go c.dsetInfs.Start(ctx.Done())
 	}
+	go c.podInfs.Start(ctx.Done())
 	go c.nsMonInf.Run(ctx.Done())
 	if c.nsPromInf != c.nsMonInf {
 		go c.nsPromInf.Run(ctx.Done())
@@ -407,6 +511,18 @@ func (c *Operator) Run(ctx context.Context) error {
 
 	// TODO(simonpasquier): watch for PrometheusAgent pods instead of polling.
 	go operator.StatusPoller(ctx, c)
+	go c.pollRemoteWrite(ctx)
+	go c.rwProber.Run(
+		ctx,
+		func(fn func(monitoringv1.PrometheusInterface)) {
+			_ = c.promInfs.ListAll(labels.Everything(), func(o interface{}) {
+				fn(o.(*monitoringv1alpha1.PrometheusAgent))
+			})
+		},
+		func(p monitoringv1.PrometheusInterface) {
+			c.rr.EnqueueForReconciliation(p.(*monitoringv1alpha1.PrometheusAgent))
+		},
+	)
 
 	c.metrics.Ready().Set(1)
 	<-ctx.Done()
@@ -428,8 +544,10 @@ func (c *Operator) RefreshStatusFor(o metav1.Object) {
 	c.rr.EnqueueForStatus(o)
 }
 
-// waitForCacheSync waits for the informers' caches to be synced.
-func (c *Operator) waitForCacheSync(ctx context.Context) error {
+// namedInformers returns the informers of the controller. The informers
+// which haven't been created (e.g. when the CRD isn't installed) are skipped.
+func (c *Operator) namedInformers() operator.NamedInformers {
+	var ni operator.NamedInformers
 	for _, infs := range []struct {
 		name                 string
 		informersForResource *informers.ForResource
@@ -443,29 +561,22 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
 		{"DaemonSet", c.dsetInfs},
+		{"Pod", c.podInfs},
 	} {
-		// Skipping informers that were not started. If prerequisites for a CRD were not met, their informer will be
-		// nil. ScrapeConfig is one example.
-		if infs.informersForResource == nil {
-			continue
-		}
-
-		for _, inf := range infs.informersForResource.GetInformers() {
-			if !operator.WaitForNamedCacheSync(ctx, "prometheusagent", c.logger.With("informer", infs.name), inf.Informer()) {
-				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
-			}
-		}
+		ni = ni.AppendForResource(infs.name, infs.informersForResource)
 	}
 
-	for _, inf := range []struct {
-		name     string
-		informer cache.SharedIndexInformer
-	}{
-		{"PromNamespace", c.nsPromInf},
-		{"MonNamespace", c.nsMonInf},
-	} {
-		if !operator.WaitForNamedCacheSync(ctx, "prometheusagent", c.logger.With("informer", inf.name), inf.informer) {
-			return fmt.Errorf("failed to sync cache for %s informer", inf.name)
+	return append(ni,
+		operator.NamedInformer{Name: "PromNamespace", Informer: c.nsPromInf},
+		operator.NamedInformer{Name: "MonNamespace", Informer: c.nsMonInf},
+	)
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (c *Operator) waitForCacheSync(ctx context.Context) error {
+	for _, inf := range c.namedInformers() {
+		if !operator.WaitForNamedCacheSync(ctx, "prometheusagent", c.logger.With("informer", inf.Name), inf.Informer) {
+			return fmt.Errorf("failed to sync cache for %s informer", inf.Name)
 		}
 	}
 
@@ -473,6 +584,12 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 	return nil
 }
 
+// HealthCheck returns an error if the controller is running and either one of
+// its informers isn't synced or one of its queues is stuck.
+func (c *Operator) HealthCheck(context.Context) error {
+	return c.rr.CheckHealth(c.namedInformers())
+}
+
 // addHandlers adds the eventhandlers to the informers.
 func (c *Operator) addHandlers() {
 	c.promInfs.AddEventHandler(c.rr)
@@ -560,22 +677,37 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if p == nil {
 		c.reconciliations.ForgetObject(key)
+		c.configHashes.Forget(key)
+		c.debouncer.Forget(key)
+		c.metrics.ForgetGeneratedArtifacts(key)
+		c.rolloutBudget.Forget(rolloutOwner(key))
+		c.removeConfigResourceBindings(key)
+		c.execReloader.Forget(key)
+		c.duplicates.Forget(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
 
 	// Check if the Agent instance is marked for deletion.
 	if c.rr.DeletionInProgress(p) {
+		c.configHashes.Forget(key)
+		c.debouncer.Forget(key)
+		c.metrics.ForgetGeneratedArtifacts(key)
+		c.removeConfigResourceBindings(key)
+		c.execReloader.Forget(key)
+		c.duplicates.Forget(key)
 		return nil
 	}
 
 	logger := c.logger.With("key", key)
 
-	if p.Spec.Paused {
+	if operator.IsPaused(p, p.Spec.Paused) {
 		logger.Info("the resource is paused, not reconciling")
 		return nil
 	}
 
+	p.Spec.RemoteWrite = c.rwProber.ApplyFailover(p.Spec.RemoteWrite)
+
 	logger.Info("sync prometheusagent")
 
 	if ptr.Deref(p.Spec.Mode, "") == monitoringv1alpha1.DaemonSetPrometheusAgentMode && !c.daemonSetFeatureGateEnabled {
@@ -593,25 +725,46 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	if ptr.Deref(p.Spec.Mode, "") == monitoringv1alpha1.DaemonSetPrometheusAgentMode {
 		opts = append(opts, prompkg.WithDaemonSet())
 	}
+	if c.currentConfig().TLSAssetsProjection {
+		assetStore.EnableTLSAssetsProjection(p.Namespace)
+	}
 
 	cg, err := prompkg.NewConfigGenerator(logger, p, opts...)
 	if err != nil {
-		return err
+		return k8sutil.NewInvalidSpecError(err)
 	}
 
-	if err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, assetStore); err != nil {
+	if err := c.createOrUpdateConfigurationSecret(ctx, logger, key, p, cg, assetStore); err != nil {
+		c.eventRecorder.Eventf(p, v1.EventTypeWarning, operator.ConfigGenerationFailedEvent, "Failed to generate the configuration: %v", err)
 		return fmt.Errorf("creating config failed: %w", err)
 	}
 
-	tlsAssets, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, prompkg.NewTLSAssetSecret(p, c.config))
+	tlsAssetsData := assetStore.TLSAssets()
+	c.metrics.SetGeneratedTLSAssets(key, len(tlsAssetsData))
+	tlsAssets, err := operator.ReconcileTLSAssets(ctx, assetStore, c.kclient, prompkg.NewTLSAssetSecret(p, c.currentConfig()), c.fieldManager)
 	if err != nil {
 		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
 
+	operator.ReportUnwatchedSecrets(c.reconciliations, c.eventRecorder, p, key, assetStore.UnwatchedSecrets(c.secretLabelSelector, c.secretFieldSelector))
+
 	if err := c.createOrUpdateWebConfigSecret(ctx, p); err != nil {
 		return fmt.Errorf("synchronizing web config secret failed: %w", err)
 	}
 
+	children := k8sutil.ExpectedChildren{}
+	children.Add(
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourceSecrets)),
+		append(
+			tlsAssets.SecretNames(),
+			prompkg.ConfigSecretName(p),
+			prompkg.WebConfigSecretName(p),
+		)...,
+	)
+	if err := operator.PruneChildren(ctx, logger, c.mdClient, p, children); err != nil {
+		return fmt.Errorf("failed to prune obsolete objects: %w", err)
+	}
+
 	switch ptr.Deref(p.Spec.Mode, "") {
 	case monitoringv1alpha1.DaemonSetPrometheusAgentMode:
 		err = c.syncDaemonSet(ctx, key, p, cg, tlsAssets)
@@ -622,8 +775,22 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 		err = c.syncStatefulSet(ctx, key, p, cg, tlsAssets)
 	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	// The exec requests can't be sent as dry-run requests hence the pods
+	// aren't reloaded in audit mode.
+	if c.auditMode {
+		return nil
+	}
+
+	// The agent has no rule files, the pods never need to be signaled again.
+	if _, err := c.execReloader.Sync(ctx, key, p, c.podInfs.ListAllByNamespace, labels.SelectorFromSet(makeSelectorLabels(p.Name)), ""); err != nil {
+		return fmt.Errorf("failed to reload the configuration: %w", err)
+	}
+
+	return nil
 }
 
 func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, tlsAssets *operator.ShardedSecret) error {
@@ -641,7 +808,7 @@ func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv
 
 	dset, err := makeDaemonSet(
 		p,
-		c.config,
+		c.currentConfig(),
 		cg,
 		tlsAssets)
 	if err != nil {
@@ -658,7 +825,7 @@ func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv
 		return nil
 	}
 
-	err = k8sutil.UpdateDaemonSet(ctx, dsetClient, dset)
+	err = k8sutil.UpdateDaemonSet(ctx, dsetClient, dset, c.fieldManager)
 	sErr, ok := err.(*apierrors.StatusError)
 
 	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
@@ -685,6 +852,8 @@ func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv
 }
 
 func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, tlsAssets *operator.ShardedSecret) error {
+	config := c.currentConfig()
+
 	logger := c.logger.With("key", key)
 
 	if p.Spec.ServiceName != nil {
@@ -699,10 +868,10 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 			governingServiceName,
 			map[string]string{"app.kubernetes.io/name": "prometheus-agent"},
 			p,
-			c.config,
+			config,
 		)
 
-		if _, err := k8sutil.CreateOrUpdateService(ctx, c.kclient.CoreV1().Services(p.Namespace), svc); err != nil {
+		if _, err := k8sutil.CreateOrUpdateService(ctx, c.kclient.CoreV1().Services(p.Namespace), svc, c.fieldManager); err != nil {
 			return fmt.Errorf("synchronizing default governing service failed: %w", err)
 		}
 	}
@@ -710,6 +879,7 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 	ssetClient := c.kclient.AppsV1().StatefulSets(p.Namespace)
 
 	// Ensure we have a StatefulSet running Prometheus Agent deployed and that StatefulSet names are created correctly.
+	var rolloutPending []string
 	expected := prompkg.ExpectedStatefulSetShardNames(p)
 	for shard, ssetName := range expected {
 		logger := logger.With("statefulset", ssetName, "shard", fmt.Sprintf("%d", shard))
@@ -739,7 +909,7 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 			}
 		}
 
-		newSSetInputHash, err := createSSetInputHash(*p, c.config, tlsAssets, existingStatefulSet.Spec)
+		newSSetInputHash, err := createSSetInputHash(*p, config, tlsAssets, existingStatefulSet.Spec)
 		if err != nil {
 			return err
 		}
@@ -747,13 +917,13 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 		sset, err := makeStatefulSet(
 			ssetName,
 			p,
-			c.config,
+			config,
 			cg,
 			newSSetInputHash,
 			int32(shard),
 			tlsAssets)
 		if err != nil {
-			return fmt.Errorf("making statefulset failed: %w", err)
+			return k8sutil.NewInvalidSpecError(fmt.Errorf("making statefulset failed: %w", err))
 		}
 		operator.SanitizeSTS(sset)
 
@@ -767,6 +937,15 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 
 		if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationName] {
 			logger.Debug("new statefulset generation inputs match current, skipping any actions")
+			if operator.StatefulSetRolledOut(existingStatefulSet) {
+				c.rolloutBudget.Release(rolloutOwner(key), ssetName)
+			}
+			continue
+		}
+
+		if ok, position := c.rolloutBudget.Acquire(rolloutOwner(key), ssetName); !ok {
+			logger.Debug("postponing the update of the statefulset until the rollout budget allows it", "position", position)
+			rolloutPending = append(rolloutPending, c.rolloutBudget.RolloutPendingMessage(ssetName, position))
 			continue
 		}
 
@@ -775,24 +954,13 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 			"existing_hash", existingStatefulSet.Annotations[operator.InputHashAnnotationName],
 		)
 
-		err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
-		sErr, ok := err.(*apierrors.StatusError)
-
-		if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
-			c.metrics.StsDeleteCreateCounter().Inc()
-
-			// Gather only reason for failed update
-			failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
-			for i, cause := range sErr.ErrStatus.Details.Causes {
-				failMsg[i] = cause.Message
-			}
-
-			logger.Info("recreating StatefulSet because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
+		err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset, c.fieldManager)
+		recreated, rErr := c.ssetRecreator.Recreate(ctx, logger, ssetClient, p, existingStatefulSet, sset, err)
+		if rErr != nil {
+			return rErr
+		}
 
-			propagationPolicy := metav1.DeletePropagationForeground
-			if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
-				return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
-			}
+		if recreated {
 			continue
 		}
 
@@ -801,6 +969,11 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 		}
 	}
 
+	c.reconciliations.SetWarning(key, operator.RolloutPendingReason, strings.Join(rolloutPending, " "))
+	if len(rolloutPending) > 0 {
+		c.rr.EnqueueForReconciliationAfter(p, operator.RolloutBudgetRetryInterval)
+	}
+
 	ssets := map[string]struct{}{}
 	for _, ssetName := range expected {
 		ssets[ssetName] = struct{}{}
@@ -819,6 +992,7 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 			return
 		}
 
+		c.rolloutBudget.Release(rolloutOwner(key), s.GetName())
 		if err := ssetClient.Delete(ctx, s.GetName(), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationForeground)}); err != nil {
 			c.logger.Error("failed to delete StatefulSet object", "err", err, "name", s.GetName(), "namespace", s.GetNamespace())
 		}
@@ -830,7 +1004,9 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 	return nil
 }
 
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder) error {
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, key string, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder) error {
+	config := c.currentConfig()
+
 	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.eventRecorder)
 	if err != nil {
 		return err
@@ -859,6 +1035,40 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		}
 	}
 
+	c.duplicates.Set(key, resourceSelector.DuplicateTargets())
+
+	if c.configResourcesStatusEnabled && c.statusWriter != nil {
+		prompkg.UpdateServiceMonitorsStatus(c.statusWriter, c.mclient, p, monitoringv1alpha1.PrometheusAgentName, smons)
+		prompkg.UpdatePodMonitorsStatus(c.statusWriter, c.mclient, p, monitoringv1alpha1.PrometheusAgentName, pmons)
+		prompkg.UpdateProbesStatus(c.statusWriter, c.mclient, p, monitoringv1alpha1.PrometheusAgentName, bmons)
+		prompkg.UpdateScrapeConfigsStatus(c.statusWriter, c.mclient, p, monitoringv1alpha1.PrometheusAgentName, scrapeConfigs)
+
+		stale := c.boundResources.Update(key, slices.Concat(
+			prompkg.ConfigResourceRefs(monitoringv1.ServiceMonitorsKind, smons),
+			prompkg.ConfigResourceRefs(monitoringv1.PodMonitorsKind, pmons),
+			prompkg.ConfigResourceRefs(monitoringv1.ProbesKind, bmons),
+			prompkg.ConfigResourceRefs(monitoringv1alpha1.ScrapeConfigsKind, scrapeConfigs),
+		))
+		operator.RemoveBindings(c.statusWriter, c.mclient, monitoringv1alpha1.PrometheusAgentName, key, stale)
+	}
+
+	if c.crDiscoverer != nil {
+		refresh, err := c.crDiscoverer.Sync(
+			ctx,
+			p,
+			scrapeConfigs.ValidResources(),
+			operator.WithLabels(config.Labels),
+			operator.WithAnnotations(config.Annotations),
+		)
+		if err != nil {
+			return err
+		}
+
+		if refresh > 0 {
+			c.rr.EnqueueForReconciliationAfter(p, refresh)
+		}
+	}
+
 	if err := prompkg.AddRemoteWritesToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
 		return err
 	}
@@ -871,13 +1081,57 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		return fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
+	if err := prompkg.AddScrapeProxyConfigToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeProxyConfig); err != nil {
+		return err
+	}
+
 	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
 		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
 	}
+	if err := prompkg.ValidateAdditionalScrapeConfigs(additionalScrapeConfigs); err != nil {
+		return k8sutil.NewInvalidSpecError(fmt.Errorf("invalid additional scrape configs in Secret %q: %w", p.Spec.AdditionalScrapeConfigs.Name, err))
+	}
+
+	inputHash, err := prompkg.ConfigurationInputHash(
+		p,
+		config,
+		smons.ValidResources(),
+		pmons.ValidResources(),
+		bmons.ValidResources(),
+		scrapeConfigs.ValidResources(),
+		store,
+		additionalScrapeConfigs,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to compute the configuration inputs hash: %w", err)
+	}
+
+	secretExists := c.configurationSecretExists(p)
+	if secretExists && c.configHashes.Unchanged(key, inputHash) {
+		logger.Debug("configuration inputs unchanged, skipping the configuration generation")
+		c.debouncer.Forget(key)
+		return nil
+	}
+
+	window, maxDelay, err := prompkg.ConfigUpdateDebounce(p)
+	if err != nil {
+		return err
+	}
+
+	// The update is postponed only when the configuration Secret already
+	// exists, otherwise the pods can't start.
+	if window > 0 && secretExists {
+		if delay := c.debouncer.Delay(key, inputHash, window, maxDelay); delay > 0 {
+			logger.Debug("postponing the update of the configuration secret", "delay", delay)
+			c.rr.EnqueueForReconciliationAfter(p, delay)
+			return nil
+		}
+	}
 
 	// Update secret based on the most recent configuration.
+	start := time.Now()
 	conf, err := cg.GenerateAgentConfiguration(
 		smons.ValidResources(),
 		pmons.ValidResources(),
@@ -889,15 +1143,36 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 	if err != nil {
 		return fmt.Errorf("generating config failed: %w", err)
 	}
+	c.metrics.ObserveConfigGeneration(key, time.Since(start))
 
 	// Compress config to avoid 1mb secret limit for a while
-	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
+	s, err := prompkg.MakeConfigurationSecret(p, config, conf)
 	if err != nil {
 		return fmt.Errorf("creating compressed secret failed: %w", err)
 	}
+	c.metrics.SetGeneratedConfigSize(key, len(conf), len(s.Data[prompkg.ConfigSecretKey(p, config)]))
+
+	if err := operator.CheckSecretSize(s); err != nil {
+		c.eventRecorder.Event(p, v1.EventTypeWarning, operator.SecretTooLargeEvent, err.Error())
+		return err
+	}
 
 	logger.Debug("updating Prometheus configuration secret")
-	return k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+	if err := k8sutil.CreateOrUpdateSecret(ctx, sClient, s, c.fieldManager); err != nil {
+		return err
+	}
+	c.execReloader.SetConfig(key, p, conf)
+
+	c.configHashes.Set(key, inputHash)
+
+	return nil
+}
+
+// configurationSecretExists returns true if the configuration Secret of the
+// PrometheusAgent object is present in the informer's cache.
+func (c *Operator) configurationSecretExists(p *monitoringv1alpha1.PrometheusAgent) bool {
+	_, err := c.secrInfs.Get(p.Namespace + "/" + prompkg.ConfigSecretName(p))
+	return err == nil
 }
 
 func createSSetInputHash(p monitoringv1alpha1.PrometheusAgent, c prompkg.Config, tlsAssets *operator.ShardedSecret, ssSpec appsv1.StatefulSetSpec) (string, error) {
@@ -958,6 +1233,9 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 	if err != nil {
 		return fmt.Errorf("failed to get prometheus agent status: %w", err)
 	}
+	// The conditions of the current status are needed to retain the last
+	// transition time of the remote write conditions.
+	previousConditions := p.Status.Conditions
 	p.Status = *pStatus
 
 	selectorLabels := makeSelectorLabels(p.Name)
@@ -967,6 +1245,19 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 	}
 	p.Status.Selector = selector.String()
 	p.Status.Shards = ptr.Deref(p.Spec.Shards, 1)
+	p.Status.RemoteWriteEndpoints = c.rwProber.Status(p.Spec.RemoteWrite)
+	p.Status.RemoteWriteQueues = c.remoteWrite.queues(key)
+	p.Status.Conditions = append(
+		p.Status.Conditions,
+		operator.UpdateConditions(
+			previousConditions,
+			slices.Concat(
+				c.remoteWrite.conditions(key, p.Generation),
+				c.duplicates.Conditions(key, p.Generation),
+			)...,
+		)...,
+	)
+	p.Status.OperatorInfo = c.operatorInfo
 
 	if _, err = c.mclient.MonitoringV1alpha1().PrometheusAgents(p.Namespace).ApplyStatus(ctx, prompkg.ApplyConfigurationFromPrometheusAgent(p, true), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
 		c.logger.Info("failed to apply prometheus status subresource, trying again without scale fields", "err", err)
@@ -980,6 +1271,8 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 }
 
 func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitoringv1alpha1.PrometheusAgent) error {
+	config := c.currentConfig()
+
 	var fields monitoringv1.WebConfigFileFields
 	if p.Spec.Web != nil {
 		fields = p.Spec.Web.WebConfigFileFields
@@ -997,12 +1290,12 @@ func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitor
 	s := &v1.Secret{}
 	operator.UpdateObject(
 		s,
-		operator.WithLabels(c.config.Labels),
-		operator.WithAnnotations(c.config.Annotations),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
 		operator.WithManagingOwner(p),
 	)
 
-	if err := webConfig.CreateOrUpdateWebConfigSecret(ctx, c.kclient.CoreV1().Secrets(p.Namespace), s); err != nil {
+	if err := webConfig.CreateOrUpdateWebConfigSecret(ctx, c.kclient.CoreV1().Secrets(p.Namespace), s, c.fieldManager); err != nil {
 		return fmt.Errorf("failed to reconcile web config secret: %w", err)
 	}
 
@@ -1184,3 +1477,45 @@ func keyToDaemonSetKey(p monitoringv1.PrometheusInterface, key string) string {
 	keyParts := strings.Split(key, "/")
 	return fmt.Sprintf("%s/%s", keyParts[0], fmt.Sprintf("%s-%s", prompkg.Prefix(p), keyParts[1]))
 }
+
+// rolloutOwner returns the identifier of the PrometheusAgent object in the
+// rollout budget.
+func rolloutOwner(key string) string {
+	return monitoringv1alpha1.PrometheusAgentsKind + "/" + key
+}
+
+func (c *Operator) currentConfig() prompkg.Config {
+	c.configMtx.RLock()
+	defer c.configMtx.RUnlock()
+
+	return c.config
+}
+
+// UpdateConfig applies the operator's configuration and reconciles all the
+// PrometheusAgent objects when the parameters of the controller have changed.
+func (c *Operator) UpdateConfig(cfg operator.Config) {
+	config := prompkg.NewConfig(cfg)
+
+	c.configMtx.Lock()
+	changed := !reflect.DeepEqual(c.config, config)
+	c.config = config
+	c.configMtx.Unlock()
+
+	if !changed {
+		return
+	}
+
+	c.logger.Info("operator configuration changed, reconciling all the objects")
+	c.rr.EnqueueAll()
+}
+
+// removeConfigResourceBindings schedules the removal of the bindings to the
+// workload from the status of the configuration resources when the workload
+// is deleted.
+func (c *Operator) removeConfigResourceBindings(key string) {
+	if c.statusWriter == nil {
+		return
+	}
+
+	operator.RemoveBindings(c.statusWriter, c.mclient, monitoringv1alpha1.PrometheusAgentName, key, c.boundResources.Forget(key))
+}
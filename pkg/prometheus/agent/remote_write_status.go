@@ -0,0 +1,465 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusagent
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	remoteWritePollInterval = time.Minute
+	remoteWritePollTimeout  = 10 * time.Second
+
+	// remoteWriteLagThreshold is the lag above which a remote write queue
+	// is considered to be lagging.
+	remoteWriteLagThreshold = 5 * time.Minute
+)
+
+// remoteWriteQueue identifies a remote write queue from the labels of the
+// remote storage metrics.
+type remoteWriteQueue struct {
+	name string
+	url  string
+}
+
+// remoteWriteSample holds the values of the remote storage metrics for a
+// queue of a pod.
+type remoteWriteSample struct {
+	highestTimestamp     float64
+	highestSentTimestamp float64
+	pending              float64
+	shards               float64
+	maxShards            float64
+	// The sum of the dropped and failed samples counters.
+	dropped float64
+}
+
+// parseRemoteWriteMetrics returns the remote storage metrics of each queue
+// from the metrics in text format.
+func parseRemoteWriteMetrics(r io.Reader) (map[remoteWriteQueue]*remoteWriteSample, error) {
+	var parser expfmt.TextParser
+	mfs, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ret = map[remoteWriteQueue]*remoteWriteSample{}
+		// Older Prometheus versions expose only the highest timestamp of
+		// the WAL (without queue labels).
+		highestTimestamp float64
+	)
+	for _, m := range mfs["prometheus_remote_storage_highest_timestamp_in_seconds"].GetMetric() {
+		highestTimestamp = max(highestTimestamp, metricValue(m))
+	}
+
+	for name, mf := range mfs {
+		for _, m := range mf.GetMetric() {
+			q := remoteWriteQueue{
+				name: labelValue(m, "remote_name"),
+				url:  labelValue(m, "url"),
+			}
+			if q.url == "" {
+				continue
+			}
+
+			s, found := ret[q]
+			if !found {
+				s = &remoteWriteSample{highestTimestamp: highestTimestamp}
+				ret[q] = s
+			}
+
+			v := metricValue(m)
+			switch name {
+			case "prometheus_remote_storage_queue_highest_timestamp_seconds":
+				s.highestTimestamp = v
+			case "prometheus_remote_storage_queue_highest_sent_timestamp_seconds":
+				s.highestSentTimestamp = v
+			case "prometheus_remote_storage_samples_pending":
+				s.pending = v
+			case "prometheus_remote_storage_shards":
+				s.shards = v
+			case "prometheus_remote_storage_shards_max":
+				s.maxShards = v
+			case "prometheus_remote_storage_samples_failed_total":
+				s.dropped += v
+			case "prometheus_remote_storage_samples_dropped_total":
+				// The samples dropped by the relabeling rules aren't lost.
+				if labelValue(m, "reason") != "relabel" {
+					s.dropped += v
+				}
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+func labelValue(m *dto.Metric, name string) string {
+	for _, lp := range m.GetLabel() {
+		if lp.GetName() == name {
+			return lp.GetValue()
+		}
+	}
+
+	return ""
+}
+
+func metricValue(m *dto.Metric) float64 {
+	switch {
+	case m.GetGauge() != nil:
+		return m.GetGauge().GetValue()
+	case m.GetCounter() != nil:
+		return m.GetCounter().GetValue()
+	case m.GetUntyped() != nil:
+		return m.GetUntyped().GetValue()
+	}
+
+	return 0
+}
+
+// fetchRemoteWriteMetrics returns the remote storage metrics exposed by the
+// Prometheus agent served at the given URL.
+func fetchRemoteWriteMetrics(ctx context.Context, client *http.Client, u url.URL) (map[remoteWriteQueue]*remoteWriteSample, error) {
+	u.Path = path.Join(u.Path, "/metrics")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeTextPlain)))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return parseRemoteWriteMetrics(resp.Body)
+}
+
+type remoteWriteEntry struct {
+	queues []monitoringv1.RemoteWriteQueueStatus
+	// The dropped samples counters of the previous update, indexed by pod
+	// and queue.
+	dropped map[string]float64
+	// The error of the last update, if any.
+	err error
+}
+
+// remoteWriteCache holds the state of the remote write queues of the
+// PrometheusAgent objects.
+type remoteWriteCache struct {
+	mtx     sync.Mutex
+	entries map[string]*remoteWriteEntry
+}
+
+func newRemoteWriteCache() *remoteWriteCache {
+	return &remoteWriteCache{
+		entries: map[string]*remoteWriteEntry{},
+	}
+}
+
+// update aggregates the remote storage metrics collected from the pods
+// (indexed by pod name). The number of dropped samples is computed from the
+// counters of the previous update.
+func (rc *remoteWriteCache) update(key string, samples map[string]map[remoteWriteQueue]*remoteWriteSample, t time.Time) {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+
+	e, found := rc.entries[key]
+	if !found {
+		e = &remoteWriteEntry{}
+		rc.entries[key] = e
+	}
+
+	var (
+		queues  = map[remoteWriteQueue]*monitoringv1.RemoteWriteQueueStatus{}
+		lags    = map[remoteWriteQueue]time.Duration{}
+		dropped = map[string]float64{}
+	)
+	for pod, podSamples := range samples {
+		for q, s := range podSamples {
+			qs, found := queues[q]
+			if !found {
+				qs = &monitoringv1.RemoteWriteQueueStatus{
+					Name:           q.name,
+					URL:            q.url,
+					LastUpdateTime: metav1.NewTime(t),
+				}
+				queues[q] = qs
+			}
+
+			qs.PendingSamples += int64(s.pending)
+			qs.Shards += int32(s.shards)
+			qs.MaxShards += int32(s.maxShards)
+
+			if s.highestSentTimestamp > 0 {
+				lag := time.Duration((s.highestTimestamp - s.highestSentTimestamp) * float64(time.Second))
+				lags[q] = max(lags[q], lag)
+			}
+
+			id := pod + "/" + q.name + "/" + q.url
+			dropped[id] = s.dropped
+			if prev, found := e.dropped[id]; found {
+				if s.dropped >= prev {
+					qs.DroppedSamples += int64(s.dropped - prev)
+				} else {
+					// The counter has been reset (e.g. pod restart).
+					qs.DroppedSamples += int64(s.dropped)
+				}
+			}
+		}
+	}
+
+	e.queues = make([]monitoringv1.RemoteWriteQueueStatus, 0, len(queues))
+	for q, qs := range queues {
+		qs.Lag = monitoringv1.Duration(model.Duration(lags[q].Round(time.Second)).String())
+		e.queues = append(e.queues, *qs)
+	}
+	slices.SortFunc(e.queues, func(a, b monitoringv1.RemoteWriteQueueStatus) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.URL, b.URL))
+	})
+
+	e.dropped = dropped
+	e.err = nil
+}
+
+// fail records the failure to collect the remote storage metrics.
+func (rc *remoteWriteCache) fail(key string, err error) {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+
+	e, found := rc.entries[key]
+	if !found {
+		e = &remoteWriteEntry{}
+		rc.entries[key] = e
+	}
+
+	e.err = err
+}
+
+// forget removes the state of the object. It returns true if the state
+// existed.
+func (rc *remoteWriteCache) forget(key string) bool {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+
+	_, found := rc.entries[key]
+	delete(rc.entries, key)
+
+	return found
+}
+
+// retain removes the state of the objects which aren't in keys.
+func (rc *remoteWriteCache) retain(keys map[string]struct{}) {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+
+	for key := range rc.entries {
+		if _, found := keys[key]; !found {
+			delete(rc.entries, key)
+		}
+	}
+}
+
+// queues returns the state of the remote write queues of the object.
+func (rc *remoteWriteCache) queues(key string) []monitoringv1.RemoteWriteQueueStatus {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+
+	e, found := rc.entries[key]
+	if !found || e.err != nil {
+		return nil
+	}
+
+	return slices.Clone(e.queues)
+}
+
+// conditions returns the RemoteWriteLagging and DroppingSamples conditions
+// of the object. It returns nil if the remote write metrics haven't been
+// collected.
+func (rc *remoteWriteCache) conditions(key string, generation int64) []monitoringv1.Condition {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+
+	e, found := rc.entries[key]
+	if !found {
+		return nil
+	}
+
+	var (
+		lagging = monitoringv1.Condition{
+			Type:               monitoringv1.RemoteWriteLagging,
+			Status:             monitoringv1.ConditionFalse,
+			LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+			ObservedGeneration: generation,
+		}
+		dropping = monitoringv1.Condition{
+			Type:               monitoringv1.DroppingSamples,
+			Status:             monitoringv1.ConditionFalse,
+			LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+			ObservedGeneration: generation,
+		}
+	)
+
+	if e.err != nil {
+		for _, c := range []*monitoringv1.Condition{&lagging, &dropping} {
+			c.Status = monitoringv1.ConditionUnknown
+			c.Reason = "MetricsUnavailable"
+			c.Message = e.err.Error()
+		}
+
+		return []monitoringv1.Condition{lagging, dropping}
+	}
+
+	var laggingMessages, droppingMessages []string
+	for _, q := range e.queues {
+		if lag, err := model.ParseDuration(string(q.Lag)); err == nil && time.Duration(lag) > remoteWriteLagThreshold {
+			laggingMessages = append(laggingMessages, fmt.Sprintf("queue %q (%s): lag is %s", q.Name, q.URL, q.Lag))
+		}
+
+		if q.DroppedSamples > 0 {
+			droppingMessages = append(droppingMessages, fmt.Sprintf("queue %q (%s): %d samples dropped", q.Name, q.URL, q.DroppedSamples))
+		}
+	}
+
+	if len(laggingMessages) > 0 {
+		lagging.Status = monitoringv1.ConditionTrue
+		lagging.Reason = "LagAboveThreshold"
+		lagging.Message = fmt.Sprintf("lag greater than %s\n%s", model.Duration(remoteWriteLagThreshold), strings.Join(laggingMessages, "\n"))
+	}
+
+	if len(droppingMessages) > 0 {
+		dropping.Status = monitoringv1.ConditionTrue
+		dropping.Reason = "SamplesDropped"
+		dropping.Message = strings.Join(droppingMessages, "\n")
+	}
+
+	return []monitoringv1.Condition{lagging, dropping}
+}
+
+// pollRemoteWrite refreshes regularly the state of the remote write queues
+// of the PrometheusAgent objects which define remote write endpoints.
+func (c *Operator) pollRemoteWrite(ctx context.Context) {
+	ticker := time.NewTicker(remoteWritePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			keys := map[string]struct{}{}
+			_ = c.promInfs.ListAll(labels.Everything(), func(o interface{}) {
+				p := o.(*monitoringv1alpha1.PrometheusAgent)
+				key := p.Namespace + "/" + p.Name
+
+				if len(p.Spec.RemoteWrite) == 0 {
+					if c.remoteWrite.forget(key) {
+						c.rr.EnqueueForStatus(p)
+					}
+					return
+				}
+				keys[key] = struct{}{}
+
+				if err := c.refreshRemoteWrite(ctx, p, key); err != nil {
+					c.logger.Debug("failed to refresh remote write metrics", "key", key, "err", err)
+					c.remoteWrite.fail(key, err)
+				}
+
+				c.rr.EnqueueForStatus(p)
+			})
+			c.remoteWrite.retain(keys)
+		}
+	}
+}
+
+// refreshRemoteWrite collects the remote storage metrics from all the ready
+// pods.
+func (c *Operator) refreshRemoteWrite(ctx context.Context, p *monitoringv1alpha1.PrometheusAgent, key string) error {
+	cpf := p.GetCommonPrometheusFields()
+	if cpf.ListenLocal {
+		return fmt.Errorf("the metrics aren't reachable when listenLocal is true")
+	}
+
+	if cpf.PrometheusURIScheme() != "http" {
+		return fmt.Errorf("unsupported scheme %q", cpf.PrometheusURIScheme())
+	}
+
+	pods, err := c.kclient.CoreV1().Pods(p.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(makeSelectorLabels(p.Name)).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		client  = &http.Client{Timeout: remoteWritePollTimeout}
+		samples = map[string]map[remoteWriteQueue]*remoteWriteSample{}
+	)
+	for _, pod := range pods.Items {
+		if pod.Status.PodIP == "" {
+			continue
+		}
+
+		if pp := operator.Pod(pod); !pp.Ready() {
+			continue
+		}
+
+		s, err := fetchRemoteWriteMetrics(ctx, client, url.URL{
+			Scheme: "http",
+			Host:   pod.Status.PodIP + ":9090",
+			Path:   cpf.WebRoutePrefix(),
+		})
+		if err != nil {
+			c.logger.Debug("failed to retrieve remote write metrics", "key", key, "pod", pod.Name, "err", err)
+			continue
+		}
+
+		samples[pod.Name] = s
+	}
+
+	if len(samples) == 0 {
+		return fmt.Errorf("no ready pod found")
+	}
+
+	c.remoteWrite.update(key, samples, time.Now())
+	return nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusagent
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const remoteWriteMetrics = `# HELP prometheus_remote_storage_highest_timestamp_in_seconds Highest timestamp that has come into the remote storage via the Appender interface.
+# TYPE prometheus_remote_storage_highest_timestamp_in_seconds gauge
+prometheus_remote_storage_highest_timestamp_in_seconds 1000
+# TYPE prometheus_remote_storage_queue_highest_sent_timestamp_seconds gauge
+prometheus_remote_storage_queue_highest_sent_timestamp_seconds{remote_name="primary",url="http://primary/api/v1/write"} 990
+prometheus_remote_storage_queue_highest_sent_timestamp_seconds{remote_name="backup",url="http://backup/api/v1/write"} 400
+# TYPE prometheus_remote_storage_samples_pending gauge
+prometheus_remote_storage_samples_pending{remote_name="primary",url="http://primary/api/v1/write"} 10
+prometheus_remote_storage_samples_pending{remote_name="backup",url="http://backup/api/v1/write"} 5000
+# TYPE prometheus_remote_storage_shards gauge
+prometheus_remote_storage_shards{remote_name="primary",url="http://primary/api/v1/write"} 1
+prometheus_remote_storage_shards{remote_name="backup",url="http://backup/api/v1/write"} 50
+# TYPE prometheus_remote_storage_shards_max gauge
+prometheus_remote_storage_shards_max{remote_name="primary",url="http://primary/api/v1/write"} 50
+prometheus_remote_storage_shards_max{remote_name="backup",url="http://backup/api/v1/write"} 50
+# TYPE prometheus_remote_storage_samples_dropped_total counter
+prometheus_remote_storage_samples_dropped_total{reason="relabel",remote_name="primary",url="http://primary/api/v1/write"} 100
+prometheus_remote_storage_samples_dropped_total{reason="too_old",remote_name="backup",url="http://backup/api/v1/write"} %d
+# TYPE prometheus_remote_storage_samples_failed_total counter
+prometheus_remote_storage_samples_failed_total{remote_name="primary",url="http://primary/api/v1/write"} 0
+prometheus_remote_storage_samples_failed_total{remote_name="backup",url="http://backup/api/v1/write"} 20
+`
+
+func parseTestMetrics(t *testing.T, dropped string) map[remoteWriteQueue]*remoteWriteSample {
+	t.Helper()
+
+	samples, err := parseRemoteWriteMetrics(strings.NewReader(strings.Replace(remoteWriteMetrics, "%d", dropped, 1)))
+	require.NoError(t, err)
+
+	return samples
+}
+
+func TestParseRemoteWriteMetrics(t *testing.T) {
+	samples := parseTestMetrics(t, "30")
+	require.Len(t, samples, 2)
+
+	require.Equal(t, &remoteWriteSample{
+		highestTimestamp:     1000,
+		highestSentTimestamp: 990,
+		pending:              10,
+		shards:               1,
+		maxShards:            50,
+	}, samples[remoteWriteQueue{name: "primary", url: "http://primary/api/v1/write"}])
+
+	require.Equal(t, &remoteWriteSample{
+		highestTimestamp:     1000,
+		highestSentTimestamp: 400,
+		pending:              5000,
+		shards:               50,
+		maxShards:            50,
+		dropped:              50,
+	}, samples[remoteWriteQueue{name: "backup", url: "http://backup/api/v1/write"}])
+}
+
+func TestRemoteWriteCache(t *testing.T) {
+	var (
+		rc  = newRemoteWriteCache()
+		key = "default/agent"
+		now = time.Now()
+	)
+
+	require.Nil(t, rc.conditions(key, 1))
+
+	rc.update(key, map[string]map[remoteWriteQueue]*remoteWriteSample{
+		"agent-0": parseTestMetrics(t, "30"),
+		"agent-1": parseTestMetrics(t, "30"),
+	}, now)
+
+	queues := rc.queues(key)
+	require.Len(t, queues, 2)
+	require.Equal(t, "backup", queues[0].Name)
+	require.Equal(t, monitoringv1.Duration("10m"), queues[0].Lag)
+	require.Equal(t, int64(10000), queues[0].PendingSamples)
+	require.Equal(t, int32(100), queues[0].Shards)
+	require.Equal(t, int32(100), queues[0].MaxShards)
+	// The first update has no reference for the dropped samples.
+	require.Equal(t, int64(0), queues[0].DroppedSamples)
+	require.Equal(t, "primary", queues[1].Name)
+	require.Equal(t, monitoringv1.Duration("10s"), queues[1].Lag)
+
+	conditions := rc.conditions(key, 1)
+	require.Len(t, conditions, 2)
+	require.Equal(t, monitoringv1.RemoteWriteLagging, conditions[0].Type)
+	require.Equal(t, monitoringv1.ConditionTrue, conditions[0].Status)
+	require.Contains(t, conditions[0].Message, `queue "backup"`)
+	require.NotContains(t, conditions[0].Message, `queue "primary"`)
+	require.Equal(t, monitoringv1.DroppingSamples, conditions[1].Type)
+	require.Equal(t, monitoringv1.ConditionFalse, conditions[1].Status)
+
+	// agent-1 has been restarted.
+	rc.update(key, map[string]map[remoteWriteQueue]*remoteWriteSample{
+		"agent-0": parseTestMetrics(t, "35"),
+		"agent-1": parseTestMetrics(t, "3"),
+	}, now.Add(time.Minute))
+
+	queues = rc.queues(key)
+	require.Equal(t, int64(5+23), queues[0].DroppedSamples)
+	require.Equal(t, int64(0), queues[1].DroppedSamples)
+
+	conditions = rc.conditions(key, 1)
+	require.Equal(t, monitoringv1.ConditionTrue, conditions[1].Status)
+	require.Equal(t, "SamplesDropped", conditions[1].Reason)
+
+	rc.fail(key, errors.New("no ready pod found"))
+	require.Nil(t, rc.queues(key))
+	for _, c := range rc.conditions(key, 1) {
+		require.Equal(t, monitoringv1.ConditionUnknown, c.Status)
+		require.Equal(t, "MetricsUnavailable", c.Reason)
+	}
+
+	rc.retain(map[string]struct{}{})
+	require.Nil(t, rc.conditions(key, 1))
+	require.False(t, rc.forget(key))
+}
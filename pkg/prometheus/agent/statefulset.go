@@ -66,6 +66,7 @@ func makeStatefulSet(
 		statefulset,
 		operator.WithName(name),
 		operator.WithInputHashAnnotation(inputHash),
+		operator.WithGenerationAnnotation(objMeta.GetGeneration()),
 		operator.WithAnnotations(objMeta.GetAnnotations()),
 		operator.WithAnnotations(config.Annotations),
 		operator.WithLabels(objMeta.GetLabels()),
@@ -160,7 +161,12 @@ func makeStatefulSetSpec(
 
 	configReloaderVolumeMounts := prompkg.CreateConfigReloaderVolumeMounts()
 
-	var configReloaderWebConfigFile string
+	var (
+		configReloaderWebConfigFile string
+		reloadClient                operator.ReloadClientFiles
+		reloadClientVols            []v1.Volume
+		reloadClientMounts          []v1.VolumeMount
+	)
 
 	// Mount web config and web TLS credentials as volumes.
 	// We always mount the web config file for versions greater than 2.24.0.
@@ -183,6 +189,14 @@ func makeStatefulSetSpec(
 			configReloaderWebConfigFile = confArg.Value
 			configReloaderVolumeMounts = append(configReloaderVolumeMounts, configMount...)
 		}
+
+		reloadClient, reloadClientVols, reloadClientMounts, err = prompkg.BuildReloadClient(cpf, p)
+		if err != nil {
+			return nil, err
+		}
+
+		volumes = append(volumes, reloadClientVols...)
+		configReloaderVolumeMounts = append(configReloaderVolumeMounts, reloadClientMounts...)
 	} else if cpf.Web != nil {
 		webConfigGenerator.Warn("web.config.file")
 	}
@@ -229,18 +243,23 @@ func makeStatefulSetSpec(
 		return nil, fmt.Errorf("failed to merge init containers spec: %w", err)
 	}
 
+	if err := operator.PrometheusAgentFlags.ValidateArgs("spec.additionalArgs", cg.Version(), cpf.AdditionalArgs); err != nil {
+		return nil, err
+	}
+
 	containerArgs, err := operator.BuildArgs(promArgs, cpf.AdditionalArgs)
 	if err != nil {
 		return nil, err
 	}
 
-	operatorContainers := append([]v1.Container{
+	operatorContainers := []v1.Container{
 		{
 			Name:                     "prometheus",
 			Image:                    pImagePath,
 			ImagePullPolicy:          cpf.ImagePullPolicy,
 			Ports:                    prompkg.MakeContainerPorts(cpf),
 			Args:                     containerArgs,
+			Env:                      c.GoRuntime.EnvVars(cpf.Resources),
 			VolumeMounts:             promVolumeMounts,
 			StartupProbe:             startupProbe,
 			LivenessProbe:            livenessProbe,
@@ -255,7 +274,12 @@ func makeStatefulSetSpec(
 				},
 			},
 		},
-		prompkg.BuildConfigReloader(
+	}
+
+	// With the OperatorExec reload strategy, the operator reloads the
+	// configuration with the Kubernetes exec API.
+	if !prompkg.UsesOperatorExecReload(p) {
+		operatorContainers = append(operatorContainers, prompkg.BuildConfigReloader(
 			p,
 			c,
 			false,
@@ -263,14 +287,20 @@ func makeStatefulSetSpec(
 			watchedDirectories,
 			operator.Shard(shard),
 			operator.WebConfigFile(configReloaderWebConfigFile),
-		),
-	}, additionalContainers...)
+			operator.ReloadClient(reloadClient),
+		))
+	}
+	operatorContainers = append(operatorContainers, additionalContainers...)
 
 	containers, err := k8sutil.MergePatchContainers(operatorContainers, cpf.Containers)
 	if err != nil {
 		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
 	}
 
+	if c.NativeSidecars {
+		containers, initContainers = k8sutil.MoveToNativeSidecars(containers, initContainers, "config-reloader")
+	}
+
 	spec := v1.PodSpec{
 		ShareProcessNamespace:         prompkg.ShareProcessNamespace(p),
 		Containers:                    containers,
@@ -19,6 +19,7 @@ import (
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	monitoringv1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
 	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
 
 func ApplyConfigurationFromPrometheusAgent(p *monitoringv1alpha1.PrometheusAgent, updateScaleSubresource bool) *monitoringv1alpha1ac.PrometheusAgentApplyConfiguration {
@@ -58,15 +59,99 @@ func prometheusStatusApplyConfigurationFromPrometheusStatus(status *monitoringv1
 	}
 
 	for _, shardStatus := range status.ShardStatuses {
-		psac.WithShardStatuses(
-			monitoringv1ac.ShardStatus().
-				WithShardID(shardStatus.ShardID).
-				WithReplicas(shardStatus.Replicas).
-				WithUpdatedReplicas(shardStatus.UpdatedReplicas).
-				WithAvailableReplicas(shardStatus.AvailableReplicas).
-				WithUnavailableReplicas(shardStatus.UnavailableReplicas),
+		ssac := monitoringv1ac.ShardStatus().
+			WithShardID(shardStatus.ShardID).
+			WithReplicas(shardStatus.Replicas).
+			WithUpdatedReplicas(shardStatus.UpdatedReplicas).
+			WithAvailableReplicas(shardStatus.AvailableReplicas).
+			WithUnavailableReplicas(shardStatus.UnavailableReplicas)
+
+		if shardStatus.Rollout != nil {
+			ssac.WithRollout(operator.RolloutStatusApplyConfiguration(shardStatus.Rollout))
+		}
+
+		if shardStatus.ObservedGeneration != 0 {
+			ssac.WithObservedGeneration(shardStatus.ObservedGeneration)
+		}
+
+		if shardStatus.RolloutBlockedReason != "" {
+			ssac.WithRolloutBlockedReason(shardStatus.RolloutBlockedReason)
+		}
+
+		psac.WithShardStatuses(ssac)
+	}
+
+	if ss := status.ShardScaling; ss != nil {
+		ssac := monitoringv1ac.ShardScalingStatus().
+			WithCurrentShards(ss.CurrentShards).
+			WithRequestedShards(ss.RequestedShards).
+			WithPendingConfirmation(ss.PendingConfirmation).
+			WithTargets(ss.Targets).
+			WithPlannedTargetMoves(ss.PlannedTargetMoves)
+
+		if ss.ObservedTargetMoves != nil {
+			ssac.WithObservedTargetMoves(*ss.ObservedTargetMoves)
+		}
+
+		if ss.LastTargetsUpdateTime != nil {
+			ssac.WithLastTargetsUpdateTime(*ss.LastTargetsUpdateTime)
+		}
+
+		psac.WithShardScaling(ssac)
+	}
+
+	for _, rwe := range status.RemoteWriteEndpoints {
+		psac.WithRemoteWriteEndpoints(
+			monitoringv1ac.RemoteWriteEndpointStatus().
+				WithURL(rwe.URL).
+				WithActiveURL(rwe.ActiveURL),
+		)
+	}
+
+	for _, rwq := range status.RemoteWriteQueues {
+		psac.WithRemoteWriteQueues(
+			monitoringv1ac.RemoteWriteQueueStatus().
+				WithName(rwq.Name).
+				WithURL(rwq.URL).
+				WithLag(rwq.Lag).
+				WithPendingSamples(rwq.PendingSamples).
+				WithShards(rwq.Shards).
+				WithMaxShards(rwq.MaxShards).
+				WithDroppedSamples(rwq.DroppedSamples).
+				WithLastUpdateTime(rwq.LastUpdateTime),
 		)
 	}
 
+	if oi := status.OperatorInfo; oi != nil {
+		psac.WithOperatorInfo(monitoringv1ac.OperatorInfo().WithVersion(oi.Version).WithEnabledFeatureGates(oi.EnabledFeatureGates...))
+	}
+
+	if sr := status.SelectedResources; sr != nil {
+		psac.WithSelectedResources(
+			monitoringv1ac.SelectedResourcesStatus().
+				WithServiceMonitors(selectionSummaryApplyConfiguration(sr.ServiceMonitors)).
+				WithPodMonitors(selectionSummaryApplyConfiguration(sr.PodMonitors)).
+				WithProbes(selectionSummaryApplyConfiguration(sr.Probes)).
+				WithScrapeConfigs(selectionSummaryApplyConfiguration(sr.ScrapeConfigs)).
+				WithPrometheusRules(selectionSummaryApplyConfiguration(sr.PrometheusRules)),
+		)
+	}
+
+	if len(status.ScrapeConfigSecrets) > 0 {
+		psac.WithScrapeConfigSecrets(status.ScrapeConfigSecrets...)
+	}
+
+	if status.LastReconcileTime != nil {
+		psac.WithLastReconcileTime(*status.LastReconcileTime)
+	}
+
 	return psac
 }
+
+func selectionSummaryApplyConfiguration(s *monitoringv1.SelectionSummary) *monitoringv1ac.SelectionSummaryApplyConfiguration {
+	if s == nil {
+		return nil
+	}
+
+	return monitoringv1ac.SelectionSummary().WithSelected(s.Selected).WithRejected(s.Rejected)
+}
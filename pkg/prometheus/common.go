@@ -45,6 +45,7 @@ const (
 	secretsDir             = "/etc/prometheus/secrets/"
 	configmapsDir          = "/etc/prometheus/configmaps/"
 	ConfigFilename         = "prometheus.yaml.gz"
+	ZstdConfigFilename     = "prometheus.yaml.zst"
 	ConfigEnvsubstFilename = "prometheus.env.yaml"
 	DefaultPortName        = "web"
 	DefaultLogFileVolume   = "log-file"
@@ -122,15 +123,59 @@ func compress(data []byte) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// ConfigCompression returns the compression algorithm of the configuration
+// file. It falls back to gzip when zstd is requested but the config-reloader
+// image doesn't support it.
+func ConfigCompression(p monitoringv1.PrometheusInterface, c Config) monitoringv1.ConfigCompressionType {
+	cpf := p.GetCommonPrometheusFields()
+	if ptr.Deref(cpf.ConfigCompression, monitoringv1.GzipConfigCompression) != monitoringv1.ZstdConfigCompression {
+		return monitoringv1.GzipConfigCompression
+	}
+
+	image := c.ReloaderConfig.Image
+	if cpf.ConfigReloader != nil && ptr.Deref(cpf.ConfigReloader.Image, "") != "" {
+		image = *cpf.ConfigReloader.Image
+	}
+
+	if !operator.ConfigReloaderSupportsZstd(image) {
+		return monitoringv1.GzipConfigCompression
+	}
+
+	return monitoringv1.ZstdConfigCompression
+}
+
+// ConfigSecretKey returns the key of the configuration file in the
+// configuration Secret.
+func ConfigSecretKey(p monitoringv1.PrometheusInterface, c Config) string {
+	if ConfigCompression(p, c) == monitoringv1.ZstdConfigCompression {
+		return ZstdConfigFilename
+	}
+
+	return ConfigFilename
+}
+
 func MakeConfigurationSecret(p monitoringv1.PrometheusInterface, config Config, data []byte) (*v1.Secret, error) {
-	promConfig, err := compress(data)
+	var (
+		promConfig []byte
+		err        error
+	)
+	switch ConfigCompression(p, config) {
+	case monitoringv1.ZstdConfigCompression:
+		var buf bytes.Buffer
+		if err = operator.ZstdConfig(&buf, data); err != nil {
+			return nil, fmt.Errorf("failed to compress config with zstd: %w", err)
+		}
+		promConfig = buf.Bytes()
+	default:
+		promConfig, err = compress(data)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	s := &v1.Secret{
 		Data: map[string][]byte{
-			ConfigFilename: promConfig,
+			ConfigSecretKey(p, config): promConfig,
 		},
 	}
 
@@ -221,12 +266,27 @@ func BuildCommonVolumes(p monitoringv1.PrometheusInterface, tlsSecrets *operator
 				},
 			},
 		},
+		{
+			// The ConfigMap holding the targets discovered from custom
+			// resources is created on demand by the operator.
+			Name: fileSDVolumeName,
+			VolumeSource: v1.VolumeSource{
+				ConfigMap: &v1.ConfigMapVolumeSource{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: FileSDConfigMapName(p),
+					},
+					Optional: ptr.To(true),
+				},
+			},
+		},
 	}
 
 	promVolumeMounts := []v1.VolumeMount{
 		{
-			Name:      "config-out",
-			ReadOnly:  true,
+			Name: "config-out",
+			// The operator writes the configuration from the Prometheus
+			// container with the OperatorExec reload strategy.
+			ReadOnly:  !UsesOperatorExecReload(p),
 			MountPath: ConfOutDir,
 		},
 		{
@@ -234,6 +294,11 @@ func BuildCommonVolumes(p monitoringv1.PrometheusInterface, tlsSecrets *operator
 			ReadOnly:  true,
 			MountPath: tlsAssetsDir,
 		},
+		{
+			Name:      fileSDVolumeName,
+			ReadOnly:  true,
+			MountPath: FileSDDir,
+		},
 	}
 
 	// Only StatefulSet needs this.
@@ -334,10 +399,11 @@ func BuildConfigReloader(
 
 	reloaderOptions := []operator.ReloaderOption{
 		operator.ReloaderConfig(c.ReloaderConfig),
+		operator.ReloaderOverrides(cpf.ConfigReloader),
 		operator.LogFormat(cpf.LogFormat),
 		operator.LogLevel(cpf.LogLevel),
 		operator.VolumeMounts(mounts),
-		operator.ConfigFile(path.Join(ConfDir, ConfigFilename)),
+		operator.ConfigFile(path.Join(ConfDir, ConfigSecretKey(p, c))),
 		operator.ConfigEnvsubstFile(path.Join(ConfOutDir, ConfigEnvsubstFilename)),
 		operator.WatchedDirectories(watchedDirectories),
 		operator.ImagePullPolicy(cpf.ImagePullPolicy),
@@ -448,12 +514,7 @@ func BuildWebconfig(
 	cpf monitoringv1.CommonPrometheusFields,
 	p monitoringv1.PrometheusInterface,
 ) (monitoringv1.Argument, []v1.Volume, []v1.VolumeMount, error) {
-	var fields monitoringv1.WebConfigFileFields
-	if cpf.Web != nil {
-		fields = cpf.Web.WebConfigFileFields
-	}
-
-	webConfig, err := webconfig.New(WebConfigDir, WebConfigSecretName(p), fields)
+	webConfig, err := newWebConfig(cpf, p)
 	if err != nil {
 		return monitoringv1.Argument{}, nil, nil, err
 	}
@@ -461,6 +522,30 @@ func BuildWebconfig(
 	return webConfig.GetMountParameters()
 }
 
+// BuildReloadClient returns the settings of the HTTP client used by the
+// config-reloader to call the reload endpoint, as well as the volumes and
+// volume mounts of its credentials.
+func BuildReloadClient(
+	cpf monitoringv1.CommonPrometheusFields,
+	p monitoringv1.PrometheusInterface,
+) (operator.ReloadClientFiles, []v1.Volume, []v1.VolumeMount, error) {
+	webConfig, err := newWebConfig(cpf, p)
+	if err != nil {
+		return operator.ReloadClientFiles{}, nil, nil, err
+	}
+
+	return webConfig.GetReloadClientParameters(cpf.ConfigReloader)
+}
+
+func newWebConfig(cpf monitoringv1.CommonPrometheusFields, p monitoringv1.PrometheusInterface) (*webconfig.Config, error) {
+	var fields monitoringv1.WebConfigFileFields
+	if cpf.Web != nil {
+		fields = cpf.Web.WebConfigFileFields
+	}
+
+	return webconfig.New(WebConfigDir, WebConfigSecretName(p), fields)
+}
+
 // BuildStatefulSetService returns a governing service to be used for a statefulset.
 func BuildStatefulSetService(name string, selector map[string]string, p monitoringv1.PrometheusInterface, config Config) *v1.Service {
 	cpf := p.GetCommonPrometheusFields()
@@ -15,14 +15,18 @@
 package prometheus
 
 import (
+	"path"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
 
 func TestStartupProbeTimeoutSeconds(t *testing.T) {
@@ -97,6 +101,81 @@ func TestStartupProbeTimeoutSeconds(t *testing.T) {
 	}
 }
 
+func TestMaximumStartupDurationSeconds(t *testing.T) {
+	pvc := func(size string) *monitoringv1.StorageSpec {
+		return &monitoringv1.StorageSpec{
+			VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{
+				Spec: v1.PersistentVolumeClaimSpec{
+					Resources: v1.VolumeResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(size)},
+					},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		cpf      monitoringv1.CommonPrometheusFields
+		expected *int32
+	}{
+		{
+			name: "fixed",
+			cpf: monitoringv1.CommonPrometheusFields{
+				Storage:                       pvc("100Gi"),
+				MaximumStartupDurationSeconds: ptr.To(int32(300)),
+			},
+			expected: ptr.To(int32(300)),
+		},
+		{
+			name: "auto with small volume",
+			cpf: monitoringv1.CommonPrometheusFields{
+				Storage:                    pvc("1Gi"),
+				MaximumStartupDurationMode: ptr.To(monitoringv1.AutoStartupDurationMode),
+			},
+			expected: ptr.To(int32(150)),
+		},
+		{
+			name: "auto with large volume",
+			cpf: monitoringv1.CommonPrometheusFields{
+				Storage:                    pvc("500G"),
+				MaximumStartupDurationMode: ptr.To(monitoringv1.AutoStartupDurationMode),
+			},
+			expected: ptr.To(int32(14100)),
+		},
+		{
+			name: "auto with lower bound",
+			cpf: monitoringv1.CommonPrometheusFields{
+				Storage:                       pvc("1Gi"),
+				MaximumStartupDurationMode:    ptr.To(monitoringv1.AutoStartupDurationMode),
+				MaximumStartupDurationSeconds: ptr.To(int32(600)),
+			},
+			expected: ptr.To(int32(600)),
+		},
+		{
+			name: "auto with emptyDir size limit",
+			cpf: monitoringv1.CommonPrometheusFields{
+				Storage: &monitoringv1.StorageSpec{
+					EmptyDir: &v1.EmptyDirVolumeSource{SizeLimit: ptr.To(resource.MustParse("10Gi"))},
+				},
+				MaximumStartupDurationMode: ptr.To(monitoringv1.AutoStartupDurationMode),
+			},
+			expected: ptr.To(int32(420)),
+		},
+		{
+			name: "auto with unknown size",
+			cpf: monitoringv1.CommonPrometheusFields{
+				MaximumStartupDurationMode: ptr.To(monitoringv1.AutoStartupDurationMode),
+			},
+			expected: nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, maximumStartupDurationSeconds(tc.cpf))
+		})
+	}
+}
+
 func TestBuildCommonPrometheusArgsWithRemoteWriteMessageV2(t *testing.T) {
 	for _, tc := range []struct {
 		version        string
@@ -282,3 +361,74 @@ func TestBuildCommonPrometheusArgsWithOTLPReceiver(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigCompression(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		compression   *monitoringv1.ConfigCompressionType
+		reloaderImage string
+		overrideImage *string
+		expected      monitoringv1.ConfigCompressionType
+		expectedKey   string
+	}{
+		{
+			name:          "default",
+			reloaderImage: "quay.io/prometheus-operator/prometheus-config-reloader:v0.85.0",
+			expected:      monitoringv1.GzipConfigCompression,
+			expectedKey:   ConfigFilename,
+		},
+		{
+			name:          "zstd",
+			compression:   ptr.To(monitoringv1.ZstdConfigCompression),
+			reloaderImage: "quay.io/prometheus-operator/prometheus-config-reloader:v0.85.0",
+			expected:      monitoringv1.ZstdConfigCompression,
+			expectedKey:   ZstdConfigFilename,
+		},
+		{
+			name:          "zstd with old config-reloader",
+			compression:   ptr.To(monitoringv1.ZstdConfigCompression),
+			reloaderImage: "quay.io/prometheus-operator/prometheus-config-reloader:v0.84.0",
+			expected:      monitoringv1.GzipConfigCompression,
+			expectedKey:   ConfigFilename,
+		},
+		{
+			name:          "zstd with old config-reloader override",
+			compression:   ptr.To(monitoringv1.ZstdConfigCompression),
+			reloaderImage: "quay.io/prometheus-operator/prometheus-config-reloader:v0.85.0",
+			overrideImage: ptr.To("quay.io/prometheus-operator/prometheus-config-reloader:v0.84.0"),
+			expected:      monitoringv1.GzipConfigCompression,
+			expectedKey:   ConfigFilename,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						ConfigCompression: tc.compression,
+					},
+				},
+			}
+			if tc.overrideImage != nil {
+				p.Spec.ConfigReloader = &monitoringv1.ConfigReloaderSpec{Image: tc.overrideImage}
+			}
+
+			c := Config{ReloaderConfig: operator.ContainerConfig{Image: tc.reloaderImage}}
+			require.Equal(t, tc.expected, ConfigCompression(p, c))
+			require.Equal(t, tc.expectedKey, ConfigSecretKey(p, c))
+
+			s, err := MakeConfigurationSecret(p, c, []byte("global: {}\n"))
+			require.NoError(t, err)
+			require.Len(t, s.Data, 1)
+			cfg, err := operator.DecompressConfig(s.Data[tc.expectedKey])
+			require.NoError(t, err)
+			require.Equal(t, "global: {}\n", cfg)
+
+			container := BuildConfigReloader(p, c, false, nil, nil)
+			require.Contains(t, container.Args, "--config-file="+path.Join(ConfDir, tc.expectedKey))
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+// ConfigurationInputHash returns a hash over all the inputs of the
+// configuration generation: the spec of the Prometheus or PrometheusAgent
+// object, the labels and annotations of the operator's configuration (applied
+// to the configuration Secret), the spec of the selected resources, the
+// content of the Secrets and ConfigMaps loaded in the store and any additional
+// input (e.g. additional scrape configs).
+//
+// Only the spec of the selected resources is considered so that changes
+// to their status or metadata don't invalidate the hash.
+func ConfigurationInputHash(
+	p monitoringv1.PrometheusInterface,
+	config Config,
+	sMons map[string]*monitoringv1.ServiceMonitor,
+	pMons map[string]*monitoringv1.PodMonitor,
+	probes map[string]*monitoringv1.Probe,
+	sCons map[string]*monitoringv1alpha1.ScrapeConfig,
+	store *assets.StoreBuilder,
+	additionalInputs ...any,
+) (string, error) {
+	var spec any
+	switch v := p.(type) {
+	case *monitoringv1.Prometheus:
+		spec = v.Spec
+	case *monitoringv1alpha1.PrometheusAgent:
+		spec = v.Spec
+	default:
+		return "", fmt.Errorf("unsupported type %T", p)
+	}
+
+	serviceMonitors := make(map[string]monitoringv1.ServiceMonitorSpec, len(sMons))
+	for k, o := range sMons {
+		serviceMonitors[k] = o.Spec
+	}
+
+	podMonitors := make(map[string]monitoringv1.PodMonitorSpec, len(pMons))
+	for k, o := range pMons {
+		podMonitors[k] = o.Spec
+	}
+
+	probeSpecs := make(map[string]monitoringv1.ProbeSpec, len(probes))
+	for k, o := range probes {
+		probeSpecs[k] = o.Spec
+	}
+
+	scrapeConfigs := make(map[string]monitoringv1alpha1.ScrapeConfigSpec, len(sCons))
+	for k, o := range sCons {
+		scrapeConfigs[k] = o.Spec
+	}
+
+	storeHash, err := store.Hash()
+	if err != nil {
+		return "", err
+	}
+
+	// The JSON encoding sorts the map keys which makes the output
+	// deterministic.
+	b, err := json.Marshal(struct {
+		Spec             any
+		Labels           map[string]string
+		Annotations      map[string]string
+		ServiceMonitors  map[string]monitoringv1.ServiceMonitorSpec
+		PodMonitors      map[string]monitoringv1.PodMonitorSpec
+		Probes           map[string]monitoringv1.ProbeSpec
+		ScrapeConfigs    map[string]monitoringv1alpha1.ScrapeConfigSpec
+		Store            string
+		AdditionalInputs []any
+	}{
+		Spec:             spec,
+		Labels:           config.Labels,
+		Annotations:      config.Annotations,
+		ServiceMonitors:  serviceMonitors,
+		PodMonitors:      podMonitors,
+		Probes:           probeSpecs,
+		ScrapeConfigs:    scrapeConfigs,
+		Store:            storeHash,
+		AdditionalInputs: additionalInputs,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the configuration inputs: %w", err)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
+
+// ConfigUpdateDebounce returns the debounce window and the maximum delay
+// applied to the updates of the configuration Secret. A zero window means
+// that the updates aren't debounced.
+func ConfigUpdateDebounce(p monitoringv1.PrometheusInterface) (time.Duration, time.Duration, error) {
+	cud := p.GetCommonPrometheusFields().ConfigUpdateDebounce
+	if cud == nil {
+		return 0, 0, nil
+	}
+
+	window, err := model.ParseDuration(string(cud.Window))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid debounce window: %w", err)
+	}
+
+	maxDelay := 5 * window
+	if cud.MaxDelay != nil {
+		d, err := model.ParseDuration(string(*cud.MaxDelay))
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid debounce max delay: %w", err)
+		}
+		maxDelay = d
+	}
+
+	return time.Duration(window), time.Duration(maxDelay), nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+func TestConfigurationInputHash(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+	}
+	newServiceMonitor := func(rv, port string) map[string]*monitoringv1.ServiceMonitor {
+		return map[string]*monitoringv1.ServiceMonitor{
+			"default/smon": {
+				ObjectMeta: metav1.ObjectMeta{Name: "smon", Namespace: "default", ResourceVersion: rv},
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{{Port: port}},
+				},
+			},
+		}
+	}
+
+	hashWithConfig := func(config Config, p *monitoringv1.Prometheus, smons map[string]*monitoringv1.ServiceMonitor, additional ...any) string {
+		t.Helper()
+
+		h, err := ConfigurationInputHash(p, config, smons, nil, nil, nil, assets.NewTestStoreBuilder(), additional...)
+		require.NoError(t, err)
+
+		return h
+	}
+	hash := func(p *monitoringv1.Prometheus, smons map[string]*monitoringv1.ServiceMonitor, additional ...any) string {
+		t.Helper()
+
+		return hashWithConfig(Config{}, p, smons, additional...)
+	}
+
+	h := hash(p, newServiceMonitor("1", "web"))
+
+	// Metadata changes of the selected resources don't modify the hash.
+	require.Equal(t, h, hash(p, newServiceMonitor("2", "web")))
+
+	require.NotEqual(t, h, hash(p, newServiceMonitor("1", "metrics")))
+	require.NotEqual(t, h, hash(p, newServiceMonitor("1", "web"), []byte("additional")))
+
+	p2 := p.DeepCopy()
+	p2.Spec.ScrapeInterval = "10s"
+	require.NotEqual(t, h, hash(p2, newServiceMonitor("1", "web")))
+
+	// The operator's labels and annotations are applied to the
+	// configuration Secret.
+	require.NotEqual(t, h, hashWithConfig(Config{Labels: map[string]string{"foo": "bar"}}, p, newServiceMonitor("1", "web")))
+	require.NotEqual(t, h, hashWithConfig(Config{Annotations: map[string]string{"foo": "bar"}}, p, newServiceMonitor("1", "web")))
+	require.Equal(t, h, hashWithConfig(Config{LocalHost: "127.0.0.1"}, p, newServiceMonitor("1", "web")))
+}
+
+func TestConfigUpdateDebounce(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		debounce *monitoringv1.ConfigUpdateDebounce
+		window   time.Duration
+		maxDelay time.Duration
+		err      bool
+	}{
+		{
+			name: "not defined",
+		},
+		{
+			name:     "default max delay",
+			debounce: &monitoringv1.ConfigUpdateDebounce{Window: "10s"},
+			window:   10 * time.Second,
+			maxDelay: 50 * time.Second,
+		},
+		{
+			name:     "explicit max delay",
+			debounce: &monitoringv1.ConfigUpdateDebounce{Window: "10s", MaxDelay: ptr.To(monitoringv1.Duration("1m"))},
+			window:   10 * time.Second,
+			maxDelay: time.Minute,
+		},
+		{
+			name:     "invalid window",
+			debounce: &monitoringv1.ConfigUpdateDebounce{Window: "foo"},
+			err:      true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						ConfigUpdateDebounce: tc.debounce,
+					},
+				},
+			}
+
+			window, maxDelay, err := ConfigUpdateDebounce(p)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.window, window)
+			require.Equal(t, tc.maxDelay, maxDelay)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	// ScrapeConfigsDir is the directory where the scrape configuration files
+	// are mounted when the configuration is split.
+	ScrapeConfigsDir = "/etc/prometheus/scrape_configs"
+	// ScrapeConfigsOutDir is the directory where the config-reloader writes
+	// the decompressed scrape configuration files.
+	ScrapeConfigsOutDir = ConfOutDir + "/scrape_configs"
+
+	// ScrapeConfigSecretsAnnotation is the annotation of the configuration
+	// Secret which lists the Secrets holding the scrape configuration files
+	// when the configuration is split.
+	ScrapeConfigSecretsAnnotation = "operator.prometheus.io/scrape-config-secrets"
+
+	scrapeConfigsKey     = "scrape_configs"
+	scrapeConfigFilesKey = "scrape_config_files"
+)
+
+// ScrapeConfigSecretName returns the name of the Secret holding the i-th
+// scrape configuration file.
+func ScrapeConfigSecretName(p monitoringv1.PrometheusInterface, i int) string {
+	return fmt.Sprintf("%s-scrape-configs-%d", PrefixedName(p), i)
+}
+
+// ScrapeConfigSecretNames returns the names of the Secrets holding the
+// scrape configuration files of a configuration Secret. It returns nil if
+// the configuration isn't split.
+func ScrapeConfigSecretNames(s metav1.Object) []string {
+	names := s.GetAnnotations()[ScrapeConfigSecretsAnnotation]
+	if names == "" {
+		return nil
+	}
+
+	return strings.Split(names, ",")
+}
+
+// SplitConfigurationSecret splits the configuration when it exceeds the
+// maximum size of a single Secret. The scrape configurations are moved to
+// scrape configuration files (see `scrape_config_files`), each file being
+// stored in a separate Secret.
+//
+// It returns the configuration Secret followed by the Secrets of the scrape
+// configuration files.
+func SplitConfigurationSecret(p monitoringv1.PrometheusInterface, config Config, data []byte) (*v1.Secret, []*v1.Secret, error) {
+	var cfg yaml.MapSlice
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the configuration: %w", err)
+	}
+
+	var scrapeConfigs []any
+	for i, item := range cfg {
+		if item.Key != scrapeConfigsKey {
+			continue
+		}
+
+		scrapeConfigs, _ = item.Value.([]any)
+		cfg[i] = yaml.MapItem{
+			Key:   scrapeConfigFilesKey,
+			Value: []string{path.Join(ScrapeConfigsOutDir, "*.yaml")},
+		}
+		break
+	}
+
+	if len(scrapeConfigs) == 0 {
+		return nil, nil, errors.New("no scrape configuration to split")
+	}
+
+	// Group the scrape configurations into files which fit into a Secret
+	// once compressed. The compressed size of a group is estimated from the
+	// compressed size of its members.
+	var (
+		groups [][]any
+		group  []any
+		size   int
+	)
+	for _, sc := range scrapeConfigs {
+		b, err := marshalScrapeConfigs([]any{sc})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(group) > 0 && size+len(b) > operator.MaxSecretDataSizeBytes {
+			groups = append(groups, group)
+			group, size = nil, 0
+		}
+
+		group = append(group, sc)
+		size += len(b)
+	}
+	groups = append(groups, group)
+
+	var (
+		secrets = make([]*v1.Secret, 0, len(groups))
+		names   = make([]string, 0, len(groups))
+	)
+	for i, group := range groups {
+		b, err := marshalScrapeConfigs(group)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		s := &v1.Secret{
+			Data: map[string][]byte{
+				fmt.Sprintf("scrape-configs-%d.yaml", i): b,
+			},
+		}
+
+		operator.UpdateObject(
+			s,
+			operator.WithLabels(config.Labels),
+			operator.WithAnnotations(config.Annotations),
+			operator.WithManagingOwner(p),
+			operator.WithName(ScrapeConfigSecretName(p, i)),
+		)
+
+		if err := operator.CheckSecretSize(s); err != nil {
+			return nil, nil, err
+		}
+
+		secrets = append(secrets, s)
+		names = append(names, s.Name)
+	}
+
+	b, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal the configuration: %w", err)
+	}
+
+	s, err := MakeConfigurationSecret(p, config, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.Annotations[ScrapeConfigSecretsAnnotation] = strings.Join(names, ",")
+
+	if err := operator.CheckSecretSize(s); err != nil {
+		return nil, nil, err
+	}
+
+	return s, secrets, nil
+}
+
+// marshalScrapeConfigs returns the compressed scrape configuration file
+// holding the given scrape configurations.
+func marshalScrapeConfigs(scrapeConfigs []any) ([]byte, error) {
+	b, err := yaml.Marshal(yaml.MapSlice{{Key: scrapeConfigsKey, Value: scrapeConfigs}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the scrape configurations: %w", err)
+	}
+
+	return compress(b)
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestSplitConfigurationSecret(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+	}
+
+	// Random values don't compress which guarantees that the configuration
+	// exceeds the size limit.
+	var scrapeConfigs []yaml.MapSlice
+	for i := range 60 {
+		b := make([]byte, 16_000)
+		_, err := rand.Read(b)
+		require.NoError(t, err)
+
+		scrapeConfigs = append(scrapeConfigs, yaml.MapSlice{
+			{Key: "job_name", Value: fmt.Sprintf("job-%d", i)},
+			{Key: "metrics_path", Value: "/" + hex.EncodeToString(b)},
+		})
+	}
+
+	conf, err := yaml.Marshal(yaml.MapSlice{
+		{Key: "global", Value: yaml.MapSlice{{Key: "scrape_interval", Value: "30s"}}},
+		{Key: "scrape_configs", Value: scrapeConfigs},
+		{Key: "rule_files", Value: []string{"/etc/prometheus/rules/*.yaml"}},
+	})
+	require.NoError(t, err)
+
+	s, err := MakeConfigurationSecret(p, Config{}, conf)
+	require.NoError(t, err)
+	require.Error(t, operator.CheckSecretSize(s))
+
+	s, secrets, err := SplitConfigurationSecret(p, Config{}, conf)
+	require.NoError(t, err)
+	require.Greater(t, len(secrets), 1)
+
+	// The configuration references the scrape configuration files instead
+	// of the scrape configurations.
+	require.NoError(t, operator.CheckSecretSize(s))
+	b, err := operator.GunzipConfig(s.Data[ConfigFilename])
+	require.NoError(t, err)
+	require.Equal(t, `global:
+  scrape_interval: 30s
+scrape_config_files:
+- /etc/prometheus/config_out/scrape_configs/*.yaml
+rule_files:
+- /etc/prometheus/rules/*.yaml
+`, b)
+
+	var (
+		names []string
+		jobs  []string
+	)
+	for i, secret := range secrets {
+		require.Equal(t, fmt.Sprintf("prometheus-test-scrape-configs-%d", i), secret.Name)
+		require.Equal(t, "test", secret.OwnerReferences[0].Name)
+		require.NoError(t, operator.CheckSecretSize(secret))
+		names = append(names, secret.Name)
+
+		b, err := operator.GunzipConfig(secret.Data[fmt.Sprintf("scrape-configs-%d.yaml", i)])
+		require.NoError(t, err)
+
+		var f struct {
+			ScrapeConfigs []struct {
+				JobName string `yaml:"job_name"`
+			} `yaml:"scrape_configs"`
+		}
+		require.NoError(t, yaml.Unmarshal([]byte(b), &f))
+		for _, sc := range f.ScrapeConfigs {
+			jobs = append(jobs, sc.JobName)
+		}
+	}
+
+	require.Equal(t, names, ScrapeConfigSecretNames(s))
+	require.Len(t, jobs, len(scrapeConfigs))
+	for i, job := range jobs {
+		require.Equal(t, fmt.Sprintf("job-%d", i), job)
+	}
+
+	// A configuration without scrape configurations can't be split.
+	_, _, err = SplitConfigurationSecret(p, Config{}, []byte("global: {}\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,457 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+	"github.com/prometheus/common/model"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	// FileSDDir is the directory where the file SD target files generated
+	// from the custom resource SD configurations are mounted.
+	FileSDDir = "/etc/prometheus/file_sd"
+
+	fileSDVolumeName = "file-sd"
+
+	defaultCustomResourceSDRefreshInterval = time.Minute
+
+	customResourceMetaLabelPrefix = model.MetaLabelPrefix + "customresource_"
+
+	// customResourceSDCostLimit bounds the cost of the evaluation of a CEL
+	// expression for one object.
+	customResourceSDCostLimit = 1000000
+)
+
+// customResourceSDEnv returns the CEL environment in which the expressions
+// of the custom resource SD configurations are evaluated. The object is
+// exposed as the `object` variable.
+var customResourceSDEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("object", cel.MapType(cel.StringType, cel.DynType)),
+		cel.OptionalTypes(),
+		ext.Strings(),
+	)
+})
+
+// FileSDConfigMapName returns the name of the ConfigMap holding the target
+// files of the custom resource SD configurations.
+func FileSDConfigMapName(p monitoringv1.PrometheusInterface) string {
+	return fmt.Sprintf("%s-file-sd", PrefixedName(p))
+}
+
+func customResourceSDFilename(sc *monitoringv1alpha1.ScrapeConfig, i int) string {
+	return fmt.Sprintf("%s_%s_%d.json", sc.GetNamespace(), sc.GetName(), i)
+}
+
+func customResourceSDRefreshInterval(config monitoringv1alpha1.CustomResourceSDConfig) time.Duration {
+	if config.RefreshInterval == nil {
+		return defaultCustomResourceSDRefreshInterval
+	}
+
+	d, err := model.ParseDuration(string(*config.RefreshInterval))
+	if err != nil || d <= 0 {
+		return defaultCustomResourceSDRefreshInterval
+	}
+
+	return time.Duration(d)
+}
+
+// targetGroup is the file SD representation of a group of targets.
+type targetGroup struct {
+	Targets []string          `json:"targets"`
+	Labels  map[string]string `json:"labels,omitempty"`
+}
+
+// CustomResourceDiscoverer materializes the targets of the custom resource SD
+// configurations into a ConfigMap which is mounted in the Prometheus pods and
+// read with file SD.
+type CustomResourceDiscoverer struct {
+	logger  *slog.Logger
+	kclient kubernetes.Interface
+	dclient dynamic.Interface
+
+	mtx sync.Mutex
+	// The discovery failures of the last synchronization indexed by the
+	// Prometheus object's key.
+	failures map[string][]discoveryFailure
+}
+
+type discoveryFailure struct {
+	forbidden bool
+	message   string
+}
+
+// NewCustomResourceDiscoverer returns a CustomResourceDiscoverer.
+func NewCustomResourceDiscoverer(logger *slog.Logger, kclient kubernetes.Interface, dclient dynamic.Interface) *CustomResourceDiscoverer {
+	return &CustomResourceDiscoverer{
+		logger:   logger,
+		kclient:  kclient,
+		dclient:  dclient,
+		failures: map[string][]discoveryFailure{},
+	}
+}
+
+// Sync lists the custom resources referenced by the scrape configs and
+// updates the file SD ConfigMap of the Prometheus object.
+//
+// It returns the smallest refresh interval of the configurations (or zero if
+// no scrape config uses custom resource SD): the caller is expected to
+// trigger a new synchronization after this delay. The discovery failures are
+// reported by the CustomResourceDiscoveryFailed condition.
+func (d *CustomResourceDiscoverer) Sync(
+	ctx context.Context,
+	p monitoringv1.PrometheusInterface,
+	scrapeConfigs map[string]*monitoringv1alpha1.ScrapeConfig,
+	opts ...operator.ObjectOption,
+) (time.Duration, error) {
+	var (
+		namespace = p.GetObjectMeta().GetNamespace()
+		name      = FileSDConfigMapName(p)
+		previous  = map[string]string{}
+	)
+
+	var exists bool
+	existing, err := d.kclient.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return 0, fmt.Errorf("failed to get file SD configmap: %w", err)
+	default:
+		exists = true
+		previous = existing.Data
+	}
+
+	var (
+		data     = map[string]string{}
+		refresh  time.Duration
+		failures []discoveryFailure
+	)
+
+	for _, sc := range scrapeConfigs {
+		for i, config := range sc.Spec.CustomResourceSDConfigs {
+			filename := customResourceSDFilename(sc, i)
+
+			if r := customResourceSDRefreshInterval(config); refresh == 0 || r < refresh {
+				refresh = r
+			}
+
+			b, err := d.discover(ctx, sc.GetNamespace(), config)
+			if err != nil {
+				// Keep the last known targets until the next refresh.
+				d.logger.Warn("failed to discover targets from custom resources",
+					"scrapeconfig", fmt.Sprintf("%s/%s", sc.GetNamespace(), sc.GetName()),
+					"index", i,
+					"err", err,
+				)
+				failures = append(failures, newDiscoveryFailure(sc, i, config, err))
+
+				if content, found := previous[filename]; found {
+					data[filename] = content
+				}
+				continue
+			}
+
+			data[filename] = string(b)
+		}
+	}
+
+	d.setFailures(p, refresh > 0, failures)
+
+	if len(data) == 0 && !exists {
+		// Don't create the ConfigMap until it's needed.
+		return refresh, nil
+	}
+
+	cm := &v1.ConfigMap{Data: data}
+	operator.UpdateObject(
+		cm,
+		append(
+			opts,
+			operator.WithName(name),
+			operator.WithNamespace(namespace),
+			operator.WithManagingOwner(p),
+		)...,
+	)
+
+	if err := k8sutil.CreateOrUpdateConfigMap(ctx, d.kclient.CoreV1().ConfigMaps(namespace), cm); err != nil {
+		return 0, fmt.Errorf("failed to reconcile file SD configmap: %w", err)
+	}
+
+	return refresh, nil
+}
+
+// discover returns the file SD content for the custom resource SD configuration.
+func (d *CustomResourceDiscoverer) discover(ctx context.Context, namespace string, config monitoringv1alpha1.CustomResourceSDConfig) ([]byte, error) {
+	var selector string
+	if config.Selector != nil {
+		s, err := metav1.LabelSelectorAsSelector(config.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector: %w", err)
+		}
+		selector = s.String()
+	}
+
+	gvr := schema.GroupVersionResource{
+		Group:    config.Group,
+		Version:  config.Version,
+		Resource: config.Resource,
+	}
+
+	list, err := d.dclient.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", gvr.GroupResource().String(), err)
+	}
+
+	tgs, err := targetGroupsFromObjects(config, list.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(tgs)
+}
+
+// targetGroupsFromObjects evaluates the CEL expressions of the configuration
+// against the objects and returns one target group per object.
+func targetGroupsFromObjects(config monitoringv1alpha1.CustomResourceSDConfig, objects []unstructured.Unstructured) ([]targetGroup, error) {
+	address, err := compileCELExpression(config.Address, true)
+	if err != nil {
+		return nil, fmt.Errorf("address: %w", err)
+	}
+
+	labels := make(map[string]cel.Program, len(config.Labels))
+	for name, expr := range config.Labels {
+		prg, err := compileCELExpression(expr, false)
+		if err != nil {
+			return nil, fmt.Errorf("label %q: %w", name, err)
+		}
+		labels[name] = prg
+	}
+
+	// Sort the objects to generate a stable output.
+	slices.SortFunc(objects, func(a, b unstructured.Unstructured) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	tgs := []targetGroup{}
+	for _, o := range objects {
+		targets, err := evalCELExpression(address, o.Object)
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: address: %w", o.GetNamespace(), o.GetName(), err)
+		}
+
+		if len(targets) == 0 {
+			continue
+		}
+
+		tg := targetGroup{
+			Targets: targets,
+			Labels: map[string]string{
+				customResourceMetaLabelPrefix + "namespace": o.GetNamespace(),
+				customResourceMetaLabelPrefix + "name":      o.GetName(),
+			},
+		}
+
+		for name, prg := range labels {
+			v, err := evalCELExpression(prg, o.Object)
+			if err != nil {
+				return nil, fmt.Errorf("%s/%s: label %q: %w", o.GetNamespace(), o.GetName(), name, err)
+			}
+
+			if len(v) == 0 {
+				continue
+			}
+			tg.Labels[name] = v[0]
+		}
+
+		tgs = append(tgs, tg)
+	}
+
+	return tgs, nil
+}
+
+// compileCELExpression compiles the CEL expression. The expression must
+// return a string or, if list is true, a list of strings.
+func compileCELExpression(expr string, list bool) (cel.Program, error) {
+	env, err := customResourceSDEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the CEL environment: %w", err)
+	}
+
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("invalid CEL expression %q: %w", expr, iss.Err())
+	}
+
+	allowed := []*cel.Type{cel.StringType, cel.DynType, cel.NullType, cel.OptionalType(cel.StringType), cel.OptionalType(cel.DynType)}
+	if list {
+		allowed = append(allowed, cel.ListType(cel.StringType), cel.ListType(cel.DynType))
+	}
+	if !slices.ContainsFunc(allowed, ast.OutputType().IsExactType) {
+		return nil, fmt.Errorf("invalid CEL expression %q: unexpected return type %s", expr, ast.OutputType())
+	}
+
+	prg, err := env.Program(ast, cel.CostLimit(customResourceSDCostLimit))
+	if err != nil {
+		return nil, fmt.Errorf("invalid CEL expression %q: %w", expr, err)
+	}
+
+	return prg, nil
+}
+
+// evalCELExpression evaluates the program against the object and returns the
+// non-empty strings.
+func evalCELExpression(prg cel.Program, obj map[string]any) ([]string, error) {
+	out, _, err := prg.Eval(map[string]any{"object": obj})
+	if err != nil {
+		return nil, err
+	}
+
+	return celStrings(out)
+}
+
+func celStrings(v ref.Val) ([]string, error) {
+	switch v := v.(type) {
+	case types.String:
+		if s := strings.TrimSpace(string(v)); s != "" {
+			return []string{s}, nil
+		}
+		return nil, nil
+	case types.Null:
+		return nil, nil
+	case *types.Optional:
+		if !v.HasValue() {
+			return nil, nil
+		}
+		return celStrings(v.GetValue())
+	case traits.Lister:
+		var ret []string
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			s, ok := it.Next().(types.String)
+			if !ok {
+				return nil, fmt.Errorf("expected a list of strings")
+			}
+
+			if s := strings.TrimSpace(string(s)); s != "" {
+				ret = append(ret, s)
+			}
+		}
+		return ret, nil
+	}
+
+	return nil, fmt.Errorf("expected a string but got %s", v.Type().TypeName())
+}
+
+func newDiscoveryFailure(sc *monitoringv1alpha1.ScrapeConfig, i int, config monitoringv1alpha1.CustomResourceSDConfig, err error) discoveryFailure {
+	prefix := fmt.Sprintf("ScrapeConfig %s/%s customResourceSDConfigs[%d]", sc.GetNamespace(), sc.GetName(), i)
+
+	if apierrors.IsForbidden(err) {
+		gr := schema.GroupResource{Group: config.Group, Resource: config.Resource}
+		return discoveryFailure{
+			forbidden: true,
+			message:   fmt.Sprintf("%s: the operator isn't allowed to list %s in namespace %q, the service account of the operator needs the list permission on the resource", prefix, gr.String(), sc.GetNamespace()),
+		}
+	}
+
+	return discoveryFailure{message: fmt.Sprintf("%s: %s", prefix, err)}
+}
+
+// setFailures records the discovery failures of the Prometheus object. The
+// entry is removed when no scrape config uses custom resource SD.
+func (d *CustomResourceDiscoverer) setFailures(p monitoringv1.PrometheusInterface, used bool, failures []discoveryFailure) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	key := p.GetObjectMeta().GetNamespace() + "/" + p.GetObjectMeta().GetName()
+	if !used {
+		delete(d.failures, key)
+		return
+	}
+
+	slices.SortFunc(failures, func(a, b discoveryFailure) int {
+		return strings.Compare(a.message, b.message)
+	})
+	d.failures[key] = failures
+}
+
+// Forget removes the state of the Prometheus object.
+func (d *CustomResourceDiscoverer) Forget(key string) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	delete(d.failures, key)
+}
+
+// Conditions returns the CustomResourceDiscoveryFailed condition of the
+// Prometheus object. It returns nil if no selected scrape config uses custom
+// resource SD.
+func (d *CustomResourceDiscoverer) Conditions(key string, generation int64) []monitoringv1.Condition {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	failures, found := d.failures[key]
+	if !found {
+		return nil
+	}
+
+	cond := monitoringv1.Condition{
+		Type:               monitoringv1.CustomResourceDiscoveryFailed,
+		Status:             monitoringv1.ConditionFalse,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		ObservedGeneration: generation,
+	}
+
+	if len(failures) == 0 {
+		return []monitoringv1.Condition{cond}
+	}
+
+	var messages []string
+	cond.Status = monitoringv1.ConditionTrue
+	cond.Reason = "DiscoveryFailed"
+	for _, f := range failures {
+		if f.forbidden {
+			cond.Reason = "Forbidden"
+		}
+		messages = append(messages, f.message)
+	}
+	cond.Message = strings.Join(messages, "; ")
+
+	return []monitoringv1.Condition{cond}
+}
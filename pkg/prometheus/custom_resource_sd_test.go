@@ -0,0 +1,320 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func newDatabase(name string, lbls map[string]string, status map[string]any) unstructured.Unstructured {
+	o := unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "example.com/v1",
+			"kind":       "Database",
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "default",
+			},
+			"status": status,
+		},
+	}
+	o.SetLabels(lbls)
+
+	return o
+}
+
+func TestTargetGroupsFromObjects(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		config   monitoringv1alpha1.CustomResourceSDConfig
+		objects  []unstructured.Unstructured
+		expected []targetGroup
+		err      bool
+	}{
+		{
+			name: "single address",
+			config: monitoringv1alpha1.CustomResourceSDConfig{
+				Address: "object.status.endpoint",
+				Labels: map[string]string{
+					"engine":  "object.status.engine",
+					"missing": "object.status.?missing.orValue('')",
+				},
+			},
+			objects: []unstructured.Unstructured{
+				newDatabase("b", nil, map[string]any{"endpoint": "b.example.com:9187", "engine": "postgres"}),
+				newDatabase("a", nil, map[string]any{"endpoint": "a.example.com:9104", "engine": "mysql"}),
+			},
+			expected: []targetGroup{
+				{
+					Targets: []string{"a.example.com:9104"},
+					Labels: map[string]string{
+						"__meta_customresource_namespace": "default",
+						"__meta_customresource_name":      "a",
+						"engine":                          "mysql",
+					},
+				},
+				{
+					Targets: []string{"b.example.com:9187"},
+					Labels: map[string]string{
+						"__meta_customresource_namespace": "default",
+						"__meta_customresource_name":      "b",
+						"engine":                          "postgres",
+					},
+				},
+			},
+		},
+		{
+			name: "multiple addresses",
+			config: monitoringv1alpha1.CustomResourceSDConfig{
+				Address: "object.status.replicas.map(r, r.host + ':' + string(r.port))",
+			},
+			objects: []unstructured.Unstructured{
+				newDatabase("a", nil, map[string]any{
+					"replicas": []any{
+						map[string]any{"host": "10.0.0.1", "port": int64(9104)},
+						map[string]any{"host": "10.0.0.2", "port": int64(9104)},
+					},
+				}),
+			},
+			expected: []targetGroup{
+				{
+					Targets: []string{"10.0.0.1:9104", "10.0.0.2:9104"},
+					Labels: map[string]string{
+						"__meta_customresource_namespace": "default",
+						"__meta_customresource_name":      "a",
+					},
+				},
+			},
+		},
+		{
+			name: "object without address",
+			config: monitoringv1alpha1.CustomResourceSDConfig{
+				Address: "has(object.status.endpoint) ? object.status.endpoint : ''",
+			},
+			objects: []unstructured.Unstructured{
+				newDatabase("a", nil, map[string]any{}),
+			},
+			expected: []targetGroup{},
+		},
+		{
+			name: "optional address",
+			config: monitoringv1alpha1.CustomResourceSDConfig{
+				Address: "object.?status.?endpoint",
+			},
+			objects: []unstructured.Unstructured{
+				newDatabase("a", nil, map[string]any{}),
+			},
+			expected: []targetGroup{},
+		},
+		{
+			name: "missing field",
+			config: monitoringv1alpha1.CustomResourceSDConfig{
+				Address: "object.status.endpoint",
+			},
+			objects: []unstructured.Unstructured{
+				newDatabase("a", nil, map[string]any{}),
+			},
+			err: true,
+		},
+		{
+			name: "invalid expression",
+			config: monitoringv1alpha1.CustomResourceSDConfig{
+				Address: "object.status.endpoint +",
+			},
+			err: true,
+		},
+		{
+			name: "invalid return type",
+			config: monitoringv1alpha1.CustomResourceSDConfig{
+				Address: "1 + 1",
+			},
+			err: true,
+		},
+		{
+			name: "invalid label return type",
+			config: monitoringv1alpha1.CustomResourceSDConfig{
+				Address: "object.status.endpoint",
+				Labels: map[string]string{
+					"port": "object.status.port",
+				},
+			},
+			objects: []unstructured.Unstructured{
+				newDatabase("a", nil, map[string]any{"endpoint": "a.example.com:9104", "port": int64(9104)}),
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tgs, err := targetGroupsFromObjects(tc.config, tc.objects)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, tgs)
+		})
+	}
+}
+
+func TestCustomResourceDiscovererSync(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "databases"}
+	dclient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "DatabaseList"},
+		asRuntimeObject(newDatabase("a", map[string]string{"team": "a"}, map[string]any{"endpoint": "a.example.com:9104"})),
+		asRuntimeObject(newDatabase("b", map[string]string{"team": "b"}, map[string]any{"endpoint": "b.example.com:9104"})),
+	)
+	kclient := fake.NewClientset()
+
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+	}
+
+	d := NewCustomResourceDiscoverer(promslog.NewNopLogger(), kclient, dclient)
+
+	// No ConfigMap is created when no scrape config uses custom resource SD.
+	refresh, err := d.Sync(context.Background(), p, nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), refresh)
+
+	_, err = kclient.CoreV1().ConfigMaps("default").Get(context.Background(), FileSDConfigMapName(p), metav1.GetOptions{})
+	require.Error(t, err)
+
+	interval := monitoringv1.Duration("30s")
+	sc := &monitoringv1alpha1.ScrapeConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "databases",
+			Namespace: "default",
+		},
+		Spec: monitoringv1alpha1.ScrapeConfigSpec{
+			CustomResourceSDConfigs: []monitoringv1alpha1.CustomResourceSDConfig{
+				{
+					Group:    "example.com",
+					Version:  "v1",
+					Resource: "databases",
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"team": "a"},
+					},
+					Address:         "object.status.endpoint",
+					RefreshInterval: &interval,
+				},
+			},
+		},
+	}
+
+	refresh, err = d.Sync(context.Background(), p, map[string]*monitoringv1alpha1.ScrapeConfig{"default/databases": sc})
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, refresh)
+
+	conds := d.Conditions("default/test", 1)
+	require.Len(t, conds, 1)
+	require.Equal(t, monitoringv1.ConditionFalse, conds[0].Status)
+
+	cm, err := kclient.CoreV1().ConfigMaps("default").Get(context.Background(), FileSDConfigMapName(p), metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, cm.OwnerReferences, 1)
+
+	var tgs []targetGroup
+	require.NoError(t, json.Unmarshal([]byte(cm.Data["default_databases_0.json"]), &tgs))
+	require.Equal(t, []targetGroup{
+		{
+			Targets: []string{"a.example.com:9104"},
+			Labels: map[string]string{
+				"__meta_customresource_namespace": "default",
+				"__meta_customresource_name":      "a",
+			},
+		},
+	}, tgs)
+}
+
+func TestCustomResourceDiscovererForbidden(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "databases"}
+	dclient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "DatabaseList"},
+	)
+	dclient.PrependReactor("list", "databases", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(gvr.GroupResource(), "", errors.New("forbidden"))
+	})
+
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+	}
+	sc := &monitoringv1alpha1.ScrapeConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "databases",
+			Namespace: "default",
+		},
+		Spec: monitoringv1alpha1.ScrapeConfigSpec{
+			CustomResourceSDConfigs: []monitoringv1alpha1.CustomResourceSDConfig{
+				{
+					Group:    "example.com",
+					Version:  "v1",
+					Resource: "databases",
+					Address:  "object.status.endpoint",
+				},
+			},
+		},
+	}
+
+	d := NewCustomResourceDiscoverer(promslog.NewNopLogger(), fake.NewClientset(), dclient)
+	require.Nil(t, d.Conditions("default/test", 1))
+
+	_, err := d.Sync(context.Background(), p, map[string]*monitoringv1alpha1.ScrapeConfig{"default/databases": sc})
+	require.NoError(t, err)
+
+	conds := d.Conditions("default/test", 1)
+	require.Len(t, conds, 1)
+	require.Equal(t, monitoringv1.CustomResourceDiscoveryFailed, conds[0].Type)
+	require.Equal(t, monitoringv1.ConditionTrue, conds[0].Status)
+	require.Equal(t, "Forbidden", conds[0].Reason)
+	require.Contains(t, conds[0].Message, "ScrapeConfig default/databases customResourceSDConfigs[0]")
+	require.Contains(t, conds[0].Message, "databases.example.com")
+
+	// The condition isn't reported when no scrape config uses custom
+	// resource SD.
+	_, err = d.Sync(context.Background(), p, nil)
+	require.NoError(t, err)
+	require.Nil(t, d.Conditions("default/test", 1))
+}
+
+func asRuntimeObject(o unstructured.Unstructured) runtime.Object {
+	return &o
+}
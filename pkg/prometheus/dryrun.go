@@ -0,0 +1,92 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// GenerateScrapeJobs runs the ServiceMonitor, PodMonitor or ScrapeConfig
+// object through the same validation and configuration generation as the
+// operator for the given Prometheus object, without selecting the object.
+// It returns the rendered scrape jobs in YAML format.
+//
+// The Secrets and ConfigMaps referenced by the object are read from the
+// store. When the object is rejected, the returned error carries the
+// rejection reason (see operator.RejectionReasonOf).
+func GenerateScrapeJobs(
+	ctx context.Context,
+	logger *slog.Logger,
+	p *monitoringv1.Prometheus,
+	obj runtime.Object,
+	store *assets.StoreBuilder,
+) ([]byte, error) {
+	rs, err := NewResourceSelector(logger, p, store, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cg, err := NewConfigGenerator(logger, p)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		apiserverConfig = p.Spec.APIServerConfig
+		shards          = shardsNumber(p)
+		scrapeConfigs   []yaml.MapSlice
+	)
+
+	switch o := obj.(type) {
+	case *monitoringv1.ServiceMonitor:
+		if err := rs.checkServiceMonitor(ctx, o); err != nil {
+			return nil, operator.NewRejectionError(rejectionReasonOf(err), err)
+		}
+
+		scrapeConfigs = cg.appendServiceMonitorConfigs(scrapeConfigs, map[string]*monitoringv1.ServiceMonitor{"": o}, apiserverConfig, store, shards)
+
+	case *monitoringv1.PodMonitor:
+		if err := rs.checkPodMonitor(ctx, o); err != nil {
+			return nil, operator.NewRejectionError(rejectionReasonOf(err), err)
+		}
+
+		scrapeConfigs = cg.appendPodMonitorConfigs(scrapeConfigs, map[string]*monitoringv1.PodMonitor{"": o}, apiserverConfig, store, shards)
+
+	case *monitoringv1alpha1.ScrapeConfig:
+		if err := rs.checkScrapeConfig(ctx, o); err != nil {
+			return nil, operator.NewRejectionError(rejectionReasonOf(err), err)
+		}
+
+		scrapeConfigs, err = cg.appendScrapeConfigs(scrapeConfigs, map[string]*monitoringv1alpha1.ScrapeConfig{"": o}, store, shards)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+
+	return yaml.Marshal(scrapeConfigs)
+}
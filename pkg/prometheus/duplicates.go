@@ -0,0 +1,261 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// serviceMonitorEndpointIdentity holds the fields of a ServiceMonitor's
+// endpoint which determine the targets and their labels.
+type serviceMonitorEndpointIdentity struct {
+	Namespaces        []string                        `json:"namespaces"`
+	Selector          metav1.LabelSelector            `json:"selector"`
+	SelectorMechanism *monitoringv1.SelectorMechanism `json:"selectorMechanism,omitempty"`
+	JobLabel          string                          `json:"jobLabel,omitempty"`
+	TargetLabels      []string                        `json:"targetLabels,omitempty"`
+	PodTargetLabels   []string                        `json:"podTargetLabels,omitempty"`
+	ScrapeClassName   *string                         `json:"scrapeClass,omitempty"`
+	Port              string                          `json:"port,omitempty"`
+	TargetPort        *intstr.IntOrString             `json:"targetPort,omitempty"`
+	Path              string                          `json:"path,omitempty"`
+	Scheme            string                          `json:"scheme,omitempty"`
+	Params            map[string][]string             `json:"params,omitempty"`
+	HonorLabels       bool                            `json:"honorLabels,omitempty"`
+	RelabelConfigs    []monitoringv1.RelabelConfig    `json:"relabelings,omitempty"`
+}
+
+// serviceMonitorNamespaces returns the namespaces in which the ServiceMonitor
+// selects Services. The "*" value means all namespaces.
+func (rs *ResourceSelector) serviceMonitorNamespaces(sm *monitoringv1.ServiceMonitor) []string {
+	if rs.p.GetCommonPrometheusFields().IgnoreNamespaceSelectors {
+		return []string{sm.Namespace}
+	}
+
+	switch {
+	case sm.Spec.NamespaceSelector.Any:
+		return []string{"*"}
+	case len(sm.Spec.NamespaceSelector.MatchNames) > 0:
+		namespaces := slices.Clone(sm.Spec.NamespaceSelector.MatchNames)
+		sort.Strings(namespaces)
+		return slices.Compact(namespaces)
+	default:
+		return []string{sm.Namespace}
+	}
+}
+
+func (rs *ResourceSelector) serviceMonitorEndpointIdentities(sm *monitoringv1.ServiceMonitor) ([]string, error) {
+	ids := make([]string, 0, len(sm.Spec.Endpoints))
+	for _, ep := range sm.Spec.Endpoints {
+		b, err := json.Marshal(serviceMonitorEndpointIdentity{
+			Namespaces:        rs.serviceMonitorNamespaces(sm),
+			Selector:          sm.Spec.Selector,
+			SelectorMechanism: sm.Spec.SelectorMechanism,
+			JobLabel:          sm.Spec.JobLabel,
+			TargetLabels:      sm.Spec.TargetLabels,
+			PodTargetLabels:   sm.Spec.PodTargetLabels,
+			ScrapeClassName:   sm.Spec.ScrapeClassName,
+			Port:              ep.Port,
+			TargetPort:        ep.TargetPort,
+			Path:              ep.Path,
+			Scheme:            ep.Scheme,
+			Params:            ep.Params,
+			HonorLabels:       ep.HonorLabels,
+			RelabelConfigs:    ep.RelabelConfigs,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, string(b))
+	}
+
+	return ids, nil
+}
+
+func scrapeConfigIdentities(sc *monitoringv1alpha1.ScrapeConfig) ([]string, error) {
+	// The job label defaults to a value which includes the name of the
+	// resource: ScrapeConfigs can't generate the same labels unless they
+	// define the same job name.
+	if ptr.Deref(sc.Spec.JobName, "") == "" {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(sc.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{string(b)}, nil
+}
+
+// checkDuplicates detects the resources which would scrape the same targets
+// with the same labels. It records the duplicated resources (see
+// DuplicateTargets()), emits a warning event for each of them and, depending
+// on the duplicate targets policy, rejects the resources for which all the
+// identities are duplicated.
+func checkDuplicates[T configurationResource](
+	rs *ResourceSelector,
+	res ResourcesSelection[T],
+	identitiesFn func(T) ([]string, error),
+) {
+	// Process the resources in a deterministic order so the first resource
+	// is always kept when dropping the duplicated ones.
+	sort.Slice(res, func(i, j int) bool { return res[i].key < res[j].key })
+
+	var (
+		owners = map[string]string{}
+		drop   = ptr.Deref(rs.p.GetCommonPrometheusFields().DuplicateTargetsPolicy, monitoringv1.WarnDuplicateTargetsPolicy) == monitoringv1.DropDuplicateTargetsPolicy
+	)
+	for i := range res {
+		if res[i].err != nil {
+			continue
+		}
+
+		ids, err := identitiesFn(res[i].resource)
+		if err != nil {
+			rs.l.Debug("failed to compute identities", "object", res[i].key, "err", err)
+			continue
+		}
+
+		var duplicates []string
+		for _, id := range ids {
+			owner, found := owners[id]
+			if !found {
+				owners[id] = res[i].key
+				continue
+			}
+
+			if owner != res[i].key && !slices.Contains(duplicates, owner) {
+				duplicates = append(duplicates, owner)
+			}
+		}
+
+		if len(duplicates) == 0 {
+			continue
+		}
+
+		msg := fmt.Sprintf("%q scrapes the same targets with the same labels as %s", res[i].key, strings.Join(duplicates, ", "))
+		rs.duplicates = append(rs.duplicates, msg)
+		rs.l.Warn("duplicate targets detected", "object", res[i].key, "duplicates", strings.Join(duplicates, ","))
+		rs.eventRecorder.Event(any(res[i].resource).(runtime.Object), v1.EventTypeWarning, operator.DuplicateTargetsEvent, msg)
+		for j := range res {
+			if slices.Contains(duplicates, res[j].key) {
+				rs.eventRecorder.Event(any(res[j].resource).(runtime.Object), v1.EventTypeWarning, operator.DuplicateTargetsEvent, msg)
+			}
+		}
+
+		if !drop || len(duplicatedIdentities(owners, ids, res[i].key)) != len(ids) {
+			continue
+		}
+
+		res[i].err = errors.New(msg)
+		res[i].reason = operator.DuplicateTargetsReason
+	}
+}
+
+// duplicatedIdentities returns the identities which are owned by another
+// resource than the given key.
+func duplicatedIdentities(owners map[string]string, ids []string, key string) []string {
+	var dup []string
+	for _, id := range ids {
+		if owners[id] != key {
+			dup = append(dup, id)
+		}
+	}
+
+	return dup
+}
+
+// DuplicateTargets returns the messages describing the duplicated resources
+// detected by the previous selections.
+func (rs *ResourceSelector) DuplicateTargets() []string {
+	return slices.Clone(rs.duplicates)
+}
+
+// DuplicateTargetsTracker records the duplicated resources detected during
+// the last selection of each Prometheus resource.
+type DuplicateTargetsTracker struct {
+	mtx     sync.Mutex
+	entries map[string][]string
+}
+
+// NewDuplicateTargetsTracker returns an empty DuplicateTargetsTracker.
+func NewDuplicateTargetsTracker() *DuplicateTargetsTracker {
+	return &DuplicateTargetsTracker{
+		entries: map[string][]string{},
+	}
+}
+
+// Set records the duplicated resources of the Prometheus resource identified
+// by its "<namespace>/<name>" key.
+func (t *DuplicateTargetsTracker) Set(key string, duplicates []string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.entries[key] = duplicates
+}
+
+// Forget removes the entry of the Prometheus resource.
+func (t *DuplicateTargetsTracker) Forget(key string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.entries, key)
+}
+
+// Conditions returns the DuplicateTargets condition of the Prometheus
+// resource. It returns nil if no selection has been recorded yet.
+func (t *DuplicateTargetsTracker) Conditions(key string, generation int64) []monitoringv1.Condition {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	duplicates, found := t.entries[key]
+	if !found {
+		return nil
+	}
+
+	cond := monitoringv1.Condition{
+		Type:               monitoringv1.DuplicateTargets,
+		Status:             monitoringv1.ConditionFalse,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		ObservedGeneration: generation,
+	}
+
+	if len(duplicates) > 0 {
+		cond.Status = monitoringv1.ConditionTrue
+		cond.Reason = string(operator.DuplicateTargetsReason)
+		cond.Message = strings.Join(duplicates, "; ")
+	}
+
+	return []monitoringv1.Condition{cond}
+}
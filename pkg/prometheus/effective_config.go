@@ -0,0 +1,201 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+// EffectiveConfigPattern is the HTTP route pattern of the EffectiveConfigs
+// handler.
+const EffectiveConfigPattern = "GET /api/v1/prometheuses/{namespace}/{name}/effective-config/{resource}/{resourceNamespace}/{resourceName}"
+
+const redactedValue = "<redacted>"
+
+// jobPrefixes maps the monitoring resources to the prefix of the generated
+// job names.
+var jobPrefixes = map[string]string{
+	monitoringv1.ServiceMonitorName:     "serviceMonitor",
+	monitoringv1.PodMonitorName:         "podMonitor",
+	monitoringv1.ProbeName:              "probe",
+	monitoringv1alpha1.ScrapeConfigName: "scrapeConfig",
+}
+
+// secretKeys are the keys of the Prometheus configuration holding sensitive
+// values.
+var secretKeys = map[string]struct{}{
+	"application_credential_secret": {},
+	"application_key":               {},
+	"application_secret":            {},
+	"bearer_token":                  {},
+	"client_secret":                 {},
+	"consumer_key":                  {},
+	"credentials":                   {},
+	"key":                           {},
+	"password":                      {},
+	"proxy_connect_header":          {},
+	"secret_key":                    {},
+	"token":                         {},
+}
+
+// EffectiveConfigs records the last configuration generated for each
+// Prometheus resource. It returns the scrape jobs generated for a given
+// monitoring resource once the scrape classes, the enforced settings and the
+// defaults have been applied.
+type EffectiveConfigs struct {
+	mtx     sync.RWMutex
+	configs map[string][]byte
+}
+
+// NewEffectiveConfigs returns an empty EffectiveConfigs.
+func NewEffectiveConfigs() *EffectiveConfigs {
+	return &EffectiveConfigs{
+		configs: map[string][]byte{},
+	}
+}
+
+// Set records the configuration generated for the Prometheus resource
+// identified by its "<namespace>/<name>" key.
+func (ec *EffectiveConfigs) Set(key string, config []byte) {
+	ec.mtx.Lock()
+	defer ec.mtx.Unlock()
+
+	ec.configs[key] = config
+}
+
+// Delete forgets the configuration of the Prometheus resource.
+func (ec *EffectiveConfigs) Delete(key string) {
+	ec.mtx.Lock()
+	defer ec.mtx.Unlock()
+
+	delete(ec.configs, key)
+}
+
+// ScrapeConfigs returns the scrape jobs generated for the monitoring resource
+// in the configuration of the Prometheus resource identified by key. The
+// sensitive values are redacted.
+//
+// It returns false if no configuration has been recorded for the Prometheus
+// resource.
+func (ec *EffectiveConfigs) ScrapeConfigs(key, resource, namespace, name string) ([]yaml.MapSlice, bool, error) {
+	prefix, found := jobPrefixes[resource]
+	if !found {
+		return nil, false, fmt.Errorf("unsupported resource %q", resource)
+	}
+
+	ec.mtx.RLock()
+	b, found := ec.configs[key]
+	ec.mtx.RUnlock()
+	if !found {
+		return nil, false, nil
+	}
+
+	var cfg struct {
+		ScrapeConfigs []yaml.MapSlice `yaml:"scrape_configs"`
+	}
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, true, fmt.Errorf("failed to parse the configuration: %w", err)
+	}
+
+	jobName := fmt.Sprintf("%s/%s/%s", prefix, namespace, name)
+	var jobs []yaml.MapSlice
+	for _, sc := range cfg.ScrapeConfigs {
+		for _, item := range sc {
+			if item.Key != "job_name" {
+				continue
+			}
+
+			if s, _ := item.Value.(string); s == jobName || strings.HasPrefix(s, jobName+"/") {
+				jobs = append(jobs, redact(sc).(yaml.MapSlice))
+			}
+			break
+		}
+	}
+
+	return jobs, true, nil
+}
+
+// ServeHTTP implements the http.Handler interface. The request's path must
+// match EffectiveConfigPattern.
+func (ec *EffectiveConfigs) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	key := req.PathValue("namespace") + "/" + req.PathValue("name")
+	resource := strings.ToLower(req.PathValue("resource"))
+
+	jobs, found, err := ec.ScrapeConfigs(key, resource, req.PathValue("resourceNamespace"), req.PathValue("resourceName"))
+	if err != nil {
+		if !found {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		http.Error(w, fmt.Sprintf("no configuration generated for Prometheus %q", key), http.StatusNotFound)
+		return
+	}
+
+	if len(jobs) == 0 {
+		http.Error(w, fmt.Sprintf("no scrape job generated for %s %s/%s (the resource isn't selected or it has been rejected)", resource, req.PathValue("resourceNamespace"), req.PathValue("resourceName")), http.StatusNotFound)
+		return
+	}
+
+	b, err := yaml.Marshal(yaml.MapSlice{{Key: "scrape_configs", Value: jobs}})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write(b)
+}
+
+// redact replaces the sensitive values of the configuration.
+func redact(v any) any {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		ret := make(yaml.MapSlice, 0, len(v))
+		for _, item := range v {
+			if k, ok := item.Key.(string); ok {
+				if _, found := secretKeys[k]; found {
+					item.Value = redactedValue
+				}
+			}
+
+			item.Value = redact(item.Value)
+			ret = append(ret, item)
+		}
+		return ret
+
+	case []any:
+		ret := make([]any, 0, len(v))
+		for _, e := range v {
+			ret = append(ret, redact(e))
+		}
+		return ret
+	}
+
+	return v
+}
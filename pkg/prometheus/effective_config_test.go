@@ -0,0 +1,119 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectiveConfigs(t *testing.T) {
+	ec := NewEffectiveConfigs()
+	ec.Set("default/k8s", []byte(`global:
+  scrape_interval: 30s
+scrape_configs:
+- job_name: serviceMonitor/default/app/0
+  honor_labels: false
+  scrape_interval: 10s
+  basic_auth:
+    username: admin
+    password: s3cr3t
+- job_name: serviceMonitor/default/app/1
+  scrape_interval: 10s
+- job_name: serviceMonitor/default/app-other/0
+- job_name: scrapeConfig/default/static
+  static_configs:
+  - targets:
+    - localhost:9090
+`))
+
+	mux := http.NewServeMux()
+	mux.Handle(EffectiveConfigPattern, ec)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	for _, tc := range []struct {
+		name     string
+		path     string
+		status   int
+		expected string
+	}{
+		{
+			name:   "service monitor",
+			path:   "/api/v1/prometheuses/default/k8s/effective-config/servicemonitors/default/app",
+			status: http.StatusOK,
+			expected: `scrape_configs:
+- job_name: serviceMonitor/default/app/0
+  honor_labels: false
+  scrape_interval: 10s
+  basic_auth:
+    username: admin
+    password: <redacted>
+- job_name: serviceMonitor/default/app/1
+  scrape_interval: 10s
+`,
+		},
+		{
+			name:   "scrape config",
+			path:   "/api/v1/prometheuses/default/k8s/effective-config/ScrapeConfigs/default/static",
+			status: http.StatusOK,
+			expected: `scrape_configs:
+- job_name: scrapeConfig/default/static
+  static_configs:
+  - targets:
+    - localhost:9090
+`,
+		},
+		{
+			name:   "resource not selected",
+			path:   "/api/v1/prometheuses/default/k8s/effective-config/podmonitors/default/app",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "unknown prometheus",
+			path:   "/api/v1/prometheuses/default/other/effective-config/servicemonitors/default/app",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "unsupported resource",
+			path:   "/api/v1/prometheuses/default/k8s/effective-config/secrets/default/app",
+			status: http.StatusBadRequest,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := http.Get(srv.URL + tc.path)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			require.Equal(t, tc.status, resp.StatusCode)
+			if tc.expected == "" {
+				return
+			}
+
+			b, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(b))
+		})
+	}
+
+	ec.Delete("default/k8s")
+	_, found, err := ec.ScrapeConfigs("default/k8s", "servicemonitors", "default", "app")
+	require.NoError(t, err)
+	require.False(t, found)
+}
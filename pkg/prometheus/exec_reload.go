@@ -0,0 +1,376 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	// execReloadPropagationPeriod is the duration during which the pods are
+	// signaled again after a change of the rule files. The kubelet updates
+	// the files of the mounted ConfigMaps asynchronously (up to the sync
+	// period of the kubelet plus the TTL of the ConfigMap cache).
+	execReloadPropagationPeriod = 2 * time.Minute
+
+	// ExecReloadResignalInterval is the interval at which the pods are
+	// signaled during the propagation period.
+	ExecReloadResignalInterval = 30 * time.Second
+
+	// execReloadTimeout is the maximum duration of a command executed in a
+	// pod.
+	execReloadTimeout = 30 * time.Second
+
+	prometheusContainerName = "prometheus"
+)
+
+var envReferenceRe = regexp.MustCompile(`\$\(([a-zA-Z_0-9]+)\)`)
+
+// UsesOperatorExecReload returns true if the operator reloads the
+// configuration of the pods using the Kubernetes exec API instead of the
+// config-reloader sidecar.
+func UsesOperatorExecReload(p monitoringv1.PrometheusInterface) bool {
+	return ptr.Deref(p.GetCommonPrometheusFields().ReloadStrategy, monitoringv1.HTTPReloadStrategyType) == monitoringv1.OperatorExecReloadStrategyType
+}
+
+// PodExecutor executes commands in the containers of pods.
+type PodExecutor interface {
+	Exec(ctx context.Context, namespace, pod, container string, command []string, stdin io.Reader) error
+}
+
+type spdyPodExecutor struct {
+	restConfig *rest.Config
+	kclient    kubernetes.Interface
+}
+
+// NewPodExecutor returns a PodExecutor using the exec subresource of the
+// Kubernetes API.
+func NewPodExecutor(restConfig *rest.Config, kclient kubernetes.Interface) PodExecutor {
+	return &spdyPodExecutor{
+		restConfig: restConfig,
+		kclient:    kclient,
+	}
+}
+
+func (e *spdyPodExecutor) Exec(ctx context.Context, namespace, pod, container string, command []string, stdin io.Reader) error {
+	req := e.kclient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, kscheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(e.restConfig, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
+		return err
+	}
+
+	return nil
+}
+
+type execReloadState struct {
+	configHash string
+	rulesHash  string
+	// The pod is signaled again until this time to load the rule files
+	// updated by the kubelet.
+	resignalUntil time.Time
+	lastSignal    time.Time
+}
+
+// ExecReloader writes the configuration into the Prometheus pods and sends
+// the SIGHUP signal to the Prometheus process using the Kubernetes exec API.
+// It replaces the config-reloader sidecar when the OperatorExec reload
+// strategy is used.
+//
+// The initial configuration is still written by the init-config-reloader
+// container.
+type ExecReloader struct {
+	executor PodExecutor
+
+	mtx sync.Mutex
+	// The configuration and the state of the pods indexed by object key.
+	objects map[string]*execReloadObject
+}
+
+type execReloadObject struct {
+	// Serializes the reloads of the object's pods. The executions in the
+	// pods of an object don't block the reloads of the other objects.
+	mtx sync.Mutex
+	// The configuration stored in the configuration Secret (before
+	// environment variable expansion).
+	config []byte
+	// The state of the pods indexed by pod UID.
+	pods map[types.UID]*execReloadState
+}
+
+// NewExecReloader returns an ExecReloader using the given executor.
+func NewExecReloader(executor PodExecutor) *ExecReloader {
+	return &ExecReloader{
+		executor: executor,
+		objects:  map[string]*execReloadObject{},
+	}
+}
+
+func (r *ExecReloader) object(key string) *execReloadObject {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	o, found := r.objects[key]
+	if !found {
+		o = &execReloadObject{}
+		r.objects[key] = o
+	}
+
+	return o
+}
+
+// SetConfig records the configuration written into the configuration Secret
+// of the object. It's a no-op if the object doesn't use the OperatorExec
+// reload strategy.
+func (r *ExecReloader) SetConfig(key string, p monitoringv1.PrometheusInterface, config []byte) {
+	if !UsesOperatorExecReload(p) {
+		return
+	}
+
+	o := r.object(key)
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.config = config
+}
+
+// Sync reloads the pods matching the selector (listed from the informers'
+// cache) with the configuration recorded by SetConfig(). It does nothing
+// until the configuration of the object has been recorded and it forgets
+// the state of the object if the object doesn't use the OperatorExec reload
+// strategy.
+//
+// See Reload() for the meaning of the rulesHash argument and of the returned
+// duration.
+func (r *ExecReloader) Sync(ctx context.Context, key string, p monitoringv1.PrometheusInterface, listPods ListAllByNamespaceFn, selector labels.Selector, rulesHash string) (time.Duration, error) {
+	if !UsesOperatorExecReload(p) {
+		r.Forget(key)
+		return 0, nil
+	}
+
+	o := r.object(key)
+	o.mtx.Lock()
+	config := o.config
+	o.mtx.Unlock()
+
+	if config == nil {
+		return 0, nil
+	}
+
+	var pods []v1.Pod
+	if err := listPods(p.GetObjectMeta().GetNamespace(), selector, func(obj any) {
+		pods = append(pods, *obj.(*v1.Pod))
+	}); err != nil {
+		return 0, fmt.Errorf("failed to list the pods: %w", err)
+	}
+
+	return r.Reload(ctx, key, pods, config, rulesHash)
+}
+
+// Reload ensures that the Prometheus process of each running pod has loaded
+// the configuration (as stored in the configuration Secret, before
+// environment variable expansion) and the rule files identified by
+// rulesHash.
+//
+// It returns a positive duration if the pods need to be signaled again
+// after this delay (e.g. while the kubelet propagates the updates of the
+// rule files).
+func (r *ExecReloader) Reload(ctx context.Context, key string, pods []v1.Pod, config []byte, rulesHash string) (time.Duration, error) {
+	o := r.object(key)
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	var (
+		now     = time.Now()
+		states  = make(map[types.UID]*execReloadState, len(pods))
+		requeue time.Duration
+		errs    []error
+	)
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil || !containerRunning(&pod, prometheusContainerName) {
+			continue
+		}
+
+		expanded, err := expandPodEnv(config, &pod)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("pod %s: %w", pod.Name, err))
+			continue
+		}
+		configHash := fmt.Sprintf("%x", sha256.Sum256(expanded))
+
+		s, found := o.pods[pod.UID]
+		if !found {
+			// The state of the pod is unknown (new pod or operator
+			// restart): push the configuration unconditionally.
+			s = &execReloadState{}
+		}
+		states[pod.UID] = s
+
+		var push, signal bool
+		switch {
+		case s.configHash != configHash:
+			push = true
+		case s.rulesHash != rulesHash:
+			signal = true
+		case now.Before(s.resignalUntil) && now.Sub(s.lastSignal) >= ExecReloadResignalInterval:
+			signal = true
+		}
+
+		if push || signal {
+			cmd, stdin := signalCommand(), io.Reader(nil)
+			if push {
+				cmd, stdin = writeConfigAndSignalCommand(), bytes.NewReader(expanded)
+			}
+
+			if err := r.exec(ctx, &pod, cmd, stdin); err != nil {
+				errs = append(errs, fmt.Errorf("pod %s: %w", pod.Name, err))
+				continue
+			}
+
+			// The rule files of new pods are up-to-date.
+			if found && s.rulesHash != rulesHash {
+				s.resignalUntil = now.Add(execReloadPropagationPeriod)
+			}
+			s.configHash = configHash
+			s.rulesHash = rulesHash
+			s.lastSignal = now
+		}
+
+		if now.Before(s.resignalUntil) {
+			requeue = ExecReloadResignalInterval
+		}
+	}
+
+	o.pods = states
+
+	return requeue, errors.Join(errs...)
+}
+
+// exec runs the command in the Prometheus container of the pod. A pod which
+// doesn't respond can't block the reconciliation of the object.
+func (r *ExecReloader) exec(ctx context.Context, pod *v1.Pod, command []string, stdin io.Reader) error {
+	ctx, cancel := context.WithTimeout(ctx, execReloadTimeout)
+	defer cancel()
+
+	return r.executor.Exec(ctx, pod.Namespace, pod.Name, prometheusContainerName, command, stdin)
+}
+
+// Forget removes the configuration and the state of the object.
+func (r *ExecReloader) Forget(key string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	delete(r.objects, key)
+}
+
+// writeConfigAndSignalCommand returns the command which atomically replaces
+// the configuration file with the content read from stdin before sending
+// the SIGHUP signal to the Prometheus process.
+func writeConfigAndSignalCommand() []string {
+	f := path.Join(ConfOutDir, ConfigEnvsubstFilename)
+	return []string{
+		"sh",
+		"-c",
+		fmt.Sprintf(`set -e; cat > "%[1]s.tmp"; mv "%[1]s.tmp" "%[1]s"; kill -HUP 1`, f),
+	}
+}
+
+// signalCommand returns the command which sends the SIGHUP signal to the
+// Prometheus process.
+func signalCommand() []string {
+	return []string{"kill", "-HUP", "1"}
+}
+
+// expandPodEnv expands the references to the environment variables which
+// are injected by the operator into the config-reloader container.
+func expandPodEnv(config []byte, pod *v1.Pod) ([]byte, error) {
+	env := map[string]string{
+		operator.PodNameEnvVar:  pod.Name,
+		operator.NodeNameEnvVar: pod.Spec.NodeName,
+	}
+	if shard, found := pod.Labels[ShardLabelName]; found {
+		env[operator.ShardEnvVar] = shard
+	}
+
+	var err error
+	expanded := envReferenceRe.ReplaceAllFunc(config, func(m []byte) []byte {
+		name := string(envReferenceRe.FindSubmatch(m)[1])
+		v, found := env[name]
+		if !found {
+			err = errors.Join(err, fmt.Errorf("found reference to unset environment variable %q", name))
+			return m
+		}
+
+		return []byte(v)
+	})
+
+	return expanded, err
+}
+
+// containerRunning returns true if the container of the pod is running.
+func containerRunning(pod *v1.Pod, name string) bool {
+	for _, s := range pod.Status.ContainerStatuses {
+		if s.Name == name {
+			return s.State.Running != nil
+		}
+	}
+
+	return false
+}
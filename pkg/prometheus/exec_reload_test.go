@@ -0,0 +1,249 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+type execCall struct {
+	pod     string
+	command []string
+	stdin   string
+}
+
+type fakePodExecutor struct {
+	calls []execCall
+	err   error
+}
+
+func (f *fakePodExecutor) Exec(_ context.Context, _, pod, _ string, command []string, stdin io.Reader) error {
+	call := execCall{pod: pod, command: command}
+	if stdin != nil {
+		b, err := io.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+		call.stdin = string(b)
+	}
+	f.calls = append(f.calls, call)
+
+	return f.err
+}
+
+func execReloadTestPod(name string, shard string, running bool) v1.Pod {
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(name),
+			Labels:    map[string]string{ShardLabelName: shard},
+		},
+		Spec: v1.PodSpec{
+			NodeName: "node-" + name,
+		},
+	}
+
+	state := v1.ContainerState{Waiting: &v1.ContainerStateWaiting{}}
+	if running {
+		state = v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	}
+	pod.Status.ContainerStatuses = []v1.ContainerStatus{{Name: "prometheus", State: state}}
+
+	return pod
+}
+
+func TestExecReloader(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		executor = &fakePodExecutor{}
+		r        = NewExecReloader(executor)
+		pods     = []v1.Pod{
+			execReloadTestPod("prom-0", "0", true),
+			execReloadTestPod("prom-1", "1", true),
+			execReloadTestPod("prom-2", "2", false),
+		}
+		config = []byte("replica: $(POD_NAME)\nshard: $(SHARD)\nnode: $(NODE_NAME)\n")
+	)
+
+	// Unknown pods get the configuration.
+	requeue, err := r.Reload(ctx, "ns/prom", pods, config, "rules-1")
+	require.NoError(t, err)
+	require.Zero(t, requeue)
+	require.Equal(t, []execCall{
+		{
+			pod:     "prom-0",
+			command: writeConfigAndSignalCommand(),
+			stdin:   "replica: prom-0\nshard: 0\nnode: node-prom-0\n",
+		},
+		{
+			pod:     "prom-1",
+			command: writeConfigAndSignalCommand(),
+			stdin:   "replica: prom-1\nshard: 1\nnode: node-prom-1\n",
+		},
+	}, executor.calls)
+
+	// Nothing changed.
+	executor.calls = nil
+	requeue, err = r.Reload(ctx, "ns/prom", pods, config, "rules-1")
+	require.NoError(t, err)
+	require.Zero(t, requeue)
+	require.Empty(t, executor.calls)
+
+	// The rule files changed: the pods are signaled and need to be signaled
+	// again later.
+	requeue, err = r.Reload(ctx, "ns/prom", pods, config, "rules-2")
+	require.NoError(t, err)
+	require.Equal(t, ExecReloadResignalInterval, requeue)
+	require.Equal(t, []execCall{
+		{pod: "prom-0", command: signalCommand()},
+		{pod: "prom-1", command: signalCommand()},
+	}, executor.calls)
+
+	// The configuration changed.
+	executor.calls = nil
+	_, err = r.Reload(ctx, "ns/prom", pods[:1], []byte("replica: $(POD_NAME)-new\n"), "rules-2")
+	require.NoError(t, err)
+	require.Equal(t, []execCall{
+		{
+			pod:     "prom-0",
+			command: writeConfigAndSignalCommand(),
+			stdin:   "replica: prom-0-new\n",
+		},
+	}, executor.calls)
+
+	// The state of the pods which are gone is removed.
+	require.Len(t, r.object("ns/prom").pods, 1)
+
+	// Failed executions are retried.
+	executor.calls = nil
+	executor.err = errors.New("exec failed")
+	_, err = r.Reload(ctx, "ns/prom", pods, config, "rules-2")
+	require.Error(t, err)
+	require.Len(t, executor.calls, 2)
+
+	executor.calls = nil
+	executor.err = nil
+	_, err = r.Reload(ctx, "ns/prom", pods, config, "rules-2")
+	require.NoError(t, err)
+	require.Len(t, executor.calls, 2)
+}
+
+func TestExecReloaderSync(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		executor = &fakePodExecutor{}
+		r        = NewExecReloader(executor)
+		p        = &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{Name: "prom", Namespace: "default"},
+			Spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					ReloadStrategy: ptr.To(monitoringv1.OperatorExecReloadStrategyType),
+				},
+			},
+		}
+		listPods = func(namespace string, _ labels.Selector, appendFn cache.AppendFunc) error {
+			require.Equal(t, "default", namespace)
+			pod := execReloadTestPod("prom-0", "0", true)
+			appendFn(&pod)
+			return nil
+		}
+	)
+
+	// The configuration isn't recorded yet.
+	_, err := r.Sync(ctx, "default/prom", p, listPods, labels.Everything(), "")
+	require.NoError(t, err)
+	require.Empty(t, executor.calls)
+
+	r.SetConfig("default/prom", p, []byte("replica: $(POD_NAME)\n"))
+	_, err = r.Sync(ctx, "default/prom", p, listPods, labels.Everything(), "")
+	require.NoError(t, err)
+	require.Equal(t, []execCall{
+		{
+			pod:     "prom-0",
+			command: writeConfigAndSignalCommand(),
+			stdin:   "replica: prom-0\n",
+		},
+	}, executor.calls)
+
+	// The object doesn't use the OperatorExec reload strategy anymore.
+	p.Spec.ReloadStrategy = nil
+	executor.calls = nil
+	_, err = r.Sync(ctx, "default/prom", p, listPods, labels.Everything(), "")
+	require.NoError(t, err)
+	require.Empty(t, executor.calls)
+	require.Empty(t, r.objects)
+}
+
+type blockingPodExecutor struct {
+	started chan struct{}
+}
+
+func (b *blockingPodExecutor) Exec(ctx context.Context, _, pod, _ string, _ []string, _ io.Reader) error {
+	if pod == "blocked-0" {
+		close(b.started)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+func TestExecReloaderDoesntBlockOtherObjects(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		executor    = &blockingPodExecutor{started: make(chan struct{})}
+		r           = NewExecReloader(executor)
+		config      = []byte("global: {}\n")
+		errc        = make(chan error)
+	)
+	defer cancel()
+
+	go func() {
+		_, err := r.Reload(ctx, "ns/blocked", []v1.Pod{execReloadTestPod("blocked-0", "0", true)}, config, "")
+		errc <- err
+	}()
+	<-executor.started
+
+	_, err := r.Reload(context.Background(), "ns/prom", []v1.Pod{execReloadTestPod("prom-0", "0", true)}, config, "")
+	require.NoError(t, err)
+
+	cancel()
+	require.Error(t, <-errc)
+}
+
+func TestExpandPodEnv(t *testing.T) {
+	pod := execReloadTestPod("prom-0", "3", true)
+
+	b, err := expandPodEnv([]byte(`regex: $(SHARD);|.+;.+`), &pod)
+	require.NoError(t, err)
+	require.Equal(t, "regex: 3;|.+;.+", string(b))
+
+	_, err = expandPodEnv([]byte(`value: $(UNKNOWN)`), &pod)
+	require.Error(t, err)
+}
@@ -17,6 +17,7 @@ package prometheus
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
@@ -44,6 +45,25 @@ type Config struct {
 	ThanosDefaultBaseImage     string
 	Annotations                operator.Map
 	Labels                     operator.Map
+	NativeSidecars             bool
+	GoRuntime                  operator.GoRuntimeConfig
+	TLSAssetsProjection        bool
+}
+
+// NewConfig returns the parameters of the Prometheus controllers from the
+// operator's configuration.
+func NewConfig(c operator.Config) Config {
+	return Config{
+		LocalHost:                  c.LocalHost,
+		ReloaderConfig:             c.ReloaderConfig,
+		PrometheusDefaultBaseImage: c.PrometheusDefaultBaseImage,
+		ThanosDefaultBaseImage:     c.ThanosDefaultBaseImage,
+		Annotations:                c.Annotations,
+		Labels:                     c.Labels,
+		NativeSidecars:             c.NativeSidecarsEnabled(),
+		GoRuntime:                  c.GoRuntime,
+		TLSAssetsProjection:        c.TLSAssetsProjection,
+	}
 }
 
 type StatusReporter struct {
@@ -107,6 +127,12 @@ func validateRemoteWriteSpec(spec monitoringv1.RemoteWriteSpec) error {
 		return fmt.Errorf("%s can't be set at the same time, at most one of them must be defined", strings.Join(nonNilFields, " and "))
 	}
 
+	for _, u := range spec.FailoverURLs {
+		if _, err := url.Parse(u); err != nil {
+			return fmt.Errorf("invalid failover URL %q: %w", u, err)
+		}
+	}
+
 	if spec.AzureAD != nil {
 		if spec.AzureAD.ManagedIdentity == nil && spec.AzureAD.OAuth == nil && spec.AzureAD.SDK == nil {
 			return fmt.Errorf("must provide Azure Managed Identity or Azure OAuth or Azure SDK in the Azure AD config")
@@ -140,7 +166,11 @@ func (sr *StatusReporter) Process(ctx context.Context, p monitoringv1.Prometheus
 
 	commonFields := p.GetCommonPrometheusFields()
 	pStatus := monitoringv1.PrometheusStatus{
-		Paused: commonFields.Paused,
+		Paused: operator.IsPaused(p.GetObjectMeta(), commonFields.Paused),
+	}
+
+	if t, found := sr.Reconciliations.LastReconcileTime(key); found {
+		pStatus.LastReconcileTime = &metav1.Time{Time: t}
 	}
 
 	var (
@@ -201,11 +231,14 @@ func (sr *StatusReporter) Process(ctx context.Context, p monitoringv1.Prometheus
 		pStatus.ShardStatuses = append(
 			pStatus.ShardStatuses,
 			monitoringv1.ShardStatus{
-				ShardID:             strconv.Itoa(shard),
-				Replicas:            int32(len(stsReporter.Pods)),
-				UpdatedReplicas:     int32(len(stsReporter.UpdatedPods())),
-				AvailableReplicas:   int32(len(stsReporter.ReadyPods())),
-				UnavailableReplicas: int32(len(stsReporter.Pods) - len(stsReporter.ReadyPods())),
+				ShardID:              strconv.Itoa(shard),
+				Replicas:             int32(len(stsReporter.Pods)),
+				UpdatedReplicas:      int32(len(stsReporter.UpdatedPods())),
+				AvailableReplicas:    int32(len(stsReporter.ReadyPods())),
+				UnavailableReplicas:  int32(len(stsReporter.Pods) - len(stsReporter.ReadyPods())),
+				Rollout:              stsReporter.RolloutStatus(),
+				ObservedGeneration:   stsReporter.ObservedGeneration(),
+				RolloutBlockedReason: stsReporter.RolloutBlockedReason(),
 			},
 		)
 
@@ -243,6 +276,7 @@ func (sr *StatusReporter) Process(ctx context.Context, p monitoringv1.Prometheus
 			ObservedGeneration: p.GetObjectMeta().GetGeneration(),
 		},
 		sr.Reconciliations.GetCondition(key, p.GetObjectMeta().GetGeneration()),
+		operator.PausedCondition(pStatus.Paused, p.GetObjectMeta().GetGeneration()),
 	)
 
 	return &pStatus, nil
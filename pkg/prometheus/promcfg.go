@@ -31,6 +31,7 @@ import (
 	"github.com/prometheus/common/model"
 	"gopkg.in/yaml.v2"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
@@ -202,6 +203,10 @@ func getScrapeClassConfig(p monitoringv1.PrometheusInterface) (map[string]monito
 			return nil, "", fmt.Errorf("invalid authorization for scrapeClass %s: %w", scrapeClass.Name, err)
 		}
 
+		if err := scrapeClass.ProxyConfig.Validate(); err != nil {
+			return nil, "", fmt.Errorf("invalid proxy config for scrapeClass %s: %w", scrapeClass.Name, err)
+		}
+
 		if ptr.Deref(scrapeClass.Default, false) {
 			if defaultScrapeClass != "" {
 				return nil, "", fmt.Errorf("multiple default scrape classes defined")
@@ -544,6 +549,10 @@ func mergeTLSConfigWithScrapeClass(tlsConfig *monitoringv1.TLSConfig, scrapeClas
 		tlsConfig.CAFile = scrapeClass.TLSConfig.CAFile
 	}
 
+	if tlsConfig.CertificateSecret != nil {
+		return tlsConfig
+	}
+
 	if tlsConfig.CertFile == "" && tlsConfig.Cert == (monitoringv1.SecretOrConfigMap{}) {
 		tlsConfig.CertFile = scrapeClass.TLSConfig.CertFile
 	}
@@ -578,6 +587,37 @@ func mergeFallbackScrapeProtocolWithScrapeClass(fallbackScrapeProtocol *monitori
 	return fallbackScrapeProtocol
 }
 
+// addScrapeProxyConfigToYaml adds the proxy settings of the scrape object to
+// the scrape job. If the scrape object doesn't define any proxy settings, it
+// falls back to the proxy settings of the scrape class and then to the scrape
+// proxy settings of the PrometheusAgent resource. The Secrets referenced by
+// the fallback settings are looked up in the namespace of the
+// Prometheus/PrometheusAgent resource.
+func (cg *ConfigGenerator) addScrapeProxyConfigToYaml(
+	cfg yaml.MapSlice,
+	s assets.StoreGetter,
+	store *assets.StoreBuilder,
+	proxyConfig monitoringv1.ProxyConfig,
+	scrapeClass monitoringv1.ScrapeClass,
+) yaml.MapSlice {
+	if !reflect.ValueOf(proxyConfig).IsZero() {
+		return cg.addProxyConfigtoYaml(cfg, s, proxyConfig)
+	}
+
+	fallback := scrapeClass.ProxyConfig
+	if fallback == nil {
+		if agent, ok := cg.prom.(*monitoringv1alpha1.PrometheusAgent); ok {
+			fallback = agent.Spec.ScrapeProxyConfig
+		}
+	}
+
+	if fallback == nil {
+		return cfg
+	}
+
+	return cg.addProxyConfigtoYaml(cfg, store.ForNamespace(cg.prom.GetObjectMeta().GetNamespace()), *fallback)
+}
+
 func (cg *ConfigGenerator) addBasicAuthToYaml(
 	cfg yaml.MapSlice,
 	store assets.StoreGetter,
@@ -853,7 +893,17 @@ func (cg *ConfigGenerator) addTLStoYaml(
 		return cfg
 	}
 
-	tlsConfig := cg.addSafeTLStoYaml(yaml.MapSlice{}, store, &tls.SafeTLSConfig)[0].Value.(yaml.MapSlice)
+	var hasCA bool
+	if tls.CertificateSecret != nil {
+		_, err := store.GetSecretKey(v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: *tls.CertificateSecret},
+			Key:                  monitoringv1.CertificateSecretCAKey,
+		})
+		hasCA = err == nil
+	}
+
+	safetls := tls.SafeTLSConfigWithCertificateSecret(hasCA)
+	tlsConfig := cg.addSafeTLStoYaml(yaml.MapSlice{}, store, &safetls)[0].Value.(yaml.MapSlice)
 
 	if tls.CAFile != "" {
 		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "ca_file", Value: tls.CAFile})
@@ -925,7 +975,7 @@ func (cg *ConfigGenerator) GenerateServerConfiguration(
 	cfg = cg.appendRuntime(cfg)
 
 	// Rule Files config
-	cfg = cg.appendRuleFiles(cfg, ruleConfigMapNames, p.Spec.RuleSelector)
+	cfg = cg.appendRuleFiles(cfg, ruleConfigMapNames, p.Spec.RuleSelector, shardsNumber(p))
 
 	// Scrape config
 	var (
@@ -946,6 +996,12 @@ func (cg *ConfigGenerator) GenerateServerConfiguration(
 	if err != nil {
 		return nil, fmt.Errorf("generate additional scrape configs: %w", err)
 	}
+
+	scrapeConfigs, err = cg.filterEmergencyScrapeConfigs(scrapeConfigs, p.Spec.EmergencyMode)
+	if err != nil {
+		return nil, fmt.Errorf("emergency mode: %w", err)
+	}
+
 	cfg = append(cfg, yaml.MapItem{
 		Key:   "scrape_configs",
 		Value: scrapeConfigs,
@@ -989,6 +1045,43 @@ func (cg *ConfigGenerator) GenerateServerConfiguration(
 	return yaml.Marshal(cfg)
 }
 
+// filterEmergencyScrapeConfigs removes the scrape configurations whose job
+// name doesn't match the emergency mode's expressions when the emergency
+// mode is enabled.
+func (cg *ConfigGenerator) filterEmergencyScrapeConfigs(scrapeConfigs []yaml.MapSlice, em *monitoringv1.EmergencyModeSpec) ([]yaml.MapSlice, error) {
+	if em == nil || !em.Enabled {
+		return scrapeConfigs, nil
+	}
+
+	res := make([]*regexp.Regexp, 0, len(em.KeepJobs))
+	for _, expr := range em.KeepJobs {
+		re, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid keepJobs expression %q: %w", expr, err)
+		}
+		res = append(res, re)
+	}
+
+	kept := make([]yaml.MapSlice, 0, len(scrapeConfigs))
+	for _, sc := range scrapeConfigs {
+		var jobName string
+		for _, item := range sc {
+			if item.Key == "job_name" {
+				jobName, _ = item.Value.(string)
+				break
+			}
+		}
+
+		if slices.ContainsFunc(res, func(re *regexp.Regexp) bool { return re.MatchString(jobName) }) {
+			kept = append(kept, sc)
+		}
+	}
+
+	cg.logger.Warn("emergency mode enabled, dropping scrape jobs", "kept", len(kept), "dropped", len(scrapeConfigs)-len(kept))
+
+	return kept, nil
+}
+
 func (cg *ConfigGenerator) appendStorageSettingsConfig(cfg yaml.MapSlice, exemplars *monitoringv1.Exemplars) (yaml.MapSlice, error) {
 	var (
 		storage   yaml.MapSlice
@@ -1207,13 +1300,14 @@ func (cg *ConfigGenerator) BuildPodMetadata() (map[string]string, map[string]str
 // The /-/ready handler returns OK only after the TSDB initialization has
 // completed. The WAL replay can take a significant time for large setups
 // hence we enable the startup probe with a generous failure threshold (15
-// minutes) to ensure that the readiness probe only comes into effect once
-// Prometheus is effectively ready.
+// minutes, or derived from the storage size in auto mode) to ensure that the
+// readiness probe only comes into effect once Prometheus is effectively
+// ready.
 // We don't want to use the /-/healthy handler here because it returns OK as
 // soon as the web server is started (irrespective of the WAL replay).
 func (cg *ConfigGenerator) BuildProbes() (*v1.Probe, *v1.Probe, *v1.Probe) {
 	readyProbeHandler := cg.buildProbeHandler("/-/ready")
-	startupPeriodSeconds, startupFailureThreshold := getStatupProbePeriodSecondsAndFailureThreshold(cg.prom.GetCommonPrometheusFields().MaximumStartupDurationSeconds)
+	startupPeriodSeconds, startupFailureThreshold := getStatupProbePeriodSecondsAndFailureThreshold(maximumStartupDurationSeconds(cg.prom.GetCommonPrometheusFields()))
 
 	startupProbe := &v1.Probe{
 		ProbeHandler:     readyProbeHandler,
@@ -1239,6 +1333,40 @@ func (cg *ConfigGenerator) BuildProbes() (*v1.Probe, *v1.Probe, *v1.Probe) {
 	return startupProbe, readinessProbe, livenessProbe
 }
 
+// BuildWarmupProbe returns a readiness probe which succeeds only once the WAL
+// replay has completed and the warmup query has been evaluated successfully.
+// Because a probe handler can't check several HTTP endpoints, the probe
+// executes curl or wget from within the container.
+func (cg *ConfigGenerator) BuildWarmupProbe(warmup *monitoringv1.WarmupSpec) *v1.Probe {
+	cpf := cg.prom.GetCommonPrometheusFields()
+
+	scheme := "http"
+	if !cpf.ListenLocal && cpf.Web != nil && cpf.Web.TLSConfig != nil && cg.IsCompatible() {
+		scheme = "https"
+	}
+
+	readyURL := url.URL{
+		Scheme: scheme,
+		Host:   "localhost:9090",
+		Path:   path.Clean(cpf.WebRoutePrefix() + "/-/ready"),
+	}
+	queryURL := url.URL{
+		Scheme:   scheme,
+		Host:     "localhost:9090",
+		Path:     path.Clean(cpf.WebRoutePrefix() + "/api/v1/query"),
+		RawQuery: url.Values{"query": []string{warmup.Query}}.Encode(),
+	}
+
+	return &v1.Probe{
+		ProbeHandler: v1.ProbeHandler{
+			Exec: operator.ExecAction(readyURL.String(), queryURL.String()),
+		},
+		TimeoutSeconds:   ptr.Deref(warmup.TimeoutSeconds, ProbeTimeoutSeconds),
+		PeriodSeconds:    5,
+		FailureThreshold: 3,
+	}
+}
+
 func (cg *ConfigGenerator) buildProbeHandler(probePath string) v1.ProbeHandler {
 	cpf := cg.prom.GetCommonPrometheusFields()
 
@@ -1266,6 +1394,61 @@ func (cg *ConfigGenerator) buildProbeHandler(probePath string) v1.ProbeHandler {
 	return handler
 }
 
+const (
+	// autoStartupBaseSeconds and autoStartupSecondsPerGiB define the maximum
+	// startup duration in auto mode as a function of the storage size.
+	autoStartupBaseSeconds   = 120
+	autoStartupSecondsPerGiB = 30
+)
+
+// maximumStartupDurationSeconds returns the maximum startup duration of the
+// prometheus container. In auto mode, it's derived from the storage size with
+// the user-defined duration as the lower bound.
+func maximumStartupDurationSeconds(cpf monitoringv1.CommonPrometheusFields) *int32 {
+	if ptr.Deref(cpf.MaximumStartupDurationMode, monitoringv1.FixedStartupDurationMode) != monitoringv1.AutoStartupDurationMode {
+		return cpf.MaximumStartupDurationSeconds
+	}
+
+	size := storageSize(cpf.Storage)
+	if size == nil || size.IsZero() {
+		return cpf.MaximumStartupDurationSeconds
+	}
+
+	gib := math.Ceil(float64(size.Value()) / float64(units.GiB))
+	d := int32(min(autoStartupBaseSeconds+gib*autoStartupSecondsPerGiB, math.MaxInt32))
+
+	return ptr.To(max(d, ptr.Deref(cpf.MaximumStartupDurationSeconds, 0)))
+}
+
+// storageSize returns the size of the storage volume or nil if it isn't
+// known.
+func storageSize(storage *monitoringv1.StorageSpec) *resource.Quantity {
+	switch {
+	case storage == nil:
+		return nil
+
+	case storage.EmptyDir != nil:
+		return storage.EmptyDir.SizeLimit
+
+	case storage.Ephemeral != nil:
+		if storage.Ephemeral.VolumeClaimTemplate == nil {
+			return nil
+		}
+
+		if q, found := storage.Ephemeral.VolumeClaimTemplate.Spec.Resources.Requests[v1.ResourceStorage]; found {
+			return &q
+		}
+
+		return nil
+	}
+
+	if q, found := storage.VolumeClaimTemplate.Spec.Resources.Requests[v1.ResourceStorage]; found {
+		return &q
+	}
+
+	return nil
+}
+
 func getStatupProbePeriodSecondsAndFailureThreshold(maxStartupDurationSeconds *int32) (int32, int32) {
 	var (
 		startupPeriodSeconds    float64 = 15
@@ -1355,7 +1538,7 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 	cfg = cg.addBasicAuthToYaml(cfg, s, ep.BasicAuth)
 	cfg = cg.addOAuth2ToYaml(cfg, s, ep.OAuth2)
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, ep.ProxyConfig)
+	cfg = cg.addScrapeProxyConfigToYaml(cfg, s, store, ep.ProxyConfig, scrapeClass)
 
 	cfg = cg.addAuthorizationToYaml(cfg, s, mergeSafeAuthorizationWithScrapeClass(ep.Authorization, scrapeClass))
 
@@ -1605,7 +1788,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 
 	s := store.ForNamespace(m.Namespace)
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, m.Spec.ProberSpec.ProxyConfig)
+	cfg = cg.addScrapeProxyConfigToYaml(cfg, s, store, m.Spec.ProberSpec.ProxyConfig, scrapeClass)
 
 	// As stated in the CRD documentation, if both StaticConfig and Ingress are
 	// defined, the former takes precedence which is why the first case statement
@@ -1838,7 +2021,7 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 		cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *ep.EnableHttp2)
 	}
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, ep.ProxyConfig)
+	cfg = cg.addScrapeProxyConfigToYaml(cfg, s, store, ep.ProxyConfig, scrapeClass)
 
 	cfg = cg.addOAuth2ToYaml(cfg, s, ep.OAuth2)
 
@@ -2921,11 +3104,16 @@ func (cg *ConfigGenerator) appendScrapeFailureLogFile(slice yaml.MapSlice, scrap
 	return cg.WithMinimumVersion("2.55.0").AppendMapItem(slice, "scrape_failure_log_file", logFilePath(*scrapeFailureLogFile))
 }
 
-func (cg *ConfigGenerator) appendRuleFiles(slice yaml.MapSlice, ruleFiles []string, ruleSelector *metav1.LabelSelector) yaml.MapSlice {
+func (cg *ConfigGenerator) appendRuleFiles(slice yaml.MapSlice, ruleFiles []string, ruleSelector *metav1.LabelSelector, shards int32) yaml.MapSlice {
 	if ruleSelector != nil {
 		ruleFilePaths := []string{}
 		for _, name := range ruleFiles {
 			ruleFilePaths = append(ruleFilePaths, RulesDir+"/"+name+"/*.yaml")
+			// The recording rules partitioned across the shards are loaded
+			// only by the owning shard.
+			if shards > 1 {
+				ruleFilePaths = append(ruleFilePaths, RulesDir+"/"+name+"/"+operator.ShardedRuleFilesGlob)
+			}
 		}
 		slice = append(slice, yaml.MapItem{
 			Key:   "rule_files",
@@ -3107,7 +3295,7 @@ func (cg *ConfigGenerator) appendScrapeConfigs(
 
 	for _, identifier := range sortutil.SortedKeys(scrapeConfigs) {
 		cfgGenerator := cg.WithKeyVals("scrapeconfig", identifier)
-		scrapeConfig, err := cfgGenerator.generateScrapeConfig(scrapeConfigs[identifier], store.ForNamespace(scrapeConfigs[identifier].GetNamespace()), shards)
+		scrapeConfig, err := cfgGenerator.generateScrapeConfig(scrapeConfigs[identifier], store, shards)
 
 		if err != nil {
 			return slices, err
@@ -3121,10 +3309,11 @@ func (cg *ConfigGenerator) appendScrapeConfigs(
 
 func (cg *ConfigGenerator) generateScrapeConfig(
 	sc *monitoringv1alpha1.ScrapeConfig,
-	s assets.StoreGetter,
+	store *assets.StoreBuilder,
 	shards int32,
 ) (yaml.MapSlice, error) {
 	scrapeClass := cg.getScrapeClassOrDefault(sc.Spec.ScrapeClassName)
+	s := store.ForNamespace(sc.GetNamespace())
 
 	jobName := fmt.Sprintf("scrapeConfig/%s/%s", sc.Namespace, sc.Name)
 
@@ -3192,7 +3381,7 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: strings.ToLower(*sc.Spec.Scheme)})
 	}
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, sc.Spec.ProxyConfig)
+	cfg = cg.addScrapeProxyConfigToYaml(cfg, s, store, sc.Spec.ProxyConfig, scrapeClass)
 
 	cfg = cg.addBasicAuthToYaml(cfg, s, sc.Spec.BasicAuth)
 
@@ -3236,8 +3425,8 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 	}
 
 	// FileSDConfig
-	if len(sc.Spec.FileSDConfigs) > 0 {
-		configs := make([][]yaml.MapItem, len(sc.Spec.FileSDConfigs))
+	if len(sc.Spec.FileSDConfigs) > 0 || len(sc.Spec.CustomResourceSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.FileSDConfigs), len(sc.Spec.FileSDConfigs)+len(sc.Spec.CustomResourceSDConfigs))
 		for i, config := range sc.Spec.FileSDConfigs {
 			configs[i] = []yaml.MapItem{
 				{
@@ -3253,6 +3442,22 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 				})
 			}
 		}
+
+		// The targets of the CustomResourceSDConfigs are written by the
+		// operator into the file SD ConfigMap.
+		for i, config := range sc.Spec.CustomResourceSDConfigs {
+			configs = append(configs, []yaml.MapItem{
+				{
+					Key:   "files",
+					Value: []string{path.Join(FileSDDir, customResourceSDFilename(sc, i))},
+				},
+				{
+					Key:   "refresh_interval",
+					Value: model.Duration(customResourceSDRefreshInterval(config)),
+				},
+			})
+		}
+
 		cfg = append(cfg, yaml.MapItem{
 			Key:   "file_sd_configs",
 			Value: configs,
@@ -5730,6 +5730,132 @@ func TestPromAgentDaemonSetPodMonitorConfig(t *testing.T) {
 	golden.Assert(t, string(cfg), "PromAgentDaemonSetPodMonitorConfig.golden")
 }
 
+func TestPrometheusAgentScrapeProxyConfig(t *testing.T) {
+	agentProxy := &monitoringv1.ProxyConfig{
+		ProxyURL: ptr.To("socks5://egress-proxy.monitoring.svc:1080"),
+		NoProxy:  ptr.To("10.0.0.0/8,192.168.0.0/16"),
+	}
+
+	scrapeClassProxy := &monitoringv1.ProxyConfig{
+		ProxyURL: ptr.To("http://scrape-class-proxy.monitoring.svc:3128"),
+		NoProxy:  ptr.To("172.16.0.0/12"),
+		ProxyConnectHeader: map[string][]v1.SecretKeySelector{
+			"Proxy-Authorization": {
+				{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: "proxy-credentials",
+					},
+					Key: "authorization",
+				},
+			},
+		},
+	}
+
+	store := assets.NewTestStoreBuilder(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "proxy-credentials",
+				Namespace: "monitoring",
+			},
+			Data: map[string][]byte{
+				"authorization": []byte("Basic dXNlcjpwYXNz"),
+			},
+		},
+	)
+
+	for _, tc := range []struct {
+		name              string
+		scrapeProxyConfig *monitoringv1.ProxyConfig
+		scrapeClass       *monitoringv1.ScrapeClass
+		sMonProxyConfig   monitoringv1.ProxyConfig
+		golden            string
+	}{
+		{
+			name:   "no proxy",
+			golden: "PrometheusAgentScrapeProxyConfig_none.golden",
+		},
+		{
+			name:              "agent proxy",
+			scrapeProxyConfig: agentProxy,
+			golden:            "PrometheusAgentScrapeProxyConfig_agent.golden",
+		},
+		{
+			name:              "scrape class proxy takes precedence over agent proxy",
+			scrapeProxyConfig: agentProxy,
+			scrapeClass: &monitoringv1.ScrapeClass{
+				Name:        "default",
+				Default:     ptr.To(true),
+				ProxyConfig: scrapeClassProxy,
+			},
+			golden: "PrometheusAgentScrapeProxyConfig_scrape_class.golden",
+		},
+		{
+			name:              "scrape object proxy takes precedence",
+			scrapeProxyConfig: agentProxy,
+			scrapeClass: &monitoringv1.ScrapeClass{
+				Name:        "default",
+				Default:     ptr.To(true),
+				ProxyConfig: scrapeClassProxy,
+			},
+			sMonProxyConfig: monitoringv1.ProxyConfig{
+				ProxyURL: ptr.To("http://service-monitor-proxy:3128"),
+			},
+			golden: "PrometheusAgentScrapeProxyConfig_scrape_object.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1alpha1.PrometheusAgent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "monitoring",
+				},
+				Spec: monitoringv1alpha1.PrometheusAgentSpec{
+					ScrapeProxyConfig: tc.scrapeProxyConfig,
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Version:        operator.DefaultPrometheusVersion,
+						ScrapeInterval: "30s",
+					},
+				},
+			}
+			if tc.scrapeClass != nil {
+				p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{*tc.scrapeClass}
+			}
+
+			serviceMonitor := defaultServiceMonitor()
+			serviceMonitor.Spec.Endpoints[0].ProxyConfig = tc.sMonProxyConfig
+
+			cg, err := NewConfigGenerator(nil, p)
+			require.NoError(t, err)
+
+			cfg, err := cg.GenerateAgentConfiguration(
+				map[string]*monitoringv1.ServiceMonitor{"monitor": serviceMonitor},
+				map[string]*monitoringv1.PodMonitor{"monitor": defaultPodMonitor()},
+				map[string]*monitoringv1.Probe{"monitor": defaultProbe()},
+				map[string]*monitoringv1alpha1.ScrapeConfig{"monitor": defaultScrapeConfig()},
+				store,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeClassProxyConfigValidation(t *testing.T) {
+	p := defaultPrometheus()
+	p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{
+		{
+			Name: "invalid",
+			ProxyConfig: &monitoringv1.ProxyConfig{
+				NoProxy: ptr.To("10.0.0.0/8"),
+			},
+		},
+	}
+
+	_, err := NewConfigGenerator(nil, p)
+	require.Error(t, err)
+}
+
 func TestGenerateRelabelConfig(t *testing.T) {
 	p := defaultPrometheus()
 
@@ -6050,6 +6176,32 @@ func TestScrapeConfigSpecConfig(t *testing.T) {
 			},
 			golden: "ScrapeConfigSpecConfig_FileSD.golden",
 		},
+		{
+			name: "custom_resource_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				FileSDConfigs: []monitoringv1alpha1.FileSDConfig{
+					{
+						Files: []monitoringv1alpha1.SDFile{"/tmp/myfile.json"},
+					},
+				},
+				CustomResourceSDConfigs: []monitoringv1alpha1.CustomResourceSDConfig{
+					{
+						Group:    "example.com",
+						Version:  "v1",
+						Resource: "databases",
+						Address:  "object.status.endpoint",
+					},
+					{
+						Group:           "example.com",
+						Version:         "v1",
+						Resource:        "caches",
+						Address:         "object.status.endpoint",
+						RefreshInterval: &refreshInterval,
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_CustomResourceSD.golden",
+		},
 		{
 			name: "http_sd_config",
 			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
@@ -13673,3 +13825,137 @@ func TestAppendConvertScrapeClassicHistograms(t *testing.T) {
 		})
 	}
 }
+
+func TestEmergencyMode(t *testing.T) {
+	sMons := map[string]*monitoringv1.ServiceMonitor{
+		"kube-system/apiserver": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "apiserver",
+				Namespace: "kube-system",
+			},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{Port: "https"}},
+			},
+		},
+		"default/app": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app",
+				Namespace: "default",
+			},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{Port: "web"}},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		em        *monitoringv1.EmergencyModeSpec
+		golden    string
+		expectErr bool
+	}{
+		{
+			name: "disabled",
+			em: &monitoringv1.EmergencyModeSpec{
+				Enabled:  false,
+				KeepJobs: []string{"serviceMonitor/kube-system/.*"},
+			},
+			golden: "EmergencyModeDisabled.golden",
+		},
+		{
+			name: "enabled",
+			em: &monitoringv1.EmergencyModeSpec{
+				Enabled:  true,
+				KeepJobs: []string{"serviceMonitor/kube-system/.*", "prometheus"},
+			},
+			golden: "EmergencyModeEnabled.golden",
+		},
+		{
+			name: "enabled without jobs",
+			em: &monitoringv1.EmergencyModeSpec{
+				Enabled: true,
+			},
+			golden: "EmergencyModeEnabledWithoutJobs.golden",
+		},
+		{
+			name: "invalid expression",
+			em: &monitoringv1.EmergencyModeSpec{
+				Enabled:  true,
+				KeepJobs: []string{"("},
+			},
+			expectErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.EmergencyMode = tc.em
+
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				sMons,
+				nil,
+				nil,
+				nil,
+				&assets.StoreBuilder{},
+				[]byte(`- job_name: prometheus
+  static_configs:
+  - targets: ["localhost:9090"]
+- job_name: other
+  static_configs:
+  - targets: ["localhost:8080"]
+`),
+				nil,
+				nil,
+				nil,
+			)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestRuleFiles(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		shards *int32
+		golden string
+	}{
+		{
+			name:   "single shard",
+			golden: "RuleFiles.golden",
+		},
+		{
+			name:   "multiple shards",
+			shards: ptr.To(int32(3)),
+			golden: "RuleFilesWithShards.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.Shards = tc.shards
+			p.Spec.RuleSelector = &metav1.LabelSelector{}
+
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				nil,
+				&assets.StoreBuilder{},
+				nil,
+				nil,
+				nil,
+				[]string{"prometheus-test-rulefiles-0", "prometheus-test-rulefiles-1"},
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	remoteWriteProbeInterval = 30 * time.Second
+	remoteWriteProbeTimeout  = 5 * time.Second
+)
+
+// RemoteWriteProber checks the reachability of the remote write endpoints
+// which define failover URLs and selects the active URL of each endpoint.
+type RemoteWriteProber struct {
+	mtx sync.Mutex
+	// Result of the last probe, indexed by URL.
+	reachable map[string]bool
+
+	dial func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+func NewRemoteWriteProber() *RemoteWriteProber {
+	d := &net.Dialer{Timeout: remoteWriteProbeTimeout}
+	return &RemoteWriteProber{
+		reachable: map[string]bool{},
+		dial:      d.DialContext,
+	}
+}
+
+func hostPort(u string) (string, error) {
+	pu, err := url.Parse(u)
+	if err != nil {
+		return "", err
+	}
+
+	if pu.Port() != "" {
+		return pu.Host, nil
+	}
+
+	port := "80"
+	if pu.Scheme == "https" {
+		port = "443"
+	}
+
+	return net.JoinHostPort(pu.Hostname(), port), nil
+}
+
+func (rwp *RemoteWriteProber) probe(ctx context.Context, u string) bool {
+	address, err := hostPort(u)
+	if err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, remoteWriteProbeTimeout)
+	defer cancel()
+
+	conn, err := rwp.dial(ctx, "tcp", address)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+
+	return true
+}
+
+// Probe checks the reachability of the URLs of the given remote write
+// configurations. It returns true if the active URL of at least one remote
+// write configuration has changed.
+func (rwp *RemoteWriteProber) Probe(ctx context.Context, rws []monitoringv1.RemoteWriteSpec) bool {
+	var changed bool
+	for _, rw := range rws {
+		if len(rw.FailoverURLs) == 0 {
+			continue
+		}
+
+		before := rwp.ActiveURL(rw)
+
+		results := make(map[string]bool, len(rw.FailoverURLs)+1)
+		for _, u := range append([]string{rw.URL}, rw.FailoverURLs...) {
+			results[u] = rwp.probe(ctx, u)
+		}
+
+		rwp.mtx.Lock()
+		for u, ok := range results {
+			rwp.reachable[u] = ok
+		}
+		rwp.mtx.Unlock()
+
+		if rwp.ActiveURL(rw) != before {
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// ActiveURL returns the URL to which Prometheus should send samples for the
+// given remote write configuration. URLs which haven't been probed yet are
+// considered reachable.
+func (rwp *RemoteWriteProber) ActiveURL(rw monitoringv1.RemoteWriteSpec) string {
+	rwp.mtx.Lock()
+	defer rwp.mtx.Unlock()
+
+	for _, u := range append([]string{rw.URL}, rw.FailoverURLs...) {
+		if ok, found := rwp.reachable[u]; !found || ok {
+			return u
+		}
+	}
+
+	return rw.URL
+}
+
+// ApplyFailover returns a copy of the remote write configurations with the
+// URLs replaced by the active URLs.
+func (rwp *RemoteWriteProber) ApplyFailover(rws []monitoringv1.RemoteWriteSpec) []monitoringv1.RemoteWriteSpec {
+	if len(rws) == 0 {
+		return rws
+	}
+
+	ret := make([]monitoringv1.RemoteWriteSpec, len(rws))
+	for i, rw := range rws {
+		ret[i] = *rw.DeepCopy()
+		if len(rw.FailoverURLs) > 0 {
+			ret[i].URL = rwp.ActiveURL(rw)
+		}
+	}
+
+	return ret
+}
+
+// Status returns the active endpoints of the remote write configurations
+// which define failover URLs.
+func (rwp *RemoteWriteProber) Status(rws []monitoringv1.RemoteWriteSpec) []monitoringv1.RemoteWriteEndpointStatus {
+	var ret []monitoringv1.RemoteWriteEndpointStatus
+	for _, rw := range rws {
+		if len(rw.FailoverURLs) == 0 {
+			continue
+		}
+
+		ret = append(ret, monitoringv1.RemoteWriteEndpointStatus{
+			URL:       rw.URL,
+			ActiveURL: rwp.ActiveURL(rw),
+		})
+	}
+
+	return ret
+}
+
+// Run probes regularly the remote write endpoints of the objects returned by
+// listFn. onChange is called for each object whose active URLs have changed.
+func (rwp *RemoteWriteProber) Run(ctx context.Context, listFn func(func(monitoringv1.PrometheusInterface)), onChange func(monitoringv1.PrometheusInterface)) {
+	ticker := time.NewTicker(remoteWriteProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rwp.probeAll(ctx, listFn, onChange)
+		}
+	}
+}
+
+// probeAll probes the remote write endpoints of the objects returned by
+// listFn and forgets the URLs which aren't referenced anymore (e.g. the
+// object has been deleted).
+func (rwp *RemoteWriteProber) probeAll(ctx context.Context, listFn func(func(monitoringv1.PrometheusInterface)), onChange func(monitoringv1.PrometheusInterface)) {
+	urls := map[string]struct{}{}
+	listFn(func(p monitoringv1.PrometheusInterface) {
+		rws := p.GetCommonPrometheusFields().RemoteWrite
+		for _, rw := range rws {
+			if len(rw.FailoverURLs) == 0 {
+				continue
+			}
+
+			for _, u := range append([]string{rw.URL}, rw.FailoverURLs...) {
+				urls[u] = struct{}{}
+			}
+		}
+
+		if rwp.Probe(ctx, rws) {
+			onChange(p)
+		}
+	})
+
+	rwp.Retain(urls)
+}
+
+// Retain removes the probe results of the URLs which aren't in the given set.
+func (rwp *RemoteWriteProber) Retain(urls map[string]struct{}) {
+	rwp.mtx.Lock()
+	defer rwp.mtx.Unlock()
+
+	for u := range rwp.reachable {
+		if _, found := urls[u]; !found {
+			delete(rwp.reachable, u)
+		}
+	}
+}
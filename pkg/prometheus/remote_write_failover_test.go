@@ -0,0 +1,138 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestRemoteWriteProber(t *testing.T) {
+	reachable := map[string]bool{}
+	rwp := NewRemoteWriteProber()
+	rwp.dial = func(_ context.Context, _, address string) (net.Conn, error) {
+		if !reachable[address] {
+			return nil, errors.New("connection refused")
+		}
+
+		c1, c2 := net.Pipe()
+		c2.Close()
+		return c1, nil
+	}
+
+	rws := []monitoringv1.RemoteWriteSpec{
+		{
+			URL: "http://primary:9090/api/v1/write",
+		},
+		{
+			URL:          "https://primary.example.com/api/v1/write",
+			FailoverURLs: []string{"https://standby.example.com:8443/api/v1/write"},
+		},
+	}
+
+	// Before the first probe, the primary URL is active.
+	require.Equal(t, rws, rwp.ApplyFailover(rws))
+	require.Equal(t, []monitoringv1.RemoteWriteEndpointStatus{
+		{
+			URL:       "https://primary.example.com/api/v1/write",
+			ActiveURL: "https://primary.example.com/api/v1/write",
+		},
+	}, rwp.Status(rws))
+
+	// The primary endpoint is reachable.
+	reachable["primary.example.com:443"] = true
+	reachable["standby.example.com:8443"] = true
+	require.False(t, rwp.Probe(context.Background(), rws))
+	require.Equal(t, "https://primary.example.com/api/v1/write", rwp.ActiveURL(rws[1]))
+
+	// The primary endpoint is down.
+	reachable["primary.example.com:443"] = false
+	require.True(t, rwp.Probe(context.Background(), rws))
+	require.Equal(t, "https://standby.example.com:8443/api/v1/write", rwp.ActiveURL(rws[1]))
+
+	applied := rwp.ApplyFailover(rws)
+	require.Equal(t, "http://primary:9090/api/v1/write", applied[0].URL)
+	require.Equal(t, "https://standby.example.com:8443/api/v1/write", applied[1].URL)
+	// The original configuration isn't modified.
+	require.Equal(t, "https://primary.example.com/api/v1/write", rws[1].URL)
+
+	// None of the endpoints is reachable.
+	reachable["standby.example.com:8443"] = false
+	require.True(t, rwp.Probe(context.Background(), rws))
+	require.Equal(t, "https://primary.example.com/api/v1/write", rwp.ActiveURL(rws[1]))
+
+	// The standby endpoint is back.
+	reachable["standby.example.com:8443"] = true
+	require.True(t, rwp.Probe(context.Background(), rws))
+	require.Equal(t, "https://standby.example.com:8443/api/v1/write", rwp.ActiveURL(rws[1]))
+
+	// The primary endpoint is back.
+	reachable["primary.example.com:443"] = true
+	require.True(t, rwp.Probe(context.Background(), rws))
+	require.Equal(t, "https://primary.example.com/api/v1/write", rwp.ActiveURL(rws[1]))
+}
+
+func TestRemoteWriteProberRetain(t *testing.T) {
+	rwp := NewRemoteWriteProber()
+	rwp.dial = func(_ context.Context, _, _ string) (net.Conn, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	newPrometheus := func(name string, urls ...string) *monitoringv1.Prometheus {
+		return &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					RemoteWrite: []monitoringv1.RemoteWriteSpec{
+						{URL: urls[0], FailoverURLs: urls[1:]},
+					},
+				},
+			},
+		}
+	}
+
+	objects := []monitoringv1.PrometheusInterface{
+		newPrometheus("a", "http://a1:9090", "http://a2:9090"),
+		newPrometheus("b", "http://b1:9090", "http://b2:9090"),
+	}
+	listFn := func(fn func(monitoringv1.PrometheusInterface)) {
+		for _, p := range objects {
+			fn(p)
+		}
+	}
+
+	rwp.probeAll(context.Background(), listFn, func(monitoringv1.PrometheusInterface) {})
+	require.Len(t, rwp.reachable, 4)
+
+	// The URLs of the deleted object are forgotten.
+	objects = objects[:1]
+	rwp.probeAll(context.Background(), listFn, func(monitoringv1.PrometheusInterface) {})
+	require.Len(t, rwp.reachable, 2)
+	require.Contains(t, rwp.reachable, "http://a1:9090")
+	require.Contains(t, rwp.reachable, "http://a2:9090")
+
+	// The URLs of the remote write configurations without failover URLs
+	// aren't probed.
+	objects = []monitoringv1.PrometheusInterface{newPrometheus("a", "http://a1:9090")}
+	rwp.probeAll(context.Background(), listFn, func(monitoringv1.PrometheusInterface) {})
+	require.Empty(t, rwp.reachable)
+}
@@ -39,15 +39,11 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
 
-const (
-	// Generic reason for selected resources that are not valid.
-	invalidConfiguration = "InvalidConfiguration"
-)
-
 // ConfigResource is a type constraint that permits only the specific pointer types for configuration resources
 // selectable by Prometheus or PrometheusAgent.
 type configurationResource interface {
@@ -66,14 +62,18 @@ type ResourceSelector struct {
 	accessor           *operator.Accessor
 
 	eventRecorder record.EventRecorder
+
+	// Messages describing the duplicated resources detected during the
+	// selection.
+	duplicates []string
 }
 
 // ResourcesSelection represents a slice of configuration resources selected by Prometheus or PrometheusAgent.
 type ResourcesSelection[T configurationResource] []struct {
 	resource T
 	key      string
-	err      error  // error encountered during selection or validation (nil if valid).
-	reason   string // Reason for rejection; empty if accepted.
+	err      error                    // error encountered during selection or validation (nil if valid).
+	reason   operator.RejectionReason // Reason for rejection; empty if accepted.
 }
 
 // ValidResources returns only the resources which the operator considers to be valid.
@@ -88,6 +88,92 @@ func (resources ResourcesSelection[T]) ValidResources() map[string]T {
 	return validRes
 }
 
+// UpdateServiceMonitorsStatus schedules the update of the workload binding
+// in the status of the selected ServiceMonitors. The resource argument is the
+// plural name of the workload resource (e.g. "prometheuses").
+//
+// ServiceMonitors for which the cached status already reports the binding
+// are skipped to avoid needless writes to the Kubernetes API.
+func UpdateServiceMonitorsStatus(w *operator.StatusWriter, mclient monitoringclient.Interface, p metav1.Object, resource string, smons ResourcesSelection[*monitoringv1.ServiceMonitor]) {
+	for _, res := range smons {
+		smon := res.resource
+		binding := operator.NewWorkloadBinding(p, resource, smon.Generation, res.reason, res.err)
+		key := operator.ConfigResourceStatusKey(monitoringv1.ServiceMonitorsKind, smon.Namespace, smon.Name, binding)
+
+		if operator.WorkloadBindingUpToDate(smon.Status.Bindings, binding) {
+			// A pending update computed from an older state would
+			// override the current status.
+			w.Cancel(key)
+			continue
+		}
+
+		w.Enqueue(key, operator.ServiceMonitorBindingUpdate(mclient, smon.Namespace, smon.Name, binding))
+	}
+}
+
+// UpdatePodMonitorsStatus schedules the update of the workload binding in the
+// status of the selected PodMonitors.
+func UpdatePodMonitorsStatus(w *operator.StatusWriter, mclient monitoringclient.Interface, p metav1.Object, resource string, pmons ResourcesSelection[*monitoringv1.PodMonitor]) {
+	for _, res := range pmons {
+		pmon := res.resource
+		binding := operator.NewWorkloadBinding(p, resource, pmon.Generation, res.reason, res.err)
+		key := operator.ConfigResourceStatusKey(monitoringv1.PodMonitorsKind, pmon.Namespace, pmon.Name, binding)
+
+		if operator.WorkloadBindingUpToDate(pmon.Status.Bindings, binding) {
+			w.Cancel(key)
+			continue
+		}
+
+		w.Enqueue(key, operator.PodMonitorBindingUpdate(mclient, pmon.Namespace, pmon.Name, binding))
+	}
+}
+
+// UpdateProbesStatus schedules the update of the workload binding in the
+// status of the selected Probes.
+func UpdateProbesStatus(w *operator.StatusWriter, mclient monitoringclient.Interface, p metav1.Object, resource string, probes ResourcesSelection[*monitoringv1.Probe]) {
+	for _, res := range probes {
+		probe := res.resource
+		binding := operator.NewWorkloadBinding(p, resource, probe.Generation, res.reason, res.err)
+		key := operator.ConfigResourceStatusKey(monitoringv1.ProbesKind, probe.Namespace, probe.Name, binding)
+
+		if operator.WorkloadBindingUpToDate(probe.Status.Bindings, binding) {
+			w.Cancel(key)
+			continue
+		}
+
+		w.Enqueue(key, operator.ProbeBindingUpdate(mclient, probe.Namespace, probe.Name, binding))
+	}
+}
+
+// UpdateScrapeConfigsStatus schedules the update of the workload binding in
+// the status of the selected ScrapeConfigs.
+func UpdateScrapeConfigsStatus(w *operator.StatusWriter, mclient monitoringclient.Interface, p metav1.Object, resource string, scrapeConfigs ResourcesSelection[*monitoringv1alpha1.ScrapeConfig]) {
+	for _, res := range scrapeConfigs {
+		sc := res.resource
+		binding := operator.NewWorkloadBinding(p, resource, sc.Generation, res.reason, res.err)
+		key := operator.ConfigResourceStatusKey(monitoringv1alpha1.ScrapeConfigsKind, sc.Namespace, sc.Name, binding)
+
+		if operator.WorkloadBindingUpToDate(sc.Status.Bindings, binding) {
+			w.Cancel(key)
+			continue
+		}
+
+		w.Enqueue(key, operator.ScrapeConfigBindingUpdate(mclient, sc.Namespace, sc.Name, binding))
+	}
+}
+
+// ConfigResourceRefs returns the references to the selected resources of the
+// given kind.
+func ConfigResourceRefs[T configurationResource](kind string, resources ResourcesSelection[T]) []operator.ConfigResourceRef {
+	refs := make([]operator.ConfigResourceRef, 0, len(resources))
+	for _, res := range resources {
+		namespace, name, _ := cache.SplitMetaNamespaceKey(res.key)
+		refs = append(refs, operator.ConfigResourceRef{Kind: kind, Namespace: namespace, Name: name})
+	}
+
+	return refs
+}
+
 type ListAllByNamespaceFn func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error
 
 func NewResourceSelector(
@@ -174,23 +260,27 @@ func selectObjects[T configurationResource](
 		}
 	}
 
+	// The rejection events are recorded in the namespace of the rejected
+	// resources which isn't necessarily the namespace of the workload.
+	workload := fmt.Sprintf("%s %s/%s", rs.workloadKind(), rs.p.GetObjectMeta().GetNamespace(), rs.p.GetObjectMeta().GetName())
+
 	var rejected int
 	res := make(ResourcesSelection[T], 0, len(objects))
 	for namespaceAndName, obj := range objects {
-		var reason string
+		var reason operator.RejectionReason
 		o := obj.(T)
 		err := checkFn(ctx, o)
 		if err != nil {
 			rejected++
-			reason = invalidConfiguration
+			reason = rejectionReasonOf(err)
 			logger.Warn("skipping object", "error", err.Error(), "object", namespaceAndName)
-			rs.eventRecorder.Eventf(obj, v1.EventTypeWarning, operator.InvalidConfigurationEvent, "%q was rejected due to invalid configuration: %v", namespaceAndName, err)
+			rs.eventRecorder.Eventf(obj, v1.EventTypeWarning, string(reason), "%q was rejected by %s: %v", namespaceAndName, workload, err)
 		}
 		res = append(res, struct {
 			resource T
 			key      string
 			err      error
-			reason   string
+			reason   operator.RejectionReason
 		}{
 			resource: o,
 			key:      namespaceAndName,
@@ -213,13 +303,22 @@ func selectObjects[T configurationResource](
 	return res, nil
 }
 
+// workloadKind returns the kind of the workload resource.
+func (rs *ResourceSelector) workloadKind() string {
+	if _, ok := rs.p.(*monitoringv1alpha1.PrometheusAgent); ok {
+		return monitoringv1alpha1.PrometheusAgentsKind
+	}
+
+	return monitoringv1.PrometheusesKind
+}
+
 // SelectServiceMonitors returns the ServiceMonitors that match the selectors in the Prometheus custom resource.
 // This function also populates authentication stores and
 // performs validations against scrape intervals and relabel configs.
 func (rs *ResourceSelector) SelectServiceMonitors(ctx context.Context, listFn ListAllByNamespaceFn) (ResourcesSelection[*monitoringv1.ServiceMonitor], error) {
 	cpf := rs.p.GetCommonPrometheusFields()
 
-	return selectObjects[*monitoringv1.ServiceMonitor](
+	res, err := selectObjects[*monitoringv1.ServiceMonitor](
 		ctx,
 		rs.l.With("kind", monitoringv1.ServiceMonitorsKind),
 		rs,
@@ -229,6 +328,13 @@ func (rs *ResourceSelector) SelectServiceMonitors(ctx context.Context, listFn Li
 		listFn,
 		rs.checkServiceMonitor,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	checkDuplicates(rs, res, rs.serviceMonitorEndpointIdentities)
+
+	return res, nil
 }
 
 // checkServiceMonitor verifies that the ServiceMonitor object is valid.
@@ -303,13 +409,34 @@ func (rs *ResourceSelector) checkServiceMonitor(ctx context.Context, sm *monitor
 
 func (rs *ResourceSelector) ValidateRelabelConfigs(rcs []monitoringv1.RelabelConfig) error {
 	lcv := &LabelConfigValidator{v: rs.version}
-	return lcv.Validate(rcs)
+	return operator.NewRejectionError(operator.InvalidRelabelConfigReason, lcv.Validate(rcs))
+}
+
+// rejectionReasonOf returns the reason of the Accepted condition for the
+// error returned by the validation of a configuration resource.
+func rejectionReasonOf(err error) operator.RejectionReason {
+	var mkErr *assets.MissingKeyError
+	if errors.As(err, &mkErr) {
+		if mkErr.Kind == "ConfigMap" {
+			return operator.MissingConfigMapKeyReason
+		}
+
+		return operator.MissingSecretKeyReason
+	}
+
+	return operator.RejectionReasonOf(err)
+}
+
+// unsupportedVersionError returns an error for a field which isn't supported
+// by the Prometheus version.
+func unsupportedVersionError(format string, a ...any) error {
+	return operator.NewRejectionError(operator.UnsupportedVersionFieldReason, fmt.Errorf(format, a...))
 }
 
 func testForArbitraryFSAccess(e monitoringv1.Endpoint) error {
 	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
 	if e.BearerTokenFile != "" {
-		return errors.New("it accesses file system via bearer token file which Prometheus specification prohibits")
+		return operator.NewRejectionError(operator.FileSystemAccessDeniedReason, errors.New("it accesses file system via bearer token file which Prometheus specification prohibits"))
 	}
 
 	tlsConf := e.TLSConfig
@@ -318,7 +445,7 @@ func testForArbitraryFSAccess(e monitoringv1.Endpoint) error {
 	}
 
 	if tlsConf.CAFile != "" || tlsConf.CertFile != "" || tlsConf.KeyFile != "" {
-		return errors.New("it accesses file system via tls config which Prometheus specification prohibits")
+		return operator.NewRejectionError(operator.FileSystemAccessDeniedReason, errors.New("it accesses file system via tls config which Prometheus specification prohibits"))
 	}
 
 	return nil
@@ -371,11 +498,11 @@ func (lcv *LabelConfigValidator) validate(rc monitoringv1.RelabelConfig) error {
 	action := strings.ToLower(rc.Action)
 
 	if (action == string(relabel.Lowercase) || action == string(relabel.Uppercase)) && !minimumVersionCaseActions {
-		return fmt.Errorf("%s relabel action is only supported from Prometheus version 2.36.0", rc.Action)
+		return unsupportedVersionError("%s relabel action is only supported from Prometheus version 2.36.0", rc.Action)
 	}
 
 	if (action == string(relabel.KeepEqual) || action == string(relabel.DropEqual)) && !minimumVersionEqualActions {
-		return fmt.Errorf("%s relabel action is only supported from Prometheus version 2.41.0", rc.Action)
+		return unsupportedVersionError("%s relabel action is only supported from Prometheus version 2.41.0", rc.Action)
 	}
 
 	if _, err := relabel.NewRegexp(rc.Regex); err != nil {
@@ -446,12 +573,12 @@ func validateScrapeClass(p monitoringv1.PrometheusInterface, sc *string) error {
 		}
 	}
 
-	return fmt.Errorf("scrapeClass %q not found in Prometheus scrapeClasses", *sc)
+	return operator.NewRejectionError(operator.ScrapeClassNotFoundReason, fmt.Errorf("scrapeClass %q not found in Prometheus scrapeClasses", *sc))
 }
 
 func (rs *ResourceSelector) validateMonitorSelectorMechanism(selectorMechanism *monitoringv1.SelectorMechanism) error {
 	if ptr.Deref(selectorMechanism, monitoringv1.SelectorMechanismRelabel) == monitoringv1.SelectorMechanismRole && !rs.version.GTE(semver.MustParse("2.17.0")) {
-		return fmt.Errorf("RoleSelector selectorMechanism is only supported in Prometheus 2.17.0 and newer")
+		return unsupportedVersionError("RoleSelector selectorMechanism is only supported in Prometheus 2.17.0 and newer")
 	}
 
 	return nil
@@ -650,7 +777,7 @@ func validateServer(server string) error {
 func (rs *ResourceSelector) SelectScrapeConfigs(ctx context.Context, listFn ListAllByNamespaceFn) (ResourcesSelection[*monitoringv1alpha1.ScrapeConfig], error) {
 	cpf := rs.p.GetCommonPrometheusFields()
 
-	return selectObjects[*monitoringv1alpha1.ScrapeConfig](
+	res, err := selectObjects[*monitoringv1alpha1.ScrapeConfig](
 		ctx,
 		rs.l.With("kind", monitoringv1alpha1.ScrapeConfigsKind),
 		rs,
@@ -660,6 +787,13 @@ func (rs *ResourceSelector) SelectScrapeConfigs(ctx context.Context, listFn List
 		listFn,
 		rs.checkScrapeConfig,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	checkDuplicates(rs, res, scrapeConfigIdentities)
+
+	return res, nil
 }
 
 // checkScrapeConfig verifies that the ScrapeConfig object is valid.
@@ -711,7 +845,7 @@ func (rs *ResourceSelector) checkScrapeConfig(ctx context.Context, sc *monitorin
 
 	// The Kubernetes API can't do the validation (for now) because kubebuilder validation markers don't work on map keys with custom type.
 	// https://github.com/prometheus-operator/prometheus-operator/issues/6889
-	if err := rs.validateStaticConfig(sc); err != nil {
+	if err := validateStaticConfigs(sc); err != nil {
 		return fmt.Errorf("staticConfigs: %w", err)
 	}
 
@@ -795,11 +929,19 @@ func (rs *ResourceSelector) checkScrapeConfig(ctx context.Context, sc *monitorin
 		return fmt.Errorf("IonosSDConfigs: %w", err)
 	}
 
+	if err := validateCustomResourceSDConfigs(sc); err != nil {
+		return fmt.Errorf("customResourceSDConfigs: %w", err)
+	}
+
 	return nil
 }
 
 func (rs *ResourceSelector) validateKubernetesSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
 	for i, config := range sc.Spec.KubernetesSDConfigs {
+		if config.Role == monitoringv1alpha1.KubernetesRoleEndpointSlice && rs.version.LT(semver.MustParse("2.21.0")) {
+			return unsupportedVersionError("[%d]: the %s role is only supported for Prometheus version >= 2.21.0", i, config.Role)
+		}
+
 		if err := rs.store.AddBasicAuth(ctx, sc.GetNamespace(), config.BasicAuth); err != nil {
 			return fmt.Errorf("[%d]: %w", i, err)
 		}
@@ -820,51 +962,62 @@ func (rs *ResourceSelector) validateKubernetesSDConfigs(ctx context.Context, sc
 			return fmt.Errorf("[%d]: %w", i, err)
 		}
 
-		if config.APIServer != nil && config.Namespaces != nil {
-			if ptr.Deref(config.Namespaces.IncludeOwnNamespace, false) {
-				return fmt.Errorf("[%d]: %w", i, errors.New("cannot use 'apiServer' and 'namespaces.ownNamespace' simultaneously"))
-			}
+		if err := validateKubernetesSDConfig(config); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
 		}
+	}
+
+	return nil
+}
 
-		allowedSelectors := map[string][]string{
-			monitoringv1.RolePod:           {string(monitoringv1.RolePod)},
-			monitoringv1.RoleService:       {string(monitoringv1.RoleService)},
-			monitoringv1.RoleEndpointSlice: {string(monitoringv1.RolePod), string(monitoringv1.RoleService), string(monitoringv1.RoleEndpointSlice)},
-			monitoringv1.RoleEndpoint:      {string(monitoringv1.RolePod), string(monitoringv1.RoleService), string(monitoringv1.RoleEndpoint)},
-			monitoringv1.RoleNode:          {string(monitoringv1.RoleNode)},
-			monitoringv1.RoleIngress:       {string(monitoringv1.RoleIngress)},
+// validateKubernetesSDConfig verifies the constraints of the Kubernetes SD
+// configuration which don't depend on the Prometheus version nor on the
+// referenced objects.
+func validateKubernetesSDConfig(config monitoringv1alpha1.KubernetesSDConfig) error {
+	if config.APIServer != nil && config.Namespaces != nil {
+		if ptr.Deref(config.Namespaces.IncludeOwnNamespace, false) {
+			return errors.New("cannot use 'apiServer' and 'namespaces.ownNamespace' simultaneously")
 		}
+	}
 
-		for _, s := range config.Selectors {
-			configRole := strings.ToLower(string(config.Role))
-			if _, ok := allowedSelectors[configRole]; !ok {
-				return fmt.Errorf("[%d]: invalid role: %q, expecting one of: pod, service, endpoints, endpointslice, node or ingress", i, s.Role)
-			}
+	allowedSelectors := map[string][]string{
+		monitoringv1.RolePod:           {string(monitoringv1.RolePod)},
+		monitoringv1.RoleService:       {string(monitoringv1.RoleService)},
+		monitoringv1.RoleEndpointSlice: {string(monitoringv1.RolePod), string(monitoringv1.RoleService), string(monitoringv1.RoleEndpointSlice)},
+		monitoringv1.RoleEndpoint:      {string(monitoringv1.RolePod), string(monitoringv1.RoleService), string(monitoringv1.RoleEndpoint)},
+		monitoringv1.RoleNode:          {string(monitoringv1.RoleNode)},
+		monitoringv1.RoleIngress:       {string(monitoringv1.RoleIngress)},
+	}
 
-			var allowed bool
+	for _, s := range config.Selectors {
+		configRole := strings.ToLower(string(config.Role))
+		if _, ok := allowedSelectors[configRole]; !ok {
+			return fmt.Errorf("invalid role: %q, expecting one of: pod, service, endpoints, endpointslice, node or ingress", s.Role)
+		}
 
-			for _, role := range allowedSelectors[configRole] {
-				if role == strings.ToLower(string(s.Role)) {
-					allowed = true
-					break
-				}
-			}
-			if !allowed {
-				return fmt.Errorf("[%d] : %s role supports only %s selectors", i, config.Role, strings.Join(allowedSelectors[configRole], ", "))
+		var allowed bool
+
+		for _, role := range allowedSelectors[configRole] {
+			if role == strings.ToLower(string(s.Role)) {
+				allowed = true
+				break
 			}
 		}
+		if !allowed {
+			return fmt.Errorf("%s role supports only %s selectors", config.Role, strings.Join(allowedSelectors[configRole], ", "))
+		}
+	}
 
-		for _, s := range config.Selectors {
-			if s.Field != nil {
-				if _, err := fields.ParseSelector(*s.Field); err != nil {
-					return fmt.Errorf("[%d]: %w", i, err)
-				}
+	for _, s := range config.Selectors {
+		if s.Field != nil {
+			if _, err := fields.ParseSelector(*s.Field); err != nil {
+				return err
 			}
+		}
 
-			if s.Label != nil {
-				if _, err := labels.Parse(*s.Label); err != nil {
-					return fmt.Errorf("[%d]: %w", i, err)
-				}
+		if s.Label != nil {
+			if _, err := labels.Parse(*s.Label); err != nil {
+				return err
 			}
 		}
 	}
@@ -875,15 +1028,15 @@ func (rs *ResourceSelector) validateKubernetesSDConfigs(ctx context.Context, sc
 func (rs *ResourceSelector) validateConsulSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
 	for i, config := range sc.Spec.ConsulSDConfigs {
 		if config.PathPrefix != nil && rs.version.LT(semver.MustParse("2.45.0")) {
-			return fmt.Errorf("field `config.PathPrefix` is only supported for Prometheus version >= 2.45.0")
+			return unsupportedVersionError("field `config.PathPrefix` is only supported for Prometheus version >= 2.45.0")
 		}
 
 		if config.Namespace != nil && rs.version.LT(semver.MustParse("2.28.0")) {
-			return fmt.Errorf("field `config.Namespace` is only supported for Prometheus version >= 2.28.0")
+			return unsupportedVersionError("field `config.Namespace` is only supported for Prometheus version >= 2.28.0")
 		}
 
 		if config.Filter != nil && rs.version.Major < 3 {
-			return fmt.Errorf("field `config.Filter` is only supported for Prometheus version >= 3.0.0")
+			return unsupportedVersionError("field `config.Filter` is only supported for Prometheus version >= 3.0.0")
 		}
 
 		if err := rs.store.AddBasicAuth(ctx, sc.GetNamespace(), config.BasicAuth); err != nil {
@@ -913,8 +1066,8 @@ func (rs *ResourceSelector) validateConsulSDConfigs(ctx context.Context, sc *mon
 }
 
 func (rs *ResourceSelector) validateHTTPSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
-	if rs.version.LT(semver.MustParse("2.28.0")) {
-		return fmt.Errorf("HTTP SD configuration is only supported for Prometheus version >= 2.28.0")
+	if len(sc.Spec.HTTPSDConfigs) > 0 && rs.version.LT(semver.MustParse("2.28.0")) {
+		return unsupportedVersionError("HTTP SD configuration is only supported for Prometheus version >= 2.28.0")
 	}
 
 	for i, config := range sc.Spec.HTTPSDConfigs {
@@ -947,12 +1100,31 @@ func (rs *ResourceSelector) validateHTTPSDConfigs(ctx context.Context, sc *monit
 }
 
 func (rs *ResourceSelector) validateDNSSDConfigs(sc *monitoringv1alpha1.ScrapeConfig) error {
+	minVersions := map[monitoringv1alpha1.DNSRecordType]string{
+		monitoringv1alpha1.DNSRecordTypeNS: "2.49.0",
+		monitoringv1alpha1.DNSRecordTypeMX: "2.38.0",
+	}
+
 	for i, config := range sc.Spec.DNSSDConfigs {
 		if config.Type != nil {
-			if *config.Type != "SRV" && config.Port == nil {
-				return fmt.Errorf("[%d]: %s %q", i, "port required for record type", *config.Type)
+			if v, found := minVersions[*config.Type]; found && rs.version.LT(semver.MustParse(v)) {
+				return unsupportedVersionError("[%d]: the %s record type is only supported for Prometheus version >= %s", i, *config.Type, v)
 			}
 		}
+
+		if err := validateDNSSDConfig(config); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateDNSSDConfig verifies the constraints of the DNS SD configuration
+// which don't depend on the Prometheus version.
+func validateDNSSDConfig(config monitoringv1alpha1.DNSSDConfig) error {
+	if config.Type != nil && *config.Type != "SRV" && config.Port == nil {
+		return fmt.Errorf("port required for record type %q", *config.Type)
 	}
 
 	return nil
@@ -988,11 +1160,15 @@ func (rs *ResourceSelector) validateAzureSDConfigs(ctx context.Context, sc *moni
 	for i, config := range sc.Spec.AzureSDConfigs {
 		authMethod := ptr.Deref(config.AuthenticationMethod, "")
 		if authMethod == "SDK" && rs.version.LT(semver.MustParse("2.52.0")) {
-			return fmt.Errorf("[%d]: SDK authentication is only supported from Prometheus version 2.52.0", i)
+			return unsupportedVersionError("[%d]: SDK authentication is only supported from Prometheus version 2.52.0", i)
 		}
 
 		if config.ResourceGroup != nil && rs.version.LT(semver.MustParse("2.35.0")) {
-			return fmt.Errorf("[%d]: ResourceGroup is only supported from Prometheus version >= 2.35.0", i)
+			return unsupportedVersionError("[%d]: ResourceGroup is only supported from Prometheus version >= 2.35.0", i)
+		}
+
+		if err := validateAzureSDConfig(config); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
 		}
 
 		// Since Prometheus uses default authentication method as "OAuth"
@@ -1000,18 +1176,6 @@ func (rs *ResourceSelector) validateAzureSDConfigs(ctx context.Context, sc *moni
 			continue
 		}
 
-		if len(ptr.Deref(config.TenantID, "")) == 0 {
-			return fmt.Errorf("[%d]: configuration requires a tenantID", i)
-		}
-
-		if len(ptr.Deref(config.ClientID, "")) == 0 {
-			return fmt.Errorf("[%d]: configuration requires a clientID", i)
-		}
-
-		if config.ClientSecret == nil {
-			return fmt.Errorf("[%d]: configuration requires a clientSecret", i)
-		}
-
 		if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), *config.ClientSecret); err != nil {
 			return fmt.Errorf("[%d]: %w", i, err)
 		}
@@ -1041,10 +1205,34 @@ func (rs *ResourceSelector) validateAzureSDConfigs(ctx context.Context, sc *moni
 	return nil
 }
 
+// validateAzureSDConfig verifies that the credentials required by the OAuth
+// authentication method are defined.
+func validateAzureSDConfig(config monitoringv1alpha1.AzureSDConfig) error {
+	// Since Prometheus uses default authentication method as "OAuth"
+	authMethod := ptr.Deref(config.AuthenticationMethod, "")
+	if authMethod == "ManagedIdentity" || authMethod == "SDK" {
+		return nil
+	}
+
+	if len(ptr.Deref(config.TenantID, "")) == 0 {
+		return errors.New("configuration requires a tenantID")
+	}
+
+	if len(ptr.Deref(config.ClientID, "")) == 0 {
+		return errors.New("configuration requires a clientID")
+	}
+
+	if config.ClientSecret == nil {
+		return errors.New("configuration requires a clientSecret")
+	}
+
+	return nil
+}
+
 func (rs *ResourceSelector) validateOpenStackSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
 	for i, config := range sc.Spec.OpenStackSDConfigs {
 		if config.Role == monitoringv1alpha1.OpenStackRoleLoadBalancer && rs.version.LT(semver.MustParse("3.2.0")) {
-			return fmt.Errorf("[%d]: The %s role is only supported from Prometheus version 3.2.0", i, string(config.Role))
+			return unsupportedVersionError("[%d]: The %s role is only supported from Prometheus version 3.2.0", i, string(config.Role))
 		}
 		if config.Password != nil {
 			if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), *config.Password); err != nil {
@@ -1062,8 +1250,8 @@ func (rs *ResourceSelector) validateOpenStackSDConfigs(ctx context.Context, sc *
 }
 
 func (rs *ResourceSelector) validateDigitalOceanSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
-	if rs.version.LT(semver.MustParse("2.20.0")) {
-		return fmt.Errorf("service discovery for Digital Ocean is only supported for Prometheus version >= 2.20.0")
+	if len(sc.Spec.DigitalOceanSDConfigs) > 0 && rs.version.LT(semver.MustParse("2.20.0")) {
+		return unsupportedVersionError("service discovery for Digital Ocean is only supported for Prometheus version >= 2.20.0")
 	}
 
 	for i, config := range sc.Spec.DigitalOceanSDConfigs {
@@ -1118,8 +1306,8 @@ func (rs *ResourceSelector) validateDockerSDConfigs(ctx context.Context, sc *mon
 	return nil
 }
 func (rs *ResourceSelector) validateLinodeSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
-	if !rs.version.GTE(semver.MustParse("2.28.0")) {
-		return fmt.Errorf("linode SD configuration is only supported for Prometheus version >= 2.28.0")
+	if len(sc.Spec.LinodeSDConfigs) > 0 && !rs.version.GTE(semver.MustParse("2.28.0")) {
+		return unsupportedVersionError("linode SD configuration is only supported for Prometheus version >= 2.28.0")
 	}
 
 	for i, config := range sc.Spec.LinodeSDConfigs {
@@ -1251,8 +1439,8 @@ func (rs *ResourceSelector) validateNomadSDConfigs(ctx context.Context, sc *moni
 }
 
 func (rs *ResourceSelector) validateDockerSwarmSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
-	if rs.version.LT(semver.MustParse("2.20.0")) {
-		return fmt.Errorf("dockerswarm SD configuration is only supported for Prometheus version >= 2.20.0")
+	if len(sc.Spec.DockerSwarmSDConfigs) > 0 && rs.version.LT(semver.MustParse("2.20.0")) {
+		return unsupportedVersionError("dockerswarm SD configuration is only supported for Prometheus version >= 2.20.0")
 	}
 
 	for i, config := range sc.Spec.DockerSwarmSDConfigs {
@@ -1285,21 +1473,14 @@ func (rs *ResourceSelector) validateDockerSwarmSDConfigs(ctx context.Context, sc
 }
 
 func (rs *ResourceSelector) validatePuppetDBSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
-	if rs.version.LT(semver.MustParse("2.31.0")) {
-		return fmt.Errorf("puppetDB SD configuration is only supported for Prometheus version >= 2.31.0")
+	if len(sc.Spec.PuppetDBSDConfigs) > 0 && rs.version.LT(semver.MustParse("2.31.0")) {
+		return unsupportedVersionError("puppetDB SD configuration is only supported for Prometheus version >= 2.31.0")
 	}
 
 	for i, config := range sc.Spec.PuppetDBSDConfigs {
-		parsedURL, err := url.Parse(config.URL)
-		if err != nil {
+		if err := validatePuppetDBURL(config.URL); err != nil {
 			return fmt.Errorf("[%d]: %w", i, err)
 		}
-		if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
-			return fmt.Errorf("[%d]: URL scheme must be 'http' or 'https'", i)
-		}
-		if parsedURL.Host == "" {
-			return fmt.Errorf("[%d]: host is missing in URL", i)
-		}
 
 		if err := rs.store.AddSafeAuthorizationCredentials(ctx, sc.GetNamespace(), config.Authorization); err != nil {
 			return fmt.Errorf("[%d]: %w", i, err)
@@ -1325,9 +1506,26 @@ func (rs *ResourceSelector) validatePuppetDBSDConfigs(ctx context.Context, sc *m
 	return nil
 }
 
+func validatePuppetDBURL(u string) error {
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return errors.New("URL scheme must be 'http' or 'https'")
+	}
+
+	if parsedURL.Host == "" {
+		return errors.New("host is missing in URL")
+	}
+
+	return nil
+}
+
 func (rs *ResourceSelector) validateLightSailSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
-	if rs.version.LT(semver.MustParse("2.27.0")) {
-		return fmt.Errorf("lightSail SD configuration is only supported for Prometheus version >= 2.27.0")
+	if len(sc.Spec.LightSailSDConfigs) > 0 && rs.version.LT(semver.MustParse("2.27.0")) {
+		return unsupportedVersionError("lightSail SD configuration is only supported for Prometheus version >= 2.27.0")
 	}
 
 	for i, config := range sc.Spec.LightSailSDConfigs {
@@ -1367,8 +1565,8 @@ func (rs *ResourceSelector) validateLightSailSDConfigs(ctx context.Context, sc *
 }
 
 func (rs *ResourceSelector) validateOVHCloudSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
-	if rs.version.LT(semver.MustParse("2.40.0")) {
-		return fmt.Errorf("OVHCloud SD configuration is only supported for Prometheus version >= 2.40.0")
+	if len(sc.Spec.OVHCloudSDConfigs) > 0 && rs.version.LT(semver.MustParse("2.40.0")) {
+		return unsupportedVersionError("OVHCloud SD configuration is only supported for Prometheus version >= 2.40.0")
 	}
 
 	for i, config := range sc.Spec.OVHCloudSDConfigs {
@@ -1384,8 +1582,8 @@ func (rs *ResourceSelector) validateOVHCloudSDConfigs(ctx context.Context, sc *m
 }
 
 func (rs *ResourceSelector) validateScalewaySDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
-	if rs.version.LT(semver.MustParse("2.26.0")) {
-		return fmt.Errorf("ScaleWay SD configuration is only supported for Prometheus version >= 2.26.0")
+	if len(sc.Spec.ScalewaySDConfigs) > 0 && rs.version.LT(semver.MustParse("2.26.0")) {
+		return unsupportedVersionError("ScaleWay SD configuration is only supported for Prometheus version >= 2.26.0")
 	}
 
 	for i, config := range sc.Spec.ScalewaySDConfigs {
@@ -1405,7 +1603,7 @@ func (rs *ResourceSelector) validateScalewaySDConfigs(ctx context.Context, sc *m
 	return nil
 }
 
-func (rs *ResourceSelector) validateStaticConfig(sc *monitoringv1alpha1.ScrapeConfig) error {
+func validateStaticConfigs(sc *monitoringv1alpha1.ScrapeConfig) error {
 	for i, config := range sc.Spec.StaticConfigs {
 		for labelName := range config.Labels {
 			if !model.LabelName(labelName).IsValid() {
@@ -1418,8 +1616,8 @@ func (rs *ResourceSelector) validateStaticConfig(sc *monitoringv1alpha1.ScrapeCo
 }
 
 func (rs *ResourceSelector) validateIonosSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
-	if rs.version.LT(semver.MustParse("2.36.0")) {
-		return fmt.Errorf("IONOS SD configuration is only supported for Prometheus version >= 2.36.0")
+	if len(sc.Spec.IonosSDConfigs) > 0 && rs.version.LT(semver.MustParse("2.36.0")) {
+		return unsupportedVersionError("IONOS SD configuration is only supported for Prometheus version >= 2.36.0")
 	}
 
 	for i, config := range sc.Spec.IonosSDConfigs {
@@ -1441,3 +1639,29 @@ func (rs *ResourceSelector) validateIonosSDConfigs(ctx context.Context, sc *moni
 	}
 	return nil
 }
+
+func validateCustomResourceSDConfigs(sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.CustomResourceSDConfigs {
+		if config.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(config.Selector); err != nil {
+				return fmt.Errorf("[%d]: invalid selector: %w", i, err)
+			}
+		}
+
+		if _, err := compileCELExpression(config.Address, true); err != nil {
+			return fmt.Errorf("[%d]: address: %w", i, err)
+		}
+
+		for labelName, expr := range config.Labels {
+			if !model.LabelName(labelName).IsValid() {
+				return fmt.Errorf("[%d]: invalid label name %s", i, labelName)
+			}
+
+			if _, err := compileCELExpression(expr, false); err != nil {
+				return fmt.Errorf("[%d]: label %s: %w", i, labelName, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ package prometheus
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"os"
 	"testing"
@@ -1314,6 +1315,20 @@ func TestSelectServiceMonitors(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			scenario: "cross-namespace bearer token secret",
+			updateSpec: func(sm *monitoringv1.ServiceMonitorSpec) {
+				sm.Endpoints = append(sm.Endpoints, monitoringv1.Endpoint{
+					BearerTokenSecret: &v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{
+							Name: "test/secret",
+						},
+						Key: "key1",
+					},
+				})
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.scenario, func(t *testing.T) {
 			cs := fake.NewSimpleClientset(
@@ -1394,6 +1409,233 @@ func TestSelectServiceMonitors(t *testing.T) {
 	}
 }
 
+func TestSelectServiceMonitorsWithDuplicates(t *testing.T) {
+	newServiceMonitor := func(name, port string) *monitoringv1.ServiceMonitor {
+		return &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+			},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "foo"},
+				},
+				Endpoints: []monitoringv1.Endpoint{
+					{Port: port},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		policy *monitoringv1.DuplicateTargetsPolicy
+		valid  []string
+	}{
+		{
+			name:  "default policy",
+			valid: []string{"test/a", "test/b", "test/c"},
+		},
+		{
+			name:   "warn policy",
+			policy: ptr.To(monitoringv1.WarnDuplicateTargetsPolicy),
+			valid:  []string{"test/a", "test/b", "test/c"},
+		},
+		{
+			name:   "drop policy",
+			policy: ptr.To(monitoringv1.DropDuplicateTargetsPolicy),
+			valid:  []string{"test/a", "test/c"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			rs, err := NewResourceSelector(
+				newLogger(),
+				&monitoringv1.Prometheus{
+					Spec: monitoringv1.PrometheusSpec{
+						CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+							DuplicateTargetsPolicy: tc.policy,
+						},
+					},
+				},
+				assets.NewStoreBuilder(fake.NewSimpleClientset().CoreV1(), fake.NewSimpleClientset().CoreV1()),
+				nil,
+				operator.NewMetrics(prometheus.NewPedanticRegistry()),
+				recorder,
+			)
+			require.NoError(t, err)
+
+			sms, err := rs.SelectServiceMonitors(context.Background(), func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+				appendFn(newServiceMonitor("b", "web"))
+				appendFn(newServiceMonitor("a", "web"))
+				appendFn(newServiceMonitor("c", "metrics"))
+				return nil
+			})
+			require.NoError(t, err)
+			require.Len(t, sms, 3)
+
+			valid := sms.ValidResources()
+			require.Len(t, valid, len(tc.valid))
+			for _, k := range tc.valid {
+				require.Contains(t, valid, k)
+			}
+
+			// Both duplicated resources get a warning event.
+			require.Len(t, recorder.Events, 2)
+			for range 2 {
+				require.Contains(t, <-recorder.Events, "\"test/b\" scrapes the same targets with the same labels as test/a")
+			}
+
+			require.Equal(t, []string{"\"test/b\" scrapes the same targets with the same labels as test/a"}, rs.DuplicateTargets())
+		})
+	}
+}
+
+func TestDuplicateTargetsTracker(t *testing.T) {
+	tracker := NewDuplicateTargetsTracker()
+
+	// No selection recorded yet.
+	require.Empty(t, tracker.Conditions("ns/test", 1))
+
+	tracker.Set("ns/test", nil)
+	conditions := tracker.Conditions("ns/test", 1)
+	require.Len(t, conditions, 1)
+	require.Equal(t, monitoringv1.DuplicateTargets, conditions[0].Type)
+	require.Equal(t, monitoringv1.ConditionFalse, conditions[0].Status)
+	require.Equal(t, int64(1), conditions[0].ObservedGeneration)
+
+	tracker.Set("ns/test", []string{"a", "b"})
+	conditions = tracker.Conditions("ns/test", 2)
+	require.Len(t, conditions, 1)
+	require.Equal(t, monitoringv1.ConditionTrue, conditions[0].Status)
+	require.Equal(t, string(operator.DuplicateTargetsReason), conditions[0].Reason)
+	require.Equal(t, "a; b", conditions[0].Message)
+
+	tracker.Forget("ns/test")
+	require.Empty(t, tracker.Conditions("ns/test", 2))
+}
+
+func TestServiceMonitorRejectionReason(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		endpoint monitoringv1.Endpoint
+		expected operator.RejectionReason
+	}{
+		{
+			name: "invalid relabel config",
+			endpoint: monitoringv1.Endpoint{
+				RelabelConfigs: []monitoringv1.RelabelConfig{{Action: "hashmod", TargetLabel: "foo"}},
+			},
+			expected: operator.InvalidRelabelConfigReason,
+		},
+		{
+			name: "relabel action unsupported by the version",
+			endpoint: monitoringv1.Endpoint{
+				RelabelConfigs: []monitoringv1.RelabelConfig{{Action: "lowercase", TargetLabel: "foo"}},
+			},
+			expected: operator.UnsupportedVersionFieldReason,
+		},
+		{
+			name: "missing secret",
+			endpoint: monitoringv1.Endpoint{
+				BasicAuth: &monitoringv1.BasicAuth{
+					Username: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "missing"}, Key: "username"},
+				},
+			},
+			expected: operator.MissingSecretKeyReason,
+		},
+		{
+			name: "missing secret key",
+			endpoint: monitoringv1.Endpoint{
+				BasicAuth: &monitoringv1.BasicAuth{
+					Username: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "secret"}, Key: "missing"},
+				},
+			},
+			expected: operator.MissingSecretKeyReason,
+		},
+		{
+			name: "missing configmap key",
+			endpoint: monitoringv1.Endpoint{
+				TLSConfig: &monitoringv1.TLSConfig{
+					SafeTLSConfig: monitoringv1.SafeTLSConfig{
+						CA: monitoringv1.SecretOrConfigMap{
+							ConfigMap: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "configmap"}, Key: "missing"},
+						},
+					},
+				},
+			},
+			expected: operator.MissingConfigMapKeyReason,
+		},
+		{
+			name: "file system access",
+			endpoint: monitoringv1.Endpoint{
+				TLSConfig: &monitoringv1.TLSConfig{CAFile: "/etc/ca.crt"},
+			},
+			expected: operator.FileSystemAccessDeniedReason,
+		},
+		{
+			name: "invalid scrape timeout",
+			endpoint: monitoringv1.Endpoint{
+				Interval:      "10s",
+				ScrapeTimeout: "20s",
+			},
+			expected: operator.InvalidConfigurationReason,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := fake.NewSimpleClientset(
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "test"},
+					Data:       map[string][]byte{"username": []byte("user")},
+				},
+				&v1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "configmap", Namespace: "test"},
+					Data:       map[string]string{"ca": "ca"},
+				},
+			)
+
+			recorder := record.NewFakeRecorder(1)
+			rs, err := NewResourceSelector(
+				newLogger(),
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{Name: "k8s", Namespace: "monitoring"},
+					Spec: monitoringv1.PrometheusSpec{
+						CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+							Version: "v2.30.0",
+							ArbitraryFSAccessThroughSMs: monitoringv1.ArbitraryFSAccessThroughSMsConfig{
+								Deny: true,
+							},
+						},
+					},
+				},
+				assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1()),
+				nil,
+				operator.NewMetrics(prometheus.NewPedanticRegistry()),
+				recorder,
+			)
+			require.NoError(t, err)
+
+			sms, err := rs.SelectServiceMonitors(context.Background(), func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+				appendFn(&monitoringv1.ServiceMonitor{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+					Spec: monitoringv1.ServiceMonitorSpec{
+						Endpoints: []monitoringv1.Endpoint{tc.endpoint},
+					},
+				})
+				return nil
+			})
+			require.NoError(t, err)
+			require.Len(t, sms, 1)
+			require.Error(t, sms[0].err)
+			require.Equal(t, tc.expected, sms[0].reason)
+
+			// The event is recorded for the rejected resource.
+			require.Len(t, recorder.Events, 1)
+			require.Contains(t, <-recorder.Events, fmt.Sprintf("Warning %s \"test/test\" was rejected by Prometheus monitoring/k8s: ", tc.expected))
+		})
+	}
+}
+
 func TestSelectPodMonitors(t *testing.T) {
 	for _, tc := range []struct {
 		scenario    string
@@ -1979,7 +2221,7 @@ func TestSelectScrapeConfigs(t *testing.T) {
 					},
 				}
 			},
-			valid:       false,
+			valid:       true,
 			promVersion: "2.29.0",
 		},
 		{
@@ -2025,7 +2267,7 @@ func TestSelectScrapeConfigs(t *testing.T) {
 					},
 				}
 			},
-			valid:       false,
+			valid:       true,
 			promVersion: "2.29.0",
 		},
 		{
@@ -2315,7 +2557,7 @@ func TestSelectScrapeConfigs(t *testing.T) {
 			valid:       true,
 		},
 		{
-			scenario: "Kubernetes SD config with Role Pod but wrong version",
+			scenario: "Kubernetes SD config with Role Pod and old version",
 			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
 				sc.KubernetesSDConfigs = []monitoringv1alpha1.KubernetesSDConfig{
 					{
@@ -2324,7 +2566,7 @@ func TestSelectScrapeConfigs(t *testing.T) {
 				}
 			},
 			promVersion: "2.31.0",
-			valid:       false,
+			valid:       true,
 		},
 		{
 			scenario: "Kubernetes SD config with Role Endpoint",
@@ -2339,7 +2581,7 @@ func TestSelectScrapeConfigs(t *testing.T) {
 			valid:       true,
 		},
 		{
-			scenario: "Kubernetes SD config with Role Endpoint but wrong version",
+			scenario: "Kubernetes SD config with Role Endpoint and old version",
 			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
 				sc.KubernetesSDConfigs = []monitoringv1alpha1.KubernetesSDConfig{
 					{
@@ -2348,7 +2590,7 @@ func TestSelectScrapeConfigs(t *testing.T) {
 				}
 			},
 			promVersion: "2.31.0",
-			valid:       false,
+			valid:       true,
 		},
 		{
 			scenario: "Kubernetes SD config with Role EndpointSlice",
@@ -2371,7 +2613,7 @@ func TestSelectScrapeConfigs(t *testing.T) {
 					},
 				}
 			},
-			promVersion: "2.31.0",
+			promVersion: "2.20.0",
 			valid:       false,
 		},
 		{
@@ -2743,6 +2985,46 @@ func TestSelectScrapeConfigs(t *testing.T) {
 			promVersion: "2.51.0",
 			valid:       true,
 		},
+		{
+			scenario: "DNS SD config with NS record type and unsupported version",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.DNSSDConfigs = []monitoringv1alpha1.DNSSDConfig{
+					{
+						Names: []string{"node.demo.do.prometheus.io"},
+						Type:  ptr.To(monitoringv1alpha1.DNSRecordTypeNS),
+						Port:  ptr.To(int32(9900)),
+					},
+				}
+			},
+			promVersion: "2.48.0",
+			valid:       false,
+		},
+		{
+			scenario: "DNS SD config with MX record type and unsupported version",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.DNSSDConfigs = []monitoringv1alpha1.DNSSDConfig{
+					{
+						Names: []string{"node.demo.do.prometheus.io"},
+						Type:  ptr.To(monitoringv1alpha1.DNSRecordTypeMX),
+						Port:  ptr.To(int32(9900)),
+					},
+				}
+			},
+			promVersion: "2.37.0",
+			valid:       false,
+		},
+		{
+			scenario: "DNS SD config with old Prometheus version",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.DNSSDConfigs = []monitoringv1alpha1.DNSSDConfig{
+					{
+						Names: []string{"node.demo.do.prometheus.io"},
+					},
+				}
+			},
+			promVersion: "2.19.0",
+			valid:       true,
+		},
 		{
 			scenario: "EC2 SD config with valid secret ref",
 			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
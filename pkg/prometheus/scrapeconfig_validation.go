@@ -0,0 +1,74 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+// ValidateScrapeConfig verifies the fields of the ScrapeConfig which don't
+// depend on the Prometheus version nor on the referenced Secrets and
+// ConfigMaps: the relabeling configurations and the constraints of the
+// service discovery configurations. It returns all the errors found.
+//
+// It is used by the admission webhook. The resource is validated again
+// against the Prometheus version when a Prometheus object selects it.
+func ValidateScrapeConfig(sc *monitoringv1alpha1.ScrapeConfig) []error {
+	// The relabel actions are validated against the default version: the
+	// actions unsupported by older versions are rejected at selection
+	// time.
+	lcv, err := NewLabelConfigValidator(&monitoringv1.Prometheus{})
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	check := func(field string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", field, err))
+		}
+	}
+
+	check("relabelings", lcv.Validate(sc.Spec.RelabelConfigs))
+	check("metricRelabelings", lcv.Validate(sc.Spec.MetricRelabelConfigs))
+	check("staticConfigs", validateStaticConfigs(sc))
+
+	for i, config := range sc.Spec.KubernetesSDConfigs {
+		check(fmt.Sprintf("kubernetesSDConfigs[%d]", i), validateKubernetesSDConfig(config))
+	}
+
+	for i, config := range sc.Spec.DNSSDConfigs {
+		check(fmt.Sprintf("dnsSDConfigs[%d]", i), validateDNSSDConfig(config))
+	}
+
+	for i, config := range sc.Spec.AzureSDConfigs {
+		check(fmt.Sprintf("azureSDConfigs[%d]", i), validateAzureSDConfig(config))
+	}
+
+	for i, config := range sc.Spec.KumaSDConfigs {
+		check(fmt.Sprintf("kumaSDConfigs[%d]", i), validateServer(config.Server))
+	}
+
+	for i, config := range sc.Spec.PuppetDBSDConfigs {
+		check(fmt.Sprintf("puppetDBSDConfigs[%d]", i), validatePuppetDBURL(config.URL))
+	}
+
+	check("customResourceSDConfigs", validateCustomResourceSDConfigs(sc))
+
+	return errs
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// SelectionPattern is the HTTP route pattern of the SelectionReports handler.
+const SelectionPattern = "GET /api/v1/prometheuses/{namespace}/{name}/selected"
+
+// SelectionReport lists the configuration resources matching the selectors
+// of a Prometheus resource and whether they have been accepted.
+type SelectionReport struct {
+	ServiceMonitors []operator.SelectionResult `json:"serviceMonitors"`
+	PodMonitors     []operator.SelectionResult `json:"podMonitors"`
+	Probes          []operator.SelectionResult `json:"probes"`
+	ScrapeConfigs   []operator.SelectionResult `json:"scrapeConfigs"`
+	PrometheusRules []operator.SelectionResult `json:"prometheusRules"`
+}
+
+// Summary returns the number of selected and rejected resources by kind.
+func (r SelectionReport) Summary() *monitoringv1.SelectedResourcesStatus {
+	return &monitoringv1.SelectedResourcesStatus{
+		ServiceMonitors: selectionSummary(r.ServiceMonitors),
+		PodMonitors:     selectionSummary(r.PodMonitors),
+		Probes:          selectionSummary(r.Probes),
+		ScrapeConfigs:   selectionSummary(r.ScrapeConfigs),
+		PrometheusRules: selectionSummary(r.PrometheusRules),
+	}
+}
+
+func selectionSummary(results []operator.SelectionResult) *monitoringv1.SelectionSummary {
+	summary := &monitoringv1.SelectionSummary{Selected: int32(len(results))}
+	for _, r := range results {
+		if !r.Accepted {
+			summary.Rejected++
+		}
+	}
+
+	return summary
+}
+
+// SelectionResults returns the outcome of the selection for each resource,
+// sorted by namespace and name.
+func SelectionResults[T configurationResource](resources ResourcesSelection[T]) []operator.SelectionResult {
+	results := make([]operator.SelectionResult, 0, len(resources))
+	for _, res := range resources {
+		namespace, name, _ := cache.SplitMetaNamespaceKey(res.key)
+		r := operator.SelectionResult{
+			Namespace: namespace,
+			Name:      name,
+			Accepted:  res.err == nil,
+		}
+
+		if res.err != nil {
+			r.Reason = string(res.reason)
+			r.Message = res.err.Error()
+		}
+
+		results = append(results, r)
+	}
+
+	operator.SortSelectionResults(results)
+	return results
+}
+
+// SelectionReports records the last selection report of each Prometheus
+// resource.
+type SelectionReports struct {
+	mtx     sync.RWMutex
+	reports map[string]*SelectionReport
+}
+
+// NewSelectionReports returns an empty SelectionReports.
+func NewSelectionReports() *SelectionReports {
+	return &SelectionReports{
+		reports: map[string]*SelectionReport{},
+	}
+}
+
+// Update modifies the report of the Prometheus resource identified by its
+// "<namespace>/<name>" key.
+func (sr *SelectionReports) Update(key string, fn func(*SelectionReport)) {
+	sr.mtx.Lock()
+	defer sr.mtx.Unlock()
+
+	r, found := sr.reports[key]
+	if !found {
+		r = &SelectionReport{}
+		sr.reports[key] = r
+	}
+
+	fn(r)
+}
+
+// Get returns a copy of the report of the Prometheus resource.
+func (sr *SelectionReports) Get(key string) (SelectionReport, bool) {
+	sr.mtx.RLock()
+	defer sr.mtx.RUnlock()
+
+	r, found := sr.reports[key]
+	if !found {
+		return SelectionReport{}, false
+	}
+
+	return *r, true
+}
+
+// Delete forgets the report of the Prometheus resource.
+func (sr *SelectionReports) Delete(key string) {
+	sr.mtx.Lock()
+	defer sr.mtx.Unlock()
+
+	delete(sr.reports, key)
+}
+
+// ServeHTTP implements the http.Handler interface. The request's path must
+// match SelectionPattern.
+func (sr *SelectionReports) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	key := req.PathValue("namespace") + "/" + req.PathValue("name")
+
+	r, found := sr.Get(key)
+	if !found {
+		http.Error(w, fmt.Sprintf("no selection recorded for Prometheus %q", key), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(r)
+}
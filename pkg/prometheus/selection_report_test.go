@@ -0,0 +1,81 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestSelectionReports(t *testing.T) {
+	smons := ResourcesSelection[*monitoringv1.ServiceMonitor]{
+		{key: "ns2/valid"},
+		{key: "ns1/invalid", err: errors.New("invalid relabeling"), reason: operator.InvalidConfigurationReason},
+	}
+
+	sr := NewSelectionReports()
+	sr.Update("default/main", func(r *SelectionReport) {
+		r.ServiceMonitors = SelectionResults(smons)
+	})
+	sr.Update("default/main", func(r *SelectionReport) {
+		r.PrometheusRules = []operator.SelectionResult{{Namespace: "ns1", Name: "rules", Accepted: true}}
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle(SelectionPattern, sr)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/prometheuses/default/main/selected", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var report SelectionReport
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&report))
+	require.Equal(t, []operator.SelectionResult{
+		{Namespace: "ns1", Name: "invalid", Reason: string(operator.InvalidConfigurationReason), Message: "invalid relabeling"},
+		{Namespace: "ns2", Name: "valid", Accepted: true},
+	}, report.ServiceMonitors)
+	require.Equal(t, []operator.SelectionResult{{Namespace: "ns1", Name: "rules", Accepted: true}}, report.PrometheusRules)
+
+	sr.Delete("default/main")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/prometheuses/default/main/selected", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSelectionReportSummary(t *testing.T) {
+	r := SelectionReport{
+		ServiceMonitors: []operator.SelectionResult{
+			{Namespace: "ns1", Name: "invalid", Reason: string(operator.InvalidConfigurationReason)},
+			{Namespace: "ns2", Name: "valid", Accepted: true},
+		},
+		PrometheusRules: []operator.SelectionResult{{Namespace: "ns1", Name: "rules", Accepted: true}},
+	}
+
+	require.Equal(t, &monitoringv1.SelectedResourcesStatus{
+		ServiceMonitors: &monitoringv1.SelectionSummary{Selected: 2, Rejected: 1},
+		PodMonitors:     &monitoringv1.SelectionSummary{},
+		Probes:          &monitoringv1.SelectionSummary{},
+		ScrapeConfigs:   &monitoringv1.SelectionSummary{},
+		PrometheusRules: &monitoringv1.SelectionSummary{Selected: 1},
+	}, r.Summary())
+}
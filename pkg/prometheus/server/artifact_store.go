@@ -0,0 +1,80 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+// configArtifactKey returns the key of the configuration in the artifact
+// store.
+func configArtifactKey(p *monitoringv1.Prometheus) operator.ArtifactKey {
+	return operator.ArtifactKey{
+		Namespace: p.Namespace,
+		Name:      prompkg.ConfigSecretName(p),
+	}
+}
+
+// mountConfigFromArtifactStore replaces the configuration Secret volume of the
+// StatefulSet by the volume of the artifact store.
+func mountConfigFromArtifactStore(sset *appsv1.StatefulSet, vs v1.VolumeSource) {
+	for i := range sset.Spec.Template.Spec.Volumes {
+		if sset.Spec.Template.Spec.Volumes[i].Name == "config" {
+			sset.Spec.Template.Spec.Volumes[i].VolumeSource = vs
+			return
+		}
+	}
+}
+
+// syncArtifactStore adds the artifact store finalizer to the Prometheus
+// object when an artifact store is configured. When the object is being
+// deleted, it removes the configuration from the store (unless the deletion
+// policy retains the managed objects) before removing the finalizer.
+//
+// It returns true if the finalizers of the object have been modified.
+func (c *Operator) syncArtifactStore(ctx context.Context, p *monitoringv1.Prometheus, logger *slog.Logger) (bool, error) {
+	if !c.rr.DeletionInProgress(p) {
+		return c.finalizerSyncer.SyncFinalizer(ctx, p, logger, k8sutil.ArtifactStoreFinalizerName, c.artifactStore != nil)
+	}
+
+	if !slices.Contains(p.GetFinalizers(), k8sutil.ArtifactStoreFinalizerName) {
+		return false, nil
+	}
+
+	// The store may have been removed from the operator's configuration
+	// after the finalizer was added, in which case the finalizer is removed
+	// without further action.
+	if c.artifactStore != nil && ptr.Deref(p.Spec.DeletionPolicy, "") != monitoringv1.DeletionPolicyRetain {
+		key := configArtifactKey(p)
+		if err := c.artifactStore.Delete(ctx, key); err != nil {
+			return false, fmt.Errorf("failed to delete the configuration from the artifact store: %w", err)
+		}
+		logger.Info("artifact deleted", "artifact", key)
+	}
+
+	return c.finalizerSyncer.SyncFinalizer(ctx, p, logger, k8sutil.ArtifactStoreFinalizerName, false)
+}
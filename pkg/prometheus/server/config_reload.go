@@ -0,0 +1,137 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+// pollConfigReload refreshes regularly the status of the configuration
+// reloads triggered by the config-reloader sidecars of the Prometheus pods.
+func (c *Operator) pollConfigReload(ctx context.Context) {
+	ticker := time.NewTicker(operator.ConfigReloadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.refreshConfigReload(ctx)
+		}
+	}
+}
+
+// refreshConfigReload collects concurrently the reload status from the ready
+// pods of all the Prometheus objects. Only the objects whose condition
+// changed are enqueued for a status update.
+func (c *Operator) refreshConfigReload(ctx context.Context) {
+	var (
+		objects = map[string]*monitoringv1.Prometheus{}
+		pods    = map[string][]v1.Pod{}
+	)
+	_ = c.promInfs.ListAll(labels.Everything(), func(o interface{}) {
+		p := o.(*monitoringv1.Prometheus)
+		key := p.Namespace + "/" + p.Name
+
+		// The config-reloader's web server isn't reachable when
+		// listenLocal is true and it uses the same TLS configuration as
+		// Prometheus. There's no config-reloader with the OperatorExec
+		// reload strategy.
+		cpf := p.GetCommonPrometheusFields()
+		if cpf.ListenLocal || cpf.PrometheusURIScheme() != "http" || prompkg.UsesOperatorExecReload(p) {
+			if c.configReload.Forget(key) {
+				c.rr.EnqueueForStatus(p)
+			}
+			return
+		}
+
+		objects[key] = p
+		pods[key] = []v1.Pod{}
+		if err := c.podInfs.ListAllByNamespace(p.Namespace, labels.SelectorFromSet(makeSelectorLabels(p.Name)), func(o interface{}) {
+			pods[key] = append(pods[key], *o.(*v1.Pod))
+		}); err != nil {
+			c.logger.Debug("failed to list pods", "key", key, "err", err)
+		}
+	})
+
+	changed := c.configReload.RefreshAll(ctx, c.logger, pods)
+
+	keys := map[string]struct{}{}
+	for key, p := range objects {
+		keys[key] = struct{}{}
+		c.configReload.ReportPersistentFailures(c.eventRecorder, p, key)
+
+		if _, found := changed[key]; found {
+			c.rr.EnqueueForStatus(p)
+		}
+	}
+	c.configReload.Retain(keys)
+}
+
+// syncExecReload reloads the configuration of the pods with the Kubernetes
+// exec API when the OperatorExec reload strategy is used. The exec requests
+// can't be sent as dry-run requests hence nothing happens in audit mode.
+func (c *Operator) syncExecReload(ctx context.Context, logger *slog.Logger, key string, p *monitoringv1.Prometheus, ruleFiles map[string]string) error {
+	if !prompkg.UsesOperatorExecReload(p) {
+		c.execReloader.Forget(key)
+		return nil
+	}
+
+	if c.auditMode {
+		logger.Debug("the OperatorExec reload strategy is disabled in audit mode, skipping the configuration reload")
+		return nil
+	}
+
+	if c.artifactStore != nil {
+		logger.Warn("the OperatorExec reload strategy isn't supported with the artifact store, skipping the configuration reload")
+		return nil
+	}
+
+	requeue, err := c.execReloader.Sync(ctx, key, p, c.podInfs.ListAllByNamespace, labels.SelectorFromSet(makeSelectorLabels(p.Name)), rulesHash(ruleFiles))
+	if err != nil {
+		return err
+	}
+
+	if requeue > 0 {
+		// Signal the pods again until the kubelet has propagated the
+		// updates of the rule files.
+		c.rr.EnqueueForReconciliationAfter(p, requeue)
+	}
+
+	return nil
+}
+
+// rulesHash returns a hash over the content of the rule files.
+func rulesHash(ruleFiles map[string]string) string {
+	h := sha256.New()
+	for _, name := range sortutil.SortedKeys(ruleFiles) {
+		fmt.Fprintf(h, "%s\x00%s\x00", name, ruleFiles[name])
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// retainedResources are the types of the objects orphaned by the Retain
+// deletion policy.
+var retainedResources = []schema.GroupVersionResource{
+	appsv1.SchemeGroupVersion.WithResource("statefulsets"),
+	v1.SchemeGroupVersion.WithResource(string(v1.ResourceSecrets)),
+	v1.SchemeGroupVersion.WithResource(string(v1.ResourceConfigMaps)),
+	v1.SchemeGroupVersion.WithResource(string(v1.ResourceServices)),
+}
+
+// syncDeletionPolicy adds the deletion policy finalizer to the Prometheus
+// object when `spec.deletionPolicy` is defined (and removes it otherwise).
+// When the object is being deleted, it applies the deletion policy before
+// removing the finalizer.
+//
+// It returns true if the finalizers of the object have been modified.
+func (c *Operator) syncDeletionPolicy(ctx context.Context, p *monitoringv1.Prometheus, logger *slog.Logger) (bool, error) {
+	if !c.rr.DeletionInProgress(p) {
+		return c.finalizerSyncer.SyncFinalizer(ctx, p, logger, k8sutil.DeletionPolicyFinalizerName, p.Spec.DeletionPolicy != nil)
+	}
+
+	if !slices.Contains(p.GetFinalizers(), k8sutil.DeletionPolicyFinalizerName) {
+		return false, nil
+	}
+
+	// The policy may have been removed after the finalizer was added, in
+	// which case the finalizer is removed without further action.
+	switch ptr.Deref(p.Spec.DeletionPolicy, "") {
+	case monitoringv1.DeletionPolicyRetain:
+		orphaned, err := k8sutil.OrphanChildren(ctx, c.mdClient, p, operator.ManagedByOperatorSelector(), retainedResources...)
+		for _, ref := range orphaned {
+			logger.Info("object retained", "object", ref)
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to retain the managed objects: %w", err)
+		}
+
+	case monitoringv1.DeletionPolicyDelete:
+		if err := c.deletePersistentVolumeClaims(ctx, p, logger); err != nil {
+			return false, err
+		}
+	}
+
+	return c.finalizerSyncer.SyncFinalizer(ctx, p, logger, k8sutil.DeletionPolicyFinalizerName, false)
+}
+
+// deletePersistentVolumeClaims deletes the claims created from the volume
+// claim templates of the StatefulSets controlled by the Prometheus object.
+// The StatefulSets are garbage-collected once the object is deleted.
+func (c *Operator) deletePersistentVolumeClaims(ctx context.Context, p *monitoringv1.Prometheus, logger *slog.Logger) error {
+	ssets, err := c.kclient.AppsV1().StatefulSets(p.Namespace).List(ctx, metav1.ListOptions{LabelSelector: operator.ManagedByOperatorSelector().String()})
+	if err != nil {
+		return fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+
+	pvcClient := c.kclient.CoreV1().PersistentVolumeClaims(p.Namespace)
+	for _, sset := range ssets.Items {
+		if !metav1.IsControlledBy(&sset, p) {
+			continue
+		}
+
+		deleted, err := k8sutil.DeleteStatefulSetClaims(ctx, pvcClient, &sset)
+		for _, name := range deleted {
+			logger.Info("persistent volume claim deleted", "statefulset", sset.Name, "persistentvolumeclaim", name)
+		}
+		if err != nil {
+			return fmt.Errorf("statefulset %q: %w", sset.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -18,8 +18,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"reflect"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mitchellh/hashstructure"
@@ -27,10 +30,12 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
@@ -51,7 +56,6 @@ import (
 )
 
 const (
-	resyncPeriod   = 5 * time.Minute
 	controllerName = "prometheus-controller"
 
 	unmanagedConfigurationReason         = "ConfigurationUnmanaged"
@@ -64,10 +68,21 @@ type Operator struct {
 	kclient  kubernetes.Interface
 	mdClient metadata.Interface
 	mclient  monitoringclient.Interface
+	// Client of the configuration resources (ServiceMonitors, PodMonitors,
+	// ...).
+	cmclient monitoringclient.Interface
 
 	logger   *slog.Logger
 	accessor *operator.Accessor
 	config   prompkg.Config
+	// Protects the config field which is updated when the operator
+	// configuration changes.
+	configMtx sync.RWMutex
+
+	// Field manager of the server-side apply requests (empty when disabled).
+	fieldManager string
+	// The write requests are sent as dry-run requests.
+	auditMode bool
 
 	controllerID string
 
@@ -83,12 +98,20 @@ type Operator struct {
 	cmapInfs  *informers.ForResource
 	secrInfs  *informers.ForResource
 	ssetInfs  *informers.ForResource
+	svcInfs   *informers.ForResource
+	podInfs   *informers.ForResource
 
 	rr *operator.ResourceReconciler
 
 	metrics         *operator.Metrics
 	reconciliations *operator.ReconciliationTracker
 	statusReporter  prompkg.StatusReporter
+	refIndex        *operator.ReferenceIndex
+	configHashes    *operator.ConfigHashCache
+	debouncer       *operator.Debouncer
+	effectiveConfig *prompkg.EffectiveConfigs
+	selection       *prompkg.SelectionReports
+	duplicates      *prompkg.DuplicateTargetsTracker
 
 	endpointSliceSupported        bool
 	scrapeConfigSupported         bool
@@ -97,8 +120,45 @@ type Operator struct {
 	retentionPoliciesEnabled      bool
 	configResourcesStatusEnabled  bool
 
+	// Applies the status updates of the configuration resources.
+	statusWriter *operator.StatusWriter
+	// Configuration resources bound to each workload.
+	boundResources *operator.BoundConfigResources
+
+	// Materializes the targets of the custom resource SD configurations.
+	crDiscoverer *prompkg.CustomResourceDiscoverer
+
+	// Limits the number of StatefulSets rolled out concurrently.
+	rolloutBudget *operator.RolloutBudget
+
+	// Deletes the StatefulSets which can't be updated.
+	ssetRecreator *operator.StatefulSetRecreator
+
+	// Splits the objects between the operator replicas.
+	sharder *operator.Sharder
+
+	// Client configuration of the cluster hosting the configuration
+	// resources when it differs from the cluster of the Prometheus resources.
+	configResourcesRESTConfig *rest.Config
+
+	// Reported in the status of the reconciled resources.
+	operatorInfo *monitoringv1.OperatorInfo
+
+	// Selectors restricting the watched Secrets.
+	secretLabelSelector labels.Selector
+	secretFieldSelector fields.Selector
+
 	eventRecorder   record.EventRecorder
 	finalizerSyncer *operator.FinalizerSyncer
+
+	targets      *targetCache
+	rwProber     *prompkg.RemoteWriteProber
+	configReload *operator.ConfigReloadTracker
+	// Reloads the pods using the OperatorExec reload strategy.
+	execReloader *prompkg.ExecReloader
+
+	// Stores the generated configuration outside of the cluster.
+	artifactStore operator.ArtifactStore
 }
 
 type ControllerOption func(*Operator)
@@ -133,6 +193,50 @@ func WithoutUnmanagedConfiguration() ControllerOption {
 	}
 }
 
+// WithStatusWriter tells the controller to update the status of the selected
+// configuration resources using the given writer. It only takes effect when
+// the StatusForConfigurationResources feature gate is enabled.
+func WithStatusWriter(w *operator.StatusWriter) ControllerOption {
+	return func(o *Operator) {
+		o.statusWriter = w
+	}
+}
+
+// WithRolloutBudget tells the controller to wait for a slot of the budget
+// before updating a StatefulSet.
+func WithRolloutBudget(b *operator.RolloutBudget) ControllerOption {
+	return func(o *Operator) {
+		o.rolloutBudget = b
+	}
+}
+
+// WithSharder tells the controller to reconcile only the objects assigned to
+// the operator replica.
+func WithSharder(s *operator.Sharder) ControllerOption {
+	return func(o *Operator) {
+		o.sharder = s
+	}
+}
+
+// WithConfigResourcesFrom tells the controller to select the configuration
+// resources (ServiceMonitors, PodMonitors, Probes, ScrapeConfigs and
+// PrometheusRules) and their namespaces from the cluster identified by the
+// client configuration instead of the cluster of the Prometheus resources.
+func WithConfigResourcesFrom(restConfig *rest.Config) ControllerOption {
+	return func(o *Operator) {
+		o.configResourcesRESTConfig = restConfig
+	}
+}
+
+// WithArtifactStore tells the controller to publish the generated
+// configuration to the artifact store instead of writing it to a Secret. The
+// pods mount the configuration from the volume provided by the store.
+func WithArtifactStore(s operator.ArtifactStore) ControllerOption {
+	return func(o *Operator) {
+		o.artifactStore = s
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, opts ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -152,36 +256,67 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
 	}
 
+	dclient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating dynamic client failed: %w", err)
+	}
+
 	// All the metrics exposed by the controller get the controller="prometheus" label.
 	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "prometheus"}, r)
 
 	o := &Operator{
-		kclient:  client,
-		mdClient: mdClient,
-		mclient:  mclient,
-		logger:   logger,
-		accessor: operator.NewAccessor(logger),
-
-		config: prompkg.Config{
-			LocalHost:                  c.LocalHost,
-			ReloaderConfig:             c.ReloaderConfig,
-			PrometheusDefaultBaseImage: c.PrometheusDefaultBaseImage,
-			ThanosDefaultBaseImage:     c.ThanosDefaultBaseImage,
-			Annotations:                c.Annotations,
-			Labels:                     c.Labels,
-		},
+		kclient:      client,
+		mdClient:     mdClient,
+		mclient:      mclient,
+		crDiscoverer: prompkg.NewCustomResourceDiscoverer(logger, client, dclient),
+		logger:       logger,
+		accessor:     operator.NewAccessor(logger),
+
+		config:          prompkg.NewConfig(c),
+		fieldManager:    c.ApplyFieldManager(),
+		auditMode:       c.Mode == operator.AuditMode,
 		metrics:         operator.NewMetrics(r),
 		reconciliations: &operator.ReconciliationTracker{},
+		refIndex:        operator.NewReferenceIndex(r),
+		configHashes:    operator.NewConfigHashCache(r),
+		debouncer:       operator.NewDebouncer(),
+		effectiveConfig: prompkg.NewEffectiveConfigs(),
+		selection:       prompkg.NewSelectionReports(),
+		duplicates:      prompkg.NewDuplicateTargetsTracker(),
+		boundResources:  operator.NewBoundConfigResources(),
+		targets:         newTargetCache(),
+		rwProber:        prompkg.NewRemoteWriteProber(),
+		configReload:    operator.NewConfigReloadTracker(),
+		execReloader:    prompkg.NewExecReloader(prompkg.NewPodExecutor(restConfig, client)),
 
 		controllerID:                 c.ControllerID,
 		eventRecorder:                c.EventRecorderFactory(client, controllerName),
 		retentionPoliciesEnabled:     c.Gates.Enabled(operator.PrometheusShardRetentionPolicyFeature),
 		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
+		operatorInfo:                 c.Gates.OperatorInfo(),
 		finalizerSyncer:              operator.NewFinalizerSyncer(mdClient, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusName), c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature)),
 	}
 	for _, opt := range opts {
 		opt(o)
 	}
+	o.ssetRecreator = operator.NewStatefulSetRecreator(c.StatefulSetRecreationPolicy, o.metrics, o.eventRecorder)
+	o.secretLabelSelector, o.secretFieldSelector = c.SecretWatchSelectors()
+
+	// By default, the configuration resources live in the same cluster as
+	// the Prometheus resources.
+	o.cmclient = mclient
+	cnsClient := client
+	if o.configResourcesRESTConfig != nil {
+		o.cmclient, err = monitoringclient.NewForConfig(o.configResourcesRESTConfig)
+		if err != nil {
+			return nil, fmt.Errorf("instantiating monitoring client for the configuration resources failed: %w", err)
+		}
+
+		cnsClient, err = kubernetes.NewForConfig(o.configResourcesRESTConfig)
+		if err != nil {
+			return nil, fmt.Errorf("instantiating kubernetes client for the configuration resources failed: %w", err)
+		}
+	}
 
 	o.metrics.MustRegister(o.reconciliations)
 
@@ -190,7 +325,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.PrometheusAllowList,
 			c.Namespaces.DenyList,
 			mclient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			func(options *metav1.ListOptions) {
 				options.LabelSelector = c.PromSelector.String()
 			},
@@ -215,14 +350,16 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		monitoringv1.PrometheusesKind,
 		r,
 		o.controllerID,
+		o.sharder,
+		c.Workers.Prometheus,
 	)
 
 	o.smonInfs, err = informers.NewInformersForResource(
 		informers.NewMonitoringInformerFactories(
 			c.Namespaces.AllowList,
 			c.Namespaces.DenyList,
-			mclient,
-			resyncPeriod,
+			o.cmclient,
+			c.ResyncPeriods.Prometheus,
 			nil,
 		),
 		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ServiceMonitorName),
@@ -235,8 +372,8 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		informers.NewMonitoringInformerFactories(
 			c.Namespaces.AllowList,
 			c.Namespaces.DenyList,
-			mclient,
-			resyncPeriod,
+			o.cmclient,
+			c.ResyncPeriods.Prometheus,
 			nil,
 		),
 		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PodMonitorName),
@@ -249,8 +386,8 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		informers.NewMonitoringInformerFactories(
 			c.Namespaces.AllowList,
 			c.Namespaces.DenyList,
-			mclient,
-			resyncPeriod,
+			o.cmclient,
+			c.ResyncPeriods.Prometheus,
 			nil,
 		),
 		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ProbeName),
@@ -264,8 +401,8 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			informers.NewMonitoringInformerFactories(
 				c.Namespaces.AllowList,
 				c.Namespaces.DenyList,
-				mclient,
-				resyncPeriod,
+				o.cmclient,
+				c.ResyncPeriods.ScrapeConfig,
 				nil,
 			),
 			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ScrapeConfigName),
@@ -278,8 +415,8 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		informers.NewMonitoringInformerFactories(
 			c.Namespaces.AllowList,
 			c.Namespaces.DenyList,
-			mclient,
-			resyncPeriod,
+			o.cmclient,
+			c.ResyncPeriods.Prometheus,
 			nil,
 		),
 		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName),
@@ -293,7 +430,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.PrometheusAllowList,
 			c.Namespaces.DenyList,
 			o.mdClient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			func(options *metav1.ListOptions) {
 				options.LabelSelector = prompkg.LabelPrometheusName
 			},
@@ -310,7 +447,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.PrometheusAllowList,
 			c.Namespaces.DenyList,
 			o.mdClient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			func(options *metav1.ListOptions) {
 				options.FieldSelector = c.SecretListWatchFieldSelector.String()
 				options.LabelSelector = c.SecretListWatchLabelSelector.String()
@@ -328,7 +465,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.PrometheusAllowList,
 			c.Namespaces.DenyList,
 			o.kclient,
-			resyncPeriod,
+			c.ResyncPeriods.Prometheus,
 			nil,
 		),
 		appsv1.SchemeGroupVersion.WithResource("statefulsets"),
@@ -337,13 +474,52 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("error creating statefulset informers: %w", err)
 	}
 
-	newNamespaceInformer := func(o *Operator, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
+	// The Services managed by the operator (except the governing Service)
+	// have the Prometheus name label.
+	o.svcInfs, err = informers.NewInformersForResourceWithTransform(
+		informers.NewMetadataInformerFactory(
+			c.Namespaces.PrometheusAllowList,
+			c.Namespaces.DenyList,
+			o.mdClient,
+			c.ResyncPeriods.Prometheus,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = prompkg.PrometheusNameLabelName
+			},
+		),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourceServices)),
+		informers.PartialObjectMetadataStrip,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating service informers: %w", err)
+	}
+
+	// The pods are used to collect the status of the configuration reloads.
+	o.podInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.Namespaces.PrometheusAllowList,
+			c.Namespaces.DenyList,
+			o.kclient,
+			c.ResyncPeriods.Prometheus,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = labels.SelectorFromSet(labels.Set{
+					"app.kubernetes.io/managed-by": "prometheus-operator",
+					"app.kubernetes.io/name":       "prometheus",
+				}).String()
+			},
+		),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourcePods)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating pod informers: %w", err)
+	}
+
+	newNamespaceInformer := func(o *Operator, kclient kubernetes.Interface, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
 		lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
 			ctx,
 			o.logger,
 			c.KubernetesVersion,
-			o.kclient.CoreV1(),
-			o.kclient.AuthorizationV1().SelfSubjectAccessReviews(),
+			kclient.CoreV1(),
+			kclient.AuthorizationV1().SelfSubjectAccessReviews(),
 			allowList,
 			c.Namespaces.DenyList,
 		)
@@ -354,19 +530,19 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		o.logger.Debug("creating namespace informer", "privileged", privileged)
 		return cache.NewSharedIndexInformer(
 			o.metrics.NewInstrumentedListerWatcher(lw),
-			&v1.Namespace{}, resyncPeriod, cache.Indexers{},
+			&v1.Namespace{}, c.ResyncPeriods.Prometheus, cache.Indexers{},
 		), nil
 	}
 
-	o.nsMonInf, err = newNamespaceInformer(o, c.Namespaces.AllowList)
+	o.nsMonInf, err = newNamespaceInformer(o, cnsClient, c.Namespaces.AllowList)
 	if err != nil {
 		return nil, err
 	}
 
-	if listwatch.IdenticalNamespaces(c.Namespaces.AllowList, c.Namespaces.PrometheusAllowList) {
+	if o.configResourcesRESTConfig == nil && listwatch.IdenticalNamespaces(c.Namespaces.AllowList, c.Namespaces.PrometheusAllowList) {
 		o.nsPromInf = o.nsMonInf
 	} else {
-		o.nsPromInf, err = newNamespaceInformer(o, c.Namespaces.PrometheusAllowList)
+		o.nsPromInf, err = newNamespaceInformer(o, o.kclient, c.Namespaces.PrometheusAllowList)
 		if err != nil {
 			return nil, err
 		}
@@ -382,8 +558,10 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 	return o, nil
 }
 
-// waitForCacheSync waits for the informers' caches to be synced.
-func (c *Operator) waitForCacheSync(ctx context.Context) error {
+// namedInformers returns the informers of the controller. The informers
+// which haven't been created (e.g. when the CRD isn't installed) are skipped.
+func (c *Operator) namedInformers() operator.NamedInformers {
+	var ni operator.NamedInformers
 	for _, infs := range []struct {
 		name                 string
 		informersForResource *informers.ForResource
@@ -397,29 +575,23 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"ConfigMap", c.cmapInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
+		{"Service", c.svcInfs},
+		{"Pod", c.podInfs},
 	} {
-		// Skipping informers that were not started. If prerequisites for a CRD were not met, their informer will be
-		// nil. ScrapeConfig is one example.
-		if infs.informersForResource == nil {
-			continue
-		}
-
-		for _, inf := range infs.informersForResource.GetInformers() {
-			if !operator.WaitForNamedCacheSync(ctx, "prometheus", c.logger.With("informer", infs.name), inf.Informer()) {
-				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
-			}
-		}
+		ni = ni.AppendForResource(infs.name, infs.informersForResource)
 	}
 
-	for _, inf := range []struct {
-		name     string
-		informer cache.SharedIndexInformer
-	}{
-		{"PromNamespace", c.nsPromInf},
-		{"MonNamespace", c.nsMonInf},
-	} {
-		if !operator.WaitForNamedCacheSync(ctx, "prometheus", c.logger.With("informer", inf.name), inf.informer) {
-			return fmt.Errorf("failed to sync cache for %s informer", inf.name)
+	return append(ni,
+		operator.NamedInformer{Name: "PromNamespace", Informer: c.nsPromInf},
+		operator.NamedInformer{Name: "MonNamespace", Informer: c.nsMonInf},
+	)
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (c *Operator) waitForCacheSync(ctx context.Context) error {
+	for _, inf := range c.namedInformers() {
+		if !operator.WaitForNamedCacheSync(ctx, "prometheus", c.logger.With("informer", inf.Name), inf.Informer) {
+			return fmt.Errorf("failed to sync cache for %s informer", inf.Name)
 		}
 	}
 
@@ -427,6 +599,12 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 	return nil
 }
 
+// HealthCheck returns an error if the controller is running and either one of
+// its informers isn't synced or one of its queues is stuck.
+func (c *Operator) HealthCheck(context.Context) error {
+	return c.rr.CheckHealth(c.namedInformers())
+}
+
 // addHandlers adds the eventhandlers to the informers.
 func (c *Operator) addHandlers() {
 	c.promInfs.AddEventHandler(c.rr)
@@ -475,20 +653,20 @@ func (c *Operator) addHandlers() {
 		c.enqueueForMonitorNamespace,
 	))
 
-	c.cmapInfs.AddEventHandler(operator.NewEventHandler(
+	c.cmapInfs.AddEventHandler(operator.NewObjectEventHandler(
 		c.logger,
 		c.accessor,
 		c.metrics,
-		"ConfigMap",
-		c.enqueueForPrometheusNamespace,
+		operator.ConfigMapKind,
+		c.enqueueForReference(operator.ConfigMapKind),
 	))
 
-	c.secrInfs.AddEventHandler(operator.NewEventHandler(
+	c.secrInfs.AddEventHandler(operator.NewObjectEventHandler(
 		c.logger,
 		c.accessor,
 		c.metrics,
-		"Secret",
-		c.enqueueForPrometheusNamespace,
+		operator.SecretKind,
+		c.enqueueForReference(operator.SecretKind),
 	))
 
 	// The controller needs to watch the namespaces in which the service/pod
@@ -517,6 +695,8 @@ func (c *Operator) Run(ctx context.Context) error {
 	go c.cmapInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
+	go c.svcInfs.Start(ctx.Done())
+	go c.podInfs.Start(ctx.Done())
 	go c.nsMonInf.Run(ctx.Done())
 	if c.nsPromInf != c.nsMonInf {
 		go c.nsPromInf.Run(ctx.Done())
@@ -534,6 +714,19 @@ func (c *Operator) Run(ctx context.Context) error {
 
 	// TODO(simonpasquier): watch for Prometheus pods instead of polling.
 	go operator.StatusPoller(ctx, c)
+	go c.pollTargets(ctx)
+	go c.pollConfigReload(ctx)
+	go c.rwProber.Run(
+		ctx,
+		func(fn func(monitoringv1.PrometheusInterface)) {
+			_ = c.promInfs.ListAll(labels.Everything(), func(o interface{}) {
+				fn(o.(*monitoringv1.Prometheus))
+			})
+		},
+		func(p monitoringv1.PrometheusInterface) {
+			c.rr.EnqueueForReconciliation(p.(*monitoringv1.Prometheus))
+		},
+	)
 
 	c.metrics.Ready().Set(1)
 	<-ctx.Done()
@@ -555,8 +748,44 @@ func (c *Operator) RefreshStatusFor(o metav1.Object) {
 	c.rr.EnqueueForStatus(o)
 }
 
-func (c *Operator) enqueueForPrometheusNamespace(nsName string) {
-	c.enqueueForNamespace(c.nsPromInf.GetStore(), nsName)
+// EffectiveConfigHandler returns the HTTP handler exposing the scrape jobs
+// generated for the monitoring resources (see prompkg.EffectiveConfigPattern).
+func (c *Operator) EffectiveConfigHandler() http.Handler {
+	return c.effectiveConfig
+}
+
+// SelectionHandler returns the HTTP handler exposing the configuration
+// resources selected and rejected by the Prometheus resources (see
+// prompkg.SelectionPattern).
+func (c *Operator) SelectionHandler() http.Handler {
+	return c.selection
+}
+
+// enqueueForReference returns a function which enqueues the Prometheus
+// objects affected by a change of the given Secret or ConfigMap: the objects
+// referencing it, the objects owning it (e.g. generated configuration and
+// rule files) and the objects which haven't been reconciled yet.
+func (c *Operator) enqueueForReference(kind string) func(string, string) {
+	return func(ns, name string) {
+		keys := c.refIndex.Lookup(kind, ns, name)
+
+		err := c.promInfs.ListAll(labels.Everything(), func(obj interface{}) {
+			p := obj.(*monitoringv1.Prometheus)
+			key := p.Namespace + "/" + p.Name
+
+			if slices.Contains(keys, key) ||
+				!c.refIndex.Has(key) ||
+				(p.Namespace == ns && strings.HasPrefix(name, prompkg.PrefixedName(p))) {
+				c.rr.EnqueueForReconciliation(p)
+			}
+		})
+		if err != nil {
+			c.logger.Error(
+				"listing all Prometheus instances from cache failed",
+				"err", err,
+			)
+		}
+	}
 }
 
 func (c *Operator) enqueueForMonitorNamespace(nsName string) {
@@ -738,6 +967,8 @@ func (c *Operator) Sync(ctx context.Context, key string) error {
 }
 
 func (c *Operator) sync(ctx context.Context, key string) error {
+	config := c.currentConfig()
+
 	p, err := operator.GetObjectFromKey[*monitoringv1.Prometheus](c.promInfs, key)
 
 	if err != nil {
@@ -746,6 +977,20 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if p == nil {
 		c.reconciliations.ForgetObject(key)
+		c.refIndex.Forget(key)
+		c.configHashes.Forget(key)
+		c.debouncer.Forget(key)
+		c.metrics.ForgetGeneratedArtifacts(key)
+		c.effectiveConfig.Delete(key)
+		c.selection.Delete(key)
+		c.duplicates.Forget(key)
+		if c.crDiscoverer != nil {
+			c.crDiscoverer.Forget(key)
+		}
+		c.targets.forget(key)
+		c.removeConfigResourceBindings(key)
+		c.rolloutBudget.Forget(rolloutOwner(key))
+		c.execReloader.Forget(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
@@ -764,8 +1009,40 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
+	finalizersChanged, err = c.syncDeletionPolicy(ctx, p, logger)
+	if err != nil {
+		return err
+	}
+
+	if finalizersChanged {
+		c.rr.EnqueueForReconciliation(p)
+		return nil
+	}
+
+	finalizersChanged, err = c.syncArtifactStore(ctx, p, logger)
+	if err != nil {
+		return err
+	}
+
+	if finalizersChanged {
+		c.rr.EnqueueForReconciliation(p)
+		return nil
+	}
+
 	if c.rr.DeletionInProgress(p) {
 		c.reconciliations.ForgetObject(key)
+		c.refIndex.Forget(key)
+		c.configHashes.Forget(key)
+		c.debouncer.Forget(key)
+		c.metrics.ForgetGeneratedArtifacts(key)
+		c.effectiveConfig.Delete(key)
+		c.selection.Delete(key)
+		c.duplicates.Forget(key)
+		if c.crDiscoverer != nil {
+			c.crDiscoverer.Forget(key)
+		}
+		c.removeConfigResourceBindings(key)
+		c.execReloader.Forget(key)
 		return nil
 	}
 
@@ -773,18 +1050,36 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return err
 	}
 
-	if p.Spec.Paused {
+	if operator.IsPaused(p, p.Spec.Paused) {
 		logger.Info("the resource is paused, not reconciling")
 		return nil
 	}
 
+	if shards := shardsToApply(p); shards != ptr.Deref(p.Spec.Shards, 1) {
+		logger.Info("change of the number of shards not confirmed yet", "current", shards, "requested", ptr.Deref(p.Spec.Shards, 1))
+		p.Spec.Shards = ptr.To(shards)
+	}
+
+	p.Spec.RemoteWrite = c.rwProber.ApplyFailover(p.Spec.RemoteWrite)
+
 	logger.Info("sync prometheus")
-	ruleConfigMapNames, err := c.createOrUpdateRuleConfigMaps(ctx, p)
+	ruleConfigMapNames, ruleFiles, err := c.createOrUpdateRuleConfigMaps(ctx, p)
 	if err != nil {
+		c.eventRecorder.Eventf(p, v1.EventTypeWarning, operator.ConfigGenerationFailedEvent, "Failed to generate the rule files: %v", err)
 		return err
 	}
 
 	assetStore := assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
+	if config.TLSAssetsProjection {
+		assetStore.EnableTLSAssetsProjection(p.Namespace)
+	}
+	// Index the Secrets and ConfigMaps referenced by the object even when the
+	// reconciliation fails (e.g. a missing Secret) so that the object gets
+	// enqueued again when they change.
+	defer func() {
+		secrets, configMaps := assetStore.References()
+		c.refIndex.Set(key, secrets, configMaps)
+	}()
 
 	opts := []prompkg.ConfigGeneratorOption{}
 	if c.endpointSliceSupported {
@@ -792,18 +1087,24 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	}
 	cg, err := prompkg.NewConfigGenerator(logger, p, opts...)
 	if err != nil {
-		return err
+		return k8sutil.NewInvalidSpecError(err)
 	}
 
-	if err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, ruleConfigMapNames, assetStore); err != nil {
+	scrapeConfigSecrets, err := c.createOrUpdateConfigurationSecret(ctx, logger, key, p, cg, ruleConfigMapNames, assetStore)
+	if err != nil {
+		c.eventRecorder.Eventf(p, v1.EventTypeWarning, operator.ConfigGenerationFailedEvent, "Failed to generate the configuration: %v", err)
 		return fmt.Errorf("creating config failed: %w", err)
 	}
 
-	tlsAssets, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, prompkg.NewTLSAssetSecret(p, c.config))
+	tlsAssetsData := assetStore.TLSAssets()
+	c.metrics.SetGeneratedTLSAssets(key, len(tlsAssetsData))
+	tlsAssets, err := operator.ReconcileTLSAssets(ctx, assetStore, c.kclient, prompkg.NewTLSAssetSecret(p, config), c.fieldManager)
 	if err != nil {
 		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
 
+	operator.ReportUnwatchedSecrets(c.reconciliations, c.eventRecorder, p, key, assetStore.UnwatchedSecrets(c.secretLabelSelector, c.secretFieldSelector))
+
 	if err := c.createOrUpdateWebConfigSecret(ctx, p); err != nil {
 		return fmt.Errorf("synchronizing web config secret failed: %w", err)
 	}
@@ -812,6 +1113,10 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return fmt.Errorf("failed to reconcile Thanos config secret: %w", err)
 	}
 
+	if err := c.pruneChildren(ctx, logger, p, slices.Concat(tlsAssets.SecretNames(), scrapeConfigSecrets), ruleConfigMapNames); err != nil {
+		return err
+	}
+
 	if p.Spec.ServiceName != nil {
 		svcClient := c.kclient.CoreV1().Services(p.Namespace)
 		selectorLabels := makeSelectorLabels(p.Name)
@@ -825,7 +1130,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 			governingServiceName,
 			map[string]string{"app.kubernetes.io/name": "prometheus"},
 			p,
-			c.config,
+			config,
 		)
 
 		if p.Spec.Thanos != nil {
@@ -836,14 +1141,19 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 			})
 		}
 
-		if _, err := k8sutil.CreateOrUpdateService(ctx, c.kclient.CoreV1().Services(p.Namespace), svc); err != nil {
+		if _, err := k8sutil.CreateOrUpdateService(ctx, c.kclient.CoreV1().Services(p.Namespace), svc, c.fieldManager); err != nil {
 			return fmt.Errorf("synchronizing default governing service failed: %w", err)
 		}
 	}
 
+	if err := c.reconcileRemoteWriteReceiverService(ctx, p); err != nil {
+		return fmt.Errorf("synchronizing remote write receiver service failed: %w", err)
+	}
+
 	ssetClient := c.kclient.AppsV1().StatefulSets(p.Namespace)
 
 	// Ensure we have a StatefulSet running Prometheus deployed and that StatefulSet names are created correctly.
+	var rolloutPending []string
 	expected := prompkg.ExpectedStatefulSetShardNames(p)
 	for shard, ssetName := range expected {
 		logger := logger.With("statefulset", ssetName, "shard", fmt.Sprintf("%d", shard))
@@ -873,7 +1183,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 			}
 		}
 
-		newSSetInputHash, err := createSSetInputHash(*p, c.config, ruleConfigMapNames, tlsAssets, existingStatefulSet.Spec)
+		newSSetInputHash, err := createSSetInputHash(*p, config, ruleConfigMapNames, tlsAssets, existingStatefulSet.Spec)
 		if err != nil {
 			return err
 		}
@@ -881,14 +1191,18 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		sset, err := makeStatefulSet(
 			ssetName,
 			p,
-			c.config,
+			config,
 			cg,
 			ruleConfigMapNames,
 			newSSetInputHash,
 			int32(shard),
-			tlsAssets)
+			tlsAssets,
+			scrapeConfigSecrets)
 		if err != nil {
-			return fmt.Errorf("making statefulset failed: %w", err)
+			return k8sutil.NewInvalidSpecError(fmt.Errorf("making statefulset failed: %w", err))
+		}
+		if c.artifactStore != nil && !c.unmanagedPrometheusConfiguration(p) {
+			mountConfigFromArtifactStore(sset, c.artifactStore.VolumeSource(configArtifactKey(p)))
 		}
 		operator.SanitizeSTS(sset)
 
@@ -902,6 +1216,15 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 		if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationName] {
 			logger.Debug("new statefulset generation inputs match current, skipping any actions")
+			if operator.StatefulSetRolledOut(existingStatefulSet) {
+				c.rolloutBudget.Release(rolloutOwner(key), ssetName)
+			}
+			continue
+		}
+
+		if ok, position := c.rolloutBudget.Acquire(rolloutOwner(key), ssetName); !ok {
+			logger.Debug("postponing the update of the statefulset until the rollout budget allows it", "position", position)
+			rolloutPending = append(rolloutPending, c.rolloutBudget.RolloutPendingMessage(ssetName, position))
 			continue
 		}
 
@@ -911,24 +1234,13 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 			"existing_hash", existingStatefulSet.Annotations[operator.InputHashAnnotationName],
 		)
 
-		err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
-		sErr, ok := err.(*apierrors.StatusError)
-
-		if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
-			c.metrics.StsDeleteCreateCounter().Inc()
-
-			// Gather only reason for failed update
-			failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
-			for i, cause := range sErr.ErrStatus.Details.Causes {
-				failMsg[i] = cause.Message
-			}
-
-			logger.Info("recreating StatefulSet because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
+		err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset, c.fieldManager)
+		recreated, rErr := c.ssetRecreator.Recreate(ctx, logger, ssetClient, p, existingStatefulSet, sset, err)
+		if rErr != nil {
+			return rErr
+		}
 
-			propagationPolicy := metav1.DeletePropagationForeground
-			if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
-				return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
-			}
+		if recreated {
 			continue
 		}
 
@@ -937,6 +1249,11 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		}
 	}
 
+	c.reconciliations.SetWarning(key, operator.RolloutPendingReason, strings.Join(rolloutPending, " "))
+	if len(rolloutPending) > 0 {
+		c.rr.EnqueueForReconciliationAfter(p, operator.RolloutBudgetRetryInterval)
+	}
+
 	ssets := map[string]struct{}{}
 	for _, ssetName := range expected {
 		ssets[ssetName] = struct{}{}
@@ -964,6 +1281,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 			return
 		}
 
+		c.rolloutBudget.Release(rolloutOwner(key), s.GetName())
 		if err := ssetClient.Delete(ctx, s.GetName(), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationForeground)}); err != nil {
 			c.logger.Error("failed to delete StatefulSet object", "err", err, "name", s.GetName(), "namespace", s.GetNamespace())
 		}
@@ -972,6 +1290,10 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return fmt.Errorf("listing StatefulSet resources failed: %w", err)
 	}
 
+	if err := c.syncExecReload(ctx, logger, key, p, ruleFiles); err != nil {
+		return fmt.Errorf("failed to reload the configuration: %w", err)
+	}
+
 	return nil
 }
 
@@ -1006,6 +1328,16 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 	if c.rr.DeletionInProgress(p) {
 		return nil
 	}
+
+	requestedShards := ptr.Deref(p.Spec.Shards, 1)
+	shards := shardsToApply(p)
+	if p.Spec.ShardScaling != nil && p.Status.Shards > 0 && p.Status.Shards != shards {
+		// The number of shards is about to change, record the current
+		// location of the targets.
+		c.targets.snapshot(key)
+	}
+	p.Spec.Shards = ptr.To(shards)
+
 	pStatus, err := c.statusReporter.Process(ctx, p, key)
 	if err != nil {
 		return fmt.Errorf("failed to get prometheus status: %w", err)
@@ -1021,14 +1353,41 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 		}
 	}
 
+	// The conditions of the current status are needed to retain the last
+	// transition time of the config reload condition.
+	previousConditions := p.Status.Conditions
 	p.Status = *pStatus
+	p.Status.Conditions = append(
+		p.Status.Conditions,
+		operator.UpdateConditions(
+			previousConditions,
+			slices.Concat(
+				c.configReload.Conditions(key, p.Generation),
+				c.duplicates.Conditions(key, p.Generation),
+				c.crDiscoveryConditions(key, p.Generation),
+			)...,
+		)...,
+	)
+
 	selectorLabels := makeSelectorLabels(p.Name)
 	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: selectorLabels})
 	if err != nil {
 		return fmt.Errorf("failed to create selector for prometheus scale status: %w", err)
 	}
 	p.Status.Selector = selector.String()
-	p.Status.Shards = ptr.Deref(p.Spec.Shards, 1)
+	p.Status.Shards = shards
+
+	if p.Spec.ShardScaling != nil {
+		p.Status.ShardScaling = c.targets.status(key, shards, requestedShards)
+	}
+
+	p.Status.RemoteWriteEndpoints = c.rwProber.Status(p.Spec.RemoteWrite)
+	p.Status.OperatorInfo = c.operatorInfo
+
+	if r, found := c.selection.Get(key); found {
+		p.Status.SelectedResources = r.Summary()
+	}
+	p.Status.ScrapeConfigSecrets = c.scrapeConfigSecretNames(p)
 
 	if _, err = c.mclient.MonitoringV1().Prometheuses(p.Namespace).ApplyStatus(ctx, prompkg.ApplyConfigurationFromPrometheus(p, true), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
 		c.logger.Info("failed to apply prometheus status subresource, trying again without scale fields", "err", err)
@@ -1139,14 +1498,71 @@ func ListOptions(name string) metav1.ListOptions {
 	}
 }
 
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator, ruleConfigMapNames []string, store *assets.StoreBuilder) error {
+// pruneChildren deletes the Secrets and ConfigMaps owned by the Prometheus
+// crDiscoveryConditions returns the condition of the custom resource
+// discovery for the Prometheus object.
+func (c *Operator) crDiscoveryConditions(key string, generation int64) []monitoringv1.Condition {
+	if c.crDiscoverer == nil {
+		return nil
+	}
+
+	return c.crDiscoverer.Conditions(key, generation)
+}
+
+// object which aren't used anymore.
+func (c *Operator) pruneChildren(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, secrets, configMaps []string) error {
+	children := k8sutil.ExpectedChildren{}
+	children.Add(
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourceSecrets)),
+		append(
+			secrets,
+			prompkg.ConfigSecretName(p),
+			prompkg.WebConfigSecretName(p),
+			thanosPrometheusHTTPClientConfigSecretName(p),
+		)...,
+	)
+
+	cmGVR := v1.SchemeGroupVersion.WithResource(string(v1.ResourceConfigMaps))
+	children.Add(cmGVR, configMaps...)
+	if c.crDiscoverer != nil {
+		// The file SD ConfigMap is managed by the custom resource discoverer.
+		children.Add(cmGVR, prompkg.FileSDConfigMapName(p))
+	}
+
+	if err := operator.PruneChildren(ctx, logger, c.mdClient, p, children); err != nil {
+		return fmt.Errorf("failed to prune obsolete objects: %w", err)
+	}
+
+	return nil
+}
+
+// loadAdditionalConfig returns the additional configuration stored in the
+// Secret. The Secret is recorded in the store's references so that the
+// Prometheus object gets reconciled when the Secret changes.
+func (c *Operator) loadAdditionalConfig(ctx context.Context, logger *slog.Logger, store *assets.StoreBuilder, namespace string, sks *v1.SecretKeySelector) ([]byte, error) {
+	if sks == nil {
+		return nil, nil
+	}
+
+	store.AddSecretReference(namespace, sks.Name)
+
+	return k8sutil.LoadSecretRef(ctx, logger, c.kclient.CoreV1().Secrets(namespace), sks)
+}
+
+// createOrUpdateConfigurationSecret returns the names of the Secrets holding
+// the scrape configuration files when the configuration is split.
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, key string, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator, ruleConfigMapNames []string, store *assets.StoreBuilder) ([]string, error) {
+	config := c.currentConfig()
+
 	// If no service/pod monitor and probe selectors are configured, the user
 	// wants to manage configuration themselves. Let's create an empty Secret
 	// if it doesn't exist.
 	if c.unmanagedPrometheusConfiguration(p) {
-		s, err := prompkg.MakeConfigurationSecret(p, c.config, nil)
+		c.removeConfigResourceBindings(key)
+
+		s, err := prompkg.MakeConfigurationSecret(p, config, nil)
 		if err != nil {
-			return fmt.Errorf("failed to generate empty configuration secret: %w", err)
+			return nil, fmt.Errorf("failed to generate empty configuration secret: %w", err)
 		}
 
 		sClient := c.kclient.CoreV1().Secrets(p.Namespace)
@@ -1154,53 +1570,93 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		if apierrors.IsNotFound(err) {
 			logger.Debug("creating an empty configuration secret")
 			if _, err := c.kclient.CoreV1().Secrets(p.Namespace).Create(ctx, s, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
-				return fmt.Errorf("failed to create an empty configuration secret: %w", err)
+				return nil, fmt.Errorf("failed to create an empty configuration secret: %w", err)
 			}
 
-			return nil
+			return nil, nil
 		}
 
-		return err
+		return nil, err
 	}
 
 	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.eventRecorder)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	smons, err := resourceSelector.SelectServiceMonitors(ctx, c.smonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting ServiceMonitors failed: %w", err)
+		return nil, fmt.Errorf("selecting ServiceMonitors failed: %w", err)
 	}
 
 	pmons, err := resourceSelector.SelectPodMonitors(ctx, c.pmonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting PodMonitors failed: %w", err)
+		return nil, fmt.Errorf("selecting PodMonitors failed: %w", err)
 	}
 
 	bmons, err := resourceSelector.SelectProbes(ctx, c.probeInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting Probes failed: %w", err)
+		return nil, fmt.Errorf("selecting Probes failed: %w", err)
 	}
 
 	var scrapeConfigs prompkg.ResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
 	if c.sconInfs != nil {
 		scrapeConfigs, err = resourceSelector.SelectScrapeConfigs(ctx, c.sconInfs.ListAllByNamespace)
 		if err != nil {
-			return fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+			return nil, fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+		}
+	}
+
+	c.duplicates.Set(key, resourceSelector.DuplicateTargets())
+	c.selection.Update(key, func(r *prompkg.SelectionReport) {
+		r.ServiceMonitors = prompkg.SelectionResults(smons)
+		r.PodMonitors = prompkg.SelectionResults(pmons)
+		r.Probes = prompkg.SelectionResults(bmons)
+		r.ScrapeConfigs = prompkg.SelectionResults(scrapeConfigs)
+	})
+
+	if c.configResourcesStatusEnabled && c.statusWriter != nil {
+		prompkg.UpdateServiceMonitorsStatus(c.statusWriter, c.cmclient, p, monitoringv1.PrometheusName, smons)
+		prompkg.UpdatePodMonitorsStatus(c.statusWriter, c.cmclient, p, monitoringv1.PrometheusName, pmons)
+		prompkg.UpdateProbesStatus(c.statusWriter, c.cmclient, p, monitoringv1.PrometheusName, bmons)
+		prompkg.UpdateScrapeConfigsStatus(c.statusWriter, c.cmclient, p, monitoringv1.PrometheusName, scrapeConfigs)
+
+		stale := c.boundResources.Update(key, slices.Concat(
+			prompkg.ConfigResourceRefs(monitoringv1.ServiceMonitorsKind, smons),
+			prompkg.ConfigResourceRefs(monitoringv1.PodMonitorsKind, pmons),
+			prompkg.ConfigResourceRefs(monitoringv1.ProbesKind, bmons),
+			prompkg.ConfigResourceRefs(monitoringv1alpha1.ScrapeConfigsKind, scrapeConfigs),
+		))
+		operator.RemoveBindings(c.statusWriter, c.cmclient, monitoringv1.PrometheusName, key, stale)
+	}
+
+	if c.crDiscoverer != nil {
+		refresh, err := c.crDiscoverer.Sync(
+			ctx,
+			p,
+			scrapeConfigs.ValidResources(),
+			operator.WithLabels(config.Labels),
+			operator.WithAnnotations(config.Annotations),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if refresh > 0 {
+			c.rr.EnqueueForReconciliationAfter(p, refresh)
 		}
 	}
 
 	if err := prompkg.AddRemoteReadsToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteRead); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddRemoteWritesToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddAPIServerConfigToStore(ctx, store, p.GetNamespace(), p.Spec.APIServerConfig); err != nil {
-		return err
+		return nil, err
 	}
 
 	if p.Spec.Alerting != nil {
@@ -1208,34 +1664,79 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 
 		for i, am := range ams {
 			if err := validateAlertmanagerEndpoints(p, am); err != nil {
-				return fmt.Errorf("alertmanager %d: %w", i, err)
+				return nil, k8sutil.NewInvalidSpecError(fmt.Errorf("alertmanager %d: %w", i, err))
 			}
 		}
 
 		if err := addAlertmanagerEndpointsToStore(ctx, store, p.GetNamespace(), ams); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	if err := prompkg.AddScrapeClassesToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeClasses); err != nil {
-		return fmt.Errorf("failed to process scrape classes: %w", err)
+		return nil, fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
-	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
-	additionalScrapeConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalScrapeConfigs)
+	additionalScrapeConfigs, err := c.loadAdditionalConfig(ctx, logger, store, p.Namespace, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+	}
+	if err := prompkg.ValidateAdditionalScrapeConfigs(additionalScrapeConfigs); err != nil {
+		return nil, k8sutil.NewInvalidSpecError(fmt.Errorf("invalid additional scrape configs in Secret %q: %w", p.Spec.AdditionalScrapeConfigs.Name, err))
 	}
-	additionalAlertRelabelConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalAlertRelabelConfigs)
+	additionalAlertRelabelConfigs, err := c.loadAdditionalConfig(ctx, logger, store, p.Namespace, p.Spec.AdditionalAlertRelabelConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
 	}
-	additionalAlertManagerConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalAlertManagerConfigs)
+	additionalAlertManagerConfigs, err := c.loadAdditionalConfig(ctx, logger, store, p.Namespace, p.Spec.AdditionalAlertManagerConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
+	}
+	if err := prompkg.ValidateAdditionalAlertmanagerConfigs(additionalAlertManagerConfigs); err != nil {
+		return nil, k8sutil.NewInvalidSpecError(fmt.Errorf("invalid additional alertmanager configs in Secret %q: %w", p.Spec.AdditionalAlertManagerConfigs.Name, err))
+	}
+
+	inputHash, err := prompkg.ConfigurationInputHash(
+		p,
+		config,
+		smons.ValidResources(),
+		pmons.ValidResources(),
+		bmons.ValidResources(),
+		scrapeConfigs.ValidResources(),
+		store,
+		additionalScrapeConfigs,
+		additionalAlertRelabelConfigs,
+		additionalAlertManagerConfigs,
+		ruleConfigMapNames,
+	)
 	if err != nil {
-		return fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("failed to compute the configuration inputs hash: %w", err)
+	}
+
+	secretExists := c.configurationSecretExists(key, p)
+	if secretExists && c.configHashes.Unchanged(key, inputHash) {
+		logger.Debug("configuration inputs unchanged, skipping the configuration generation")
+		c.debouncer.Forget(key)
+		return c.scrapeConfigSecretNames(p), nil
+	}
+
+	window, maxDelay, err := prompkg.ConfigUpdateDebounce(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// The update is postponed only when the configuration Secret already
+	// exists, otherwise the pods can't start.
+	if window > 0 && secretExists {
+		if delay := c.debouncer.Delay(key, inputHash, window, maxDelay); delay > 0 {
+			logger.Debug("postponing the update of the configuration secret", "delay", delay)
+			c.rr.EnqueueForReconciliationAfter(p, delay)
+			return c.scrapeConfigSecretNames(p), nil
+		}
 	}
 
 	// Update secret based on the most recent configuration.
+	start := time.Now()
 	conf, err := cg.GenerateServerConfiguration(
 		p,
 		smons.ValidResources(),
@@ -1249,20 +1750,102 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		ruleConfigMapNames,
 	)
 	if err != nil {
-		return fmt.Errorf("generating config failed: %w", err)
+		return nil, k8sutil.NewInvalidSpecError(fmt.Errorf("generating config failed: %w", err))
 	}
+	c.metrics.ObserveConfigGeneration(key, time.Since(start))
 
 	// Compress config to avoid 1mb secret limit for a while
-	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
+	s, err := prompkg.MakeConfigurationSecret(p, config, conf)
 	if err != nil {
-		return fmt.Errorf("creating compressed secret failed: %w", err)
+		return nil, fmt.Errorf("creating compressed secret failed: %w", err)
+	}
+	c.metrics.SetGeneratedConfigSize(key, len(conf), len(s.Data[prompkg.ConfigSecretKey(p, config)]))
+
+	var scrapeConfigSecrets []*v1.Secret
+	if err := operator.CheckSecretSize(s); err != nil {
+		// The scrape configurations can be moved to separate Secrets only
+		// when they're mounted by the config-reloader container.
+		if c.artifactStore != nil || prompkg.UsesOperatorExecReload(p) || !cg.WithMinimumVersion("2.43.0").IsCompatible() {
+			c.eventRecorder.Event(p, v1.EventTypeWarning, operator.SecretTooLargeEvent, err.Error())
+			return nil, err
+		}
+
+		logger.Debug("splitting the configuration into scrape configuration files", "err", err)
+		s, scrapeConfigSecrets, err = prompkg.SplitConfigurationSecret(p, config, conf)
+		if err != nil {
+			c.eventRecorder.Event(p, v1.EventTypeWarning, operator.SecretTooLargeEvent, err.Error())
+			return nil, err
+		}
+	} else if len(c.scrapeConfigSecretNames(p)) > 0 {
+		// The annotations of the existing Secret are retained on update
+		// hence the annotation needs to be reset explicitly.
+		s.Annotations[prompkg.ScrapeConfigSecretsAnnotation] = ""
 	}
 
-	logger.Debug("updating Prometheus configuration secret")
-	return k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+	if c.artifactStore != nil {
+		logger.Debug("publishing Prometheus configuration to the artifact store")
+		if err := c.artifactStore.Publish(ctx, configArtifactKey(p), s.Data); err != nil {
+			return nil, fmt.Errorf("failed to publish the configuration: %w", err)
+		}
+	} else {
+		sClient := c.kclient.CoreV1().Secrets(p.Namespace)
+		// The scrape configuration files are written before the
+		// configuration which references them.
+		for _, sc := range scrapeConfigSecrets {
+			if err := k8sutil.CreateOrUpdateSecret(ctx, sClient, sc, c.fieldManager); err != nil {
+				return nil, fmt.Errorf("failed to update the scrape configuration secret %q: %w", sc.Name, err)
+			}
+		}
+
+		logger.Debug("updating Prometheus configuration secret")
+		if err := k8sutil.CreateOrUpdateSecret(ctx, sClient, s, c.fieldManager); err != nil {
+			return nil, err
+		}
+		c.execReloader.SetConfig(key, p, conf)
+	}
+
+	c.configHashes.Set(key, inputHash)
+	c.effectiveConfig.Set(key, conf)
+
+	return prompkg.ScrapeConfigSecretNames(s), nil
+}
+
+// scrapeConfigSecretNames returns the names of the Secrets holding the scrape
+// configuration files from the configuration Secret present in the
+// informer's cache.
+func (c *Operator) scrapeConfigSecretNames(p *monitoringv1.Prometheus) []string {
+	if c.artifactStore != nil {
+		return nil
+	}
+
+	obj, err := c.secrInfs.Get(p.Namespace + "/" + prompkg.ConfigSecretName(p))
+	if err != nil {
+		return nil
+	}
+
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return nil
+	}
+
+	return prompkg.ScrapeConfigSecretNames(o)
+}
+
+// configurationSecretExists returns true if the configuration Secret of the
+// Prometheus object is present in the informer's cache. With an artifact
+// store, the configuration exists once it's been published by the operator.
+func (c *Operator) configurationSecretExists(key string, p *monitoringv1.Prometheus) bool {
+	if c.artifactStore != nil {
+		return c.configHashes.Has(key)
+	}
+
+	_, err := c.secrInfs.Get(p.Namespace + "/" + prompkg.ConfigSecretName(p))
+	return err == nil
 }
 
 func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitoringv1.Prometheus) error {
+	config := c.currentConfig()
+
 	var fields monitoringv1.WebConfigFileFields
 	if p.Spec.Web != nil {
 		fields = p.Spec.Web.WebConfigFileFields
@@ -1280,12 +1863,12 @@ func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitor
 	s := &v1.Secret{}
 	operator.UpdateObject(
 		s,
-		operator.WithLabels(c.config.Labels),
-		operator.WithAnnotations(c.config.Annotations),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
 		operator.WithManagingOwner(p),
 	)
 
-	if err := webConfig.CreateOrUpdateWebConfigSecret(ctx, c.kclient.CoreV1().Secrets(p.Namespace), s); err != nil {
+	if err := webConfig.CreateOrUpdateWebConfigSecret(ctx, c.kclient.CoreV1().Secrets(p.Namespace), s, c.fieldManager); err != nil {
 		return fmt.Errorf("failed to reconcile web config secret: %w", err)
 	}
 
@@ -1293,6 +1876,8 @@ func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitor
 }
 
 func (c *Operator) createOrUpdateThanosConfigSecret(ctx context.Context, p *monitoringv1.Prometheus) error {
+	config := c.currentConfig()
+
 	secret, err := buildPrometheusHTTPClientConfigSecret(p)
 	if err != nil {
 		return fmt.Errorf("failed to build Thanos HTTP client config secret: :%w", err)
@@ -1300,12 +1885,94 @@ func (c *Operator) createOrUpdateThanosConfigSecret(ctx context.Context, p *moni
 
 	operator.UpdateObject(
 		secret,
-		operator.WithLabels(c.config.Labels),
-		operator.WithAnnotations(c.config.Annotations),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
 		operator.WithManagingOwner(p),
 	)
 
-	return k8sutil.CreateOrUpdateSecret(ctx, c.kclient.CoreV1().Secrets(secret.Namespace), secret)
+	return k8sutil.CreateOrUpdateSecret(ctx, c.kclient.CoreV1().Secrets(secret.Namespace), secret, c.fieldManager)
+}
+
+func remoteWriteReceiverServiceName(p *monitoringv1.Prometheus) string {
+	return fmt.Sprintf("%s-remote-write", prompkg.PrefixedName(p))
+}
+
+func makeRemoteWriteReceiverService(p *monitoringv1.Prometheus, config prompkg.Config) *v1.Service {
+	spec := p.Spec.RemoteWriteReceiverService
+
+	portName := prompkg.DefaultPortName
+	if p.Spec.PortName != "" {
+		portName = p.Spec.PortName
+	}
+
+	svc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			Type: ptr.Deref(spec.Type, v1.ServiceTypeClusterIP),
+			Ports: []v1.ServicePort{
+				{
+					Name:       "remote-write",
+					Port:       ptr.Deref(spec.Port, 9090),
+					TargetPort: intstr.FromString(portName),
+				},
+			},
+			Selector: makeSelectorLabels(p.Name),
+		},
+	}
+
+	operator.UpdateObject(
+		svc,
+		operator.WithName(remoteWriteReceiverServiceName(p)),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithAnnotations(spec.Annotations),
+		operator.WithLabels(config.Labels),
+		operator.WithLabels(spec.Labels),
+		operator.WithLabels(map[string]string{prompkg.PrometheusNameLabelName: p.Name}),
+		operator.WithOwner(p),
+	)
+
+	return svc
+}
+
+// reconcileRemoteWriteReceiverService creates or updates the Service exposing
+// the remote write receiver endpoint. The Service is deleted when the remote
+// write receiver or the Service isn't enabled anymore.
+func (c *Operator) reconcileRemoteWriteReceiverService(ctx context.Context, p *monitoringv1.Prometheus) error {
+	svcClient := c.kclient.CoreV1().Services(p.Namespace)
+
+	if !p.Spec.EnableRemoteWriteReceiver || p.Spec.RemoteWriteReceiverService == nil {
+		name := remoteWriteReceiverServiceName(p)
+
+		// Avoid a useless API request when the Service doesn't exist (which
+		// is the usual case).
+		obj, err := c.svcInfs.Get(p.Namespace + "/" + name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		svc, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+
+		if !slices.ContainsFunc(svc.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+			return ref.UID == p.UID
+		}) {
+			return nil
+		}
+
+		err = svcClient.Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	_, err := k8sutil.CreateOrUpdateService(ctx, svcClient, makeRemoteWriteReceiverService(p, c.currentConfig()), c.fieldManager)
+	return err
 }
 
 func makeSelectorLabels(name string) map[string]string {
@@ -1382,3 +2049,45 @@ func addAlertmanagerEndpointsToStore(ctx context.Context, store *assets.StoreBui
 
 	return nil
 }
+
+// rolloutOwner returns the identifier of the Prometheus object in the rollout
+// budget.
+func rolloutOwner(key string) string {
+	return monitoringv1.PrometheusesKind + "/" + key
+}
+
+func (c *Operator) currentConfig() prompkg.Config {
+	c.configMtx.RLock()
+	defer c.configMtx.RUnlock()
+
+	return c.config
+}
+
+// UpdateConfig applies the operator's configuration and reconciles all the
+// Prometheus objects when the parameters of the controller have changed.
+func (c *Operator) UpdateConfig(cfg operator.Config) {
+	config := prompkg.NewConfig(cfg)
+
+	c.configMtx.Lock()
+	changed := !reflect.DeepEqual(c.config, config)
+	c.config = config
+	c.configMtx.Unlock()
+
+	if !changed {
+		return
+	}
+
+	c.logger.Info("operator configuration changed, reconciling all the objects")
+	c.rr.EnqueueAll()
+}
+
+// removeConfigResourceBindings schedules the removal of the bindings to the
+// workload from the status of the configuration resources when the workload
+// is deleted or doesn't select configuration resources anymore.
+func (c *Operator) removeConfigResourceBindings(key string) {
+	if c.statusWriter == nil {
+		return
+	}
+
+	operator.RemoveBindings(c.statusWriter, c.cmclient, monitoringv1.PrometheusName, key, c.boundResources.Forget(key))
+}
@@ -16,17 +16,28 @@ package prometheus
 
 import (
 	"context"
+	"io"
+	"log/slog"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
@@ -264,3 +275,429 @@ func TestCreateThanosConfigSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileRemoteWriteReceiverService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+			UID:       "uid",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				EnableRemoteWriteReceiver: true,
+			},
+			RemoteWriteReceiverService: &monitoringv1.RemoteWriteReceiverServiceSpec{
+				Type:        ptr.To(v1.ServiceTypeLoadBalancer),
+				Port:        ptr.To(int32(8080)),
+				Labels:      map[string]string{"traffic": "ingest"},
+				Annotations: map[string]string{"foo": "bar"},
+			},
+		},
+	}
+
+	kclient := fake.NewSimpleClientset()
+	svcInfs, err := informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			nil,
+			kclient,
+			0,
+			nil,
+		),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourceServices)),
+	)
+	require.NoError(t, err)
+	go svcInfs.Start(ctx.Done())
+	require.Eventually(t, svcInfs.HasSynced, 10*time.Second, 10*time.Millisecond)
+
+	c := &Operator{
+		kclient: kclient,
+		svcInfs: svcInfs,
+		config: prompkg.Config{
+			Labels: map[string]string{"operator": "label"},
+		},
+	}
+
+	require.NoError(t, c.reconcileRemoteWriteReceiverService(ctx, p))
+
+	svc, err := c.kclient.CoreV1().Services("ns").Get(ctx, "prometheus-test-remote-write", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, v1.ServiceTypeLoadBalancer, svc.Spec.Type)
+	require.Len(t, svc.Spec.Ports, 1)
+	require.Equal(t, "remote-write", svc.Spec.Ports[0].Name)
+	require.Equal(t, int32(8080), svc.Spec.Ports[0].Port)
+	require.Equal(t, "web", svc.Spec.Ports[0].TargetPort.String())
+	require.Equal(t, makeSelectorLabels("test"), svc.Spec.Selector)
+	require.Equal(t, "ingest", svc.Labels["traffic"])
+	require.Equal(t, "label", svc.Labels["operator"])
+	require.Equal(t, "test", svc.Labels[prompkg.PrometheusNameLabelName])
+	require.Equal(t, "bar", svc.Annotations["foo"])
+	require.Len(t, svc.OwnerReferences, 1)
+
+	require.Eventually(t, func() bool {
+		_, err := svcInfs.Get("ns/prometheus-test-remote-write")
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	p.Spec.EnableRemoteWriteReceiver = false
+	require.NoError(t, c.reconcileRemoteWriteReceiverService(ctx, p))
+
+	_, err = c.kclient.CoreV1().Services("ns").Get(ctx, "prometheus-test-remote-write", metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+
+	require.Eventually(t, func() bool {
+		_, err := svcInfs.Get("ns/prometheus-test-remote-write")
+		return apierrors.IsNotFound(err)
+	}, 10*time.Second, 10*time.Millisecond)
+
+	countDeletes := func() int {
+		var n int
+		for _, a := range kclient.Actions() {
+			if a.GetVerb() == "delete" {
+				n++
+			}
+		}
+		return n
+	}
+
+	// No API request is sent when the Service doesn't exist.
+	require.NoError(t, c.reconcileRemoteWriteReceiverService(ctx, p))
+	require.Equal(t, 1, countDeletes())
+
+	// A Service which isn't owned by the Prometheus object is left alone.
+	_, err = kclient.CoreV1().Services("ns").Create(ctx, &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus-test-remote-write",
+			Namespace: "ns",
+			Labels:    map[string]string{prompkg.PrometheusNameLabelName: "test"},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		_, err := svcInfs.Get("ns/prometheus-test-remote-write")
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, c.reconcileRemoteWriteReceiverService(ctx, p))
+	require.Equal(t, 1, countDeletes())
+}
+
+type recordingSyncer struct {
+	keys       chan string
+	statusKeys chan string
+}
+
+func (s *recordingSyncer) Sync(_ context.Context, key string) error {
+	s.keys <- key
+	return nil
+}
+
+func (s *recordingSyncer) UpdateStatus(_ context.Context, key string) error {
+	if s.statusKeys != nil {
+		s.statusKeys <- key
+	}
+	return nil
+}
+
+func TestEnqueueForAdditionalScrapeConfigsSecret(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				AdditionalScrapeConfigs: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "additional-scrape-configs"},
+					Key:                  "scrape.yaml",
+				},
+			},
+		},
+	}
+
+	promInfs, err := informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			nil,
+			monitoringfake.NewSimpleClientset(p),
+			0,
+			nil,
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusName),
+	)
+	require.NoError(t, err)
+	go promInfs.Start(ctx.Done())
+	require.Eventually(t, promInfs.HasSynced, 10*time.Second, 10*time.Millisecond)
+
+	reg := prometheus.NewRegistry()
+	syncer := &recordingSyncer{keys: make(chan string, 10)}
+	c := &Operator{
+		kclient: fake.NewSimpleClientset(&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "additional-scrape-configs",
+				Namespace: "ns",
+			},
+			Data: map[string][]byte{"scrape.yaml": []byte("[]")},
+		}),
+		promInfs: promInfs,
+		refIndex: operator.NewReferenceIndex(reg),
+		logger:   slog.New(slog.DiscardHandler),
+	}
+	c.rr = operator.NewResourceReconciler(c.logger, syncer, promInfs, operator.NewMetrics(reg), monitoringv1.PrometheusesKind, reg, "", nil, 1)
+	defer c.rr.Stop()
+	c.rr.Run(ctx)
+
+	store := assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
+	b, err := c.loadAdditionalConfig(ctx, c.logger, store, p.Namespace, p.Spec.AdditionalScrapeConfigs)
+	require.NoError(t, err)
+	require.Equal(t, "[]", string(b))
+
+	secrets, configMaps := store.References()
+	c.refIndex.Set("ns/test", secrets, configMaps)
+
+	// A Secret which isn't referenced doesn't trigger a reconciliation.
+	c.enqueueForReference(operator.SecretKind)("ns", "other")
+	select {
+	case key := <-syncer.keys:
+		t.Fatalf("unexpected reconciliation of %q", key)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	c.enqueueForReference(operator.SecretKind)("ns", "additional-scrape-configs")
+	select {
+	case key := <-syncer.keys:
+		require.Equal(t, "ns/test", key)
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the Prometheus object to be enqueued")
+	}
+}
+
+func TestRefreshConfigReload(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+	}
+
+	promInfs, err := informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			nil,
+			monitoringfake.NewSimpleClientset(p),
+			0,
+			nil,
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusName),
+	)
+	require.NoError(t, err)
+	go promInfs.Start(ctx.Done())
+
+	// The pod has no IP address yet.
+	podInfs, err := informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			nil,
+			fake.NewSimpleClientset(&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "prometheus-test-0",
+					Namespace: "ns",
+					Labels:    makeSelectorLabels("test"),
+				},
+			}),
+			0,
+			nil,
+		),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourcePods)),
+	)
+	require.NoError(t, err)
+	go podInfs.Start(ctx.Done())
+	require.Eventually(t, func() bool { return promInfs.HasSynced() && podInfs.HasSynced() }, 10*time.Second, 10*time.Millisecond)
+
+	reg := prometheus.NewRegistry()
+	syncer := &recordingSyncer{keys: make(chan string, 10), statusKeys: make(chan string, 10)}
+	c := &Operator{
+		promInfs:      promInfs,
+		podInfs:       podInfs,
+		configReload:  operator.NewConfigReloadTracker(),
+		eventRecorder: record.NewFakeRecorder(10),
+		logger:        slog.New(slog.DiscardHandler),
+	}
+	c.rr = operator.NewResourceReconciler(c.logger, syncer, promInfs, operator.NewMetrics(reg), monitoringv1.PrometheusesKind, reg, "", nil, 1)
+	defer c.rr.Stop()
+	c.rr.Run(ctx)
+
+	c.refreshConfigReload(ctx)
+	select {
+	case key := <-syncer.statusKeys:
+		require.Equal(t, "ns/test", key)
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the Prometheus object to be enqueued for status")
+	}
+
+	conds := c.configReload.Conditions("ns/test", 1)
+	require.Len(t, conds, 1)
+	require.Equal(t, monitoringv1.ConditionUnknown, conds[0].Status)
+
+	// The condition doesn't change.
+	c.refreshConfigReload(ctx)
+	select {
+	case key := <-syncer.statusKeys:
+		t.Fatalf("unexpected status update of %q", key)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+type recordingPodExecutor struct {
+	pods []string
+}
+
+func (r *recordingPodExecutor) Exec(_ context.Context, _, pod, _ string, _ []string, _ io.Reader) error {
+	r.pods = append(r.pods, pod)
+	return nil
+}
+
+func TestSyncExecReload(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				ReloadStrategy: ptr.To(monitoringv1.OperatorExecReloadStrategyType),
+			},
+		},
+	}
+
+	podInfs, err := informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			map[string]struct{}{v1.NamespaceAll: {}},
+			nil,
+			fake.NewSimpleClientset(&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "prometheus-test-0",
+					Namespace: "ns",
+					Labels:    makeSelectorLabels("test"),
+				},
+				Status: v1.PodStatus{
+					ContainerStatuses: []v1.ContainerStatus{{
+						Name:  "prometheus",
+						State: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+					}},
+				},
+			}),
+			0,
+			nil,
+		),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourcePods)),
+	)
+	require.NoError(t, err)
+	go podInfs.Start(ctx.Done())
+	require.Eventually(t, podInfs.HasSynced, 10*time.Second, 10*time.Millisecond)
+
+	for _, tc := range []struct {
+		name      string
+		auditMode bool
+		execs     []string
+	}{
+		{
+			name:  "reconcile mode",
+			execs: []string{"prometheus-test-0"},
+		},
+		{
+			// The exec requests can't be sent as dry-run requests.
+			name:      "audit mode",
+			auditMode: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			executor := &recordingPodExecutor{}
+			c := &Operator{
+				podInfs:      podInfs,
+				auditMode:    tc.auditMode,
+				execReloader: prompkg.NewExecReloader(executor),
+			}
+			c.execReloader.SetConfig("ns/test", p, []byte("global: {}\n"))
+
+			err := c.syncExecReload(ctx, slog.New(slog.DiscardHandler), "ns/test", p, map[string]string{})
+			require.NoError(t, err)
+			require.Equal(t, tc.execs, executor.pods)
+		})
+	}
+}
+
+func TestPruneChildren(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+			UID:       "uid",
+		},
+	}
+
+	newConfigMap := func(name string) *metav1.PartialObjectMetadata {
+		o := &metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		}
+		operator.UpdateObject(o, operator.WithName(name), operator.WithNamespace("ns"), operator.WithManagingOwner(p))
+
+		return o
+	}
+
+	for _, tc := range []struct {
+		name         string
+		crDiscoverer *prompkg.CustomResourceDiscoverer
+		expected     []string
+	}{
+		{
+			name:     "custom resource discovery disabled",
+			expected: []string{"prometheus-test-rulefiles-0"},
+		},
+		{
+			name:         "custom resource discovery enabled",
+			crDiscoverer: prompkg.NewCustomResourceDiscoverer(slog.New(slog.DiscardHandler), fake.NewSimpleClientset(), nil),
+			expected:     []string{"prometheus-test-file-sd", "prometheus-test-rulefiles-0"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, metav1.AddMetaToScheme(scheme))
+
+			mdClient := metadatafake.NewSimpleMetadataClient(
+				scheme,
+				newConfigMap("prometheus-test-rulefiles-0"),
+				newConfigMap("prometheus-test-rulefiles-1"),
+				newConfigMap(prompkg.FileSDConfigMapName(p)),
+			)
+
+			c := &Operator{
+				mdClient:     mdClient,
+				crDiscoverer: tc.crDiscoverer,
+			}
+			require.NoError(t, c.pruneChildren(context.Background(), slog.New(slog.DiscardHandler), p, nil, []string{"prometheus-test-rulefiles-0"}))
+
+			list, err := mdClient.Resource(v1.SchemeGroupVersion.WithResource("configmaps")).Namespace("ns").List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+
+			var names []string
+			for _, o := range list.Items {
+				names = append(names, o.Name)
+			}
+			require.ElementsMatch(t, tc.expected, names)
+		})
+	}
+}
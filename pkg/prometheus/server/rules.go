@@ -18,25 +18,32 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	namespacelabeler "github.com/prometheus-operator/prometheus-operator/pkg/namespacelabeler"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
 
-func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitoringv1.Prometheus) ([]string, error) {
+// createOrUpdateRuleConfigMaps generates the ConfigMaps holding the rule
+// files of the Prometheus object. It returns the names of the ConfigMaps and
+// the content of the rule files indexed by file name.
+func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitoringv1.Prometheus) ([]string, map[string]string, error) {
 	cClient := c.kclient.CoreV1().ConfigMaps(p.Namespace)
 
 	namespaces, err := c.selectRuleNamespaces(p)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	excludedFromEnforcement := p.Spec.ExcludedFromEnforcement
@@ -61,22 +68,39 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 
 	promRuleSelector, err := operator.NewPrometheusRuleSelector(operator.PrometheusFormat, promVersion, p.Spec.RuleSelector, nsLabeler, c.ruleInfs, c.eventRecorder, logger)
 	if err != nil {
-		return nil, fmt.Errorf("initializing PrometheusRules failed: %w", err)
+		return nil, nil, fmt.Errorf("initializing PrometheusRules failed: %w", err)
 	}
+	promRuleSelector.ShardRecordingRules(ptr.Deref(p.Spec.Shards, 1))
 
 	newRules, rejected, err := promRuleSelector.Select(namespaces)
 	if err != nil {
-		return nil, fmt.Errorf("selecting PrometheusRules failed: %w", err)
+		return nil, nil, fmt.Errorf("selecting PrometheusRules failed: %w", err)
 	}
 
-	if pKey, ok := c.accessor.MetaNamespaceKey(p); ok {
+	if rejected > 0 {
+		c.eventRecorder.Eventf(p, v1.EventTypeWarning, operator.RejectedRulesEvent, "%d PrometheusRule(s) were rejected due to invalid configuration", rejected)
+	}
+
+	pKey, hasKey := c.accessor.MetaNamespaceKey(p)
+	if hasKey {
+		c.selection.Update(pKey, func(r *prompkg.SelectionReport) {
+			r.PrometheusRules = promRuleSelector.Selection()
+		})
+
 		c.metrics.SetSelectedResources(pKey, monitoringv1.PrometheusRuleKind, len(newRules))
 		c.metrics.SetRejectedResources(pKey, monitoringv1.PrometheusRuleKind, rejected)
+
+		c.reconciliations.SetWarning(pKey, operator.UnsupportedRuleFieldsEvent, promRuleSelector.UnsupportedFieldsWarning())
+	}
+
+	var rulesSize int
+	for _, r := range newRules {
+		rulesSize += len(r)
 	}
 
 	currentConfigMapList, err := cClient.List(ctx, prometheusRulesConfigMapSelector(p.Name))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	currentConfigMaps := currentConfigMapList.Items
 
@@ -97,17 +121,21 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 		for _, cm := range currentConfigMaps {
 			currentConfigMapNames = append(currentConfigMapNames, cm.Name)
 		}
-		return currentConfigMapNames, nil
+		if hasKey {
+			c.metrics.SetGeneratedRuleConfigMaps(pKey, len(currentConfigMaps), rulesSize)
+		}
+		return currentConfigMapNames, newRules, nil
 	}
 
+	config := c.currentConfig()
 	newConfigMaps, err := makeRulesConfigMaps(
 		p,
 		newRules,
-		operator.WithAnnotations(c.config.Annotations),
-		operator.WithLabels(c.config.Labels),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(config.Labels),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make rules ConfigMaps: %w", err)
+		return nil, nil, fmt.Errorf("failed to make rules ConfigMaps: %w", err)
 	}
 
 	newConfigMapNames := make([]string, 0, len(newConfigMaps))
@@ -115,27 +143,8 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 		newConfigMapNames = append(newConfigMapNames, cm.Name)
 	}
 
-	if len(currentConfigMaps) == 0 {
-		c.logger.Debug("no PrometheusRule configmap found, creating new one",
-			"namespace", p.Namespace,
-			"prometheus", p.Name,
-		)
-		for _, cm := range newConfigMaps {
-			_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
-			if err != nil {
-				return nil, fmt.Errorf("failed to create ConfigMap '%v': %w", cm.Name, err)
-			}
-		}
-		return newConfigMapNames, nil
-	}
-
-	// Simply deleting old ConfigMaps and creating new ones for now. Could be
-	// replaced by logic that only deletes obsolete ConfigMaps in the future.
-	for _, cm := range currentConfigMaps {
-		err := cClient.Delete(ctx, cm.Name, metav1.DeleteOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to delete current ConfigMap '%v': %w", cm.Name, err)
-		}
+	if hasKey {
+		c.metrics.SetGeneratedRuleConfigMaps(pKey, len(newConfigMaps), rulesSize)
 	}
 
 	c.logger.Debug("updating PrometheusRule",
@@ -143,13 +152,24 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 		"prometheus", p.Name,
 	)
 	for _, cm := range newConfigMaps {
-		_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to create new ConfigMap '%v': %w", cm.Name, err)
+		if err := k8sutil.CreateOrUpdateConfigMap(ctx, cClient, &cm); err != nil {
+			return nil, nil, fmt.Errorf("failed to create or update ConfigMap %q: %w", cm.Name, err)
+		}
+	}
+
+	// Delete the ConfigMaps which aren't needed anymore (e.g. when the size
+	// of the rules decreases).
+	for _, cm := range currentConfigMaps {
+		if slices.Contains(newConfigMapNames, cm.Name) {
+			continue
+		}
+
+		if err := cClient.Delete(ctx, cm.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("failed to delete obsolete ConfigMap %q: %w", cm.Name, err)
 		}
 	}
 
-	return newConfigMapNames, nil
+	return newConfigMapNames, newRules, nil
 }
 
 func prometheusRulesConfigMapSelector(prometheusName string) metav1.ListOptions {
@@ -0,0 +1,181 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// targetScrape is the outcome of the last scrape of a target.
+type targetScrape struct {
+	lastScrape time.Time
+	duration   time.Duration
+	interval   time.Duration
+}
+
+func newTargetScrape(t activeTarget) targetScrape {
+	ts := targetScrape{
+		lastScrape: t.LastScrape,
+		duration:   time.Duration(t.LastScrapeDuration * float64(time.Second)),
+	}
+
+	if d, err := model.ParseDuration(t.ScrapeInterval); err == nil {
+		ts.interval = time.Duration(d)
+	}
+
+	return ts
+}
+
+// configResource identifies the configuration resource which generated a
+// scrape pool.
+type configResource struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// configResourceFromScrapePool returns the configuration resource from the
+// scrape pool's name (e.g. "serviceMonitor/<namespace>/<name>/<endpoint>").
+func configResourceFromScrapePool(pool string) (configResource, bool) {
+	parts := strings.Split(pool, "/")
+	switch {
+	case len(parts) == 4 && parts[0] == "serviceMonitor":
+		return configResource{kind: monitoringv1.ServiceMonitorsKind, namespace: parts[1], name: parts[2]}, true
+	case len(parts) == 4 && parts[0] == "podMonitor":
+		return configResource{kind: monitoringv1.PodMonitorsKind, namespace: parts[1], name: parts[2]}, true
+	case len(parts) == 3 && parts[0] == "probe":
+		return configResource{kind: monitoringv1.ProbesKind, namespace: parts[1], name: parts[2]}, true
+	case len(parts) == 3 && parts[0] == "scrapeConfig":
+		return configResource{kind: monitoringv1alpha1.ScrapeConfigsKind, namespace: parts[1], name: parts[2]}, true
+	}
+
+	return configResource{}, false
+}
+
+// scrapeStatistics returns the scrape statistics of the configuration
+// resources for the given object's key.
+func (tc *targetCache) scrapeStatistics(key string) map[configResource]*monitoringv1.ScrapeStatistics {
+	tc.mtx.Lock()
+	defer tc.mtx.Unlock()
+
+	e, found := tc.entries[key]
+	if !found {
+		return nil
+	}
+
+	// ServiceMonitors and PodMonitors generate one scrape pool per endpoint.
+	scrapes := map[configResource][]targetScrape{}
+	for pool, s := range e.scrapes {
+		cr, ok := configResourceFromScrapePool(pool)
+		if !ok {
+			continue
+		}
+		scrapes[cr] = append(scrapes[cr], s...)
+	}
+
+	ret := make(map[configResource]*monitoringv1.ScrapeStatistics, len(scrapes))
+	for cr, s := range scrapes {
+		ret[cr] = newScrapeStatistics(s, e.updated)
+	}
+
+	return ret
+}
+
+// newScrapeStatistics computes the statistics of the target scrapes at the
+// given time.
+//
+// The interval drift of a target is the time elapsed since its last scrape
+// beyond the scrape interval. A target is stale when the drift exceeds the
+// scrape interval. The targets which haven't been scraped yet are only
+// accounted in the number of targets.
+func newScrapeStatistics(scrapes []targetScrape, now time.Time) *monitoringv1.ScrapeStatistics {
+	st := &monitoringv1.ScrapeStatistics{
+		Targets:        int32(len(scrapes)),
+		LastUpdateTime: metav1.NewTime(now),
+	}
+
+	var (
+		durations = make([]time.Duration, 0, len(scrapes))
+		drifts    = make([]int32, 0, len(scrapes))
+	)
+	for _, s := range scrapes {
+		if s.lastScrape.IsZero() || s.interval <= 0 {
+			continue
+		}
+
+		durations = append(durations, s.duration)
+
+		drift := max(now.Sub(s.lastScrape)-s.interval, 0)
+		if drift > s.interval {
+			st.StaleTargets++
+		}
+		drifts = append(drifts, int32(min(int64(drift*100/s.interval), math.MaxInt32)))
+	}
+
+	if len(durations) == 0 {
+		return st
+	}
+
+	slices.Sort(durations)
+	slices.Sort(drifts)
+
+	st.LastScrapeDurationP50 = formatDuration(percentile(durations, 0.5))
+	st.LastScrapeDurationP90 = formatDuration(percentile(durations, 0.9))
+	st.LastScrapeDurationP99 = formatDuration(percentile(durations, 0.99))
+	st.IntervalDriftPercentP50 = percentile(drifts, 0.5)
+	st.IntervalDriftPercentP90 = percentile(drifts, 0.9)
+	st.IntervalDriftPercentP99 = percentile(drifts, 0.99)
+
+	return st
+}
+
+// percentile returns the q-th percentile of the sorted values using the
+// nearest-rank method.
+func percentile[T cmp.Ordered](sorted []T, q float64) T {
+	i := int(math.Ceil(q*float64(len(sorted)))) - 1
+	return sorted[max(i, 0)]
+}
+
+func formatDuration(d time.Duration) monitoringv1.Duration {
+	return monitoringv1.Duration(model.Duration(d.Round(time.Millisecond)).String())
+}
+
+// updateScrapeStatistics schedules the update of the scrape statistics in
+// the status of the configuration resources which generated the targets of
+// the Prometheus object.
+func (c *Operator) updateScrapeStatistics(p *monitoringv1.Prometheus, key string) {
+	if !c.configResourcesStatusEnabled || c.statusWriter == nil {
+		return
+	}
+
+	workload := operator.NewWorkloadBinding(p, monitoringv1.PrometheusName, 0, "", nil)
+	for cr, stats := range c.targets.scrapeStatistics(key) {
+		c.statusWriter.Enqueue(
+			operator.ConfigResourceStatusKey(cr.kind, cr.namespace, cr.name, workload)+":statistics",
+			operator.ScrapeStatisticsUpdate(c.cmclient, cr.kind, cr.namespace, cr.name, workload, stats),
+		)
+	}
+}
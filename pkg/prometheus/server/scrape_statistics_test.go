@@ -0,0 +1,116 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func TestConfigResourceFromScrapePool(t *testing.T) {
+	for _, tc := range []struct {
+		pool     string
+		expected configResource
+		ok       bool
+	}{
+		{
+			pool:     "serviceMonitor/default/app/0",
+			expected: configResource{kind: monitoringv1.ServiceMonitorsKind, namespace: "default", name: "app"},
+			ok:       true,
+		},
+		{
+			pool:     "scrapeConfig/default/databases",
+			expected: configResource{kind: monitoringv1alpha1.ScrapeConfigsKind, namespace: "default", name: "databases"},
+			ok:       true,
+		},
+		{
+			pool:     "podMonitor/default/app/0",
+			expected: configResource{kind: monitoringv1.PodMonitorsKind, namespace: "default", name: "app"},
+			ok:       true,
+		},
+		{
+			pool:     "probe/default/app",
+			expected: configResource{kind: monitoringv1.ProbesKind, namespace: "default", name: "app"},
+			ok:       true,
+		},
+		{
+			pool: "additional-job",
+		},
+	} {
+		t.Run(tc.pool, func(t *testing.T) {
+			cr, ok := configResourceFromScrapePool(tc.pool)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.expected, cr)
+		})
+	}
+}
+
+func TestNewScrapeStatistics(t *testing.T) {
+	now := time.Now()
+
+	scrapes := []targetScrape{
+		// Not scraped yet.
+		{interval: 30 * time.Second},
+		{lastScrape: now.Add(-10 * time.Second), duration: 100 * time.Millisecond, interval: 30 * time.Second},
+		{lastScrape: now.Add(-20 * time.Second), duration: 200 * time.Millisecond, interval: 30 * time.Second},
+		// Scrape late by 15s.
+		{lastScrape: now.Add(-45 * time.Second), duration: 2 * time.Second, interval: 30 * time.Second},
+		// Stale.
+		{lastScrape: now.Add(-90 * time.Second), duration: 10 * time.Second, interval: 30 * time.Second},
+	}
+
+	require.Equal(t, &monitoringv1.ScrapeStatistics{
+		Targets:                 5,
+		StaleTargets:            1,
+		LastScrapeDurationP50:   "200ms",
+		LastScrapeDurationP90:   "10s",
+		LastScrapeDurationP99:   "10s",
+		IntervalDriftPercentP50: 0,
+		IntervalDriftPercentP90: 200,
+		IntervalDriftPercentP99: 200,
+		LastUpdateTime:          metav1.NewTime(now),
+	}, newScrapeStatistics(scrapes, now))
+
+	// No target scraped yet.
+	require.Equal(t, &monitoringv1.ScrapeStatistics{
+		Targets:        1,
+		LastUpdateTime: metav1.NewTime(now),
+	}, newScrapeStatistics(scrapes[:1], now))
+}
+
+func TestTargetCacheScrapeStatistics(t *testing.T) {
+	now := time.Now()
+	tc := newTargetCache()
+	require.Nil(t, tc.scrapeStatistics("ns/p"))
+
+	scrape := targetScrape{lastScrape: now.Add(-time.Second), duration: time.Second, interval: time.Minute}
+	tc.update("ns/p", nil, map[string][]targetScrape{
+		"serviceMonitor/default/app/0":   {scrape},
+		"serviceMonitor/default/app/1":   {scrape, scrape},
+		"scrapeConfig/default/databases": {scrape},
+		"additional-job":                 {scrape},
+	}, now)
+
+	stats := tc.scrapeStatistics("ns/p")
+	require.Len(t, stats, 2)
+	require.Equal(t, int32(3), stats[configResource{kind: monitoringv1.ServiceMonitorsKind, namespace: "default", name: "app"}].Targets)
+	require.Equal(t, int32(1), stats[configResource{kind: monitoringv1alpha1.ScrapeConfigsKind, namespace: "default", name: "databases"}].Targets)
+}
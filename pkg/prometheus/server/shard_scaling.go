@@ -0,0 +1,348 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+const (
+	targetsPollInterval = time.Minute
+	targetsPollTimeout  = 10 * time.Second
+)
+
+// shardsToApply returns the number of shards that the operator should deploy
+// for the given Prometheus object.
+//
+// When `spec.shardScaling.confirm` is true, a change of `spec.shards` is
+// applied only once the confirmation annotation matches the requested number
+// of shards. Until then, the most recently applied number of shards is kept.
+func shardsToApply(p *monitoringv1.Prometheus) int32 {
+	requested := ptr.Deref(p.Spec.Shards, 1)
+	if requested < 1 {
+		requested = 1
+	}
+
+	if p.Spec.ShardScaling == nil || !ptr.Deref(p.Spec.ShardScaling.Confirm, false) {
+		return requested
+	}
+
+	current := p.Status.Shards
+	if current < 1 || current == requested {
+		return requested
+	}
+
+	if p.Annotations[monitoringv1.ConfirmShardsAnnotation] == strconv.Itoa(int(requested)) {
+		return requested
+	}
+
+	return current
+}
+
+// shardForAddress returns the shard scraping the given address.
+func shardForAddress(address string, shards int32) int32 {
+	return operator.ShardForValue(address, shards)
+}
+
+type cachedTarget struct {
+	address string
+	shard   int32
+}
+
+type targetCacheEntry struct {
+	targets map[string]cachedTarget
+	// Scrapes of the targets indexed by scrape pool.
+	scrapes map[string][]targetScrape
+	updated time.Time
+	// Shards of the targets before the last change of the number of shards.
+	baseline map[string]int32
+}
+
+// targetCache stores the active targets discovered by the Prometheus pods,
+// indexed by the Prometheus object's key.
+type targetCache struct {
+	mtx     sync.Mutex
+	entries map[string]*targetCacheEntry
+}
+
+func newTargetCache() *targetCache {
+	return &targetCache{entries: map[string]*targetCacheEntry{}}
+}
+
+func (tc *targetCache) update(key string, targets map[string]cachedTarget, scrapes map[string][]targetScrape, t time.Time) {
+	tc.mtx.Lock()
+	defer tc.mtx.Unlock()
+
+	e, found := tc.entries[key]
+	if !found {
+		e = &targetCacheEntry{}
+		tc.entries[key] = e
+	}
+
+	e.targets = targets
+	e.scrapes = scrapes
+	e.updated = t
+}
+
+// snapshot records the current shard of each target. It should be called
+// when the number of shards changes to report the observed movements.
+func (tc *targetCache) snapshot(key string) {
+	tc.mtx.Lock()
+	defer tc.mtx.Unlock()
+
+	e, found := tc.entries[key]
+	if !found {
+		return
+	}
+
+	e.baseline = make(map[string]int32, len(e.targets))
+	for id, t := range e.targets {
+		e.baseline[id] = t.shard
+	}
+}
+
+func (tc *targetCache) forget(key string) {
+	tc.mtx.Lock()
+	defer tc.mtx.Unlock()
+
+	delete(tc.entries, key)
+}
+
+// status returns the shard scaling report for the given object's key.
+func (tc *targetCache) status(key string, current, requested int32) *monitoringv1.ShardScalingStatus {
+	st := &monitoringv1.ShardScalingStatus{
+		CurrentShards:       current,
+		RequestedShards:     requested,
+		PendingConfirmation: current != requested,
+	}
+
+	tc.mtx.Lock()
+	defer tc.mtx.Unlock()
+
+	e, found := tc.entries[key]
+	if !found {
+		return st
+	}
+
+	st.Targets = int32(len(e.targets))
+	st.LastTargetsUpdateTime = ptr.To(metav1.NewTime(e.updated))
+
+	for _, t := range e.targets {
+		if shardForAddress(t.address, current) != shardForAddress(t.address, requested) {
+			st.PlannedTargetMoves++
+		}
+	}
+
+	if e.baseline != nil {
+		var moved int32
+		for id, t := range e.targets {
+			if shard, found := e.baseline[id]; found && shard != t.shard {
+				moved++
+			}
+		}
+		st.ObservedTargetMoves = ptr.To(moved)
+	}
+
+	return st
+}
+
+type activeTarget struct {
+	DiscoveredLabels   map[string]string `json:"discoveredLabels"`
+	Labels             map[string]string `json:"labels"`
+	ScrapeURL          string            `json:"scrapeUrl"`
+	ScrapePool         string            `json:"scrapePool"`
+	LastScrape         time.Time         `json:"lastScrape"`
+	LastScrapeDuration float64           `json:"lastScrapeDuration"`
+	ScrapeInterval     string            `json:"scrapeInterval"`
+}
+
+// address returns the address of the target after relabeling which is the
+// value hashed by the sharding relabel configuration.
+func (t activeTarget) address() string {
+	if address := t.Labels["__address__"]; address != "" {
+		return address
+	}
+
+	// The labels returned by the API don't include the reserved labels.
+	if u, err := url.Parse(t.ScrapeURL); err == nil && u.Host != "" {
+		return u.Host
+	}
+
+	return t.DiscoveredLabels["__address__"]
+}
+
+type targetsResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ActiveTargets []activeTarget `json:"activeTargets"`
+	} `json:"data"`
+}
+
+// fetchActiveTargets returns the active targets from the Prometheus API
+// served at the given URL.
+func fetchActiveTargets(ctx context.Context, client *http.Client, u url.URL) ([]activeTarget, error) {
+	u.Path = path.Join(u.Path, "/api/v1/targets")
+	u.RawQuery = url.Values{"state": []string{"active"}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var tr targetsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if tr.Status != "success" {
+		return nil, fmt.Errorf("unexpected response status %q", tr.Status)
+	}
+
+	return tr.Data.ActiveTargets, nil
+}
+
+// pollTargets refreshes regularly the targets of the Prometheus objects
+// which define `spec.shardScaling` or of all the Prometheus objects when the
+// status of the configuration resources is enabled.
+func (c *Operator) pollTargets(ctx context.Context) {
+	ticker := time.NewTicker(targetsPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = c.promInfs.ListAll(labels.Everything(), func(o interface{}) {
+				p := o.(*monitoringv1.Prometheus)
+				key := p.Namespace + "/" + p.Name
+
+				if p.Spec.ShardScaling == nil && !c.configResourcesStatusEnabled {
+					c.targets.forget(key)
+					return
+				}
+
+				if err := c.refreshTargets(ctx, p, key); err != nil {
+					c.logger.Debug("failed to refresh targets", "key", key, "err", err)
+					return
+				}
+
+				c.updateScrapeStatistics(p, key)
+
+				if p.Spec.ShardScaling != nil {
+					c.rr.EnqueueForStatus(p)
+				}
+			})
+		}
+	}
+}
+
+// refreshTargets retrieves the active targets from one ready pod per shard.
+func (c *Operator) refreshTargets(ctx context.Context, p *monitoringv1.Prometheus, key string) error {
+	cpf := p.GetCommonPrometheusFields()
+	if cpf.ListenLocal {
+		return fmt.Errorf("the Prometheus API isn't reachable when listenLocal is true")
+	}
+
+	if cpf.PrometheusURIScheme() != "http" {
+		return fmt.Errorf("unsupported scheme %q", cpf.PrometheusURIScheme())
+	}
+
+	pods, err := c.kclient.CoreV1().Pods(p.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(makeSelectorLabels(p.Name)).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		client  = &http.Client{Timeout: targetsPollTimeout}
+		targets = map[string]cachedTarget{}
+		scrapes = map[string][]targetScrape{}
+		done    = map[string]struct{}{}
+	)
+	for _, pod := range pods.Items {
+		shard, found := pod.Labels[prompkg.ShardLabelName]
+		if !found || pod.Status.PodIP == "" {
+			continue
+		}
+
+		if _, found := done[shard]; found {
+			continue
+		}
+
+		if pp := operator.Pod(pod); !pp.Ready() {
+			continue
+		}
+
+		n, err := strconv.Atoi(shard)
+		if err != nil {
+			continue
+		}
+
+		active, err := fetchActiveTargets(ctx, client, url.URL{
+			Scheme: "http",
+			Host:   pod.Status.PodIP + ":9090",
+			Path:   cpf.WebRoutePrefix(),
+		})
+		if err != nil {
+			c.logger.Debug("failed to retrieve targets", "key", key, "pod", pod.Name, "err", err)
+			continue
+		}
+
+		for _, t := range active {
+			address := t.address()
+			targets[t.ScrapePool+"/"+address] = cachedTarget{
+				address: address,
+				shard:   int32(n),
+			}
+			scrapes[t.ScrapePool] = append(scrapes[t.ScrapePool], newTargetScrape(t))
+		}
+		done[shard] = struct{}{}
+	}
+
+	if len(done) == 0 {
+		return fmt.Errorf("no ready pod found")
+	}
+
+	c.targets.update(key, targets, scrapes, time.Now())
+	return nil
+}
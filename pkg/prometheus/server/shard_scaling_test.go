@@ -0,0 +1,245 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestShardsToApply(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		shards      *int32
+		scaling     *monitoringv1.ShardScalingSpec
+		current     int32
+		annotations map[string]string
+		exp         int32
+	}{
+		{
+			name: "default",
+			exp:  1,
+		},
+		{
+			name:    "no shard scaling",
+			shards:  ptr.To(int32(3)),
+			current: 2,
+			exp:     3,
+		},
+		{
+			name:    "confirmation not required",
+			shards:  ptr.To(int32(3)),
+			scaling: &monitoringv1.ShardScalingSpec{Confirm: ptr.To(false)},
+			current: 2,
+			exp:     3,
+		},
+		{
+			name:    "first reconciliation",
+			shards:  ptr.To(int32(3)),
+			scaling: &monitoringv1.ShardScalingSpec{Confirm: ptr.To(true)},
+			exp:     3,
+		},
+		{
+			name:    "pending confirmation",
+			shards:  ptr.To(int32(3)),
+			scaling: &monitoringv1.ShardScalingSpec{Confirm: ptr.To(true)},
+			current: 2,
+			exp:     2,
+		},
+		{
+			name:        "confirmation for another number of shards",
+			shards:      ptr.To(int32(3)),
+			scaling:     &monitoringv1.ShardScalingSpec{Confirm: ptr.To(true)},
+			current:     2,
+			annotations: map[string]string{monitoringv1.ConfirmShardsAnnotation: "4"},
+			exp:         2,
+		},
+		{
+			name:        "confirmed",
+			shards:      ptr.To(int32(3)),
+			scaling:     &monitoringv1.ShardScalingSpec{Confirm: ptr.To(true)},
+			current:     2,
+			annotations: map[string]string{monitoringv1.ConfirmShardsAnnotation: "3"},
+			exp:         3,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Shards: tc.shards,
+					},
+					ShardScaling: tc.scaling,
+				},
+				Status: monitoringv1.PrometheusStatus{
+					Shards: tc.current,
+				},
+			}
+
+			require.Equal(t, tc.exp, shardsToApply(p))
+		})
+	}
+}
+
+func TestShardForAddress(t *testing.T) {
+	require.Equal(t, int32(0), shardForAddress("10.0.0.1:9100", 1))
+
+	for i := range 100 {
+		shard := shardForAddress(fmt.Sprintf("10.0.0.%d:9100", i), 4)
+		require.GreaterOrEqual(t, shard, int32(0))
+		require.Less(t, shard, int32(4))
+	}
+}
+
+func TestTargetCacheStatus(t *testing.T) {
+	tc := newTargetCache()
+
+	st := tc.status("ns/p", 2, 2)
+	require.Equal(t, int32(0), st.Targets)
+	require.False(t, st.PendingConfirmation)
+	require.Nil(t, st.LastTargetsUpdateTime)
+
+	targets := map[string]cachedTarget{}
+	for i := range 100 {
+		address := fmt.Sprintf("10.0.0.%d:9100", i)
+		targets["job/"+address] = cachedTarget{address: address, shard: shardForAddress(address, 2)}
+	}
+	tc.update("ns/p", targets, nil, time.Now())
+
+	var planned int32
+	for _, target := range targets {
+		if shardForAddress(target.address, 2) != shardForAddress(target.address, 3) {
+			planned++
+		}
+	}
+
+	st = tc.status("ns/p", 2, 3)
+	require.Equal(t, int32(100), st.Targets)
+	require.True(t, st.PendingConfirmation)
+	require.Equal(t, planned, st.PlannedTargetMoves)
+	require.Nil(t, st.ObservedTargetMoves)
+	require.NotNil(t, st.LastTargetsUpdateTime)
+
+	// Scale to 3 shards.
+	tc.snapshot("ns/p")
+	scaled := map[string]cachedTarget{}
+	for id, target := range targets {
+		scaled[id] = cachedTarget{address: target.address, shard: shardForAddress(target.address, 3)}
+	}
+	tc.update("ns/p", scaled, nil, time.Now())
+
+	st = tc.status("ns/p", 3, 3)
+	require.False(t, st.PendingConfirmation)
+	require.Equal(t, int32(0), st.PlannedTargetMoves)
+	require.Equal(t, ptr.To(planned), st.ObservedTargetMoves)
+
+	tc.forget("ns/p")
+	st = tc.status("ns/p", 3, 3)
+	require.Equal(t, int32(0), st.Targets)
+}
+
+func TestFetchActiveTargets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prometheus/api/v1/targets" || r.URL.Query().Get("state") != "active" {
+			http.NotFound(w, r)
+			return
+		}
+
+		fmt.Fprint(w, `{"status":"success","data":{"activeTargets":[{"scrapePool":"job","discoveredLabels":{"__address__":"10.0.0.1:9100"}}]}}`)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	u.Path = "/prometheus"
+
+	targets, err := fetchActiveTargets(context.Background(), srv.Client(), *u)
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	require.Equal(t, "job", targets[0].ScrapePool)
+	require.Equal(t, "10.0.0.1:9100", targets[0].DiscoveredLabels["__address__"])
+
+	u.Path = "/"
+	_, err = fetchActiveTargets(context.Background(), srv.Client(), *u)
+	require.Error(t, err)
+}
+
+func TestActiveTargetAddress(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		target   activeTarget
+		expected string
+	}{
+		{
+			name: "relabeled address",
+			target: activeTarget{
+				DiscoveredLabels: map[string]string{"__address__": "10.0.0.1:9100"},
+				ScrapeURL:        "http://10.0.0.2:8080/metrics",
+			},
+			expected: "10.0.0.2:8080",
+		},
+		{
+			name: "address label",
+			target: activeTarget{
+				DiscoveredLabels: map[string]string{"__address__": "10.0.0.1:9100"},
+				Labels:           map[string]string{"__address__": "10.0.0.3:9100"},
+				ScrapeURL:        "http://10.0.0.2:8080/metrics",
+			},
+			expected: "10.0.0.3:9100",
+		},
+		{
+			name: "no scrape URL",
+			target: activeTarget{
+				DiscoveredLabels: map[string]string{"__address__": "10.0.0.1:9100"},
+			},
+			expected: "10.0.0.1:9100",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.target.address())
+		})
+	}
+}
+
+func TestFetchRelabeledActiveTargets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"status":"success","data":{"activeTargets":[{"scrapePool":"job","discoveredLabels":{"__address__":"10.0.0.1:9100"},"labels":{"job":"job"},"scrapeUrl":"http://10.0.0.2:8080/metrics"}]}}`)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	targets, err := fetchActiveTargets(context.Background(), srv.Client(), *u)
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+
+	// The shard is computed from the address rewritten by the relabeling.
+	require.Equal(t, "10.0.0.2:8080", targets[0].address())
+}
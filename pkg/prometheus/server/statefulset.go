@@ -20,6 +20,7 @@ import (
 	"path/filepath"
 
 	"github.com/blang/semver/v4"
+	"github.com/prometheus/common/model"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +37,7 @@ const (
 	prometheusMode                       = "server"
 	governingServiceName                 = "prometheus-operated"
 	thanosSupportedVersionHTTPClientFlag = "0.24.0"
+	scrapeConfigsVolumeName              = "scrape-configs"
 )
 
 func makeStatefulSet(
@@ -47,6 +49,7 @@ func makeStatefulSet(
 	inputHash string,
 	shard int32,
 	tlsSecrets *operator.ShardedSecret,
+	scrapeConfigSecrets []string,
 ) (*appsv1.StatefulSet, error) {
 	cpf := p.GetCommonPrometheusFields()
 	objMeta := p.GetObjectMeta()
@@ -60,7 +63,7 @@ func makeStatefulSet(
 	// We need to re-set the common fields because cpf is only a copy of the original object.
 	// We set some defaults if some fields are not present, and we want those fields set in the original Prometheus object before building the StatefulSetSpec.
 	p.SetCommonPrometheusFields(cpf)
-	spec, err := makeStatefulSetSpec(p, config, cg, shard, ruleConfigMapNames, tlsSecrets)
+	spec, err := makeStatefulSetSpec(p, config, cg, shard, ruleConfigMapNames, tlsSecrets, scrapeConfigSecrets)
 	if err != nil {
 		return nil, fmt.Errorf("make StatefulSet spec: %w", err)
 	}
@@ -71,6 +74,7 @@ func makeStatefulSet(
 		statefulset,
 		operator.WithName(name),
 		operator.WithInputHashAnnotation(inputHash),
+		operator.WithGenerationAnnotation(objMeta.GetGeneration()),
 		operator.WithAnnotations(objMeta.GetAnnotations()),
 		operator.WithAnnotations(config.Annotations),
 		operator.WithLabels(objMeta.GetLabels()),
@@ -145,6 +149,7 @@ func makeStatefulSetSpec(
 	shard int32,
 	ruleConfigMapNames []string,
 	tlsSecrets *operator.ShardedSecret,
+	scrapeConfigSecrets []string,
 ) (*appsv1.StatefulSetSpec, error) {
 	cpf := p.GetCommonPrometheusFields()
 
@@ -173,7 +178,25 @@ func makeStatefulSetSpec(
 
 	configReloaderVolumeMounts := prompkg.CreateConfigReloaderVolumeMounts()
 
-	var configReloaderWebConfigFile string
+	// When the configuration is split, the scrape configuration files are
+	// written by the config-reloader next to the configuration file.
+	reloaderOpts := []operator.ReloaderOption{operator.Shard(shard)}
+	if len(scrapeConfigSecrets) > 0 {
+		volumes = append(volumes, scrapeConfigsVolume(scrapeConfigSecrets))
+		configReloaderVolumeMounts = append(configReloaderVolumeMounts, v1.VolumeMount{
+			Name:      scrapeConfigsVolumeName,
+			ReadOnly:  true,
+			MountPath: prompkg.ScrapeConfigsDir,
+		})
+		reloaderOpts = append(reloaderOpts, operator.ConfigDir(prompkg.ScrapeConfigsDir, prompkg.ScrapeConfigsOutDir))
+	}
+
+	var (
+		configReloaderWebConfigFile string
+		reloadClient                operator.ReloadClientFiles
+		reloadClientVols            []v1.Volume
+		reloadClientMounts          []v1.VolumeMount
+	)
 
 	// Mount web config and web TLS credentials as volumes.
 	// We always mount the web config file for versions greater than 2.24.0.
@@ -196,11 +219,22 @@ func makeStatefulSetSpec(
 			configReloaderWebConfigFile = confArg.Value
 			configReloaderVolumeMounts = append(configReloaderVolumeMounts, configMount...)
 		}
+
+		reloadClient, reloadClientVols, reloadClientMounts, err = prompkg.BuildReloadClient(cpf, p)
+		if err != nil {
+			return nil, err
+		}
+
+		volumes = append(volumes, reloadClientVols...)
+		configReloaderVolumeMounts = append(configReloaderVolumeMounts, reloadClientMounts...)
 	} else if cpf.Web != nil {
 		webConfigGenerator.Warn("web.config.file")
 	}
 
 	startupProbe, readinessProbe, livenessProbe := cg.BuildProbes()
+	if p.Spec.Warmup != nil {
+		readinessProbe = cg.BuildWarmupProbe(p.Spec.Warmup)
+	}
 
 	podAnnotations, podLabels := cg.BuildPodMetadata()
 	// In cases where an existing selector label is modified, or a new one is added, new sts cannot match existing pods.
@@ -229,13 +263,17 @@ func makeStatefulSetSpec(
 		volumes = append(volumes, thanosVolumes...)
 	}
 
-	if compactionDisabled(p) {
-		thanosBlockDuration := "2h"
-		if p.Spec.Thanos != nil {
-			thanosBlockDuration = operator.StringValOrDefault(string(p.Spec.Thanos.BlockDuration), thanosBlockDuration)
-		}
-		promArgs = append(promArgs, monitoringv1.Argument{Name: "storage.tsdb.max-block-duration", Value: thanosBlockDuration})
-		promArgs = append(promArgs, monitoringv1.Argument{Name: "storage.tsdb.min-block-duration", Value: thanosBlockDuration})
+	minBlockDuration, maxBlockDuration, err := blockDurations(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxBlockDuration != "" {
+		promArgs = append(promArgs, monitoringv1.Argument{Name: "storage.tsdb.max-block-duration", Value: maxBlockDuration})
+	}
+
+	if minBlockDuration != "" {
+		promArgs = append(promArgs, monitoringv1.Argument{Name: "storage.tsdb.min-block-duration", Value: minBlockDuration})
 	}
 
 	// ref: https://github.com/prometheus-operator/prometheus-operator/issues/6829
@@ -274,7 +312,7 @@ func makeStatefulSetSpec(
 			true,
 			configReloaderVolumeMounts,
 			watchedDirectories,
-			operator.Shard(shard),
+			reloaderOpts...,
 		),
 	)
 
@@ -283,6 +321,10 @@ func makeStatefulSetSpec(
 		return nil, fmt.Errorf("failed to merge init containers spec: %w", err)
 	}
 
+	if err := operator.PrometheusServerFlags.ValidateArgs("spec.additionalArgs", cg.Version(), cpf.AdditionalArgs); err != nil {
+		return nil, err
+	}
+
 	containerArgs, err := operator.BuildArgs(promArgs, cpf.AdditionalArgs)
 	if err != nil {
 		return nil, err
@@ -293,8 +335,9 @@ func makeStatefulSetSpec(
 	if p.Spec.Runtime != nil && p.Spec.Runtime.GoGC != nil && !cg.WithMinimumVersion("2.53.0").IsCompatible() {
 		envVars = append(envVars, v1.EnvVar{Name: "GOGC", Value: fmt.Sprintf("%d", *p.Spec.Runtime.GoGC)})
 	}
+	envVars = append(envVars, c.GoRuntime.EnvVars(cpf.Resources)...)
 
-	operatorContainers := append([]v1.Container{
+	operatorContainers := []v1.Container{
 		{
 			Name:                     "prometheus",
 			Image:                    pImagePath,
@@ -316,22 +359,35 @@ func makeStatefulSetSpec(
 				},
 			},
 		},
-		prompkg.BuildConfigReloader(
+	}
+
+	// With the OperatorExec reload strategy, the operator reloads the
+	// configuration with the Kubernetes exec API.
+	if !prompkg.UsesOperatorExecReload(p) {
+		operatorContainers = append(operatorContainers, prompkg.BuildConfigReloader(
 			p,
 			c,
 			false,
 			configReloaderVolumeMounts,
 			watchedDirectories,
-			operator.Shard(shard),
-			operator.WebConfigFile(configReloaderWebConfigFile),
-		),
-	}, additionalContainers...)
+			append(
+				reloaderOpts,
+				operator.WebConfigFile(configReloaderWebConfigFile),
+				operator.ReloadClient(reloadClient),
+			)...,
+		))
+	}
+	operatorContainers = append(operatorContainers, additionalContainers...)
 
 	containers, err := k8sutil.MergePatchContainers(operatorContainers, cpf.Containers)
 	if err != nil {
 		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
 	}
 
+	if c.NativeSidecars {
+		containers, initContainers = k8sutil.MoveToNativeSidecars(containers, initContainers, "config-reloader", "thanos-sidecar")
+	}
+
 	spec := appsv1.StatefulSetSpec{
 		ServiceName: ptr.Deref(cpf.ServiceName, governingServiceName),
 		Replicas:    cpf.Replicas,
@@ -495,6 +551,27 @@ func appendServerVolumes(p *monitoringv1.Prometheus, volumes []v1.Volume, volume
 	return volumes, volumeMounts
 }
 
+// scrapeConfigsVolume returns the volume projecting the Secrets of the scrape
+// configuration files.
+func scrapeConfigsVolume(secrets []string) v1.Volume {
+	volume := v1.Volume{
+		Name: scrapeConfigsVolumeName,
+		VolumeSource: v1.VolumeSource{
+			Projected: &v1.ProjectedVolumeSource{},
+		},
+	}
+
+	for _, name := range secrets {
+		volume.Projected.Sources = append(volume.Projected.Sources, v1.VolumeProjection{
+			Secret: &v1.SecretProjection{
+				LocalObjectReference: v1.LocalObjectReference{Name: name},
+			},
+		})
+	}
+
+	return volume
+}
+
 func createThanosContainer(p *monitoringv1.Prometheus, c prompkg.Config) (*v1.Container, []v1.Volume, error) {
 	if p.Spec.Thanos == nil {
 		return nil, nil, nil
@@ -569,6 +646,7 @@ func createThanosContainer(p *monitoringv1.Prometheus, c prompkg.Config) (*v1.Co
 				ContainerPort: 10901,
 			},
 		},
+		Env:       c.GoRuntime.EnvVars(thanos.Resources),
 		Resources: thanos.Resources,
 	}
 
@@ -671,6 +749,10 @@ func createThanosContainer(p *monitoringv1.Prometheus, c prompkg.Config) (*v1.Co
 		})
 	}
 
+	if err := operator.ThanosSidecarFlags.ValidateArgs("spec.thanos.additionalArgs", thanosVersion, thanos.AdditionalArgs); err != nil {
+		return nil, nil, err
+	}
+
 	containerArgs, err := operator.BuildArgs(thanosArgs, thanos.AdditionalArgs)
 	if err != nil {
 		return nil, nil, err
@@ -705,6 +787,78 @@ func queryLogFileVolume(queryLogFile string) (v1.Volume, bool) {
 	}, true
 }
 
+// blockDurations returns the minimum and maximum durations of the TSDB blocks.
+// An empty value means that Prometheus should use its default value.
+func blockDurations(p *monitoringv1.Prometheus) (string, string, error) {
+	var minBlockDuration, maxBlockDuration string
+	if p.Spec.MinBlockDuration != nil {
+		minBlockDuration = string(*p.Spec.MinBlockDuration)
+	}
+
+	if p.Spec.MaxBlockDuration != nil {
+		maxBlockDuration = string(*p.Spec.MaxBlockDuration)
+	}
+
+	if !compactionDisabled(p) {
+		if minBlockDuration == "" || maxBlockDuration == "" {
+			return minBlockDuration, maxBlockDuration, nil
+		}
+
+		minDuration, maxDuration, err := parseBlockDurations(minBlockDuration, maxBlockDuration)
+		if err != nil {
+			return "", "", err
+		}
+
+		if maxDuration < minDuration {
+			return "", "", fmt.Errorf("maxBlockDuration (%s) must be greater than or equal to minBlockDuration (%s)", maxBlockDuration, minBlockDuration)
+		}
+
+		return minBlockDuration, maxBlockDuration, nil
+	}
+
+	// Without compaction, Prometheus should only produce blocks of the same
+	// duration.
+	switch {
+	case minBlockDuration == "" && maxBlockDuration == "":
+		thanosBlockDuration := "2h"
+		if p.Spec.Thanos != nil {
+			thanosBlockDuration = operator.StringValOrDefault(string(p.Spec.Thanos.BlockDuration), thanosBlockDuration)
+		}
+		return thanosBlockDuration, thanosBlockDuration, nil
+
+	case minBlockDuration == "":
+		return maxBlockDuration, maxBlockDuration, nil
+
+	case maxBlockDuration == "":
+		return minBlockDuration, minBlockDuration, nil
+	}
+
+	minDuration, maxDuration, err := parseBlockDurations(minBlockDuration, maxBlockDuration)
+	if err != nil {
+		return "", "", err
+	}
+
+	if minDuration != maxDuration {
+		return "", "", fmt.Errorf("minBlockDuration (%s) and maxBlockDuration (%s) must be equal when the compaction is disabled", minBlockDuration, maxBlockDuration)
+	}
+
+	return minBlockDuration, maxBlockDuration, nil
+}
+
+func parseBlockDurations(minBlockDuration, maxBlockDuration string) (model.Duration, model.Duration, error) {
+	minDuration, err := model.ParseDuration(minBlockDuration)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid minBlockDuration: %w", err)
+	}
+
+	maxDuration, err := model.ParseDuration(maxBlockDuration)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maxBlockDuration: %w", err)
+	}
+
+	return minDuration, maxDuration, nil
+}
+
 func compactionDisabled(p *monitoringv1.Prometheus) bool {
 	// NOTE(bwplotka): As described in https://thanos.io/components/sidecar.md/
 	// we have to turn off compaction of Prometheus if export to object
@@ -61,7 +61,8 @@ func makeStatefulSetFromPrometheus(p monitoringv1.Prometheus) (*appsv1.StatefulS
 		nil,
 		"",
 		0,
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil)
 }
 
 func TestStatefulSetLabelingAndAnnotations(t *testing.T) {
@@ -76,8 +77,9 @@ func TestStatefulSetLabelingAndAnnotations(t *testing.T) {
 	// kubectl annotations must not be on the statefulset so kubectl does
 	// not manage the generated object
 	expectedStatefulSetAnnotations := map[string]string{
-		"prometheus-operator-input-hash": "",
-		"testannotation":                 "testannotationvalue",
+		"prometheus-operator-input-hash":    "",
+		"operator.prometheus.io/generation": "3",
+		"testannotation":                    "testannotationvalue",
 	}
 
 	expectedStatefulSetLabels := map[string]string{
@@ -102,6 +104,7 @@ func TestStatefulSetLabelingAndAnnotations(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{
 			Labels:      labels,
 			Annotations: annotations,
+			Generation:  3,
 		},
 	})
 	require.NoError(t, err)
@@ -303,6 +306,12 @@ func TestStatefulSetVolumeInitial(t *testing.T) {
 									MountPropagation: nil,
 									SubPathExpr:      "",
 								},
+								{
+									Name:      "file-sd",
+									ReadOnly:  true,
+									MountPath: "/etc/prometheus/file_sd",
+									SubPath:   "",
+								},
 								{
 									Name:      "prometheus-volume-init-test-db",
 									ReadOnly:  false,
@@ -363,6 +372,17 @@ func TestStatefulSetVolumeInitial(t *testing.T) {
 								},
 							},
 						},
+						{
+							Name: "file-sd",
+							VolumeSource: v1.VolumeSource{
+								ConfigMap: &v1.ConfigMapVolumeSource{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: prompkg.FileSDConfigMapName(&p),
+									},
+									Optional: ptr.To(true),
+								},
+							},
+						},
 						{
 							Name: "secret-test-secret1",
 							VolumeSource: v1.VolumeSource{
@@ -416,6 +436,7 @@ func TestStatefulSetVolumeInitial(t *testing.T) {
 				Namespace: "test",
 			},
 		},
+		"",
 	)
 	require.NoError(t, err)
 
@@ -427,13 +448,63 @@ func TestStatefulSetVolumeInitial(t *testing.T) {
 		[]string{"rules-configmap-one"},
 		"",
 		0,
-		shardedSecret)
+		shardedSecret,
+		nil)
 	require.NoError(t, err)
 
 	require.Equalf(t, expected.Spec.Template.Spec.Volumes, sset.Spec.Template.Spec.Volumes, "expected volumes to match \n%s", pretty.Compare(expected.Spec.Template.Spec.Volumes, sset.Spec.Template.Spec.Volumes))
 	require.Equalf(t, expected.Spec.Template.Spec.Containers[0].VolumeMounts, sset.Spec.Template.Spec.Containers[0].VolumeMounts, "expected volume mounts to match \n%s", pretty.Compare(expected.Spec.Template.Spec.Containers[0].VolumeMounts, sset.Spec.Template.Spec.Containers[0].VolumeMounts))
 }
 
+func TestScrapeConfigSecretsVolume(t *testing.T) {
+	p := monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+	}
+
+	cg, err := prompkg.NewConfigGenerator(prompkg.NewLogger(), &p)
+	require.NoError(t, err)
+
+	sset, err := makeStatefulSet(
+		"test",
+		&p,
+		defaultTestConfig,
+		cg,
+		nil,
+		"",
+		0,
+		&operator.ShardedSecret{},
+		[]string{"prometheus-test-scrape-configs-0", "prometheus-test-scrape-configs-1"})
+	require.NoError(t, err)
+
+	require.Contains(t, sset.Spec.Template.Spec.Volumes, v1.Volume{
+		Name: "scrape-configs",
+		VolumeSource: v1.VolumeSource{
+			Projected: &v1.ProjectedVolumeSource{
+				Sources: []v1.VolumeProjection{
+					{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "prometheus-test-scrape-configs-0"}}},
+					{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "prometheus-test-scrape-configs-1"}}},
+				},
+			},
+		},
+	})
+
+	for _, c := range []v1.Container{sset.Spec.Template.Spec.InitContainers[0], sset.Spec.Template.Spec.Containers[1]} {
+		require.Contains(t, []string{"init-config-reloader", "config-reloader"}, c.Name)
+		require.Contains(t, c.VolumeMounts, v1.VolumeMount{Name: "scrape-configs", ReadOnly: true, MountPath: "/etc/prometheus/scrape_configs"})
+		require.Contains(t, c.Args, "--config-dir=/etc/prometheus/scrape_configs")
+		require.Contains(t, c.Args, "--config-envsubst-dir=/etc/prometheus/config_out/scrape_configs")
+	}
+
+	// The Prometheus container reads the files written by the
+	// config-reloader.
+	for _, m := range sset.Spec.Template.Spec.Containers[0].VolumeMounts {
+		require.NotEqual(t, "scrape-configs", m.Name)
+	}
+}
+
 func TestAdditionalConfigMap(t *testing.T) {
 	sset, err := makeStatefulSetFromPrometheus(monitoringv1.Prometheus{
 		Spec: monitoringv1.PrometheusSpec{
@@ -822,7 +893,8 @@ func TestPrometheusDefaultBaseImageFlag(t *testing.T) {
 		nil,
 		"",
 		0,
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil)
 	require.NoError(t, err)
 
 	image := sset.Spec.Template.Spec.Containers[0].Image
@@ -864,7 +936,8 @@ func TestThanosDefaultBaseImageFlag(t *testing.T) {
 		nil,
 		"",
 		0,
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil)
 	require.NoError(t, err)
 
 	image := sset.Spec.Template.Spec.Containers[2].Image
@@ -1162,6 +1235,107 @@ func TestThanosBlockDuration(t *testing.T) {
 	require.True(t, found, "Thanos BlockDuration arg change not found")
 }
 
+func TestBlockDurations(t *testing.T) {
+	objectStorage := &monitoringv1.ThanosSpec{
+		BlockDuration: "2h",
+		ObjectStorageConfig: &v1.SecretKeySelector{
+			Key: "thanos.yaml",
+		},
+	}
+
+	for _, tc := range []struct {
+		name             string
+		minBlockDuration *monitoringv1.Duration
+		maxBlockDuration *monitoringv1.Duration
+		thanos           *monitoringv1.ThanosSpec
+		expected         []string
+		err              bool
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name:             "min and max",
+			minBlockDuration: ptr.To(monitoringv1.Duration("1h")),
+			maxBlockDuration: ptr.To(monitoringv1.Duration("1d")),
+			expected: []string{
+				"--storage.tsdb.max-block-duration=1d",
+				"--storage.tsdb.min-block-duration=1h",
+			},
+		},
+		{
+			name:             "max only",
+			maxBlockDuration: ptr.To(monitoringv1.Duration("1d")),
+			expected: []string{
+				"--storage.tsdb.max-block-duration=1d",
+			},
+		},
+		{
+			name:             "max lower than min",
+			minBlockDuration: ptr.To(monitoringv1.Duration("4h")),
+			maxBlockDuration: ptr.To(monitoringv1.Duration("2h")),
+			err:              true,
+		},
+		{
+			name:   "thanos uploads with defaults",
+			thanos: objectStorage,
+			expected: []string{
+				"--storage.tsdb.max-block-duration=2h",
+				"--storage.tsdb.min-block-duration=2h",
+			},
+		},
+		{
+			name:             "thanos uploads with min only",
+			minBlockDuration: ptr.To(monitoringv1.Duration("1h")),
+			thanos:           objectStorage,
+			expected: []string{
+				"--storage.tsdb.max-block-duration=1h",
+				"--storage.tsdb.min-block-duration=1h",
+			},
+		},
+		{
+			name:             "thanos uploads with equal min and max",
+			minBlockDuration: ptr.To(monitoringv1.Duration("60m")),
+			maxBlockDuration: ptr.To(monitoringv1.Duration("1h")),
+			thanos:           objectStorage,
+			expected: []string{
+				"--storage.tsdb.max-block-duration=1h",
+				"--storage.tsdb.min-block-duration=60m",
+			},
+		},
+		{
+			name:             "thanos uploads with different min and max",
+			minBlockDuration: ptr.To(monitoringv1.Duration("2h")),
+			maxBlockDuration: ptr.To(monitoringv1.Duration("1d")),
+			thanos:           objectStorage,
+			err:              true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSetFromPrometheus(monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					MinBlockDuration: tc.minBlockDuration,
+					MaxBlockDuration: tc.maxBlockDuration,
+					Thanos:           tc.thanos,
+				},
+			})
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var args []string
+			for _, arg := range sset.Spec.Template.Spec.Containers[0].Args {
+				if strings.Contains(arg, "-block-duration=") {
+					args = append(args, arg)
+				}
+			}
+			require.Equal(t, tc.expected, args)
+		})
+	}
+}
+
 func TestThanosWithNamedPVC(t *testing.T) {
 	testKey := "named-pvc"
 	storageClass := "storageclass"
@@ -1389,7 +1563,8 @@ func TestReplicasConfigurationWithSharding(t *testing.T) {
 		nil,
 		"",
 		1,
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil)
 	require.NoError(t, err)
 
 	require.Equal(t, int32(2), *sset.Spec.Replicas, "Unexpected replicas configuration.")
@@ -1430,7 +1605,8 @@ func TestSidecarResources(t *testing.T) {
 			nil,
 			"",
 			0,
-			&operator.ShardedSecret{})
+			&operator.ShardedSecret{},
+			nil)
 		require.NoError(t, err)
 		return sset
 	})
@@ -1854,7 +2030,8 @@ func TestConfigReloader(t *testing.T) {
 		nil,
 		"",
 		int32(expectedShardNum),
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil)
 	require.NoError(t, err)
 
 	expectedArgsConfigReloader := []string{
@@ -1912,7 +2089,8 @@ func TestConfigReloaderWithSignal(t *testing.T) {
 		nil,
 		"",
 		int32(expectedShardNum),
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil)
 	require.NoError(t, err)
 
 	expectedArgsConfigReloader := []string{
@@ -1957,6 +2135,41 @@ func TestConfigReloaderWithSignal(t *testing.T) {
 	}
 }
 
+func TestConfigReloaderWithOperatorExec(t *testing.T) {
+	sset, err := makeStatefulSetFromPrometheus(monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				ReloadStrategy: ptr.To(monitoringv1.OperatorExecReloadStrategyType),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	spec := sset.Spec.Template.Spec
+	require.False(t, ptr.Deref(spec.ShareProcessNamespace, false))
+
+	var containers []string
+	for _, c := range spec.Containers {
+		containers = append(containers, c.Name)
+
+		if c.Name != "prometheus" {
+			continue
+		}
+
+		require.NotContains(t, c.Args, "--web.enable-lifecycle")
+		for _, vm := range c.VolumeMounts {
+			if vm.Name == "config-out" {
+				require.False(t, vm.ReadOnly)
+			}
+		}
+	}
+	require.Equal(t, []string{"prometheus"}, containers)
+
+	// The initial configuration is still generated by the init container.
+	require.Len(t, spec.InitContainers, 1)
+	require.Equal(t, "init-config-reloader", spec.InitContainers[0].Name)
+}
+
 func TestThanosGetConfigInterval(t *testing.T) {
 	sset, err := makeStatefulSetFromPrometheus(monitoringv1.Prometheus{
 		Spec: monitoringv1.PrometheusSpec{
@@ -2449,6 +2662,88 @@ func TestPrometheusAdditionalArgsDuplicate(t *testing.T) {
 	require.Contains(t, err.Error(), expectedErrorMsg, "expected the following text to be present in the error msg: %s", expectedErrorMsg)
 }
 
+func TestPrometheusAdditionalArgsUnknownFlag(t *testing.T) {
+	_, err := makeStatefulSetFromPrometheus(monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				Version: "v3.0.0",
+				AdditionalArgs: []monitoringv1.Argument{
+					{
+						Name:  "storage.tsdb.retention",
+						Value: "30d",
+					},
+				},
+			},
+		},
+	})
+	require.ErrorContains(t, err, `spec.additionalArgs[0]: flag "storage.tsdb.retention" isn't supported by Prometheus >= 3.0.0`)
+}
+
+func TestNativeSidecars(t *testing.T) {
+	p := monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			Thanos: &monitoringv1.ThanosSpec{},
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				Containers: []v1.Container{
+					{
+						Name:  "config-reloader",
+						Image: "custom-reloader:latest",
+					},
+				},
+			},
+		},
+	}
+
+	logger := prompkg.NewLogger()
+	cg, err := prompkg.NewConfigGenerator(logger, &p)
+	require.NoError(t, err)
+
+	c := defaultTestConfig
+	c.NativeSidecars = true
+	sset, err := makeStatefulSet("test", &p, c, cg, nil, "", 0, &operator.ShardedSecret{}, nil)
+	require.NoError(t, err)
+
+	spec := sset.Spec.Template.Spec
+	require.Len(t, spec.Containers, 1)
+	require.Equal(t, "prometheus", spec.Containers[0].Name)
+
+	require.Len(t, spec.InitContainers, 3)
+	require.Equal(t, "init-config-reloader", spec.InitContainers[0].Name)
+	require.Nil(t, spec.InitContainers[0].RestartPolicy)
+
+	for i, name := range []string{"config-reloader", "thanos-sidecar"} {
+		sidecar := spec.InitContainers[i+1]
+		require.Equal(t, name, sidecar.Name)
+		require.Equal(t, ptr.To(v1.ContainerRestartPolicyAlways), sidecar.RestartPolicy)
+	}
+
+	// The container patches still apply to the native sidecars.
+	require.Equal(t, "custom-reloader:latest", spec.InitContainers[1].Image)
+}
+
+func TestConfigReloaderOverrides(t *testing.T) {
+	resources := v1.ResourceRequirements{
+		Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("500Mi")},
+	}
+	sset, err := makeStatefulSetFromPrometheus(monitoringv1.Prometheus{
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				ConfigReloader: &monitoringv1.ConfigReloaderSpec{
+					Image:     ptr.To("example.com/config-reloader:v1"),
+					Resources: &resources,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, c := range []v1.Container{sset.Spec.Template.Spec.InitContainers[0], sset.Spec.Template.Spec.Containers[1]} {
+		require.Contains(t, c.Name, "config-reloader")
+		require.Equal(t, "example.com/config-reloader:v1", c.Image)
+		require.Equal(t, resources, c.Resources)
+	}
+}
+
 func TestRuntimeGOGCEnvVar(t *testing.T) {
 	for _, tc := range []struct {
 		scenario       string
@@ -3024,6 +3319,49 @@ func TestStartupProbeTimeoutSeconds(t *testing.T) {
 	}
 }
 
+func TestWarmupReadinessProbe(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		spec            monitoringv1.PrometheusSpec
+		expectedCommand string
+		expectedTimeout int32
+	}{
+		{
+			name: "default",
+			spec: monitoringv1.PrometheusSpec{
+				Warmup: &monitoringv1.WarmupSpec{Query: `up{job="prometheus"}`},
+			},
+			expectedCommand: `if [ -x "$(command -v curl)" ]; then curl --fail http://localhost:9090/-/ready && exec curl --fail 'http://localhost:9090/api/v1/query?query=up%7Bjob%3D%22prometheus%22%7D'; elif [ -x "$(command -v wget)" ]; then wget -q -O /dev/null http://localhost:9090/-/ready && exec wget -q -O /dev/null 'http://localhost:9090/api/v1/query?query=up%7Bjob%3D%22prometheus%22%7D'; else exit 1; fi`,
+			expectedTimeout: 3,
+		},
+		{
+			name: "route prefix and timeout",
+			spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					RoutePrefix: "/prometheus",
+				},
+				Warmup: &monitoringv1.WarmupSpec{Query: "up", TimeoutSeconds: ptr.To(int32(10))},
+			},
+			expectedCommand: `if [ -x "$(command -v curl)" ]; then curl --fail http://localhost:9090/prometheus/-/ready && exec curl --fail 'http://localhost:9090/prometheus/api/v1/query?query=up'; elif [ -x "$(command -v wget)" ]; then wget -q -O /dev/null http://localhost:9090/prometheus/-/ready && exec wget -q -O /dev/null 'http://localhost:9090/prometheus/api/v1/query?query=up'; else exit 1; fi`,
+			expectedTimeout: 10,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSetFromPrometheus(monitoringv1.Prometheus{Spec: tc.spec})
+			require.NoError(t, err)
+
+			probe := sset.Spec.Template.Spec.Containers[0].ReadinessProbe
+			require.NotNil(t, probe.Exec)
+			require.Nil(t, probe.HTTPGet)
+			require.Equal(t, []string{"sh", "-c", tc.expectedCommand}, probe.Exec.Command)
+			require.Equal(t, tc.expectedTimeout, probe.TimeoutSeconds)
+
+			// The startup probe doesn't depend on the warmup query.
+			require.NotNil(t, sset.Spec.Template.Spec.Containers[0].StartupProbe.HTTPGet)
+		})
+	}
+}
+
 func TestIfThanosVersionDontHaveHttpClientFlag(t *testing.T) {
 	version := "v0.23.0"
 
@@ -3242,3 +3580,34 @@ func TestStatefulSetenableServiceLinks(t *testing.T) {
 		}
 	}
 }
+
+func TestMountConfigFromArtifactStore(t *testing.T) {
+	p := monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "default",
+		},
+	}
+
+	sset, err := makeStatefulSetFromPrometheus(p)
+	require.NoError(t, err)
+
+	key := configArtifactKey(&p)
+	require.Equal(t, operator.ArtifactKey{Namespace: "default", Name: "prometheus-main"}, key)
+
+	mountConfigFromArtifactStore(sset, operator.SecretsStoreCSIVolumeSource(key.Name))
+
+	var found bool
+	for _, v := range sset.Spec.Template.Spec.Volumes {
+		if v.Name != "config" {
+			continue
+		}
+
+		found = true
+		require.Nil(t, v.Secret)
+		require.NotNil(t, v.CSI)
+		require.Equal(t, operator.SecretsStoreCSIDriver, v.CSI.Driver)
+		require.Equal(t, "prometheus-main", v.CSI.VolumeAttributes["secretProviderClass"])
+	}
+	require.True(t, found)
+}
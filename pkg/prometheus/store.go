@@ -120,7 +120,27 @@ func AddScrapeClassesToStore(ctx context.Context, store *assets.StoreBuilder, na
 		if err := store.AddTLSConfig(ctx, namespace, scrapeClass.TLSConfig); err != nil {
 			return fmt.Errorf("scrape class %q: %w", scrapeClass.Name, err)
 		}
+
+		if scrapeClass.ProxyConfig != nil {
+			if err := addProxyConfigToStore(ctx, *scrapeClass.ProxyConfig, store, namespace); err != nil {
+				return fmt.Errorf("scrape class %q: %w", scrapeClass.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// AddScrapeProxyConfigToStore validates the scrape proxy settings and adds
+// the Secrets they reference to the store.
+func AddScrapeProxyConfigToStore(ctx context.Context, store *assets.StoreBuilder, namespace string, pc *monitoringv1.ProxyConfig) error {
+	if pc == nil {
+		return nil
 	}
+
+	if err := addProxyConfigToStore(ctx, *pc, store, namespace); err != nil {
+		return fmt.Errorf("scrape proxy config: %w", err)
+	}
+
 	return nil
 }
 
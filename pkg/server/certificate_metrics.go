@@ -0,0 +1,59 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+)
+
+var certificateExpiryDesc = prometheus.NewDesc(
+	"prometheus_operator_web_tls_certificate_expiry_timestamp_seconds",
+	"Expiry time of the serving certificate of the web server in Unix timestamp seconds. The value reflects the certificate currently served after the reloads.",
+	nil,
+	nil,
+)
+
+// certificateCollector exposes the expiry time of the current serving
+// certificate.
+type certificateCollector struct {
+	servingCert dynamiccertificates.CertKeyContentProvider
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *certificateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- certificateExpiryDesc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *certificateCollector) Collect(ch chan<- prometheus.Metric) {
+	crt, _ := c.servingCert.CurrentCertKeyContent()
+
+	// The first certificate of the chain is the leaf certificate.
+	block, _ := pem.Decode(crt)
+	if block == nil {
+		return
+	}
+
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(certificateExpiryDesc, prometheus.GaugeValue, float64(leaf.NotAfter.Unix()))
+}
@@ -0,0 +1,266 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/cert"
+)
+
+// CAKey is the key of the Secret holding the client CA bundle.
+const CAKey = "ca.crt"
+
+// secretContent watches a Secret and keeps the last valid content of the
+// given keys. The listeners are notified when the content changes.
+type secretContent struct {
+	logger    *slog.Logger
+	name      string
+	namespace string
+	secret    string
+	keys      []string
+	validate  func(map[string][]byte) error
+	kclient   kubernetes.Interface
+
+	mtx       sync.RWMutex
+	data      map[string][]byte
+	listeners []dynamiccertificates.Listener
+}
+
+func newSecretContent(logger *slog.Logger, kclient kubernetes.Interface, name, ref string, keys []string, validate func(map[string][]byte) error) (*secretContent, error) {
+	namespace, secret, err := cache.SplitMetaNamespaceKey(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Secret reference %q: %w", ref, err)
+	}
+
+	if namespace == "" || secret == "" {
+		return nil, fmt.Errorf("invalid Secret reference %q: expected <namespace>/<name>", ref)
+	}
+
+	return &secretContent{
+		logger:    logger.With("secret", ref),
+		name:      name,
+		namespace: namespace,
+		secret:    secret,
+		keys:      keys,
+		validate:  validate,
+		kclient:   kclient,
+	}, nil
+}
+
+// Name implements the dynamiccertificates.CertKeyContentProvider and
+// dynamiccertificates.CAContentProvider interfaces.
+func (sc *secretContent) Name() string {
+	return sc.name
+}
+
+// AddListener implements the dynamiccertificates.Notifier interface.
+func (sc *secretContent) AddListener(l dynamiccertificates.Listener) {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	sc.listeners = append(sc.listeners, l)
+}
+
+func (sc *secretContent) get(key string) []byte {
+	sc.mtx.RLock()
+	defer sc.mtx.RUnlock()
+
+	return sc.data[key]
+}
+
+// RunOnce loads the content of the Secret.
+func (sc *secretContent) RunOnce(ctx context.Context) error {
+	s, err := sc.kclient.CoreV1().Secrets(sc.namespace).Get(ctx, sc.secret, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get Secret %s/%s: %w", sc.namespace, sc.secret, err)
+	}
+
+	_, err = sc.update(s)
+	return err
+}
+
+// Run watches the Secret until the context is canceled.
+func (sc *secretContent) Run(ctx context.Context) {
+	inf := corev1informers.NewFilteredSecretInformer(
+		sc.kclient,
+		sc.namespace,
+		0,
+		cache.Indexers{},
+		func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", sc.secret).String()
+		},
+	)
+
+	onChange := func(obj any) {
+		s, ok := obj.(*v1.Secret)
+		if !ok {
+			return
+		}
+
+		changed, err := sc.update(s)
+		if err != nil {
+			sc.logger.Warn("ignoring invalid Secret content", "err", err)
+			return
+		}
+
+		if changed {
+			sc.logger.Info("Secret content updated")
+			sc.notify()
+		}
+	}
+
+	_, _ = inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    onChange,
+		UpdateFunc: func(_, obj any) { onChange(obj) },
+		DeleteFunc: func(any) {
+			sc.logger.Warn("Secret deleted, keeping the last known content")
+		},
+	})
+
+	inf.Run(ctx.Done())
+}
+
+// update stores the content of the Secret if it is valid. It returns true if
+// the content has changed.
+func (sc *secretContent) update(s *v1.Secret) (bool, error) {
+	data := make(map[string][]byte, len(sc.keys))
+	for _, k := range sc.keys {
+		v, found := s.Data[k]
+		if !found || len(v) == 0 {
+			return false, fmt.Errorf("key %q not found in Secret %s/%s", k, s.Namespace, s.Name)
+		}
+		data[k] = v
+	}
+
+	if err := sc.validate(data); err != nil {
+		return false, fmt.Errorf("invalid content in Secret %s/%s: %w", s.Namespace, s.Name, err)
+	}
+
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	changed := false
+	for _, k := range sc.keys {
+		if !bytes.Equal(sc.data[k], data[k]) {
+			changed = true
+			break
+		}
+	}
+	sc.data = data
+
+	return changed, nil
+}
+
+func (sc *secretContent) notify() {
+	sc.mtx.RLock()
+	defer sc.mtx.RUnlock()
+
+	for _, l := range sc.listeners {
+		l.Enqueue()
+	}
+}
+
+// secretCertKeyContent provides the certificate and key from the tls.crt and
+// tls.key keys of a Secret.
+type secretCertKeyContent struct {
+	*secretContent
+}
+
+var _ = dynamiccertificates.CertKeyContentProvider(&secretCertKeyContent{})
+
+func newSecretCertKeyContent(logger *slog.Logger, kclient kubernetes.Interface, ref string) (*secretCertKeyContent, error) {
+	sc, err := newSecretContent(
+		logger,
+		kclient,
+		"servingCert",
+		ref,
+		[]string{v1.TLSCertKey, v1.TLSPrivateKeyKey},
+		func(data map[string][]byte) error {
+			_, err := tls.X509KeyPair(data[v1.TLSCertKey], data[v1.TLSPrivateKeyKey])
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretCertKeyContent{secretContent: sc}, nil
+}
+
+// CurrentCertKeyContent implements the
+// dynamiccertificates.CertKeyContentProvider interface.
+func (c *secretCertKeyContent) CurrentCertKeyContent() ([]byte, []byte) {
+	return c.get(v1.TLSCertKey), c.get(v1.TLSPrivateKeyKey)
+}
+
+// secretCAContent provides the CA bundle from the ca.crt key of a Secret.
+type secretCAContent struct {
+	*secretContent
+}
+
+var _ = dynamiccertificates.CAContentProvider(&secretCAContent{})
+
+func newSecretCAContent(logger *slog.Logger, kclient kubernetes.Interface, ref string) (*secretCAContent, error) {
+	sc, err := newSecretContent(
+		logger,
+		kclient,
+		"clientCA",
+		ref,
+		[]string{CAKey},
+		func(data map[string][]byte) error {
+			_, err := cert.NewPoolFromBytes(data[CAKey])
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretCAContent{secretContent: sc}, nil
+}
+
+// CurrentCABundleContent implements the dynamiccertificates.CAContentProvider
+// interface.
+func (c *secretCAContent) CurrentCABundleContent() []byte {
+	return c.get(CAKey)
+}
+
+// VerifyOptions implements the dynamiccertificates.CAContentProvider
+// interface.
+func (c *secretCAContent) VerifyOptions() (x509.VerifyOptions, bool) {
+	pool, err := cert.NewPoolFromBytes(c.CurrentCABundleContent())
+	if err != nil {
+		return x509.VerifyOptions{}, false
+	}
+
+	return x509.VerifyOptions{
+		Roots:     pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, true
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/cert"
+)
+
+type countingListener struct {
+	c chan struct{}
+}
+
+func (l *countingListener) Enqueue() {
+	l.c <- struct{}{}
+}
+
+func newTLSSecret(t *testing.T, host string) *v1.Secret {
+	t.Helper()
+
+	crt, key, err := cert.GenerateSelfSignedCertKey(host, nil, nil)
+	require.NoError(t, err)
+
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-tls",
+			Namespace: "monitoring",
+		},
+		Data: map[string][]byte{
+			v1.TLSCertKey:       crt,
+			v1.TLSPrivateKeyKey: key,
+			CAKey:               crt,
+		},
+	}
+}
+
+func TestSecretContentInvalidReference(t *testing.T) {
+	for _, ref := range []string{"web-tls", "monitoring/", "a/b/c"} {
+		_, err := newSecretCertKeyContent(slog.New(slog.DiscardHandler), fake.NewClientset(), ref)
+		require.Error(t, err, ref)
+	}
+}
+
+func TestSecretCertKeyContent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := newTLSSecret(t, "foo")
+	kclient := fake.NewClientset(s)
+
+	c, err := newSecretCertKeyContent(slog.New(slog.DiscardHandler), kclient, "monitoring/web-tls")
+	require.NoError(t, err)
+	require.NoError(t, c.RunOnce(ctx))
+
+	crt, key := c.CurrentCertKeyContent()
+	require.Equal(t, s.Data[v1.TLSCertKey], crt)
+	require.Equal(t, s.Data[v1.TLSPrivateKeyKey], key)
+
+	l := &countingListener{c: make(chan struct{}, 1)}
+	c.AddListener(l)
+	go c.Run(ctx)
+
+	// Invalid content is ignored.
+	invalid := s.DeepCopy()
+	invalid.Data[v1.TLSPrivateKeyKey] = []byte("invalid")
+	_, err = kclient.CoreV1().Secrets("monitoring").Update(ctx, invalid, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// The rotated certificate is applied.
+	rotated := newTLSSecret(t, "bar")
+	_, err = kclient.CoreV1().Secrets("monitoring").Update(ctx, rotated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	select {
+	case <-l.c:
+	case <-time.After(10 * time.Second):
+		t.Fatal("listener not notified")
+	}
+
+	crt, key = c.CurrentCertKeyContent()
+	require.Equal(t, rotated.Data[v1.TLSCertKey], crt)
+	require.Equal(t, rotated.Data[v1.TLSPrivateKeyKey], key)
+}
+
+func TestSecretCAContent(t *testing.T) {
+	s := newTLSSecret(t, "foo")
+
+	c, err := newSecretCAContent(slog.New(slog.DiscardHandler), fake.NewClientset(s), "monitoring/web-tls")
+	require.NoError(t, err)
+	require.NoError(t, c.RunOnce(context.Background()))
+
+	require.Equal(t, s.Data[CAKey], c.CurrentCABundleContent())
+	_, ok := c.VerifyOptions()
+	require.True(t, ok)
+
+	// Missing key.
+	delete(s.Data, CAKey)
+	c, err = newSecretCAContent(slog.New(slog.DiscardHandler), fake.NewClientset(s), "monitoring/web-tls")
+	require.NoError(t, err)
+	require.Error(t, c.RunOnce(context.Background()))
+}
@@ -26,7 +26,10 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	kflag "k8s.io/component-base/cli/flag"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
@@ -72,6 +75,8 @@ func RegisterFlags(fs *flag.FlagSet, c *Config) {
 	fs.StringVar(&c.TLSConfig.CertFile, "web.cert-file", c.TLSConfig.CertFile, "Certficate file to be used for the web server.")
 	fs.StringVar(&c.TLSConfig.KeyFile, "web.key-file", c.TLSConfig.KeyFile, "Private key matching the cert file to be used for the web server.")
 	fs.StringVar(&c.TLSConfig.ClientCAFile, "web.client-ca-file", c.TLSConfig.ClientCAFile, "Client CA certificate file to be used for the web server.")
+	fs.StringVar(&c.TLSConfig.CertSecret, "web.tls-secret", "", "Secret (in the <namespace>/<name> format) holding the certificate (tls.crt key) and private key (tls.key key) of the web server. It takes precedence over --web.cert-file and --web.key-file. The changes of the Secret are applied without restart.")
+	fs.StringVar(&c.TLSConfig.ClientCASecret, "web.client-ca-secret", "", "Secret (in the <namespace>/<name> format) holding the client CA certificate (ca.crt key) of the web server. It takes precedence over --web.client-ca-file. The changes of the Secret are applied without restart.")
 
 	fs.DurationVar(&c.TLSConfig.ReloadInterval, "web.tls-reload-interval", c.TLSConfig.ReloadInterval, "The interval at which to watch for TLS certificate changes, by default set to 1 minute. (default 1m0s).")
 
@@ -96,6 +101,8 @@ type TLSConfig struct {
 	CertFile       string
 	KeyFile        string
 	ClientCAFile   string
+	CertSecret     string
+	ClientCASecret string
 	MinVersion     string
 	CipherSuites   operator.StringSet
 	ReloadInterval time.Duration
@@ -112,8 +119,8 @@ func (tc *TLSConfig) Convert(logger *slog.Logger) (*tls.Config, error) {
 		return nil, nil
 	}
 
-	if tc.CertFile == "" && tc.KeyFile == "" {
-		if tc.ClientCAFile != "" {
+	if tc.CertSecret == "" && tc.CertFile == "" && tc.KeyFile == "" {
+		if tc.ClientCAFile != "" || tc.ClientCASecret != "" {
 			return nil, fmt.Errorf("server key and certificate must be provided when a client CA is configured")
 		}
 
@@ -148,6 +155,13 @@ func (tc *TLSConfig) Convert(logger *slog.Logger) (*tls.Config, error) {
 	// Note that TLS 1.3 ciphersuites are not configurable.
 	tlsCfg.CipherSuites = cipherSuiteIDs
 
+	if tc.ClientCASecret != "" {
+		// The client CA content will be checked by the cert controller.
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+		logger.Info("server TLS client verification enabled", "client_ca_secret", tc.ClientCASecret)
+		return tlsCfg, nil
+	}
+
 	if tc.ClientCAFile == "" {
 		return tlsCfg, nil
 	}
@@ -180,8 +194,56 @@ type Server struct {
 	cfg *Config
 }
 
+// Option configures the web server.
+type Option func(*options)
+
+type options struct {
+	kclient    kubernetes.Interface
+	registerer prometheus.Registerer
+}
+
+// WithKubernetesClient configures the client used to read the Secrets
+// referenced by the TLS configuration. If not set, the server uses the
+// in-cluster configuration.
+func WithKubernetesClient(kclient kubernetes.Interface) Option {
+	return func(o *options) {
+		o.kclient = kclient
+	}
+}
+
+// WithRegisterer configures the registerer of the metrics exposing the
+// serving certificate.
+func WithRegisterer(r prometheus.Registerer) Option {
+	return func(o *options) {
+		o.registerer = r
+	}
+}
+
+func (o *options) kubernetesClient() (kubernetes.Interface, error) {
+	if o.kclient != nil {
+		return o.kclient, nil
+	}
+
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create in-cluster configuration: %w", err)
+	}
+
+	o.kclient, err = kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	return o.kclient, nil
+}
+
 // NewServer initializes a web server with the given handler (typically an http.MuxServe).
-func NewServer(logger *slog.Logger, c *Config, handler http.Handler) (*Server, error) {
+func NewServer(logger *slog.Logger, c *Config, handler http.Handler, opts ...Option) (*Server, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	listener, err := net.Listen("tcp", c.ListenAddress)
 	if err != nil {
 		return nil, err
@@ -200,7 +262,26 @@ func NewServer(logger *slog.Logger, c *Config, handler http.Handler) (*Server, e
 			servingCert    dynamiccertificates.CertKeyContentProvider
 		)
 
-		if tlsConfig.ClientAuth == tls.RequireAndVerifyClientCert {
+		switch {
+		case tlsConfig.ClientAuth == tls.RequireAndVerifyClientCert && c.TLSConfig.ClientCASecret != "":
+			kclient, err := o.kubernetesClient()
+			if err != nil {
+				return nil, err
+			}
+
+			secretCA, err := newSecretCAContent(logger, kclient, c.TLSConfig.ClientCASecret)
+			if err != nil {
+				return nil, err
+			}
+
+			if err = secretCA.RunOnce(context.Background()); err != nil {
+				return nil, fmt.Errorf("failed to sync client CA certificate: %w", err)
+			}
+
+			clientCA = secretCA
+			runners = append(runners, secretCA.Run)
+
+		case tlsConfig.ClientAuth == tls.RequireAndVerifyClientCert:
 			clientCA, err = dynamiccertificates.NewDynamicCAContentFromFile("clientCA", c.TLSConfig.ClientCAFile)
 			if err != nil {
 				return nil, fmt.Errorf("failed to load client CA certificate: %w", err)
@@ -210,12 +291,34 @@ func NewServer(logger *slog.Logger, c *Config, handler http.Handler) (*Server, e
 				return nil, fmt.Errorf("failed to sync client CA certificate: %w", err)
 			}
 
-			runners = append(runners, func(ctx context.Context) {
-				clientCA.(*dynamiccertificates.DynamicFileCAContent).Run(ctx, 1)
-			})
+			runners = append(runners,
+				func(ctx context.Context) {
+					clientCA.(*dynamiccertificates.DynamicFileCAContent).Run(ctx, 1)
+				},
+				pollFile(logger, c.TLSConfig.ReloadInterval, clientCA.(*dynamiccertificates.DynamicFileCAContent)),
+			)
 		}
 
-		if c.TLSConfig.CertFile != "" && c.TLSConfig.KeyFile != "" {
+		switch {
+		case c.TLSConfig.CertSecret != "":
+			kclient, err := o.kubernetesClient()
+			if err != nil {
+				return nil, err
+			}
+
+			secretCert, err := newSecretCertKeyContent(logger, kclient, c.TLSConfig.CertSecret)
+			if err != nil {
+				return nil, err
+			}
+
+			if err = secretCert.RunOnce(context.Background()); err != nil {
+				return nil, fmt.Errorf("failed to sync serving certificate: %w", err)
+			}
+
+			servingCert = secretCert
+			runners = append(runners, secretCert.Run)
+
+		case c.TLSConfig.CertFile != "" && c.TLSConfig.KeyFile != "":
 			servingCert, err = dynamiccertificates.NewDynamicServingContentFromFiles("servingCert", c.TLSConfig.CertFile, c.TLSConfig.KeyFile)
 			if err != nil {
 				return nil, fmt.Errorf("failed to load serving certificate and key: %w", err)
@@ -225,9 +328,16 @@ func NewServer(logger *slog.Logger, c *Config, handler http.Handler) (*Server, e
 				return nil, fmt.Errorf("failed to sync serving certificate: %w", err)
 			}
 
-			runners = append(runners, func(ctx context.Context) {
-				servingCert.(*dynamiccertificates.DynamicCertKeyPairContent).Run(ctx, 1)
-			})
+			runners = append(runners,
+				func(ctx context.Context) {
+					servingCert.(*dynamiccertificates.DynamicCertKeyPairContent).Run(ctx, 1)
+				},
+				pollFile(logger, c.TLSConfig.ReloadInterval, servingCert.(*dynamiccertificates.DynamicCertKeyPairContent)),
+			)
+		}
+
+		if servingCert != nil && o.registerer != nil {
+			o.registerer.MustRegister(&certificateCollector{servingCert: servingCert})
 		}
 
 		certController = dynamiccertificates.NewDynamicServingCertificateController(
@@ -282,6 +392,36 @@ func NewServer(logger *slog.Logger, c *Config, handler http.Handler) (*Server, e
 	}, nil
 }
 
+type fileContent interface {
+	Name() string
+	RunOnce(context.Context) error
+}
+
+// pollFile returns a function reloading the file content at the given
+// interval. It complements the file system notifications watched by the
+// content provider whose own polling period isn't configurable.
+func pollFile(logger *slog.Logger, interval time.Duration, fc fileContent) func(context.Context) {
+	return func(ctx context.Context) {
+		if interval <= 0 {
+			return
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := fc.RunOnce(ctx); err != nil {
+					logger.Warn("failed to reload TLS content", "name", fc.Name(), "err", err)
+				}
+			}
+		}
+	}
+}
+
 // Serve starts the web server. It will block until the server is shutted down
 // or an error occurs.
 func (s *Server) Serve(ctx context.Context) error {
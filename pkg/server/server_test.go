@@ -15,10 +15,20 @@
 package server
 
 import (
+	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/cert"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
@@ -98,6 +108,25 @@ func TestConvertTLSConfig(t *testing.T) {
 				require.Equal(t, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA}, c.CipherSuites)
 			},
 		},
+		{
+			c: TLSConfig{
+				Enabled:        true,
+				ClientCASecret: "monitoring/client-ca",
+			},
+			err: true,
+		},
+		{
+			c: TLSConfig{
+				Enabled:        true,
+				CertSecret:     "monitoring/web-tls",
+				ClientCASecret: "monitoring/client-ca",
+			},
+
+			assert: func(t *testing.T, c *tls.Config) {
+				require.NotNil(t, c)
+				require.Equal(t, tls.RequireAndVerifyClientCert, c.ClientAuth)
+			},
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			c, err := tc.c.Convert(nil)
@@ -113,3 +142,59 @@ func TestConvertTLSConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestServingCertificateExpiryMetric(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+
+	writeCert := func(host string) time.Time {
+		crt, key, err := cert.GenerateSelfSignedCertKey(host, nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(certFile, crt, 0o600))
+		require.NoError(t, os.WriteFile(keyFile, key, 0o600))
+
+		block, _ := pem.Decode(crt)
+		leaf, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+
+		return leaf.NotAfter
+	}
+
+	expiry := func(reg *prometheus.Registry) float64 {
+		mfs, err := reg.Gather()
+		require.NoError(t, err)
+		require.Len(t, mfs, 1)
+		require.Len(t, mfs[0].GetMetric(), 1)
+
+		return mfs[0].GetMetric()[0].GetGauge().GetValue()
+	}
+
+	notAfter := writeCert("foo")
+
+	c := DefaultConfig("127.0.0.1:0", true)
+	c.TLSConfig.CertFile = certFile
+	c.TLSConfig.KeyFile = keyFile
+	c.TLSConfig.ClientCAFile = ""
+	c.TLSConfig.ReloadInterval = 100 * time.Millisecond
+
+	reg := prometheus.NewRegistry()
+	srv, err := NewServer(slog.New(slog.DiscardHandler), &c, http.NewServeMux(), WithRegisterer(reg))
+	require.NoError(t, err)
+	require.Equal(t, float64(notAfter.Unix()), expiry(reg))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, srv.Shutdown(context.Background()))
+	})
+	go func() { _ = srv.Serve(ctx) }()
+
+	// The validity of the self-signed certificates is computed from the
+	// current time with a second precision.
+	time.Sleep(time.Second)
+	notAfter = writeCert("bar")
+
+	require.Eventually(t, func() bool {
+		return expiry(reg) == float64(notAfter.Unix())
+	}, 10*time.Second, 100*time.Millisecond)
+}
@@ -20,7 +20,7 @@ import (
 	"log/slog"
 	"reflect"
 	"strings"
-	"time"
+	"sync"
 
 	"github.com/blang/semver/v4"
 	"github.com/mitchellh/hashstructure"
@@ -52,7 +52,6 @@ import (
 )
 
 const (
-	resyncPeriod     = 5 * time.Minute
 	thanosRulerLabel = "thanos-ruler"
 	controllerName   = "thanos-controller"
 	rwConfigFile     = "remote-write.yaml"
@@ -89,8 +88,26 @@ type Operator struct {
 	eventRecorder record.EventRecorder
 
 	config Config
+	// Protects the config field which is updated when the operator
+	// configuration changes.
+	configMtx sync.RWMutex
+
+	// Field manager of the server-side apply requests (empty when disabled).
+	fieldManager string
 
 	configResourcesStatusEnabled bool
+
+	// Reported in the status of the reconciled resources.
+	operatorInfo *monitoringv1.OperatorInfo
+
+	// Limits the number of StatefulSets rolled out concurrently.
+	rolloutBudget *operator.RolloutBudget
+
+	// Deletes the StatefulSets which can't be updated.
+	ssetRecreator *operator.StatefulSetRecreator
+
+	// Splits the objects between the operator replicas.
+	sharder *operator.Sharder
 }
 
 // Config defines the operator's parameters for the Thanos controller.
@@ -102,6 +119,22 @@ type Config struct {
 	ThanosDefaultBaseImage string
 	Annotations            operator.Map
 	Labels                 operator.Map
+	NativeSidecars         bool
+	GoRuntime              operator.GoRuntimeConfig
+	TLSAssetsProjection    bool
+}
+
+func newConfig(c operator.Config) Config {
+	return Config{
+		ReloaderConfig:         c.ReloaderConfig,
+		ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
+		Annotations:            c.Annotations,
+		Labels:                 c.Labels,
+		LocalHost:              c.LocalHost,
+		NativeSidecars:         c.NativeSidecarsEnabled(),
+		GoRuntime:              c.GoRuntime,
+		TLSAssetsProjection:    c.TLSAssetsProjection,
+	}
 }
 
 type ControllerOption func(*Operator)
@@ -114,6 +147,22 @@ func WithStorageClassValidation() ControllerOption {
 	}
 }
 
+// WithRolloutBudget tells the controller to wait for a slot of the budget
+// before updating the StatefulSet.
+func WithRolloutBudget(b *operator.RolloutBudget) ControllerOption {
+	return func(o *Operator) {
+		o.rolloutBudget = b
+	}
+}
+
+// WithSharder tells the controller to reconcile only the objects assigned to
+// the operator replica.
+func WithSharder(s *operator.Sharder) ControllerOption {
+	return func(o *Operator) {
+		o.sharder = s
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -137,39 +186,37 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "thanos"}, r)
 
 	o := &Operator{
-		kclient:         client,
-		mdClient:        mdClient,
-		mclient:         mclient,
-		logger:          logger,
-		accessor:        operator.NewAccessor(logger),
-		metrics:         operator.NewMetrics(r),
-		eventRecorder:   c.EventRecorderFactory(client, controllerName),
-		reconciliations: &operator.ReconciliationTracker{},
-		controllerID:    c.ControllerID,
-		config: Config{
-			ReloaderConfig:         c.ReloaderConfig,
-			ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
-			Annotations:            c.Annotations,
-			Labels:                 c.Labels,
-			LocalHost:              c.LocalHost,
-		},
+		kclient:                      client,
+		mdClient:                     mdClient,
+		mclient:                      mclient,
+		logger:                       logger,
+		accessor:                     operator.NewAccessor(logger),
+		metrics:                      operator.NewMetrics(r),
+		eventRecorder:                c.EventRecorderFactory(client, controllerName),
+		reconciliations:              &operator.ReconciliationTracker{},
+		controllerID:                 c.ControllerID,
+		config:                       newConfig(c),
+		fieldManager:                 c.ApplyFieldManager(),
 		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
+		operatorInfo:                 c.Gates.OperatorInfo(),
 	}
 	for _, opt := range options {
 		opt(o)
 	}
+	o.ssetRecreator = operator.NewStatefulSetRecreator(c.StatefulSetRecreationPolicy, o.metrics, o.eventRecorder)
 
-	o.cmapInfs, err = informers.NewInformersForResource(
+	o.cmapInfs, err = informers.NewInformersForResourceWithTransform(
 		informers.NewMetadataInformerFactory(
 			c.Namespaces.ThanosRulerAllowList,
 			c.Namespaces.DenyList,
 			o.mdClient,
-			resyncPeriod,
+			c.ResyncPeriods.ThanosRuler,
 			func(options *metav1.ListOptions) {
 				options.LabelSelector = labelThanosRulerName
 			},
 		),
 		v1.SchemeGroupVersion.WithResource(string(v1.ResourceConfigMaps)),
+		informers.PartialObjectMetadataStrip,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating configmap informers: %w", err)
@@ -180,7 +227,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.ThanosRulerAllowList,
 			c.Namespaces.DenyList,
 			mclient,
-			resyncPeriod,
+			c.ResyncPeriods.ThanosRuler,
 			func(options *metav1.ListOptions) {
 				options.LabelSelector = c.ThanosRulerSelector.String()
 			},
@@ -205,6 +252,8 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		monitoringv1.ThanosRulerKind,
 		r,
 		o.controllerID,
+		o.sharder,
+		c.Workers.ThanosRuler,
 	)
 
 	o.ruleInfs, err = informers.NewInformersForResource(
@@ -212,7 +261,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.AllowList,
 			c.Namespaces.DenyList,
 			mclient,
-			resyncPeriod,
+			c.ResyncPeriods.ThanosRuler,
 			nil,
 		),
 		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName),
@@ -226,7 +275,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			c.Namespaces.ThanosRulerAllowList,
 			c.Namespaces.DenyList,
 			o.kclient,
-			resyncPeriod,
+			c.ResyncPeriods.ThanosRuler,
 			nil,
 		),
 		appsv1.SchemeGroupVersion.WithResource("statefulsets"),
@@ -252,7 +301,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return cache.NewSharedIndexInformer(
 			o.metrics.NewInstrumentedListerWatcher(lw),
 			&v1.Namespace{},
-			resyncPeriod,
+			c.ResyncPeriods.ThanosRuler,
 			cache.Indexers{},
 		), nil
 	}
@@ -274,8 +323,10 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 	return o, nil
 }
 
-// waitForCacheSync waits for the informers' caches to be synced.
-func (o *Operator) waitForCacheSync(ctx context.Context) error {
+// namedInformers returns the informers of the controller. The informers
+// which haven't been created (e.g. when the CRD isn't installed) are skipped.
+func (o *Operator) namedInformers() operator.NamedInformers {
+	var ni operator.NamedInformers
 	for _, infs := range []struct {
 		name                 string
 		informersForResource *informers.ForResource
@@ -285,22 +336,20 @@ func (o *Operator) waitForCacheSync(ctx context.Context) error {
 		{"PrometheusRule", o.ruleInfs},
 		{"StatefulSet", o.ssetInfs},
 	} {
-		for _, inf := range infs.informersForResource.GetInformers() {
-			if !operator.WaitForNamedCacheSync(ctx, "thanos", o.logger.With("informer", infs.name), inf.Informer()) {
-				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
-			}
-		}
+		ni = ni.AppendForResource(infs.name, infs.informersForResource)
 	}
 
-	for _, inf := range []struct {
-		name     string
-		informer cache.SharedIndexInformer
-	}{
-		{"ThanosRulerNamespace", o.nsThanosRulerInf},
-		{"RuleNamespace", o.nsRuleInf},
-	} {
-		if !operator.WaitForNamedCacheSync(ctx, "thanos", o.logger.With("informer", inf.name), inf.informer) {
-			return fmt.Errorf("failed to sync cache for %s informer", inf.name)
+	return append(ni,
+		operator.NamedInformer{Name: "ThanosRulerNamespace", Informer: o.nsThanosRulerInf},
+		operator.NamedInformer{Name: "RuleNamespace", Informer: o.nsRuleInf},
+	)
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (o *Operator) waitForCacheSync(ctx context.Context) error {
+	for _, inf := range o.namedInformers() {
+		if !operator.WaitForNamedCacheSync(ctx, "thanos", o.logger.With("informer", inf.Name), inf.Informer) {
+			return fmt.Errorf("failed to sync cache for %s informer", inf.Name)
 		}
 	}
 
@@ -308,6 +357,12 @@ func (o *Operator) waitForCacheSync(ctx context.Context) error {
 	return nil
 }
 
+// HealthCheck returns an error if the controller is running and either one of
+// its informers isn't synced or one of its queues is stuck.
+func (o *Operator) HealthCheck(context.Context) error {
+	return o.rr.CheckHealth(o.namedInformers())
+}
+
 // addHandlers adds the eventhandlers to the informers.
 func (o *Operator) addHandlers() {
 	o.thanosRulerInfs.AddEventHandler(o.rr)
@@ -440,6 +495,8 @@ func (o *Operator) Sync(ctx context.Context, key string) error {
 }
 
 func (o *Operator) sync(ctx context.Context, key string) error {
+	config := o.currentConfig()
+
 	tr, err := operator.GetObjectFromKey[*monitoringv1.ThanosRuler](o.thanosRulerInfs, key)
 
 	if err != nil {
@@ -448,6 +505,7 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 
 	if tr == nil {
 		o.reconciliations.ForgetObject(key)
+		o.rolloutBudget.Forget(rolloutOwner(key))
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
@@ -457,7 +515,8 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
-	if tr.Spec.Paused {
+	if operator.IsPaused(tr, tr.Spec.Paused) {
+		o.logger.Info("the resource is paused, not reconciling", "key", key)
 		return nil
 	}
 
@@ -470,16 +529,20 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 
 	ruleConfigMapNames, err := o.createOrUpdateRuleConfigMaps(ctx, tr)
 	if err != nil {
+		o.eventRecorder.Eventf(tr, v1.EventTypeWarning, operator.ConfigGenerationFailedEvent, "Failed to generate the rule files: %v", err)
 		return err
 	}
 
 	assetStore := assets.NewStoreBuilder(o.kclient.CoreV1(), o.kclient.CoreV1())
+	if config.TLSAssetsProjection {
+		assetStore.EnableTLSAssetsProjection(tr.Namespace)
+	}
 
 	if err := o.createOrUpdateRulerConfigSecret(ctx, assetStore, tr); err != nil {
 		return fmt.Errorf("failed to synchronize ruler config secret: %w", err)
 	}
 
-	tlsAssets, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), o.kclient, newTLSAssetSecret(tr, o.config))
+	tlsAssets, err := operator.ReconcileTLSAssets(ctx, assetStore, o.kclient, newTLSAssetSecret(tr, config), o.fieldManager)
 	if err != nil {
 		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
@@ -488,6 +551,27 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 		return fmt.Errorf("failed to synchronize web config secret: %w", err)
 	}
 
+	selfMonitoringNames, err := o.reconcileSelfMonitoring(ctx, tr)
+	if err != nil {
+		return err
+	}
+
+	children := k8sutil.ExpectedChildren{}
+	children.Add(
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourceSecrets)),
+		append(
+			tlsAssets.SecretNames(),
+			rulerConfigSecretName(tr.Name),
+			webConfigSecretName(tr.Name),
+		)...,
+	)
+	children.Add(v1.SchemeGroupVersion.WithResource(string(v1.ResourceConfigMaps)), ruleConfigMapNames...)
+	children.Add(monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ServiceMonitorName), selfMonitoringNames...)
+	children.Add(monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName), selfMonitoringNames...)
+	if err := operator.PruneChildren(ctx, logger, o.mdClient, tr, children); err != nil {
+		return fmt.Errorf("failed to prune obsolete objects: %w", err)
+	}
+
 	svcClient := o.kclient.CoreV1().Services(tr.Namespace)
 	if tr.Spec.ServiceName != nil {
 		selectorLabels := makeSelectorLabels(tr.Name)
@@ -496,7 +580,7 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 		}
 	} else {
 		// Create governing service if it doesn't exist.
-		if _, err = k8sutil.CreateOrUpdateService(ctx, svcClient, makeStatefulSetService(tr, o.config)); err != nil {
+		if _, err = k8sutil.CreateOrUpdateService(ctx, svcClient, makeStatefulSetService(tr, config), o.fieldManager); err != nil {
 			return fmt.Errorf("synchronizing governing service failed: %w", err)
 		}
 	}
@@ -509,9 +593,9 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 
 	if existingStatefulSet == nil {
 		ssetClient := o.kclient.AppsV1().StatefulSets(tr.Namespace)
-		sset, err := makeStatefulSet(tr, o.config, ruleConfigMapNames, "", tlsAssets)
+		sset, err := makeStatefulSet(tr, config, ruleConfigMapNames, "", tlsAssets)
 		if err != nil {
-			return fmt.Errorf("making thanos statefulset config failed: %w", err)
+			return k8sutil.NewInvalidSpecError(fmt.Errorf("making thanos statefulset config failed: %w", err))
 		}
 
 		operator.SanitizeSTS(sset)
@@ -526,42 +610,43 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
-	newSSetInputHash, err := createSSetInputHash(*tr, o.config, tlsAssets, ruleConfigMapNames, existingStatefulSet.Spec)
+	newSSetInputHash, err := createSSetInputHash(*tr, config, tlsAssets, ruleConfigMapNames, existingStatefulSet.Spec)
 	if err != nil {
 		return err
 	}
 
-	sset, err := makeStatefulSet(tr, o.config, ruleConfigMapNames, newSSetInputHash, tlsAssets)
+	sset, err := makeStatefulSet(tr, config, ruleConfigMapNames, newSSetInputHash, tlsAssets)
 	if err != nil {
-		return fmt.Errorf("failed to generate statefulset: %w", err)
+		return k8sutil.NewInvalidSpecError(fmt.Errorf("failed to generate statefulset: %w", err))
 	}
 
 	operator.SanitizeSTS(sset)
 
+	o.reconciliations.SetWarning(key, operator.RolloutPendingReason, "")
 	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationName] {
 		logger.Debug("new statefulset generation inputs match current, skipping any actions", "hash", newSSetInputHash)
+		if operator.StatefulSetRolledOut(existingStatefulSet) {
+			o.rolloutBudget.Release(rolloutOwner(key), sset.Name)
+		}
+		return nil
+	}
+
+	if ok, position := o.rolloutBudget.Acquire(rolloutOwner(key), sset.Name); !ok {
+		logger.Debug("postponing the update of the statefulset until the rollout budget allows it", "position", position)
+		o.reconciliations.SetWarning(key, operator.RolloutPendingReason, o.rolloutBudget.RolloutPendingMessage(sset.Name, position))
+		o.rr.EnqueueForReconciliationAfter(tr, operator.RolloutBudgetRetryInterval)
 		return nil
 	}
 
 	logger.Debug("new hash differs from the existing value", "new", newSSetInputHash, "existing", existingStatefulSet.Annotations[operator.InputHashAnnotationName])
 	ssetClient := o.kclient.AppsV1().StatefulSets(tr.Namespace)
-	err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
-	sErr, ok := err.(*apierrors.StatusError)
-
-	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
-		o.metrics.StsDeleteCreateCounter().Inc()
-
-		// Gather only reason for failed update
-		failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
-		for i, cause := range sErr.ErrStatus.Details.Causes {
-			failMsg[i] = cause.Message
-		}
+	err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset, o.fieldManager)
+	recreated, rErr := o.ssetRecreator.Recreate(ctx, logger, ssetClient, tr, existingStatefulSet, sset, err)
+	if rErr != nil {
+		return rErr
+	}
 
-		logger.Info("recreating ThanosRuler StatefulSet because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
-		propagationPolicy := metav1.DeletePropagationForeground
-		if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
-			return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
-		}
+	if recreated {
 		return nil
 	}
 
@@ -621,8 +706,10 @@ func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
 
 	availableCondition := stsReporter.Update(tr)
 	reconciledCondition := o.reconciliations.GetCondition(key, tr.Generation)
-	tr.Status.Conditions = operator.UpdateConditions(tr.Status.Conditions, availableCondition, reconciledCondition)
-	tr.Status.Paused = tr.Spec.Paused
+	paused := operator.IsPaused(tr, tr.Spec.Paused)
+	tr.Status.Conditions = operator.UpdateConditions(tr.Status.Conditions, availableCondition, reconciledCondition, operator.PausedCondition(paused, tr.Generation))
+	tr.Status.Paused = paused
+	tr.Status.OperatorInfo = o.operatorInfo
 
 	if _, err = o.mclient.MonitoringV1().ThanosRulers(tr.Namespace).ApplyStatus(ctx, applyConfigurationFromThanosRuler(tr), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
 		return fmt.Errorf("failed to apply status subresource: %w", err)
@@ -733,6 +820,8 @@ func (o *Operator) enqueueForNamespace(store cache.Store, nsName string) {
 }
 
 func (o *Operator) createOrUpdateWebConfigSecret(ctx context.Context, tr *monitoringv1.ThanosRuler) error {
+	config := o.currentConfig()
+
 	var fields monitoringv1.WebConfigFileFields
 	if tr.Spec.Web != nil {
 		fields = tr.Spec.Web.WebConfigFileFields
@@ -750,12 +839,12 @@ func (o *Operator) createOrUpdateWebConfigSecret(ctx context.Context, tr *monito
 	s := &v1.Secret{}
 	operator.UpdateObject(
 		s,
-		operator.WithLabels(o.config.Labels),
-		operator.WithAnnotations(o.config.Annotations),
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
 		operator.WithManagingOwner(tr),
 	)
 
-	if err := webConfig.CreateOrUpdateWebConfigSecret(ctx, o.kclient.CoreV1().Secrets(tr.Namespace), s); err != nil {
+	if err := webConfig.CreateOrUpdateWebConfigSecret(ctx, o.kclient.CoreV1().Secrets(tr.Namespace), s, o.fieldManager); err != nil {
 		return fmt.Errorf("failed to update the web config secret: %w", err)
 	}
 
@@ -782,6 +871,10 @@ func applyConfigurationFromThanosRuler(a *monitoringv1.ThanosRuler) *monitoringv
 		)
 	}
 
+	if oi := a.Status.OperatorInfo; oi != nil {
+		trac.WithOperatorInfo(monitoringv1ac.OperatorInfo().WithVersion(oi.Version).WithEnabledFeatureGates(oi.EnabledFeatureGates...))
+	}
+
 	return monitoringv1ac.ThanosRuler(a.Name, a.Namespace).WithStatus(trac)
 }
 
@@ -816,6 +909,8 @@ func makeSelectorLabels(name string) map[string]string {
 }
 
 func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *assets.StoreBuilder, tr *monitoringv1.ThanosRuler) error {
+	config := o.currentConfig()
+
 	sClient := o.kclient.CoreV1().Secrets(tr.GetNamespace())
 
 	s := &v1.Secret{
@@ -825,8 +920,8 @@ func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *a
 	operator.UpdateObject(
 		s,
 		operator.WithName(rulerConfigSecretName(tr.Name)),
-		operator.WithAnnotations(o.config.Annotations),
-		operator.WithLabels(o.config.Labels),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(config.Labels),
 		operator.WithOwner(tr),
 	)
 
@@ -937,9 +1032,40 @@ func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *a
 	}
 	s.Data[rwConfigFile] = rwConfig
 
-	if err = k8sutil.CreateOrUpdateSecret(ctx, sClient, s); err != nil {
+	if err = k8sutil.CreateOrUpdateSecret(ctx, sClient, s, o.fieldManager); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// rolloutOwner returns the identifier of the ThanosRuler object in the rollout
+// budget.
+func rolloutOwner(key string) string {
+	return monitoringv1.ThanosRulerKind + "/" + key
+}
+
+func (o *Operator) currentConfig() Config {
+	o.configMtx.RLock()
+	defer o.configMtx.RUnlock()
+
+	return o.config
+}
+
+// UpdateConfig applies the operator's configuration and reconciles all the
+// ThanosRuler objects when the parameters of the controller have changed.
+func (o *Operator) UpdateConfig(cfg operator.Config) {
+	config := newConfig(cfg)
+
+	o.configMtx.Lock()
+	changed := !reflect.DeepEqual(o.config, config)
+	o.config = config
+	o.configMtx.Unlock()
+
+	if !changed {
+		return
+	}
+
+	o.logger.Info("operator configuration changed, reconciling all the objects")
+	o.rr.EnqueueAll()
+}
@@ -18,15 +18,18 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	namespacelabeler "github.com/prometheus-operator/prometheus-operator/pkg/namespacelabeler"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
@@ -71,9 +74,15 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 		return nil, fmt.Errorf("selecting PrometheusRules failed: %w", err)
 	}
 
+	if rejected > 0 {
+		o.eventRecorder.Eventf(t, v1.EventTypeWarning, operator.RejectedRulesEvent, "%d PrometheusRule(s) were rejected due to invalid configuration", rejected)
+	}
+
 	if tKey, ok := o.accessor.MetaNamespaceKey(t); ok {
 		o.metrics.SetSelectedResources(tKey, monitoringv1.PrometheusRuleKind, len(newRules))
 		o.metrics.SetRejectedResources(tKey, monitoringv1.PrometheusRuleKind, rejected)
+
+		o.reconciliations.SetWarning(tKey, operator.UnsupportedRuleFieldsEvent, promRuleSelector.UnsupportedFieldsWarning())
 	}
 
 	currentConfigMapList, err := cClient.List(ctx, prometheusRulesConfigMapSelector(t.Name))
@@ -102,11 +111,12 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 		return currentConfigMapNames, nil
 	}
 
+	config := o.currentConfig()
 	newConfigMaps, err := makeRulesConfigMaps(
 		t,
 		newRules,
-		operator.WithAnnotations(o.config.Annotations),
-		operator.WithLabels(o.config.Labels),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(config.Labels),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make rules ConfigMaps: %w", err)
@@ -117,37 +127,25 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 		newConfigMapNames = append(newConfigMapNames, cm.Name)
 	}
 
-	if len(currentConfigMaps) == 0 {
-		o.logger.Debug("no PrometheusRule configmap found, creating new one",
-			"namespace", t.Namespace,
-			"thanos", t.Name,
-		)
-		for _, cm := range newConfigMaps {
-			_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
-			if err != nil {
-				return nil, fmt.Errorf("failed to create ConfigMap '%v': %w", cm.Name, err)
-			}
+	o.logger.Debug("updating PrometheusRule",
+		"namespace", t.Namespace,
+		"thanos", t.Name,
+	)
+	for _, cm := range newConfigMaps {
+		if err := k8sutil.CreateOrUpdateConfigMap(ctx, cClient, &cm); err != nil {
+			return nil, fmt.Errorf("failed to create or update ConfigMap %q: %w", cm.Name, err)
 		}
-		return newConfigMapNames, nil
 	}
 
-	// Simply deleting old ConfigMaps and creating new ones for now. Could be
-	// replaced by logic that only deletes obsolete ConfigMaps in the future.
+	// Delete the ConfigMaps which aren't needed anymore (e.g. when the size
+	// of the rules decreases).
 	for _, cm := range currentConfigMaps {
-		err := cClient.Delete(ctx, cm.Name, metav1.DeleteOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to delete current ConfigMap '%v': %w", cm.Name, err)
+		if slices.Contains(newConfigMapNames, cm.Name) {
+			continue
 		}
-	}
 
-	o.logger.Debug("updating PrometheusRule",
-		"namespace", t.Namespace,
-		"thanos", t.Name,
-	)
-	for _, cm := range newConfigMaps {
-		_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to create new ConfigMap '%v': %w", cm.Name, err)
+		if err := cClient.Delete(ctx, cm.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete obsolete ConfigMap %q: %w", cm.Name, err)
 		}
 	}
 
@@ -0,0 +1,203 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// reconcileSelfMonitoring creates or updates the ServiceMonitor and the
+// PrometheusRule monitoring the ThanosRuler instance. It returns the names of
+// the objects which is empty if self-monitoring isn't enabled.
+func (o *Operator) reconcileSelfMonitoring(ctx context.Context, tr *monitoringv1.ThanosRuler) ([]string, error) {
+	if tr.Spec.SelfMonitoring == nil {
+		return nil, nil
+	}
+
+	config := o.currentConfig()
+
+	sm := makeSelfMonitoringServiceMonitor(tr, config)
+	if err := k8sutil.CreateOrUpdateServiceMonitor(ctx, o.mclient.MonitoringV1().ServiceMonitors(tr.Namespace), sm); err != nil {
+		return nil, fmt.Errorf("failed to reconcile the self-monitoring ServiceMonitor: %w", err)
+	}
+
+	rule := makeSelfMonitoringPrometheusRule(tr, config)
+	if err := k8sutil.CreateOrUpdatePrometheusRule(ctx, o.mclient.MonitoringV1().PrometheusRules(tr.Namespace), rule); err != nil {
+		return nil, fmt.Errorf("failed to reconcile the self-monitoring PrometheusRule: %w", err)
+	}
+
+	return []string{prefixedName(tr.Name)}, nil
+}
+
+func selfMonitoringObjectOptions(tr *monitoringv1.ThanosRuler, config Config) []operator.ObjectOption {
+	return []operator.ObjectOption{
+		operator.WithName(prefixedName(tr.Name)),
+		operator.WithNamespace(tr.Namespace),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(config.Labels),
+		operator.WithLabels(tr.Spec.SelfMonitoring.Labels),
+		operator.WithManagingOwner(tr),
+	}
+}
+
+// makeSelfMonitoringServiceMonitor returns a ServiceMonitor scraping the
+// pods of the ThanosRuler instance via the governing service. The job label
+// of the targets is set to the name of the StatefulSet.
+func makeSelfMonitoringServiceMonitor(tr *monitoringv1.ThanosRuler, config Config) *monitoringv1.ServiceMonitor {
+	portName := tr.Spec.PortName
+	if portName == "" {
+		portName = defaultPortName
+	}
+
+	// The custom governing service has no known labels: the ServiceMonitor
+	// selects all the services and keeps only the targets of the governing
+	// service.
+	var selector metav1.LabelSelector
+	if tr.Spec.ServiceName == nil {
+		selector.MatchLabels = map[string]string{"operated-thanos-ruler": "true"}
+	}
+
+	sm := &monitoringv1.ServiceMonitor{
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector: selector,
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					Port:     portName,
+					Interval: ptr.Deref(tr.Spec.SelfMonitoring.Interval, ""),
+					RelabelConfigs: []monitoringv1.RelabelConfig{
+						{
+							Action:       "keep",
+							SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_service_name"},
+							Regex:        ptr.Deref(tr.Spec.ServiceName, governingServiceName),
+						},
+						{
+							Action:       "keep",
+							SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_label_app_kubernetes_io_instance"},
+							Regex:        tr.Name,
+						},
+						{
+							Action:      "replace",
+							TargetLabel: "job",
+							Replacement: ptr.To(prefixedName(tr.Name)),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	operator.UpdateObject(sm, selfMonitoringObjectOptions(tr, config)...)
+
+	return sm
+}
+
+// makeSelfMonitoringPrometheusRule returns the baseline alerting rules for
+// the ThanosRuler instance. The expressions select the series of the
+// instance by their job and namespace labels.
+func makeSelfMonitoringPrometheusRule(tr *monitoringv1.ThanosRuler, config Config) *monitoringv1.PrometheusRule {
+	selector := fmt.Sprintf("job=%q,namespace=%q", prefixedName(tr.Name), tr.Namespace)
+
+	alert := func(name, expr, duration, severity, summary, description string) monitoringv1.Rule {
+		return monitoringv1.Rule{
+			Alert: name,
+			Expr:  intstr.FromString(expr),
+			For:   ptr.To(monitoringv1.Duration(duration)),
+			Labels: map[string]string{
+				"severity": severity,
+			},
+			Annotations: map[string]string{
+				"summary":     summary,
+				"description": description,
+			},
+		}
+	}
+
+	rule := &monitoringv1.PrometheusRule{
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name: prefixedName(tr.Name),
+					Rules: []monitoringv1.Rule{
+						alert(
+							"ThanosRulerDown",
+							fmt.Sprintf("up{%s} == 0", selector),
+							"5m",
+							"critical",
+							"Thanos Ruler is down.",
+							"Thanos Ruler {{ $labels.namespace }}/{{ $labels.pod }} has been unreachable for more than 5 minutes.",
+						),
+						alert(
+							"ThanosRulerRuleEvaluationFailures",
+							fmt.Sprintf(
+								"(sum by (namespace, job, pod) (rate(prometheus_rule_evaluation_failures_total{%[1]s}[5m])) / sum by (namespace, job, pod) (rate(prometheus_rule_evaluations_total{%[1]s}[5m]))) * 100 > 5",
+								selector,
+							),
+							"5m",
+							"critical",
+							"Thanos Ruler is failing to evaluate rules.",
+							"Thanos Ruler {{ $labels.namespace }}/{{ $labels.pod }} is failing to evaluate {{ $value | humanize }}% of the rules.",
+						),
+						alert(
+							"ThanosRulerNoRuleEvaluations",
+							fmt.Sprintf(
+								"sum by (namespace, job, pod) (rate(prometheus_rule_evaluations_total{%[1]s}[5m])) <= 0 and sum by (namespace, job, pod) (thanos_rule_loaded_rules{%[1]s}) > 0",
+								selector,
+							),
+							"5m",
+							"critical",
+							"Thanos Ruler doesn't evaluate rules.",
+							"Thanos Ruler {{ $labels.namespace }}/{{ $labels.pod }} has loaded rules but hasn't evaluated any of them for the last 5 minutes.",
+						),
+						alert(
+							"ThanosRulerQueryWarnings",
+							fmt.Sprintf(
+								"sum by (namespace, job, pod) (rate(thanos_rule_evaluation_with_warnings_total{%s}[5m])) > 0",
+								selector,
+							),
+							"15m",
+							"warning",
+							"Thanos Ruler receives warnings from the query APIs.",
+							"Thanos Ruler {{ $labels.namespace }}/{{ $labels.pod }} evaluates rules with warnings (e.g. partial responses) from the query APIs.",
+						),
+						alert(
+							"ThanosRulerQueryDNSFailures",
+							fmt.Sprintf(
+								"(sum by (namespace, job, pod) (rate(thanos_rule_query_apis_dns_failures_total{%[1]s}[5m])) / sum by (namespace, job, pod) (rate(thanos_rule_query_apis_dns_lookups_total{%[1]s}[5m]))) * 100 > 1",
+								selector,
+							),
+							"15m",
+							"warning",
+							"Thanos Ruler fails to resolve the query APIs.",
+							"Thanos Ruler {{ $labels.namespace }}/{{ $labels.pod }} fails to resolve {{ $value | humanize }}% of the DNS lookups for the query APIs.",
+						),
+					},
+				},
+			},
+		},
+	}
+
+	operator.UpdateObject(rule, selfMonitoringObjectOptions(tr, config)...)
+
+	return rule
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestReconcileSelfMonitoring(t *testing.T) {
+	ctx := context.Background()
+	mclient := monitoringfake.NewSimpleClientset()
+	o := &Operator{
+		mclient: mclient,
+		config: Config{
+			Labels: operator.Map{"operator": "label"},
+		},
+	}
+
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "default",
+		},
+	}
+
+	names, err := o.reconcileSelfMonitoring(ctx, tr)
+	require.NoError(t, err)
+	require.Empty(t, names)
+
+	tr.Spec.SelfMonitoring = &monitoringv1.ThanosRulerSelfMonitoring{
+		Labels:   map[string]string{"team": "observability"},
+		Interval: ptr.To(monitoringv1.Duration("30s")),
+	}
+
+	names, err = o.reconcileSelfMonitoring(ctx, tr)
+	require.NoError(t, err)
+	require.Equal(t, []string{"thanos-ruler-foo"}, names)
+
+	sm, err := mclient.MonitoringV1().ServiceMonitors("default").Get(ctx, "thanos-ruler-foo", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "observability", sm.Labels["team"])
+	require.Equal(t, "label", sm.Labels["operator"])
+	require.Len(t, sm.OwnerReferences, 1)
+	require.Equal(t, map[string]string{"operated-thanos-ruler": "true"}, sm.Spec.Selector.MatchLabels)
+	require.Len(t, sm.Spec.Endpoints, 1)
+	require.Equal(t, "web", sm.Spec.Endpoints[0].Port)
+	require.Equal(t, monitoringv1.Duration("30s"), sm.Spec.Endpoints[0].Interval)
+
+	rule, err := mclient.MonitoringV1().PrometheusRules("default").Get(ctx, "thanos-ruler-foo", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "observability", rule.Labels["team"])
+	require.Empty(t, operator.ValidateRule(rule.Spec))
+	for _, r := range rule.Spec.Groups[0].Rules {
+		require.Contains(t, r.Expr.String(), `job="thanos-ruler-foo",namespace="default"`)
+	}
+
+	// Updating the labels updates the existing objects.
+	tr.Spec.SelfMonitoring.Labels["team"] = "platform"
+	_, err = o.reconcileSelfMonitoring(ctx, tr)
+	require.NoError(t, err)
+
+	sm, err = mclient.MonitoringV1().ServiceMonitors("default").Get(ctx, "thanos-ruler-foo", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "platform", sm.Labels["team"])
+}
+
+func TestSelfMonitoringServiceMonitorCustomServiceName(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			ServiceName:    ptr.To("custom"),
+			PortName:       "http",
+			SelfMonitoring: &monitoringv1.ThanosRulerSelfMonitoring{},
+		},
+	}
+
+	sm := makeSelfMonitoringServiceMonitor(tr, Config{})
+	require.Empty(t, sm.Spec.Selector.MatchLabels)
+	require.Equal(t, "http", sm.Spec.Endpoints[0].Port)
+	require.Equal(t, "custom", sm.Spec.Endpoints[0].RelabelConfigs[0].Regex)
+}
@@ -183,6 +183,7 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 			},
 		},
 	}
+	trEnvVars = append(trEnvVars, config.GoRuntime.EnvVars(tr.Spec.Resources)...)
 
 	trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "label", Value: fmt.Sprintf(`%s="$(POD_NAME)"`, defaultReplicaLabelName)})
 	labels := operator.Map(tr.Spec.Labels)
@@ -322,6 +323,10 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "alert.query-url", Value: tr.Spec.AlertQueryURL})
 	}
 
+	if err := operator.ThanosRulerFlags.ValidateArgs("spec.additionalArgs", version, tr.Spec.AdditionalArgs); err != nil {
+		return nil, err
+	}
+
 	containerArgs, err := operator.BuildArgs(trCLIArgs, tr.Spec.AdditionalArgs)
 	if err != nil {
 		return nil, err
@@ -336,6 +341,9 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 		var (
 			watchedDirectories         []string
 			configReloaderVolumeMounts []v1.VolumeMount
+			reloadClient               operator.ReloadClientFiles
+			reloadClientVols           []v1.Volume
+			reloadClientMounts         []v1.VolumeMount
 		)
 
 		for _, name := range ruleConfigMapNames {
@@ -368,6 +376,14 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 
 			configReloaderWebConfigFile = confArg.Value
 			configReloaderVolumeMounts = append(configReloaderVolumeMounts, configMount...)
+
+			reloadClient, reloadClientVols, reloadClientMounts, err = webConfig.GetReloadClientParameters(tr.Spec.ConfigReloader)
+			if err != nil {
+				return nil, err
+			}
+
+			trVolumes = append(trVolumes, reloadClientVols...)
+			configReloaderVolumeMounts = append(configReloaderVolumeMounts, reloadClientMounts...)
 		}
 
 		additionalContainers = append(
@@ -375,7 +391,9 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 			operator.CreateConfigReloader(
 				"config-reloader",
 				operator.ReloaderConfig(config.ReloaderConfig),
+				operator.ReloaderOverrides(tr.Spec.ConfigReloader),
 				operator.WebConfigFile(configReloaderWebConfigFile),
+				operator.ReloadClient(reloadClient),
 				operator.ReloaderURL(url.URL{
 					Scheme: thanosrulerURIScheme,
 					Host:   config.LocalHost + ":10902",
@@ -469,6 +487,11 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
 	}
 
+	initContainers := tr.Spec.InitContainers
+	if config.NativeSidecars {
+		containers, initContainers = k8sutil.MoveToNativeSidecars(containers, initContainers, "config-reloader")
+	}
+
 	var minReadySeconds int32
 	if tr.Spec.MinReadySeconds != nil {
 		minReadySeconds = int32(*tr.Spec.MinReadySeconds)
@@ -498,7 +521,7 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 				ServiceAccountName:            tr.Spec.ServiceAccountName,
 				TerminationGracePeriodSeconds: ptr.To(ptr.Deref(tr.Spec.TerminationGracePeriodSeconds, defaultTerminationGracePeriodSeconds)),
 				Containers:                    containers,
-				InitContainers:                tr.Spec.InitContainers,
+				InitContainers:                initContainers,
 				Volumes:                       trVolumes,
 				SecurityContext:               tr.Spec.SecurityContext,
 				Tolerations:                   tr.Spec.Tolerations,
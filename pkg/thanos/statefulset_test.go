@@ -746,7 +746,7 @@ func TestPodTemplateConfig(t *testing.T) {
 	imagePullPolicy := v1.PullAlways
 
 	additionalArgs := []monitoringv1.Argument{
-		{Name: "additional.arg", Value: "additional-arg-value"},
+		{Name: "restore-ignored-label", Value: "team"},
 	}
 
 	sset, err := makeStatefulSet(&monitoringv1.ThanosRuler{
@@ -781,7 +781,7 @@ func TestPodTemplateConfig(t *testing.T) {
 	for _, container := range sset.Spec.Template.Spec.Containers {
 		require.Equal(t, imagePullPolicy, container.ImagePullPolicy)
 	}
-	require.Contains(t, sset.Spec.Template.Spec.Containers[0].Args[len(sset.Spec.Template.Spec.Containers[0].Args)-1], "--additional.arg=additional-arg-value")
+	require.Contains(t, sset.Spec.Template.Spec.Containers[0].Args[len(sset.Spec.Template.Spec.Containers[0].Args)-1], "--restore-ignored-label=team")
 	require.Equal(t, "rule", sset.Spec.Template.Spec.Containers[0].Args[0])
 }
 
@@ -81,7 +81,8 @@ func (c Config) GetMountParameters() (monitoringv1.Argument, []v1.Volume, []v1.V
 	tls := c.tlsConfig
 
 	if c.tlsConfig != nil {
-		tlsRefs := NewTLSReferences(c.mountingDir, tls.KeySecret, tls.Cert, tls.ClientCA)
+		cert, keySecret := tls.CertAndKeySecret()
+		tlsRefs := NewTLSReferences(c.mountingDir, keySecret, cert, tls.ClientCA)
 		tlsVolumes, tlsMounts, err := tlsRefs.GetMountParameters(volumePrefix)
 		if err != nil {
 			return monitoringv1.Argument{}, nil, nil, err
@@ -98,7 +99,7 @@ func (c Config) GetMountParameters() (monitoringv1.Argument, []v1.Volume, []v1.V
 // data for the web config file.
 // The format of the web config file is available in the official prometheus documentation:
 // https://prometheus.io/docs/prometheus/latest/configuration/https/#https-and-authentication
-func (c Config) CreateOrUpdateWebConfigSecret(ctx context.Context, secretClient clientv1.SecretInterface, s *v1.Secret) error {
+func (c Config) CreateOrUpdateWebConfigSecret(ctx context.Context, secretClient clientv1.SecretInterface, s *v1.Secret, fieldManager string) error {
 	data, err := c.generateConfigFileContents()
 	if err != nil {
 		return err
@@ -109,7 +110,7 @@ func (c Config) CreateOrUpdateWebConfigSecret(ctx context.Context, secretClient
 		configFile: data,
 	}
 
-	return k8sutil.CreateOrUpdateSecret(ctx, secretClient, s)
+	return k8sutil.CreateOrUpdateSecret(ctx, secretClient, s, fieldManager)
 }
 
 func (c Config) generateConfigFileContents() ([]byte, error) {
@@ -131,20 +132,16 @@ func (c Config) addTLSServerConfigToYaml(cfg yaml.MapSlice) yaml.MapSlice {
 	}
 
 	tlsServerConfig := yaml.MapSlice{}
-	tlsRefs := NewTLSReferences(c.mountingDir, tls.KeySecret, tls.Cert, tls.ClientCA)
+	cert, keySecret := tls.CertAndKeySecret()
+	tlsRefs := NewTLSReferences(c.mountingDir, keySecret, cert, tls.ClientCA)
 
-	switch {
-	case ptr.Deref(tls.CertFile, "") != "":
-		tlsServerConfig = append(tlsServerConfig, yaml.MapItem{Key: "cert_file", Value: *tls.CertFile})
-	case tlsRefs.GetCertMountPath() != "":
-		tlsServerConfig = append(tlsServerConfig, yaml.MapItem{Key: "cert_file", Value: filepath.Join(tlsRefs.GetCertMountPath(), tlsRefs.GetCertFilename())})
+	certFile, keyFile := c.certAndKeyFiles()
+	if certFile != "" {
+		tlsServerConfig = append(tlsServerConfig, yaml.MapItem{Key: "cert_file", Value: certFile})
 	}
 
-	switch {
-	case ptr.Deref(tls.KeyFile, "") != "":
-		tlsServerConfig = append(tlsServerConfig, yaml.MapItem{Key: "key_file", Value: *tls.KeyFile})
-	case tlsRefs.GetKeyMountPath() != "":
-		tlsServerConfig = append(tlsServerConfig, yaml.MapItem{Key: "key_file", Value: filepath.Join(tlsRefs.GetKeyMountPath(), tlsRefs.GetKeyFilename())})
+	if keyFile != "" {
+		tlsServerConfig = append(tlsServerConfig, yaml.MapItem{Key: "key_file", Value: keyFile})
 	}
 
 	if ptr.Deref(tls.ClientAuthType, "") != "" {
@@ -199,6 +196,31 @@ func (c Config) addTLSServerConfigToYaml(cfg yaml.MapSlice) yaml.MapSlice {
 	return append(cfg, yaml.MapItem{Key: "tls_server_config", Value: tlsServerConfig})
 }
 
+// certAndKeyFiles returns the paths of the TLS certificate and private key
+// files of the web server.
+func (c Config) certAndKeyFiles() (string, string) {
+	tls := c.tlsConfig
+	cert, keySecret := tls.CertAndKeySecret()
+	tlsRefs := NewTLSReferences(c.mountingDir, keySecret, cert, tls.ClientCA)
+
+	var certFile, keyFile string
+	switch {
+	case ptr.Deref(tls.CertFile, "") != "":
+		certFile = *tls.CertFile
+	case tlsRefs.GetCertMountPath() != "":
+		certFile = filepath.Join(tlsRefs.GetCertMountPath(), tlsRefs.GetCertFilename())
+	}
+
+	switch {
+	case ptr.Deref(tls.KeyFile, "") != "":
+		keyFile = *tls.KeyFile
+	case tlsRefs.GetKeyMountPath() != "":
+		keyFile = filepath.Join(tlsRefs.GetKeyMountPath(), tlsRefs.GetKeyFilename())
+	}
+
+	return certFile, keyFile
+}
+
 func (c Config) addHTTPServerConfigToYaml(cfg yaml.MapSlice) yaml.MapSlice {
 	http := c.httpConfig
 	if http == nil {
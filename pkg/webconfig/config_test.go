@@ -26,6 +26,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
 )
 
@@ -189,7 +190,7 @@ func TestCreateOrUpdateWebConfigSecret(t *testing.T) {
 				s            = v1.Secret{}
 				secretClient = fake.NewSimpleClientset().CoreV1().Secrets("default")
 			)
-			err = config.CreateOrUpdateWebConfigSecret(context.Background(), secretClient, &s)
+			err = config.CreateOrUpdateWebConfigSecret(context.Background(), secretClient, &s, "")
 			require.NoError(t, err)
 
 			secret, err := secretClient.Get(context.Background(), secretName, metav1.GetOptions{})
@@ -337,3 +338,119 @@ func TestGetMountParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestGetReloadClientParameters(t *testing.T) {
+	webTLSConfig := &monitoringv1.WebTLSConfig{
+		CertificateSecret: ptr.To("web-tls"),
+		ClientCA: monitoringv1.SecretOrConfigMap{
+			ConfigMap: &v1.ConfigMapKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "client-ca"},
+				Key:                  "ca.crt",
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		tlsConfig     *monitoringv1.WebTLSConfig
+		spec          *monitoringv1.ConfigReloaderSpec
+		expectedFiles operator.ReloadClientFiles
+		expectedMount []string
+	}{
+		{
+			name: "no configuration",
+		},
+		{
+			name:      "web TLS without client certificate",
+			tlsConfig: webTLSConfig,
+		},
+		{
+			name: "web TLS requiring client certificates",
+			tlsConfig: func() *monitoringv1.WebTLSConfig {
+				c := webTLSConfig.DeepCopy()
+				c.ClientAuthType = ptr.To("RequireAndVerifyClientCert")
+				return c
+			}(),
+			expectedFiles: operator.ReloadClientFiles{
+				CertFile: "/etc/prometheus/web_config/secret/web-tls-cert/tls.crt",
+				KeyFile:  "/etc/prometheus/web_config/secret/web-tls-key/tls.key",
+			},
+		},
+		{
+			name: "explicit client configuration",
+			tlsConfig: func() *monitoringv1.WebTLSConfig {
+				c := webTLSConfig.DeepCopy()
+				c.ClientAuthType = ptr.To("RequireAndVerifyClientCert")
+				return c
+			}(),
+			spec: &monitoringv1.ConfigReloaderSpec{
+				ReloadClient: &monitoringv1.ConfigReloaderClientConfig{
+					CA: monitoringv1.SecretOrConfigMap{
+						ConfigMap: &v1.ConfigMapKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "server-ca"},
+							Key:                  "ca.crt",
+						},
+					},
+					ServerName:        ptr.To("prometheus.example.com"),
+					CertificateSecret: ptr.To("reloader-tls"),
+					BasicAuth: &monitoringv1.BasicAuth{
+						Username: v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "reloader-auth"},
+							Key:                  "username",
+						},
+						Password: v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "reloader-auth"},
+							Key:                  "password",
+						},
+					},
+				},
+			},
+			expectedFiles: operator.ReloadClientFiles{
+				CAFile:       "/etc/prometheus/web_config/reload-client/configmap/server-ca-ca/ca.crt",
+				CertFile:     "/etc/prometheus/web_config/reload-client/secret/reloader-tls-cert/tls.crt",
+				KeyFile:      "/etc/prometheus/web_config/reload-client/secret/reloader-tls-cert/tls.key",
+				ServerName:   "prometheus.example.com",
+				UsernameFile: "/etc/prometheus/web_config/reload-client/secret/reloader-auth-username/username",
+				PasswordFile: "/etc/prometheus/web_config/reload-client/secret/reloader-auth-password/password",
+			},
+			expectedMount: []string{
+				"/etc/prometheus/web_config/reload-client/configmap/server-ca-ca",
+				"/etc/prometheus/web_config/reload-client/secret/reloader-tls-cert",
+				"/etc/prometheus/web_config/reload-client/secret/reloader-auth-username",
+				"/etc/prometheus/web_config/reload-client/secret/reloader-auth-password",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			webConfig, err := webconfig.New("/etc/prometheus/web_config", "web-config", monitoringv1.WebConfigFileFields{TLSConfig: tc.tlsConfig})
+			require.NoError(t, err)
+
+			files, volumes, mounts, err := webConfig.GetReloadClientParameters(tc.spec)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedFiles, files)
+			require.Len(t, volumes, len(tc.expectedMount))
+			require.Len(t, mounts, len(tc.expectedMount))
+			for i, m := range mounts {
+				require.Equal(t, tc.expectedMount[i], m.MountPath)
+				require.Equal(t, volumes[i].Name, m.Name)
+				require.True(t, m.ReadOnly)
+			}
+		})
+	}
+}
+
+func TestGetReloadClientParametersInvalid(t *testing.T) {
+	webConfig, err := webconfig.New("/etc/prometheus/web_config", "web-config", monitoringv1.WebConfigFileFields{})
+	require.NoError(t, err)
+
+	_, _, _, err = webConfig.GetReloadClientParameters(&monitoringv1.ConfigReloaderSpec{
+		ReloadClient: &monitoringv1.ConfigReloaderClientConfig{
+			CA: monitoringv1.SecretOrConfigMap{
+				Secret:    &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "ca"}, Key: "ca.crt"},
+				ConfigMap: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "ca"}, Key: "ca.crt"},
+			},
+		},
+	})
+	require.Error(t, err)
+}
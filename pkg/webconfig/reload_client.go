@@ -0,0 +1,119 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webconfig
+
+import (
+	"path"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	reloadClientDir          = "reload-client"
+	reloadClientVolumePrefix = "reload-client-"
+)
+
+// clientCertRequiredAuthTypes are the client authentication policies for
+// which the web server rejects the requests without client certificate.
+var clientCertRequiredAuthTypes = []string{"RequireAnyClientCert", "RequireAndVerifyClientCert"}
+
+// GetReloadClientParameters returns the files used by the config-reloader
+// to call the reload endpoint of the web server, as well as the volumes and
+// volume mounts referencing the TLS and basic authentication credentials.
+//
+// When the client certificate isn't defined and the web server requires
+// client certificates, the config-reloader presents the certificate of the
+// web server which is expected to be mounted already (see
+// GetMountParameters()).
+func (c Config) GetReloadClientParameters(spec *monitoringv1.ConfigReloaderSpec) (operator.ReloadClientFiles, []v1.Volume, []v1.VolumeMount, error) {
+	var (
+		files   operator.ReloadClientFiles
+		volumes []v1.Volume
+		mounts  []v1.VolumeMount
+		err     error
+	)
+
+	var rc monitoringv1.ConfigReloaderClientConfig
+	if spec != nil && spec.ReloadClient != nil {
+		rc = *spec.ReloadClient
+	}
+
+	if err := rc.Validate(); err != nil {
+		return files, nil, nil, err
+	}
+
+	refs := &TLSReferences{mountPath: path.Join(c.mountingDir, reloadClientDir)}
+
+	switch {
+	case rc.CA.Secret != nil:
+		mountPath := refs.tlsPathForSelector(rc.CA, "ca")
+		volumes, mounts, err = refs.mountParamsForSecret(volumes, mounts, *rc.CA.Secret, reloadClientVolumePrefix+"secret-ca-", mountPath)
+		if err != nil {
+			return files, nil, nil, err
+		}
+		files.CAFile = path.Join(mountPath, rc.CA.Secret.Key)
+	case rc.CA.ConfigMap != nil:
+		mountPath := refs.tlsPathForSelector(rc.CA, "ca")
+		volumes, mounts, err = refs.mountParamsForConfigmap(volumes, mounts, *rc.CA.ConfigMap, reloadClientVolumePrefix+"configmap-ca-", mountPath)
+		if err != nil {
+			return files, nil, nil, err
+		}
+		files.CAFile = path.Join(mountPath, rc.CA.ConfigMap.Key)
+	}
+
+	files.ServerName = ptr.Deref(rc.ServerName, "")
+
+	switch {
+	case rc.CertificateSecret != nil:
+		// The certificate and the private key are in the same Secret.
+		cert := monitoringv1.SecretOrConfigMap{
+			Secret: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: *rc.CertificateSecret}},
+		}
+		mountPath := refs.tlsPathForSelector(cert, "cert")
+		volumes, mounts, err = refs.mountParamsForSecret(volumes, mounts, *cert.Secret, reloadClientVolumePrefix+"secret-cert-", mountPath)
+		if err != nil {
+			return files, nil, nil, err
+		}
+		files.CertFile = path.Join(mountPath, v1.TLSCertKey)
+		files.KeyFile = path.Join(mountPath, v1.TLSPrivateKeyKey)
+	case c.tlsConfig != nil && slices.Contains(clientCertRequiredAuthTypes, ptr.Deref(c.tlsConfig.ClientAuthType, "")):
+		files.CertFile, files.KeyFile = c.certAndKeyFiles()
+	}
+
+	if rc.BasicAuth != nil {
+		for _, cred := range []struct {
+			name string
+			sel  v1.SecretKeySelector
+			file *string
+		}{
+			{name: "username", sel: rc.BasicAuth.Username, file: &files.UsernameFile},
+			{name: "password", sel: rc.BasicAuth.Password, file: &files.PasswordFile},
+		} {
+			mountPath := refs.tlsPathForSelector(monitoringv1.SecretOrConfigMap{Secret: &cred.sel}, cred.name)
+			volumes, mounts, err = refs.mountParamsForSecret(volumes, mounts, cred.sel, reloadClientVolumePrefix+"secret-"+cred.name+"-", mountPath)
+			if err != nil {
+				return files, nil, nil, err
+			}
+			*cred.file = path.Join(mountPath, cred.sel.Key)
+		}
+	}
+
+	return files, volumes, mounts, nil
+}